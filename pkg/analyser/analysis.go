@@ -34,11 +34,22 @@ type Summary struct {
 	TotalIaCSourceCount uint `json:"total_iac_source_count"`
 }
 
+// AccountSummary breaks the summary down per scanned account,
+// resources missing from the cloud provider cannot be attributed to any account
+type AccountSummary struct {
+	TotalResources int `json:"total_resources"`
+	TotalDrifted   int `json:"total_changed"`
+	TotalUnmanaged int `json:"total_unmanaged"`
+	TotalManaged   int `json:"total_managed"`
+	Coverage       int `json:"coverage"`
+}
+
 type Analysis struct {
 	unmanaged       []*resource.Resource
 	managed         []*resource.Resource
 	deleted         []*resource.Resource
 	differences     []Difference
+	dataSources     []*resource.Resource
 	options         AnalyzerOptions
 	summary         Summary
 	alerts          alerter.Alerts
@@ -60,7 +71,9 @@ type serializableAnalysis struct {
 	Unmanaged       []resource.SerializableResource        `json:"unmanaged"`
 	Deleted         []resource.SerializableResource        `json:"missing"`
 	Differences     []serializableDifference               `json:"differences"`
+	DataSources     []resource.SerializableResource        `json:"data_sources,omitempty"`
 	Coverage        int                                    `json:"coverage"`
+	Accounts        map[string]AccountSummary              `json:"accounts,omitempty"`
 	Alerts          map[string][]alerter.SerializableAlert `json:"alerts"`
 	ProviderName    string                                 `json:"provider_name"`
 	ProviderVersion string                                 `json:"provider_version"`
@@ -97,6 +110,9 @@ func (a Analysis) MarshalJSON() ([]byte, error) {
 			Changelog: di.Changelog,
 		})
 	}
+	for _, d := range a.dataSources {
+		bla.DataSources = append(bla.DataSources, *resource.NewSerializableResource(d))
+	}
 	if len(a.alerts) > 0 {
 		bla.Alerts = make(map[string][]alerter.SerializableAlert)
 		for k, v := range a.alerts {
@@ -107,6 +123,7 @@ func (a Analysis) MarshalJSON() ([]byte, error) {
 	}
 	bla.Summary = a.summary
 	bla.Coverage = a.Coverage()
+	bla.Accounts = a.SummaryByAccount()
 	bla.ProviderName = a.ProviderName
 	bla.ProviderVersion = a.ProviderVersion
 	bla.ScanDuration = uint(a.Duration.Seconds())
@@ -123,20 +140,26 @@ func (a *Analysis) UnmarshalJSON(bytes []byte) error {
 	}
 	for _, u := range bla.Unmanaged {
 		a.AddUnmanaged(&resource.Resource{
-			Id:   u.Id,
-			Type: u.Type,
+			Id:      u.Id,
+			Type:    u.Type,
+			Account: u.Account,
+			Region:  u.Region,
 		})
 	}
 	for _, d := range bla.Deleted {
 		a.AddDeleted(&resource.Resource{
-			Id:   d.Id,
-			Type: d.Type,
+			Id:      d.Id,
+			Type:    d.Type,
+			Account: d.Account,
+			Region:  d.Region,
 		})
 	}
 	for _, m := range bla.Managed {
 		res := &resource.Resource{
-			Id:   m.Id,
-			Type: m.Type,
+			Id:      m.Id,
+			Type:    m.Type,
+			Account: m.Account,
+			Region:  m.Region,
 		}
 		if m.Source != nil {
 			// We loose the source type in the serialization process, for now everything is serialized back to a
@@ -147,6 +170,7 @@ func (a *Analysis) UnmarshalJSON(bytes []byte) error {
 				State:  m.Source.S,
 				Module: m.Source.Ns,
 				Name:   m.Source.Name,
+				Key:    m.Source.Key,
 			}
 		}
 		a.AddManaged(res)
@@ -154,12 +178,30 @@ func (a *Analysis) UnmarshalJSON(bytes []byte) error {
 	for _, di := range bla.Differences {
 		a.AddDifference(Difference{
 			Res: &resource.Resource{
-				Id:   di.Res.Id,
-				Type: di.Res.Type,
+				Id:      di.Res.Id,
+				Type:    di.Res.Type,
+				Account: di.Res.Account,
+				Region:  di.Res.Region,
 			},
 			Changelog: di.Changelog,
 		})
 	}
+	for _, d := range bla.DataSources {
+		res := &resource.Resource{
+			Id:   d.Id,
+			Type: d.Type,
+		}
+		if d.Source != nil {
+			res.Source = &resource.TerraformStateSource{
+				State:  d.Source.S,
+				Module: d.Source.Ns,
+				Name:   d.Source.Name,
+				Key:    d.Source.Key,
+				Mode:   d.Source.Mode,
+			}
+		}
+		a.dataSources = append(a.dataSources, res)
+	}
 	if len(bla.Alerts) > 0 {
 		a.alerts = make(alerter.Alerts)
 		for k, v := range bla.Alerts {
@@ -210,6 +252,11 @@ func (a *Analysis) AddDifference(diffs ...Difference) {
 	a.summary.TotalDrifted += len(diffs)
 }
 
+// SetDataSources records the data sources read from IaC, they are reported but take no part in the analysis
+func (a *Analysis) SetDataSources(dataSources []*resource.Resource) {
+	a.dataSources = dataSources
+}
+
 func (a *Analysis) SetAlerts(alerts alerter.Alerts) {
 	a.alerts = alerts
 }
@@ -229,6 +276,47 @@ func (a *Analysis) Coverage() int {
 	return 0
 }
 
+// SummaryByAccount returns the summary of each scanned account, it is empty when a single account was scanned
+func (a *Analysis) SummaryByAccount() map[string]AccountSummary {
+	summaries := map[string]AccountSummary{}
+	for _, res := range a.managed {
+		if res.Account == "" {
+			continue
+		}
+		summary := summaries[res.Account]
+		summary.TotalManaged++
+		summary.TotalResources++
+		summaries[res.Account] = summary
+	}
+	for _, res := range a.unmanaged {
+		if res.Account == "" {
+			continue
+		}
+		summary := summaries[res.Account]
+		summary.TotalUnmanaged++
+		summary.TotalResources++
+		summaries[res.Account] = summary
+	}
+	for _, difference := range a.differences {
+		if difference.Res.Account == "" {
+			continue
+		}
+		summary := summaries[difference.Res.Account]
+		summary.TotalDrifted++
+		summaries[difference.Res.Account] = summary
+	}
+	if len(summaries) == 0 {
+		return nil
+	}
+	for account, summary := range summaries {
+		if summary.TotalResources > 0 {
+			summary.Coverage = int((float32(summary.TotalManaged) / float32(summary.TotalResources)) * 100.0)
+		}
+		summaries[account] = summary
+	}
+	return summaries
+}
+
 func (a *Analysis) Managed() []*resource.Resource {
 	return a.managed
 }
@@ -237,6 +325,10 @@ func (a *Analysis) Unmanaged() []*resource.Resource {
 	return a.unmanaged
 }
 
+func (a *Analysis) DataSources() []*resource.Resource {
+	return a.dataSources
+}
+
 func (a *Analysis) Deleted() []*resource.Resource {
 	return a.deleted
 }
@@ -1,6 +1,8 @@
 package analyser
 
 import (
+	"fmt"
+
 	"github.com/r3labs/diff/v2"
 	"github.com/snyk/driftctl/pkg/filter"
 	resourceaws "github.com/snyk/driftctl/pkg/resource/aws"
@@ -37,6 +39,23 @@ func (c *ComputedDiffAlert) ShouldIgnoreResource() bool {
 	return false
 }
 
+type AmbiguousResourceAlert struct {
+	resourceType string
+	resourceId   string
+}
+
+func newAmbiguousResourceAlert(res *resource.Resource) *AmbiguousResourceAlert {
+	return &AmbiguousResourceAlert{res.ResourceType(), res.ResourceId()}
+}
+
+func (a *AmbiguousResourceAlert) Message() string {
+	return fmt.Sprintf("%s.%s was found in several accounts or regions and its state does not tell which one it is, it will not be compared with the cloud resource", a.resourceType, a.resourceId)
+}
+
+func (a *AmbiguousResourceAlert) ShouldIgnoreResource() bool {
+	return false
+}
+
 type AnalyzerOptions struct {
 	Deep          bool `json:"deep"`
 	OnlyManaged   bool `json:"only_managed"`
@@ -67,21 +86,37 @@ func (a Analyzer) Analyze(remoteResources, resourcesFromState []*resource.Resour
 
 	haveComputedDiff := false
 	for _, stateRes := range resourcesFromState {
-		i, remoteRes, found := findCorrespondingRes(filteredRemoteResource, stateRes)
+		matches := findCorrespondingRes(filteredRemoteResource, stateRes)
 
 		if a.filter.IsResourceIgnored(stateRes) || a.alerter.IsResourceIgnored(stateRes) {
 			continue
 		}
 
-		if !found {
+		if len(matches) == 0 {
 			if !analysis.Options().OnlyUnmanaged {
 				analysis.AddDeleted(stateRes)
 			}
 			continue
 		}
 
+		i := matches[0]
+		remoteRes := filteredRemoteResource[i]
 		// Remove managed resources, so it will remain only unmanaged ones
 		filteredRemoteResource = removeResourceByIndex(i, filteredRemoteResource)
+
+		// The resource is managed, but we can't tell which of the remote ones it is
+		if len(matches) > 1 {
+			a.alerter.SendAlert(fmt.Sprintf("%s.%s", stateRes.ResourceType(), stateRes.ResourceId()), newAmbiguousResourceAlert(stateRes))
+			analysis.AddManaged(stateRes)
+			continue
+		}
+		// IaC resources do not know the account and region they were deployed to
+		if stateRes.Account == "" {
+			stateRes.Account = remoteRes.Account
+		}
+		if stateRes.Region == "" {
+			stateRes.Region = remoteRes.Region
+		}
 		analysis.AddManaged(stateRes)
 
 		// Stop there if we are not in deep mode, we do not want to compute diffs
@@ -94,6 +129,12 @@ func (a Analyzer) Analyze(remoteResources, resourcesFromState []*resource.Resour
 			continue
 		}
 
+		// Stop if the resource comes from a CloudFormation or Pulumi stack, we only know its identifier
+		switch stateRes.Source.(type) {
+		case *resource.CloudformationStackSource, *resource.PulumiStackSource:
+			continue
+		}
+
 		var delta diff.Changelog
 		delta, _ = diff.Diff(stateRes.Attributes(), remoteRes.Attributes())
 
@@ -147,13 +188,36 @@ func (a Analyzer) Analyze(remoteResources, resourcesFromState []*resource.Resour
 	return analysis, nil
 }
 
-func findCorrespondingRes(resources []*resource.Resource, res *resource.Resource) (int, *resource.Resource, bool) {
+// findCorrespondingRes returns the indexes of the remote resources matching a state one. The same identifier may be
+// found in several accounts or regions, in which case only the remote resources of the account and region of the
+// state resource are kept, several indexes are returned when they can't be told apart.
+func findCorrespondingRes(resources []*resource.Resource, res *resource.Resource) []int {
+	matches := make([]int, 0, 1)
 	for i, r := range resources {
 		if res.Equal(r) {
-			return i, r, true
+			matches = append(matches, i)
+		}
+	}
+	if len(matches) <= 1 {
+		return matches
+	}
+
+	account, region := res.Scope()
+	if account == "" && region == "" {
+		return matches
+	}
+	scoped := make([]int, 0, 1)
+	for _, i := range matches {
+		r := resources[i]
+		if account != "" && r.Account != "" && r.Account != account {
+			continue
+		}
+		if region != "" && r.Region != "" && r.Region != region {
+			continue
 		}
+		scoped = append(scoped, i)
 	}
-	return -1, nil, false
+	return scoped
 }
 
 func removeResourceByIndex(i int, resources []*resource.Resource) []*resource.Resource {
@@ -18,6 +18,7 @@ import (
 	"github.com/snyk/driftctl/pkg/alerter"
 	"github.com/snyk/driftctl/pkg/resource"
 	"github.com/snyk/driftctl/pkg/resource/aws"
+	"github.com/snyk/driftctl/pkg/resource/google"
 
 	"github.com/r3labs/diff/v2"
 )
@@ -70,6 +71,41 @@ func TestAnalyze(t *testing.T) {
 			},
 			hasDrifted: true,
 		},
+		{
+			name: "TestCloudformationResourcesAreNotDiffed",
+			iac: []*resource.Resource{
+				{
+					Id:     "foobar",
+					Type:   "aws_s3_bucket",
+					Attrs:  &resource.Attributes{},
+					Source: resource.NewCloudformationStackSource("cfn://my-stack", "Bucket"),
+				},
+			},
+			cloud: []*resource.Resource{
+				{
+					Id:   "foobar",
+					Type: "aws_s3_bucket",
+					Attrs: &resource.Attributes{
+						"bucket": "foobar",
+					},
+				},
+			},
+			expected: Analysis{
+				managed: []*resource.Resource{
+					{
+						Id:     "foobar",
+						Type:   "aws_s3_bucket",
+						Attrs:  &resource.Attributes{},
+						Source: resource.NewCloudformationStackSource("cfn://my-stack", "Bucket"),
+					},
+				},
+				summary: Summary{
+					TotalResources: 1,
+					TotalManaged:   1,
+				},
+			},
+			hasDrifted: false,
+		},
 		{
 			name: "TestResourceIgnoredDeleted",
 			iac: []*resource.Resource{
@@ -1263,6 +1299,205 @@ func TestAnalyze(t *testing.T) {
 	}
 }
 
+func TestAnalyze_ResourcesInSeveralScopes(t *testing.T) {
+	remoteTable := func(account, region, billingMode string) *resource.Resource {
+		return &resource.Resource{
+			Id:      "users",
+			Type:    aws.AwsDynamodbTableResourceType,
+			Account: account,
+			Region:  region,
+			Attrs: &resource.Attributes{
+				"arn":          "arn:aws:dynamodb:" + region + ":" + account + ":table/users",
+				"billing_mode": billingMode,
+			},
+		}
+	}
+
+	cases := []struct {
+		name              string
+		iac               []*resource.Resource
+		cloud             []*resource.Resource
+		expectedManaged   []*resource.Resource
+		expectedUnmanaged []*resource.Resource
+		expectedDeleted   []*resource.Resource
+		expectedAlerts    alerter.Alerts
+	}{
+		{
+			name: "state resource scoped by its ARN",
+			iac: []*resource.Resource{
+				{
+					Id:   "users",
+					Type: aws.AwsDynamodbTableResourceType,
+					Attrs: &resource.Attributes{
+						"arn":          "arn:aws:dynamodb:eu-west-1:222222222222:table/users",
+						"billing_mode": "PROVISIONED",
+					},
+				},
+			},
+			cloud: []*resource.Resource{
+				remoteTable("111111111111", "eu-west-1", "PAY_PER_REQUEST"),
+				remoteTable("222222222222", "eu-west-1", "PROVISIONED"),
+			},
+			expectedManaged: []*resource.Resource{
+				{
+					Id:      "users",
+					Type:    aws.AwsDynamodbTableResourceType,
+					Account: "222222222222",
+					Region:  "eu-west-1",
+					Attrs: &resource.Attributes{
+						"arn":          "arn:aws:dynamodb:eu-west-1:222222222222:table/users",
+						"billing_mode": "PROVISIONED",
+					},
+				},
+			},
+			expectedUnmanaged: []*resource.Resource{
+				remoteTable("111111111111", "eu-west-1", "PAY_PER_REQUEST"),
+			},
+			expectedAlerts: alerter.Alerts{},
+		},
+		{
+			name: "state resource without scope",
+			iac: []*resource.Resource{
+				{
+					Id:   "users",
+					Type: aws.AwsDynamodbTableResourceType,
+					Attrs: &resource.Attributes{
+						"billing_mode": "PROVISIONED",
+					},
+				},
+			},
+			cloud: []*resource.Resource{
+				remoteTable("111111111111", "eu-west-1", "PAY_PER_REQUEST"),
+				remoteTable("222222222222", "eu-west-1", "PROVISIONED"),
+			},
+			expectedManaged: []*resource.Resource{
+				{
+					Id:   "users",
+					Type: aws.AwsDynamodbTableResourceType,
+					Attrs: &resource.Attributes{
+						"billing_mode": "PROVISIONED",
+					},
+				},
+			},
+			expectedUnmanaged: []*resource.Resource{
+				remoteTable("222222222222", "eu-west-1", "PROVISIONED"),
+			},
+			expectedAlerts: alerter.Alerts{
+				"aws_dynamodb_table.users": {
+					newAmbiguousResourceAlert(&resource.Resource{Id: "users", Type: aws.AwsDynamodbTableResourceType}),
+				},
+			},
+		},
+		{
+			name: "state resource scoped by its GCP project",
+			iac: []*resource.Resource{
+				{
+					Id:   "assets",
+					Type: google.GoogleStorageBucketResourceType,
+					Attrs: &resource.Attributes{
+						"project":       "driftctl-qa-2",
+						"storage_class": "STANDARD",
+					},
+				},
+			},
+			cloud: []*resource.Resource{
+				{
+					Id:      "assets",
+					Type:    google.GoogleStorageBucketResourceType,
+					Account: "driftctl-qa-1",
+					Attrs: &resource.Attributes{
+						"project":       "driftctl-qa-1",
+						"storage_class": "NEARLINE",
+					},
+				},
+				{
+					Id:      "assets",
+					Type:    google.GoogleStorageBucketResourceType,
+					Account: "driftctl-qa-2",
+					Attrs: &resource.Attributes{
+						"project":       "driftctl-qa-2",
+						"storage_class": "STANDARD",
+					},
+				},
+			},
+			expectedManaged: []*resource.Resource{
+				{
+					Id:      "assets",
+					Type:    google.GoogleStorageBucketResourceType,
+					Account: "driftctl-qa-2",
+					Attrs: &resource.Attributes{
+						"project":       "driftctl-qa-2",
+						"storage_class": "STANDARD",
+					},
+				},
+			},
+			expectedUnmanaged: []*resource.Resource{
+				{
+					Id:      "assets",
+					Type:    google.GoogleStorageBucketResourceType,
+					Account: "driftctl-qa-1",
+					Attrs: &resource.Attributes{
+						"project":       "driftctl-qa-1",
+						"storage_class": "NEARLINE",
+					},
+				},
+			},
+			expectedAlerts: alerter.Alerts{},
+		},
+		{
+			name: "state resource of an account that was not scanned",
+			iac: []*resource.Resource{
+				{
+					Id:   "users",
+					Type: aws.AwsDynamodbTableResourceType,
+					Attrs: &resource.Attributes{
+						"arn": "arn:aws:dynamodb:eu-west-1:333333333333:table/users",
+					},
+				},
+			},
+			cloud: []*resource.Resource{
+				remoteTable("111111111111", "eu-west-1", "PAY_PER_REQUEST"),
+				remoteTable("222222222222", "eu-west-1", "PROVISIONED"),
+			},
+			expectedUnmanaged: []*resource.Resource{
+				remoteTable("111111111111", "eu-west-1", "PAY_PER_REQUEST"),
+				remoteTable("222222222222", "eu-west-1", "PROVISIONED"),
+			},
+			expectedDeleted: []*resource.Resource{
+				{
+					Id:   "users",
+					Type: aws.AwsDynamodbTableResourceType,
+					Attrs: &resource.Attributes{
+						"arn": "arn:aws:dynamodb:eu-west-1:333333333333:table/users",
+					},
+				},
+			},
+			expectedAlerts: alerter.Alerts{},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			testFilter := &filter.MockFilter{}
+			testFilter.On("IsResourceIgnored", mock.Anything).Return(false)
+			testFilter.On("IsFieldIgnored", mock.Anything, mock.Anything).Return(false)
+
+			analyzer := NewAnalyzer(alerter.NewAlerter(), AnalyzerOptions{Deep: true}, testFilter)
+			result, err := analyzer.Analyze(c.cloud, c.iac)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			assert.Equal(t, c.expectedManaged, result.Managed())
+			assert.Equal(t, c.expectedUnmanaged, result.Unmanaged())
+			assert.Equal(t, c.expectedDeleted, result.Deleted())
+			// Drifts are only computed against the remote resource of the same account and region
+			assert.Empty(t, result.Differences())
+			assert.Equal(t, c.expectedAlerts, result.Alerts())
+		})
+	}
+}
+
 func addSchemaToRes(res *resource.Resource, repo resource.SchemaRepositoryInterface) {
 	schema, _ := repo.GetSchema(res.ResourceType())
 	res.Sch = schema
@@ -82,7 +82,7 @@ func TestDriftctlCmd_Scan(t *testing.T) {
 			env: map[string]string{
 				"DCTL_FROM": "test",
 			},
-			err: fmt.Errorf("Unable to parse from flag 'test': \nAccepted schemes are: tfstate://,tfstate+s3://,tfstate+http://,tfstate+https://,tfstate+tfcloud://,tfstate+gs://,tfstate+azurerm://"),
+			err: fmt.Errorf("Unable to parse from flag 'test': \nAccepted schemes are: tfstate://,tfplan://,cfn://,pulumi://,tfstate+s3://,tfstate+http://,tfstate+https://,tfstate+tfcloud://,tfstate+gs://,tfstate+azurerm://,tfstate+consul://,tfstate+pg://,tfstate+kubernetes://,tfstate+stdin://,tfstate+tfexec://,tfstate+terragrunt://,tfplan+s3://,tfplan+http://,tfplan+https://,tfplan+tfcloud://,tfplan+gs://,tfplan+azurerm://,tfplan+consul://,tfplan+pg://,tfplan+kubernetes://,tfplan+stdin://,cfn+file://,pulumi+s3://,pulumi+http://,pulumi+https://,pulumi+tfcloud://,pulumi+gs://,pulumi+azurerm://,pulumi+consul://,pulumi+pg://,pulumi+kubernetes://,pulumi+stdin://"),
 		},
 		{
 			env: map[string]string{
@@ -15,10 +15,13 @@ import (
 func parseFromFlag(from []string) ([]config.SupplierConfig, error) {
 
 	configs := make([]config.SupplierConfig, 0, len(from))
+	readsStdin := false
 
 	for _, flag := range from {
 		schemePath := strings.Split(flag, "://")
-		if len(schemePath) != 2 || schemePath[1] == "" || schemePath[0] == "" {
+		// States piped into driftctl do not have any path
+		isStdin := len(schemePath) == 2 && strings.HasSuffix(schemePath[0], "+"+backend.BackendKeyStdin)
+		if len(schemePath) != 2 || (schemePath[1] == "" && !isStdin) || schemePath[0] == "" {
 			return nil, errors.Wrapf(
 				cmderrors.NewUsageError(
 					fmt.Sprintf(
@@ -60,15 +63,26 @@ func parseFromFlag(from []string) ([]config.SupplierConfig, error) {
 			)
 		}
 
+		if isStdin {
+			if readsStdin {
+				return nil, errors.Wrapf(
+					cmderrors.NewUsageError("\nOnly one IaC source can be piped into driftctl"),
+					"Unable to parse from flag '%s'",
+					flag,
+				)
+			}
+			readsStdin = true
+		}
+
 		backendString := ""
 		if len(supplierBackend) == 2 {
 			backendString = supplierBackend[1]
-			if !backend.IsSupported(backendString) {
+			if !supplier.IsBackendSupported(supplierKey, backendString) {
 				return nil, errors.Wrapf(
 					cmderrors.NewUsageError(
 						fmt.Sprintf(
 							"\nAccepted values are: %s",
-							strings.Join(backend.GetSupportedBackends(), ","),
+							strings.Join(supplier.GetSupportedBackends(supplierKey), ","),
 						),
 					),
 					"Unsupported IaC backend '%s'",
@@ -77,10 +91,26 @@ func parseFromFlag(from []string) ([]config.SupplierConfig, error) {
 			}
 		}
 
+		path, sourceOptions, err := backend.ParseSourceOptions(backendString, path)
+		if err != nil {
+			return nil, errors.Wrapf(
+				cmderrors.NewUsageError(
+					fmt.Sprintf(
+						"\nAccepted source options are: %s",
+						strings.Join(backend.GetSupportedSourceOptions(), ","),
+					),
+				),
+				"Unable to parse from flag '%s': %s",
+				flag,
+				err,
+			)
+		}
+
 		configs = append(configs, config.SupplierConfig{
 			Key:     supplierKey,
 			Backend: backendString,
 			Path:    path,
+			Options: sourceOptions,
 		})
 	}
 
@@ -52,6 +52,127 @@ func Test_parseFromFlag(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "test cloudformation from parsing",
+			args: args{
+				from: []string{"cfn://my-stack", "cfn+file://stack-resources.json"},
+			},
+			want: []config.SupplierConfig{
+				{
+					Key:     "cfn",
+					Backend: "",
+					Path:    "my-stack",
+				},
+				{
+					Key:     "cfn",
+					Backend: "file",
+					Path:    "stack-resources.json",
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "test stdin from parsing",
+			args: args{
+				from: []string{"tfstate+stdin://", "tfstate+tfexec://infra#production"},
+			},
+			want: []config.SupplierConfig{
+				{
+					Key:     "tfstate",
+					Backend: "stdin",
+					Path:    "",
+				},
+				{
+					Key:     "tfstate",
+					Backend: "tfexec",
+					Path:    "infra#production",
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "test multiple stdin from parsing",
+			args: args{
+				from: []string{"tfstate+stdin://", "tfplan+stdin://"},
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "test from parsing with source options",
+			args: args{
+				from: []string{
+					"tfstate+s3://bucket/path/to/state.tfstate?profile=prod&region=eu-west-1",
+					"tfstate+https://example.com/state.tfstate?X-Amz-Signature=abc&header.Authorization=Bearer%20token",
+				},
+			},
+			want: []config.SupplierConfig{
+				{
+					Key:     "tfstate",
+					Backend: "s3",
+					Path:    "bucket/path/to/state.tfstate",
+					Options: map[string]string{"profile": "prod", "region": "eu-west-1"},
+				},
+				{
+					Key:     "tfstate",
+					Backend: "https",
+					Path:    "example.com/state.tfstate?X-Amz-Signature=abc",
+					Options: map[string]string{"header.Authorization": "Bearer token"},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "test from parsing with unsupported source option",
+			args: args{
+				from: []string{"tfstate+s3://bucket/path/to/state.tfstate?token=secret"},
+			},
+			want: []config.SupplierConfig{
+				{
+					Key:     "tfstate",
+					Backend: "s3",
+					Path:    "bucket/path/to/state.tfstate?token=secret",
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "test from parsing with glob wildcards",
+			args: args{
+				from: []string{
+					"tfstate://states/env-?.tfstate",
+					"tfstate+s3://bucket/env-?/terraform.tfstate",
+					"tfstate+s3://bucket/env-?/terraform.tfstate?region=eu-west-1",
+				},
+			},
+			want: []config.SupplierConfig{
+				{
+					Key:     "tfstate",
+					Backend: "",
+					Path:    "states/env-?.tfstate",
+				},
+				{
+					Key:     "tfstate",
+					Backend: "s3",
+					Path:    "bucket/env-?/terraform.tfstate",
+				},
+				{
+					Key:     "tfstate",
+					Backend: "s3",
+					Path:    "bucket/env-?/terraform.tfstate",
+					Options: map[string]string{"region": "eu-west-1"},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "test tfexec backend for a plan",
+			args: args{
+				from: []string{"tfplan+tfexec://infra"},
+			},
+			want:    nil,
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
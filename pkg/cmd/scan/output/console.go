@@ -87,6 +87,9 @@ func (c *Console) Write(analysis *analyser.Analysis) error {
 			fmt.Printf("  %s:\n", ty)
 			for _, res := range unmanagedByType[ty] {
 				humanString := fmt.Sprintf("    - %s", res.ResourceId())
+				if location := formatResourceLocation(res); location != "" {
+					humanString += fmt.Sprintf(" (%s)", location)
+				}
 				if humanAttrs := formatResourceAttributes(res); humanAttrs != "" {
 					humanString += fmt.Sprintf("\n        %s", humanAttrs)
 				}
@@ -227,6 +230,29 @@ func (c Console) writeSummary(analysis *analyser.Analysis) {
 			fmt.Printf(" - %s resource(s) found in a Terraform state but missing on the cloud provider\n", deleted)
 		}
 	}
+	if summaries := analysis.SummaryByAccount(); len(summaries) > 0 {
+		accounts := make([]string, 0, len(summaries))
+		for account := range summaries {
+			accounts = append(accounts, account)
+		}
+		sort.Strings(accounts)
+		fmt.Println(" - per account:")
+		for _, account := range accounts {
+			summary := summaries[account]
+			line := fmt.Sprintf(
+				"     - %s: %s resource(s), %s%% coverage, %d managed, %d not managed",
+				account,
+				boldWriter.Sprintf("%d", summary.TotalResources),
+				boldWriter.Sprintf("%d", summary.Coverage),
+				summary.TotalManaged,
+				summary.TotalUnmanaged,
+			)
+			if analysis.Options().Deep {
+				line += fmt.Sprintf(", %d out of sync", summary.TotalDrifted)
+			}
+			fmt.Println(line)
+		}
+	}
 	if analysis.IsSync() {
 		fmt.Println(color.GreenString("Congrats! Your infrastructure is fully in sync."))
 	}
@@ -280,6 +306,17 @@ func jsonDiff(a, b interface{}, coloring bool) string {
 	return diffStr
 }
 
+// formatResourceLocation returns the account and region a resource was found in, when known
+func formatResourceLocation(res *resource.Resource) string {
+	location := make([]string, 0, 2)
+	for _, value := range []string{res.Account, res.Region} {
+		if value != "" {
+			location = append(location, value)
+		}
+	}
+	return strings.Join(location, ", ")
+}
+
 func formatResourceAttributes(res *resource.Resource) string {
 	if res.Schema() == nil || res.Schema().HumanReadableAttributesFunc == nil {
 		return ""
@@ -40,6 +40,22 @@ func TestJSON_Write(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name:       "test json output with resource instance keys",
+			goldenfile: "output_instance_keys.json",
+			args: args{
+				analysis: fakeAnalysisForJSONPlan(),
+			},
+			wantErr: false,
+		},
+		{
+			name:       "test json output with accounts",
+			goldenfile: "output_accounts.json",
+			args: args{
+				analysis: fakeAnalysisWithAccounts(),
+			},
+			wantErr: false,
+		},
 		{
 			name:       "test json output with AWS enumeration alerts",
 			goldenfile: "output_access_denied_alert_aws.json",
@@ -457,7 +457,46 @@ func fakeAnalysisForJSONPlan() *analyser.Analysis {
 				"name": "Second managed resource",
 			},
 		},
+		&resource.Resource{
+			Id:   "managed-id-3",
+			Type: "aws_managed_resource",
+			Attrs: &resource.Attributes{
+				"name": "Managed resource from a count",
+			},
+			Source: &resource.TerraformStateSource{
+				State:  "tfstate://terraform.tfstate",
+				Module: "module.managed",
+				Name:   "counted",
+				Key:    "[3]",
+			},
+		},
+		&resource.Resource{
+			Id:   "managed-id-4",
+			Type: "aws_managed_resource",
+			Attrs: &resource.Attributes{
+				"name": "Managed resource from a for_each",
+			},
+			Source: &resource.TerraformStateSource{
+				State: "tfstate://terraform.tfstate",
+				Name:  "iterated",
+				Key:   `["eu-west-1"]`,
+			},
+		},
 	)
+	a.SetDataSources([]*resource.Resource{
+		{
+			Id:   "ami-0d3f551818b21ed81",
+			Type: "aws_ami",
+			Attrs: &resource.Attributes{
+				"name": "ubuntu/images/hvm-ssd/ubuntu-focal-20.04-amd64-server-20201026",
+			},
+			Source: &resource.TerraformStateSource{
+				State: "tfstate://terraform.tfstate",
+				Name:  "ubuntu",
+				Mode:  resource.TerraformDataMode,
+			},
+		},
+	})
 	a.ProviderName = "AWS"
 	a.ProviderVersion = "3.19.0"
 	return &a
@@ -480,6 +519,49 @@ func fakeAnalysisWithoutDeep() *analyser.Analysis {
 	return &a
 }
 
+func fakeAnalysisWithAccounts() *analyser.Analysis {
+	a := analyser.Analysis{}
+	a.Date = time.Date(2022, 4, 8, 10, 35, 0, 0, time.UTC)
+	a.AddManaged(
+		&resource.Resource{
+			Id:      "AROA5QYBVVD25KFDRL6TU",
+			Type:    "aws_iam_role",
+			Account: "111111111111",
+			Source:  resource.NewTerraformStateSource("tfstate://terraform.tfstate", "", "role"),
+		},
+		&resource.Resource{
+			Id:      "i-0b3b1f2c4d5e6f7a8",
+			Type:    "aws_instance",
+			Account: "222222222222",
+			Region:  "eu-west-1",
+			Source:  resource.NewTerraformStateSource("tfstate://terraform.tfstate", "", "web"),
+		},
+	)
+	a.AddUnmanaged(
+		&resource.Resource{
+			Id:      "AROA5QYBVVD25KFDRL6TV",
+			Type:    "aws_iam_role",
+			Account: "222222222222",
+		},
+		&resource.Resource{
+			Id:      "i-0c4c2a3d5e6f7a8b9",
+			Type:    "aws_instance",
+			Account: "222222222222",
+			Region:  "us-east-1",
+		},
+	)
+	a.AddDeleted(
+		&resource.Resource{
+			Id:     "deleted-bucket",
+			Type:   "aws_s3_bucket",
+			Source: resource.NewTerraformStateSource("tfstate://terraform.tfstate", "", "bucket"),
+		},
+	)
+	a.ProviderName = "AWS"
+	a.ProviderVersion = "3.19.0"
+	return &a
+}
+
 func fakeAnalysisWithOnlyManagedFlag() *analyser.Analysis {
 	a := analyser.Analysis{}
 	a.Date = time.Date(2022, 4, 8, 10, 35, 0, 0, time.UTC)
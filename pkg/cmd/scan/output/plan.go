@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"strings"
 
 	"github.com/snyk/driftctl/pkg/analyser"
 	"github.com/snyk/driftctl/pkg/resource"
@@ -24,10 +25,12 @@ type plannedValues struct {
 }
 
 type rscChange struct {
-	Address string `json:"address,omitempty"`
-	Type    string `json:"type,omitempty"`
-	Name    string `json:"name,omitempty"`
-	Change  change `json:"change,omitempty"`
+	Address       string      `json:"address,omitempty"`
+	ModuleAddress string      `json:"module_address,omitempty"`
+	Type          string      `json:"type,omitempty"`
+	Name          string      `json:"name,omitempty"`
+	Index         interface{} `json:"index,omitempty"`
+	Change        change      `json:"change,omitempty"`
 }
 
 type change struct {
@@ -42,11 +45,56 @@ type module struct {
 
 type rsc struct {
 	Address         string                 `json:"address,omitempty"`
+	Mode            string                 `json:"mode,omitempty"`
+	ModuleAddress   string                 `json:"module_address,omitempty"`
 	Type            string                 `json:"type,omitempty"`
 	Name            string                 `json:"name,omitempty"`
+	Index           interface{}            `json:"index,omitempty"`
 	AttributeValues map[string]interface{} `json:"values,omitempty"`
 }
 
+// rscAddress identifies a resource in the plan, resources read from a terraform state keep
+// their address in the state while the others are addressed by their id
+type rscAddress struct {
+	address string
+	mode    string
+	module  string
+	name    string
+	index   interface{}
+}
+
+func newRscAddress(res *resource.Resource) rscAddress {
+	source, ok := res.Source.(*resource.TerraformStateSource)
+	if !ok {
+		return rscAddress{
+			address: fmt.Sprintf("%s.%s", res.ResourceType(), res.ResourceId()),
+			name:    res.ResourceId(),
+		}
+	}
+	return rscAddress{
+		address: res.SourceString(),
+		mode:    source.Mode,
+		module:  source.Module,
+		name:    source.Name,
+		index:   instanceIndex(source.Key),
+	}
+}
+
+// instanceIndex turns a formatted instance key back to the index terraform outputs in plans,
+// a number for count and a string for for_each
+func instanceIndex(key string) interface{} {
+	if key == "" {
+		return nil
+	}
+	decoder := json.NewDecoder(strings.NewReader(strings.TrimSuffix(strings.TrimPrefix(key, "["), "]")))
+	decoder.UseNumber()
+	var index interface{}
+	if err := decoder.Decode(&index); err != nil {
+		return key
+	}
+	return index
+}
+
 type Plan struct {
 	path string
 }
@@ -81,18 +129,24 @@ func (c *Plan) Write(analysis *analyser.Analysis) error {
 func addPlannedValues(analysis *analyser.Analysis) module {
 	managedRsc := listRsc(analysis.Managed())
 	unmanagedRsc := listRsc(analysis.Unmanaged())
+	// Data sources are not part of the analysis, they are given as is to keep the plan complete
+	dataRsc := listRsc(analysis.DataSources())
 	return module{
-		Resources: append(managedRsc, unmanagedRsc...),
+		Resources: append(append(managedRsc, unmanagedRsc...), dataRsc...),
 	}
 }
 
 func listRsc(resources []*resource.Resource) []rsc {
 	var ret []rsc
 	for _, res := range resources {
+		addr := newRscAddress(res)
 		r := rsc{
-			Address:         fmt.Sprintf("%s.%s", res.ResourceType(), res.ResourceId()),
+			Address:         addr.address,
+			Mode:            addr.mode,
+			ModuleAddress:   addr.module,
 			Type:            res.ResourceType(),
-			Name:            res.ResourceId(),
+			Name:            addr.name,
+			Index:           addr.index,
 			AttributeValues: *res.Attributes(),
 		}
 		ret = append(ret, r)
@@ -109,10 +163,13 @@ func addResourceChanges(analysis *analyser.Analysis) []rscChange {
 func listRscChange(resources []*resource.Resource, action string) []rscChange {
 	var ret []rscChange
 	for _, res := range resources {
+		addr := newRscAddress(res)
 		r := rscChange{
-			Address: fmt.Sprintf("%s.%s", res.ResourceType(), res.ResourceId()),
-			Type:    res.ResourceType(),
-			Name:    res.ResourceId(),
+			Address:       addr.address,
+			ModuleAddress: addr.module,
+			Type:          res.ResourceType(),
+			Name:          addr.name,
+			Index:         addr.index,
 			Change: change{
 				Actions: []string{action},
 				After:   *res.Attributes(),
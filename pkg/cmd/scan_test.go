@@ -76,20 +76,24 @@ func TestScanCmd_Invalid(t *testing.T) {
 		{args: []string{"scan", "-f"}, expected: `flag needs an argument: 'f' in -f`},
 		{args: []string{"scan", "--from"}, expected: `flag needs an argument: --from`},
 		{args: []string{"scan", "--from"}, expected: `flag needs an argument: --from`},
-		{args: []string{"scan", "--from", "tosdgjhgsdhgkjs"}, expected: "Unable to parse from flag 'tosdgjhgsdhgkjs': \nAccepted schemes are: tfstate://,tfstate+s3://,tfstate+http://,tfstate+https://,tfstate+tfcloud://,tfstate+gs://,tfstate+azurerm://"},
-		{args: []string{"scan", "--from", "://"}, expected: "Unable to parse from flag '://': \nAccepted schemes are: tfstate://,tfstate+s3://,tfstate+http://,tfstate+https://,tfstate+tfcloud://,tfstate+gs://,tfstate+azurerm://"},
-		{args: []string{"scan", "--from", "://test"}, expected: "Unable to parse from flag '://test': \nAccepted schemes are: tfstate://,tfstate+s3://,tfstate+http://,tfstate+https://,tfstate+tfcloud://,tfstate+gs://,tfstate+azurerm://"},
-		{args: []string{"scan", "--from", "tosdgjhgsdhgkjs://"}, expected: "Unable to parse from flag 'tosdgjhgsdhgkjs://': \nAccepted schemes are: tfstate://,tfstate+s3://,tfstate+http://,tfstate+https://,tfstate+tfcloud://,tfstate+gs://,tfstate+azurerm://"},
-		{args: []string{"scan", "--from", "terraform+foo+bar://test"}, expected: "Unable to parse from scheme 'terraform+foo+bar': \nAccepted schemes are: tfstate://,tfstate+s3://,tfstate+http://,tfstate+https://,tfstate+tfcloud://,tfstate+gs://,tfstate+azurerm://"},
-		{args: []string{"scan", "--from", "unsupported://test"}, expected: "Unsupported IaC source 'unsupported': \nAccepted values are: tfstate"},
-		{args: []string{"scan", "--from", "tfstate+foobar://test"}, expected: "Unsupported IaC backend 'foobar': \nAccepted values are: s3,http,https,tfcloud,gs,azurerm"},
-		{args: []string{"scan", "--from", "tfstate:///tmp/test", "--from", "tfstate+toto://test"}, expected: "Unsupported IaC backend 'toto': \nAccepted values are: s3,http,https,tfcloud,gs,azurerm"},
+		{args: []string{"scan", "--from", "tosdgjhgsdhgkjs"}, expected: "Unable to parse from flag 'tosdgjhgsdhgkjs': \nAccepted schemes are: tfstate://,tfplan://,cfn://,pulumi://,tfstate+s3://,tfstate+http://,tfstate+https://,tfstate+tfcloud://,tfstate+gs://,tfstate+azurerm://,tfstate+consul://,tfstate+pg://,tfstate+kubernetes://,tfstate+stdin://,tfstate+tfexec://,tfstate+terragrunt://,tfplan+s3://,tfplan+http://,tfplan+https://,tfplan+tfcloud://,tfplan+gs://,tfplan+azurerm://,tfplan+consul://,tfplan+pg://,tfplan+kubernetes://,tfplan+stdin://,cfn+file://,pulumi+s3://,pulumi+http://,pulumi+https://,pulumi+tfcloud://,pulumi+gs://,pulumi+azurerm://,pulumi+consul://,pulumi+pg://,pulumi+kubernetes://,pulumi+stdin://"},
+		{args: []string{"scan", "--from", "://"}, expected: "Unable to parse from flag '://': \nAccepted schemes are: tfstate://,tfplan://,cfn://,pulumi://,tfstate+s3://,tfstate+http://,tfstate+https://,tfstate+tfcloud://,tfstate+gs://,tfstate+azurerm://,tfstate+consul://,tfstate+pg://,tfstate+kubernetes://,tfstate+stdin://,tfstate+tfexec://,tfstate+terragrunt://,tfplan+s3://,tfplan+http://,tfplan+https://,tfplan+tfcloud://,tfplan+gs://,tfplan+azurerm://,tfplan+consul://,tfplan+pg://,tfplan+kubernetes://,tfplan+stdin://,cfn+file://,pulumi+s3://,pulumi+http://,pulumi+https://,pulumi+tfcloud://,pulumi+gs://,pulumi+azurerm://,pulumi+consul://,pulumi+pg://,pulumi+kubernetes://,pulumi+stdin://"},
+		{args: []string{"scan", "--from", "://test"}, expected: "Unable to parse from flag '://test': \nAccepted schemes are: tfstate://,tfplan://,cfn://,pulumi://,tfstate+s3://,tfstate+http://,tfstate+https://,tfstate+tfcloud://,tfstate+gs://,tfstate+azurerm://,tfstate+consul://,tfstate+pg://,tfstate+kubernetes://,tfstate+stdin://,tfstate+tfexec://,tfstate+terragrunt://,tfplan+s3://,tfplan+http://,tfplan+https://,tfplan+tfcloud://,tfplan+gs://,tfplan+azurerm://,tfplan+consul://,tfplan+pg://,tfplan+kubernetes://,tfplan+stdin://,cfn+file://,pulumi+s3://,pulumi+http://,pulumi+https://,pulumi+tfcloud://,pulumi+gs://,pulumi+azurerm://,pulumi+consul://,pulumi+pg://,pulumi+kubernetes://,pulumi+stdin://"},
+		{args: []string{"scan", "--from", "tosdgjhgsdhgkjs://"}, expected: "Unable to parse from flag 'tosdgjhgsdhgkjs://': \nAccepted schemes are: tfstate://,tfplan://,cfn://,pulumi://,tfstate+s3://,tfstate+http://,tfstate+https://,tfstate+tfcloud://,tfstate+gs://,tfstate+azurerm://,tfstate+consul://,tfstate+pg://,tfstate+kubernetes://,tfstate+stdin://,tfstate+tfexec://,tfstate+terragrunt://,tfplan+s3://,tfplan+http://,tfplan+https://,tfplan+tfcloud://,tfplan+gs://,tfplan+azurerm://,tfplan+consul://,tfplan+pg://,tfplan+kubernetes://,tfplan+stdin://,cfn+file://,pulumi+s3://,pulumi+http://,pulumi+https://,pulumi+tfcloud://,pulumi+gs://,pulumi+azurerm://,pulumi+consul://,pulumi+pg://,pulumi+kubernetes://,pulumi+stdin://"},
+		{args: []string{"scan", "--from", "terraform+foo+bar://test"}, expected: "Unable to parse from scheme 'terraform+foo+bar': \nAccepted schemes are: tfstate://,tfplan://,cfn://,pulumi://,tfstate+s3://,tfstate+http://,tfstate+https://,tfstate+tfcloud://,tfstate+gs://,tfstate+azurerm://,tfstate+consul://,tfstate+pg://,tfstate+kubernetes://,tfstate+stdin://,tfstate+tfexec://,tfstate+terragrunt://,tfplan+s3://,tfplan+http://,tfplan+https://,tfplan+tfcloud://,tfplan+gs://,tfplan+azurerm://,tfplan+consul://,tfplan+pg://,tfplan+kubernetes://,tfplan+stdin://,cfn+file://,pulumi+s3://,pulumi+http://,pulumi+https://,pulumi+tfcloud://,pulumi+gs://,pulumi+azurerm://,pulumi+consul://,pulumi+pg://,pulumi+kubernetes://,pulumi+stdin://"},
+		{args: []string{"scan", "--from", "unsupported://test"}, expected: "Unsupported IaC source 'unsupported': \nAccepted values are: tfstate,tfplan,cfn,pulumi"},
+		{args: []string{"scan", "--from", "tfstate+foobar://test"}, expected: "Unsupported IaC backend 'foobar': \nAccepted values are: s3,http,https,tfcloud,gs,azurerm,consul,pg,kubernetes,stdin,tfexec,terragrunt"},
+		{args: []string{"scan", "--from", "tfstate:///tmp/test", "--from", "tfstate+toto://test"}, expected: "Unsupported IaC backend 'toto': \nAccepted values are: s3,http,https,tfcloud,gs,azurerm,consul,pg,kubernetes,stdin,tfexec,terragrunt"},
+		{args: []string{"scan", "--from", "cfn+s3://test"}, expected: "Unsupported IaC backend 's3': \nAccepted values are: file"},
 		{args: []string{"scan", "--filter", "Type='test'"}, expected: "unable to parse filter expression: SyntaxError: Expected tRbracket, received: tUnknown"},
 		{args: []string{"scan", "--filter", "Type='test'", "--filter", "Type='test2'"}, expected: "Filter flag should be specified only once"},
 		{args: []string{"scan", "--tf-provider-version", ".30.2"}, expected: "Invalid version argument .30.2, expected a valid semver string (e.g. 2.13.4)"},
 		{args: []string{"scan", "--tf-provider-version", "foo"}, expected: "Invalid version argument foo, expected a valid semver string (e.g. 2.13.4)"},
 		{args: []string{"scan", "--driftignore"}, expected: "flag needs an argument: --driftignore"},
 		{args: []string{"scan", "--tf-lockfile"}, expected: "flag needs an argument: --tf-lockfile"},
+		{args: []string{"scan", "--from", "tfstate+s3://bucket/terraform.tfstate", "--at", "yesterday"}, expected: "Invalid timestamp argument yesterday, expected a RFC3339 timestamp (e.g. 2021-11-23T15:04:05Z)"},
+		{args: []string{"scan", "--from", "tfstate+s3://bucket/terraform.tfstate", "--from", "tfstate://terraform.tfstate", "--at", "2021-11-23T15:04:05Z"}, expected: "Unable to scan tfstate://terraform.tfstate at a given time, only s3 and gs backends are versioned"},
+		{args: []string{"scan", "--from", "tfstate+terragrunt://terragrunt.hcl", "--from", "tfstate://terraform.tfstate", "--at", "2021-11-23T15:04:05Z"}, expected: "Unable to scan tfstate://terraform.tfstate at a given time, only s3 and gs backends are versioned"},
 	}
 
 	for _, tt := range cases {
@@ -16,6 +16,7 @@ import (
 	"github.com/snyk/driftctl/pkg/iac/config"
 	"github.com/snyk/driftctl/pkg/iac/terraform/state/backend"
 	"github.com/snyk/driftctl/pkg/middlewares"
+	"github.com/snyk/driftctl/pkg/remote/common"
 	"github.com/snyk/driftctl/pkg/resource"
 )
 
@@ -41,6 +42,7 @@ type ScanOptions struct {
 	Deep             bool
 	OnlyManaged      bool
 	OnlyUnmanaged    bool
+	RemoteOptions    common.RemoteOptions
 }
 
 type DriftCTL struct {
@@ -113,6 +115,7 @@ func (d DriftCTL) Run() (*analyser.Analysis, error) {
 		middlewares.NewAwsSQSQueuePolicyExpander(d.resourceFactory, d.resourceSchemaRepository),
 		middlewares.NewAwsDefaultSQSQueuePolicy(),
 		middlewares.NewAwsSNSTopicPolicyExpander(d.resourceFactory, d.resourceSchemaRepository),
+		middlewares.NewAwsSecretsManagerSecretPolicyExpander(d.resourceFactory),
 		middlewares.NewAwsRoleManagedPolicyExpander(d.resourceFactory),
 		middlewares.NewTagsAllManager(),
 		middlewares.NewEipAssociationExpander(d.resourceFactory),
@@ -124,7 +127,10 @@ func (d DriftCTL) Run() (*analyser.Analysis, error) {
 		middlewares.NewAwsConsoleApiGatewayGatewayResponse(),
 		middlewares.NewAwsApiGatewayDomainNamesReconciler(),
 		middlewares.NewAwsEbsEncryptionByDefaultReconciler(d.resourceFactory),
+		middlewares.NewAwsS3AccountPublicAccessBlockReconciler(d.resourceFactory),
 		middlewares.NewAwsALBTransformer(d.resourceFactory),
+		middlewares.NewAwsEksClusterSecurityGroup(),
+		middlewares.NewAwsNetworkInterfaceServiceOwned(),
 
 		middlewares.NewGoogleIAMBindingTransformer(d.resourceFactory),
 		middlewares.NewGoogleIAMPolicyTransformer(d.resourceFactory),
@@ -167,6 +173,9 @@ func (d DriftCTL) Run() (*analyser.Analysis, error) {
 	}
 
 	analysis.SetIaCSourceCount(d.iacSupplier.SourceCount())
+	if dataSupplier, ok := d.iacSupplier.(resource.DataSourceSupplier); ok {
+		analysis.SetDataSources(dataSupplier.DataSources())
+	}
 	analysis.Duration = time.Since(start)
 	analysis.Date = time.Now()
 
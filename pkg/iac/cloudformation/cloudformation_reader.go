@@ -0,0 +1,190 @@
+package cloudformation
+
+import (
+	"encoding/json"
+	"os"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/snyk/driftctl/pkg/filter"
+	"github.com/snyk/driftctl/pkg/iac/config"
+	"github.com/snyk/driftctl/pkg/output"
+	"github.com/snyk/driftctl/pkg/remote/aws/repository"
+	"github.com/snyk/driftctl/pkg/remote/cache"
+	"github.com/snyk/driftctl/pkg/resource"
+)
+
+const CloudformationReaderSupplier = "cfn"
+
+// BackendKeyFile reads the output of `aws cloudformation list-stack-resources` from a local file
+const BackendKeyFile = "file"
+
+var supportedBackends = []string{
+	BackendKeyFile,
+}
+
+func IsBackendSupported(backend string) bool {
+	if backend == "" {
+		return true
+	}
+	for _, b := range supportedBackends {
+		if b == backend {
+			return true
+		}
+	}
+	return false
+}
+
+func GetSupportedBackends() []string {
+	return supportedBackends
+}
+
+// stackResourcesFile supports both `aws cloudformation list-stack-resources`
+// and `aws cloudformation describe-stack-resources` outputs
+type stackResourcesFile struct {
+	StackResourceSummaries []*cloudformation.StackResourceSummary
+	StackResources         []*cloudformation.StackResourceSummary
+}
+
+type CloudformationReader struct {
+	config      config.SupplierConfig
+	repository  repository.CloudformationRepository
+	factory     resource.ResourceFactory
+	progress    output.Progress
+	filter      filter.Filter
+	sourceCount uint
+}
+
+func NewReader(config config.SupplierConfig, progress output.Progress, factory resource.ResourceFactory, filter filter.Filter) (*CloudformationReader, error) {
+	var repo repository.CloudformationRepository
+	if config.Backend == "" {
+		sess := session.Must(session.NewSessionWithOptions(session.Options{
+			SharedConfigState: session.SharedConfigEnable,
+		}))
+		repo = repository.NewCloudformationRepository(sess, cache.New(10))
+	}
+	return newReader(config, repo, progress, factory, filter), nil
+}
+
+func newReader(config config.SupplierConfig, repo repository.CloudformationRepository, progress output.Progress, factory resource.ResourceFactory, filter filter.Filter) *CloudformationReader {
+	return &CloudformationReader{
+		config:     config,
+		repository: repo,
+		factory:    factory,
+		progress:   progress,
+		filter:     filter,
+	}
+}
+
+func (r *CloudformationReader) SourceCount() uint {
+	return r.sourceCount
+}
+
+func (r *CloudformationReader) Resources() ([]*resource.Resource, error) {
+	r.sourceCount += 1
+	logrus.WithFields(logrus.Fields{
+		"path":    r.config.Path,
+		"backend": r.config.Backend,
+	}).Debug("Reading resources from cloudformation stack")
+	r.progress.Inc()
+
+	stackResources, err := r.retrieve()
+	if err != nil {
+		return nil, errors.Wrap(err, r.config.String())
+	}
+
+	results := make([]*resource.Resource, 0, len(stackResources))
+	for _, stackRes := range stackResources {
+		res := r.decode(stackRes)
+		if res == nil {
+			continue
+		}
+		results = append(results, res)
+	}
+
+	return results, nil
+}
+
+func (r *CloudformationReader) retrieve() ([]*cloudformation.StackResourceSummary, error) {
+	if r.config.Backend == BackendKeyFile {
+		return readFile(r.config.Path)
+	}
+
+	stackResources, err := r.repository.ListAllStackResources(r.config.Path)
+	if err != nil {
+		return nil, err
+	}
+
+	// The stack itself is managed too, we retrieve it to know its ID
+	stacks, err := r.repository.ListAllStacks()
+	if err != nil {
+		return nil, err
+	}
+	for _, stack := range stacks {
+		if aws.StringValue(stack.StackName) == r.config.Path || aws.StringValue(stack.StackId) == r.config.Path {
+			stackResources = append(stackResources, &cloudformation.StackResourceSummary{
+				LogicalResourceId:  stack.StackName,
+				PhysicalResourceId: stack.StackId,
+				ResourceType:       aws.String("AWS::CloudFormation::Stack"),
+				ResourceStatus:     stack.StackStatus,
+			})
+			break
+		}
+	}
+
+	return stackResources, nil
+}
+
+func (r *CloudformationReader) decode(stackRes *cloudformation.StackResourceSummary) *resource.Resource {
+	cfnType := aws.StringValue(stackRes.ResourceType)
+	logicalId := aws.StringValue(stackRes.LogicalResourceId)
+	physicalId := aws.StringValue(stackRes.PhysicalResourceId)
+
+	ty, exist := resourceTypes[cfnType]
+	if !exist {
+		logrus.WithFields(logrus.Fields{
+			"type": cfnType,
+			"name": logicalId,
+		}).Debug("Ignored unsupported resource from cloudformation stack")
+		return nil
+	}
+
+	if r.filter != nil && r.filter.IsTypeIgnored(resource.ResourceType(ty)) {
+		logrus.WithFields(logrus.Fields{
+			"type": ty,
+			"name": logicalId,
+		}).Debug("Ignored resource from cloudformation stack since it is ignored in filter")
+		return nil
+	}
+
+	if physicalId == "" || aws.StringValue(stackRes.ResourceStatus) == cloudformation.ResourceStatusDeleteComplete {
+		logrus.WithFields(logrus.Fields{
+			"type":   cfnType,
+			"name":   logicalId,
+			"status": aws.StringValue(stackRes.ResourceStatus),
+		}).Debug("Skipping cloudformation resource as it does not exist")
+		return nil
+	}
+
+	res := r.factory.CreateAbstractResource(ty, physicalId, map[string]interface{}{})
+	res.Source = resource.NewCloudformationStackSource(r.config.String(), logicalId)
+	return res
+}
+
+func readFile(path string) ([]*cloudformation.StackResourceSummary, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var content stackResourcesFile
+	if err := json.NewDecoder(f).Decode(&content); err != nil {
+		return nil, errors.Errorf("given file is not a valid cloudformation stack resources listing: %s", err)
+	}
+
+	return append(content.StackResourceSummaries, content.StackResources...), nil
+}
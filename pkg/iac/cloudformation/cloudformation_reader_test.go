@@ -0,0 +1,151 @@
+package cloudformation
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/snyk/driftctl/pkg/iac/config"
+	"github.com/snyk/driftctl/pkg/output"
+	"github.com/snyk/driftctl/pkg/remote/aws/repository"
+	"github.com/snyk/driftctl/pkg/resource"
+	"github.com/snyk/driftctl/pkg/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCloudformationReader_Resources(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  config.SupplierConfig
+		mocks   func(repo *repository.MockCloudformationRepository)
+		want    []*resource.Resource
+		wantErr string
+	}{
+		{
+			name:   "read resources from a stack",
+			config: config.SupplierConfig{Key: CloudformationReaderSupplier, Path: "my-stack"},
+			mocks: func(repo *repository.MockCloudformationRepository) {
+				repo.On("ListAllStackResources", "my-stack").Return([]*cloudformation.StackResourceSummary{
+					{
+						LogicalResourceId:  aws.String("Bucket"),
+						PhysicalResourceId: aws.String("my-stack-bucket-1h5vqz9w0a2nf"),
+						ResourceType:       aws.String("AWS::S3::Bucket"),
+						ResourceStatus:     aws.String(cloudformation.ResourceStatusCreateComplete),
+					},
+					{
+						LogicalResourceId:  aws.String("Queue"),
+						PhysicalResourceId: aws.String("https://sqs.eu-west-3.amazonaws.com/047081014315/my-stack-queue"),
+						ResourceType:       aws.String("AWS::SQS::Queue"),
+						ResourceStatus:     aws.String(cloudformation.ResourceStatusUpdateComplete),
+					},
+					{
+						LogicalResourceId:  aws.String("InlinePolicy"),
+						PhysicalResourceId: aws.String("my-st-Inlin-1NJ8E5AKXSP3G"),
+						ResourceType:       aws.String("AWS::IAM::Policy"),
+						ResourceStatus:     aws.String(cloudformation.ResourceStatusCreateComplete),
+					},
+					{
+						LogicalResourceId:  aws.String("Topic"),
+						PhysicalResourceId: aws.String("arn:aws:sns:eu-west-3:047081014315:my-stack-topic"),
+						ResourceType:       aws.String("AWS::SNS::Topic"),
+						ResourceStatus:     aws.String(cloudformation.ResourceStatusDeleteComplete),
+					},
+					{
+						LogicalResourceId: aws.String("Role"),
+						ResourceType:      aws.String("AWS::IAM::Role"),
+						ResourceStatus:    aws.String(cloudformation.ResourceStatusCreateFailed),
+					},
+				}, nil)
+				repo.On("ListAllStacks").Return([]*cloudformation.Stack{
+					{
+						StackName:   aws.String("other-stack"),
+						StackId:     aws.String("arn:aws:cloudformation:eu-west-3:047081014315:stack/other-stack/1a2b3c4d"),
+						StackStatus: aws.String(cloudformation.StackStatusCreateComplete),
+					},
+					{
+						StackName:   aws.String("my-stack"),
+						StackId:     aws.String("arn:aws:cloudformation:eu-west-3:047081014315:stack/my-stack/5e6f7a8b"),
+						StackStatus: aws.String(cloudformation.StackStatusUpdateComplete),
+					},
+				}, nil)
+			},
+			want: []*resource.Resource{
+				{
+					Id:     "my-stack-bucket-1h5vqz9w0a2nf",
+					Type:   "aws_s3_bucket",
+					Attrs:  &resource.Attributes{},
+					Source: resource.NewCloudformationStackSource("cfn://my-stack", "Bucket"),
+				},
+				{
+					Id:     "https://sqs.eu-west-3.amazonaws.com/047081014315/my-stack-queue",
+					Type:   "aws_sqs_queue",
+					Attrs:  &resource.Attributes{},
+					Source: resource.NewCloudformationStackSource("cfn://my-stack", "Queue"),
+				},
+				{
+					Id:     "arn:aws:cloudformation:eu-west-3:047081014315:stack/my-stack/5e6f7a8b",
+					Type:   "aws_cloudformation_stack",
+					Attrs:  &resource.Attributes{},
+					Source: resource.NewCloudformationStackSource("cfn://my-stack", "my-stack"),
+				},
+			},
+		},
+		{
+			name:   "cannot list stack resources",
+			config: config.SupplierConfig{Key: CloudformationReaderSupplier, Path: "my-stack"},
+			mocks: func(repo *repository.MockCloudformationRepository) {
+				repo.On("ListAllStackResources", "my-stack").Return(nil, errors.New("Stack with id my-stack does not exist"))
+			},
+			wantErr: "cfn://my-stack: Stack with id my-stack does not exist",
+		},
+		{
+			name:   "read resources from a file",
+			config: config.SupplierConfig{Key: CloudformationReaderSupplier, Backend: BackendKeyFile, Path: "testdata/stack-resources.json"},
+			mocks:  func(repo *repository.MockCloudformationRepository) {},
+			want: []*resource.Resource{
+				{
+					Id:     "my-stack-bucket-1h5vqz9w0a2nf",
+					Type:   "aws_s3_bucket",
+					Attrs:  &resource.Attributes{},
+					Source: resource.NewCloudformationStackSource("cfn+file://testdata/stack-resources.json", "Bucket"),
+				},
+				{
+					Id:     "my-stack-Role-1C3N6GBA1WCPB",
+					Type:   "aws_iam_role",
+					Attrs:  &resource.Attributes{},
+					Source: resource.NewCloudformationStackSource("cfn+file://testdata/stack-resources.json", "Role"),
+				},
+			},
+		},
+		{
+			name:    "read resources from an invalid file",
+			config:  config.SupplierConfig{Key: CloudformationReaderSupplier, Backend: BackendKeyFile, Path: "testdata/invalid.json"},
+			mocks:   func(repo *repository.MockCloudformationRepository) {},
+			wantErr: "cfn+file://testdata/invalid.json: given file is not a valid cloudformation stack resources listing: json: cannot unmarshal string into Go struct field stackResourcesFile.StackResourceSummaries of type []*cloudformation.StackResourceSummary",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			progress := &output.MockProgress{}
+			progress.On("Inc").Return().Times(1)
+
+			repo := &repository.MockCloudformationRepository{}
+			tt.mocks(repo)
+
+			factory := terraform.NewTerraformResourceFactory(resource.NewSchemaRepository())
+
+			r := newReader(tt.config, repo, progress, factory, nil)
+			got, err := r.Resources()
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+			} else {
+				assert.Nil(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+			assert.Equal(t, uint(1), r.SourceCount())
+			repo.AssertExpectations(t)
+			progress.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,72 @@
+package cloudformation
+
+import (
+	"github.com/snyk/driftctl/pkg/resource/aws"
+)
+
+// resourceTypes maps CloudFormation resource types to driftctl ones.
+// Only types whose physical resource ID is the same as the terraform ID are listed here.
+var resourceTypes = map[string]string{
+	"AWS::ApiGateway::RestApi":                  aws.AwsApiGatewayRestApiResourceType,
+	"AWS::ApiGatewayV2::Api":                    aws.AwsApiGatewayV2ApiResourceType,
+	"AWS::AutoScaling::LaunchConfiguration":     aws.AwsLaunchConfigurationResourceType,
+	"AWS::CertificateManager::Certificate":      aws.AwsAcmCertificateResourceType,
+	"AWS::CloudFormation::Stack":                aws.AwsCloudformationStackResourceType,
+	"AWS::CloudFront::Distribution":             aws.AwsCloudfrontDistributionResourceType,
+	"AWS::CloudTrail::Trail":                    aws.AwsCloudtrailResourceType,
+	"AWS::CloudWatch::Alarm":                    aws.AwsCloudwatchMetricAlarmResourceType,
+	"AWS::Cognito::UserPool":                    aws.AwsCognitoUserPoolResourceType,
+	"AWS::Cognito::UserPoolClient":              aws.AwsCognitoUserPoolClientResourceType,
+	"AWS::DynamoDB::Table":                      aws.AwsDynamodbTableResourceType,
+	"AWS::EC2::FlowLog":                         aws.AwsFlowLogResourceType,
+	"AWS::EC2::Instance":                        aws.AwsInstanceResourceType,
+	"AWS::EC2::InternetGateway":                 aws.AwsInternetGatewayResourceType,
+	"AWS::EC2::KeyPair":                         aws.AwsKeyPairResourceType,
+	"AWS::EC2::LaunchTemplate":                  aws.AwsLaunchTemplateResourceType,
+	"AWS::EC2::NatGateway":                      aws.AwsNatGatewayResourceType,
+	"AWS::EC2::NetworkAcl":                      aws.AwsNetworkACLResourceType,
+	"AWS::EC2::NetworkInterface":                aws.AwsNetworkInterfaceResourceType,
+	"AWS::EC2::RouteTable":                      aws.AwsRouteTableResourceType,
+	"AWS::EC2::SecurityGroup":                   aws.AwsSecurityGroupResourceType,
+	"AWS::EC2::Subnet":                          aws.AwsSubnetResourceType,
+	"AWS::EC2::TransitGateway":                  aws.AwsEc2TransitGatewayResourceType,
+	"AWS::EC2::TransitGatewayAttachment":        aws.AwsEc2TransitGatewayVpcAttachmentResourceType,
+	"AWS::EC2::Volume":                          aws.AwsEbsVolumeResourceType,
+	"AWS::EC2::VPC":                             aws.AwsVpcResourceType,
+	"AWS::EC2::VPCEndpoint":                     aws.AwsVpcEndpointResourceType,
+	"AWS::EC2::VPCPeeringConnection":            aws.AwsVpcPeeringConnectionResourceType,
+	"AWS::ECR::Repository":                      aws.AwsEcrRepositoryResourceType,
+	"AWS::ECS::Service":                         aws.AwsEcsServiceResourceType,
+	"AWS::EKS::Cluster":                         aws.AwsEksClusterResourceType,
+	"AWS::EFS::FileSystem":                      aws.AwsEfsFileSystemResourceType,
+	"AWS::EFS::MountTarget":                     aws.AwsEfsMountTargetResourceType,
+	"AWS::ElastiCache::CacheCluster":            aws.AwsElasticacheClusterResourceType,
+	"AWS::ElastiCache::ReplicationGroup":        aws.AwsElasticacheReplicationGroupResourceType,
+	"AWS::ElasticLoadBalancingV2::LoadBalancer": aws.AwsLoadBalancerResourceType,
+	"AWS::Events::Rule":                         aws.AwsCloudwatchEventRuleResourceType,
+	"AWS::Glue::Crawler":                        aws.AwsGlueCrawlerResourceType,
+	"AWS::Glue::Job":                            aws.AwsGlueJobResourceType,
+	"AWS::GuardDuty::Detector":                  aws.AwsGuarddutyDetectorResourceType,
+	"AWS::IAM::AccessKey":                       aws.AwsIamAccessKeyResourceType,
+	"AWS::IAM::ManagedPolicy":                   aws.AwsIamPolicyResourceType,
+	"AWS::IAM::Role":                            aws.AwsIamRoleResourceType,
+	"AWS::IAM::User":                            aws.AwsIamUserResourceType,
+	"AWS::KMS::Alias":                           aws.AwsKmsAliasResourceType,
+	"AWS::KMS::Key":                             aws.AwsKmsKeyResourceType,
+	"AWS::Lambda::EventSourceMapping":           aws.AwsLambdaEventSourceMappingResourceType,
+	"AWS::Lambda::Function":                     aws.AwsLambdaFunctionResourceType,
+	"AWS::Logs::LogGroup":                       aws.AwsCloudwatchLogGroupResourceType,
+	"AWS::Logs::MetricFilter":                   aws.AwsCloudwatchLogMetricFilterResourceType,
+	"AWS::RDS::DBCluster":                       aws.AwsRDSClusterResourceType,
+	"AWS::RDS::DBInstance":                      aws.AwsDbInstanceResourceType,
+	"AWS::RDS::DBSubnetGroup":                   aws.AwsDbSubnetGroupResourceType,
+	"AWS::Redshift::Cluster":                    aws.AwsRedshiftClusterResourceType,
+	"AWS::Route53::HealthCheck":                 aws.AwsRoute53HealthCheckResourceType,
+	"AWS::Route53::HostedZone":                  aws.AwsRoute53ZoneResourceType,
+	"AWS::S3::Bucket":                           aws.AwsS3BucketResourceType,
+	"AWS::SecretsManager::Secret":               aws.AwsSecretsManagerSecretResourceType,
+	"AWS::SNS::Topic":                           aws.AwsSnsTopicResourceType,
+	"AWS::SQS::Queue":                           aws.AwsSqsQueueResourceType,
+	"AWS::SSM::Parameter":                       aws.AwsSsmParameterResourceType,
+	"AWS::StepFunctions::StateMachine":          aws.AwsSfnStateMachineResourceType,
+}
@@ -6,6 +6,9 @@ type SupplierConfig struct {
 	Key     string
 	Backend string
 	Path    string
+	// Options override the global backend options for this source only,
+	// they are left out of String() as they may hold credentials
+	Options map[string]string
 }
 
 func (c *SupplierConfig) String() string {
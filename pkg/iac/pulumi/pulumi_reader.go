@@ -0,0 +1,147 @@
+package pulumi
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/snyk/driftctl/pkg/filter"
+	"github.com/snyk/driftctl/pkg/iac/config"
+	"github.com/snyk/driftctl/pkg/iac/terraform/state/backend"
+	"github.com/snyk/driftctl/pkg/output"
+	"github.com/snyk/driftctl/pkg/resource"
+)
+
+const PulumiReaderSupplier = "pulumi"
+
+// export is the subset of the `pulumi stack export` output we rely on
+type export struct {
+	Version    int         `json:"version"`
+	Deployment *deployment `json:"deployment"`
+}
+
+type deployment struct {
+	Resources []*stackResource `json:"resources"`
+}
+
+type stackResource struct {
+	Urn    string `json:"urn"`
+	Custom bool   `json:"custom"`
+	Delete bool   `json:"delete"`
+	ID     string `json:"id"`
+	Type   string `json:"type"`
+}
+
+type PulumiReader struct {
+	config         config.SupplierConfig
+	backendOptions *backend.Options
+	factory        resource.ResourceFactory
+	progress       output.Progress
+	filter         filter.Filter
+	sourceCount    uint
+}
+
+func NewReader(config config.SupplierConfig, backendOpts *backend.Options, progress output.Progress, factory resource.ResourceFactory, filter filter.Filter) (*PulumiReader, error) {
+	return &PulumiReader{
+		config:         config,
+		backendOptions: backendOpts,
+		factory:        factory,
+		progress:       progress,
+		filter:         filter,
+	}, nil
+}
+
+func (r *PulumiReader) SourceCount() uint {
+	return r.sourceCount
+}
+
+func (r *PulumiReader) Resources() ([]*resource.Resource, error) {
+	r.sourceCount += 1
+	logrus.WithFields(logrus.Fields{
+		"path":    r.config.Path,
+		"backend": r.config.Backend,
+	}).Debug("Reading resources from pulumi stack export")
+	r.progress.Inc()
+
+	stackResources, err := r.retrieve()
+	if err != nil {
+		return nil, errors.Wrap(err, r.config.String())
+	}
+
+	results := make([]*resource.Resource, 0, len(stackResources))
+	for _, stackRes := range stackResources {
+		res := r.decode(stackRes)
+		if res == nil {
+			continue
+		}
+		results = append(results, res)
+	}
+
+	return results, nil
+}
+
+func (r *PulumiReader) retrieve() ([]*stackResource, error) {
+	b, err := backend.GetBackend(r.config, r.backendOptions)
+	if err != nil {
+		return nil, err
+	}
+	defer b.Close()
+
+	var e export
+	if err := json.NewDecoder(b).Decode(&e); err != nil {
+		return nil, errors.Errorf("given file is not a valid pulumi stack export: %s", err)
+	}
+	if e.Deployment == nil {
+		return nil, errors.New("given file is not a valid pulumi stack export: deployment is missing, please use the output of `pulumi stack export`")
+	}
+
+	return e.Deployment.Resources, nil
+}
+
+func (r *PulumiReader) decode(stackRes *stackResource) *resource.Resource {
+	// Component resources (and the stack itself) have no physical counterpart
+	if !stackRes.Custom || stackRes.ID == "" {
+		return nil
+	}
+
+	if stackRes.Delete {
+		logrus.WithFields(logrus.Fields{
+			"urn": stackRes.Urn,
+		}).Debug("Skipping pulumi resource pending deletion")
+		return nil
+	}
+
+	ty, exist := terraformType(stackRes.Type)
+	if !exist {
+		logrus.WithFields(logrus.Fields{
+			"type": stackRes.Type,
+			"urn":  stackRes.Urn,
+		}).Debug("Ignored unsupported resource from pulumi stack")
+		return nil
+	}
+
+	if r.filter != nil && r.filter.IsTypeIgnored(resource.ResourceType(ty)) {
+		logrus.WithFields(logrus.Fields{
+			"type": ty,
+			"urn":  stackRes.Urn,
+		}).Debug("Ignored resource from pulumi stack since it is ignored in filter")
+		return nil
+	}
+
+	res := r.factory.CreateAbstractResource(ty, stackRes.ID, map[string]interface{}{})
+	res.Source = resource.NewPulumiStackSource(stackName(stackRes.Urn), stackRes.Urn)
+	return res
+}
+
+// stackName extracts the project and the stack from an URN
+// e.g. urn:pulumi:production::website::aws:s3/bucket:Bucket::assets gives pulumi://website/production
+func stackName(urn string) string {
+	parts := strings.Split(urn, "::")
+	if len(parts) < 2 {
+		return ""
+	}
+	stack := strings.TrimPrefix(parts[0], "urn:pulumi:")
+	return fmt.Sprintf("%s://%s/%s", PulumiReaderSupplier, parts[1], stack)
+}
@@ -0,0 +1,78 @@
+package pulumi
+
+import (
+	"testing"
+
+	"github.com/snyk/driftctl/pkg/iac/config"
+	"github.com/snyk/driftctl/pkg/output"
+	"github.com/snyk/driftctl/pkg/resource"
+	"github.com/snyk/driftctl/pkg/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPulumiReader_Resources(t *testing.T) {
+	tests := []struct {
+		name    string
+		path    string
+		want    []*resource.Resource
+		wantErr string
+	}{
+		{
+			name: "read resources from a stack export",
+			path: "testdata/stack.json",
+			want: []*resource.Resource{
+				{
+					Id:     "assets-7d2a1b3",
+					Type:   "aws_s3_bucket",
+					Attrs:  &resource.Attributes{},
+					Source: resource.NewPulumiStackSource("pulumi://website/production", "urn:pulumi:production::website::aws:s3/bucket:Bucket::assets"),
+				},
+				{
+					Id:     "i-0a1b2c3d4e5f60718",
+					Type:   "aws_instance",
+					Attrs:  &resource.Attributes{},
+					Source: resource.NewPulumiStackSource("pulumi://website/production", "urn:pulumi:production::website::aws:ec2/instance:Instance::web"),
+				},
+				{
+					Id:     "website-db",
+					Type:   "aws_db_instance",
+					Attrs:  &resource.Attributes{},
+					Source: resource.NewPulumiStackSource("pulumi://website/production", "urn:pulumi:production::website::aws:rds/instance:Instance::db"),
+				},
+			},
+		},
+		{
+			name:    "read a state instead of a stack export",
+			path:    "testdata/not_an_export.json",
+			wantErr: "pulumi://testdata/not_an_export.json: given file is not a valid pulumi stack export: deployment is missing, please use the output of `pulumi stack export`",
+		},
+		{
+			name:    "read a missing file",
+			path:    "testdata/missing.json",
+			wantErr: "pulumi://testdata/missing.json: open testdata/missing.json: no such file or directory",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			progress := &output.MockProgress{}
+			progress.On("Inc").Return().Times(1)
+
+			factory := terraform.NewTerraformResourceFactory(resource.NewSchemaRepository())
+
+			r, err := NewReader(config.SupplierConfig{Key: PulumiReaderSupplier, Path: tt.path}, nil, progress, factory, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			got, err := r.Resources()
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+			} else {
+				assert.Nil(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+			assert.Equal(t, uint(1), r.SourceCount())
+			progress.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,81 @@
+package pulumi
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/snyk/driftctl/pkg/resource"
+)
+
+// providerPrefixes maps Pulumi packages to the prefix of terraform resource types
+var providerPrefixes = map[string]string{
+	"aws":   "aws",
+	"gcp":   "google",
+	"azure": "azurerm",
+}
+
+// moduleAliases maps Pulumi modules named differently than their terraform counterpart
+var moduleAliases = map[string]string{
+	"aws:apigateway": "api_gateway",
+}
+
+// resourceTypes maps Pulumi type tokens that cannot be guessed from their name
+var resourceTypes = map[string]string{
+	"aws:alb/loadBalancer:LoadBalancer": "aws_alb",
+	"aws:lb/loadBalancer:LoadBalancer":  "aws_lb",
+	"aws:rds/instance:Instance":         "aws_db_instance",
+	"aws:rds/subnetGroup:SubnetGroup":   "aws_db_subnet_group",
+}
+
+// terraformType returns the terraform resource type matching a Pulumi type token.
+// Pulumi aws, gcp and azure providers are bridged from terraform ones, a token like
+// aws:s3/bucket:Bucket is thus either aws_s3_bucket or aws_bucket in terraform.
+func terraformType(token string) (string, bool) {
+	if ty, exist := resourceTypes[token]; exist {
+		return ty, true
+	}
+
+	// Tokens are of the form <package>:<module>/<name>:<Type>
+	parts := strings.Split(token, ":")
+	if len(parts) != 3 {
+		return "", false
+	}
+	prefix, exist := providerPrefixes[parts[0]]
+	if !exist {
+		return "", false
+	}
+	moduleName := strings.Split(parts[1], "/")
+	if len(moduleName) != 2 {
+		return "", false
+	}
+	module := moduleName[0]
+	if alias, exist := moduleAliases[parts[0]+":"+module]; exist {
+		module = alias
+	}
+	name := toSnakeCase(moduleName[1])
+
+	for _, candidate := range []string{
+		strings.Join([]string{prefix, module, name}, "_"),
+		strings.Join([]string{prefix, name}, "_"),
+	} {
+		if resource.IsResourceTypeSupported(candidate) {
+			return candidate, true
+		}
+	}
+
+	return "", false
+}
+
+func toSnakeCase(s string) string {
+	var b strings.Builder
+	for i, r := range s {
+		if unicode.IsUpper(r) {
+			if i > 0 {
+				b.WriteRune('_')
+			}
+			r = unicode.ToLower(r)
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
@@ -0,0 +1,35 @@
+package pulumi
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_terraformType(t *testing.T) {
+	tests := []struct {
+		token string
+		want  string
+		found bool
+	}{
+		{token: "aws:s3/bucket:Bucket", want: "aws_s3_bucket", found: true},
+		{token: "aws:ec2/securityGroupRule:SecurityGroupRule", want: "aws_security_group_rule", found: true},
+		{token: "aws:ec2/vpc:Vpc", want: "aws_vpc", found: true},
+		{token: "aws:apigateway/restApi:RestApi", want: "aws_api_gateway_rest_api", found: true},
+		{token: "aws:lb/loadBalancer:LoadBalancer", want: "aws_lb", found: true},
+		{token: "aws:rds/instance:Instance", want: "aws_db_instance", found: true},
+		{token: "gcp:compute/instance:Instance", want: "google_compute_instance", found: true},
+		{token: "azure:core/resourceGroup:ResourceGroup", want: "azurerm_resource_group", found: true},
+		{token: "azure:network/virtualNetwork:VirtualNetwork", want: "azurerm_virtual_network", found: true},
+		{token: "aws:cloudwatch/dashboard:Dashboard", found: false},
+		{token: "kubernetes:core/v1:Namespace", found: false},
+		{token: "pulumi:providers:aws", found: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.token, func(t *testing.T) {
+			got, found := terraformType(tt.token)
+			assert.Equal(t, tt.found, found)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
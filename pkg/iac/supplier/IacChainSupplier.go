@@ -2,8 +2,11 @@ package supplier
 
 import (
 	"context"
+	"fmt"
 	"runtime"
+	"sort"
 
+	"github.com/snyk/driftctl/pkg/alerter"
 	"github.com/snyk/driftctl/pkg/iac"
 	"github.com/snyk/driftctl/pkg/parallel"
 	"github.com/snyk/driftctl/pkg/resource"
@@ -12,11 +15,13 @@ import (
 type IacChainSupplier struct {
 	suppliers []resource.IaCSupplier
 	runner    *parallel.ParallelRunner
+	alerter   alerter.AlerterInterface
 }
 
-func NewIacChainSupplier() *IacChainSupplier {
+func NewIacChainSupplier(alerter alerter.AlerterInterface) *IacChainSupplier {
 	return &IacChainSupplier{
-		runner: parallel.NewParallelRunner(context.TODO(), int64(runtime.NumCPU())),
+		runner:  parallel.NewParallelRunner(context.TODO(), int64(runtime.NumCPU())),
+		alerter: alerter,
 	}
 }
 
@@ -28,6 +33,17 @@ func (r *IacChainSupplier) SourceCount() uint {
 	return count
 }
 
+// DataSources returns the data sources read by suppliers once their resources have been retrieved
+func (r *IacChainSupplier) DataSources() []*resource.Resource {
+	results := make([]*resource.Resource, 0)
+	for _, supplier := range r.suppliers {
+		if dataSupplier, ok := supplier.(resource.DataSourceSupplier); ok {
+			results = append(results, dataSupplier.DataSources()...)
+		}
+	}
+	return results
+}
+
 func (r *IacChainSupplier) AddSupplier(supplier resource.IaCSupplier) {
 	r.suppliers = append(r.suppliers, supplier)
 }
@@ -76,7 +92,104 @@ ReadLoop:
 		return nil, retrieveError
 	}
 
-	return results, nil
+	return r.deduplicate(results), nil
+}
+
+// deduplicate drops resources read from older copies of a state (e.g. a backup matched by a glob)
+// and keeps a single occurrence of resources found in states of different lineages
+func (r *IacChainSupplier) deduplicate(results []*resource.Resource) []*resource.Resource {
+	serials := make(map[string]map[string]uint64)
+	for _, res := range results {
+		source, ok := res.Source.(*resource.TerraformStateSource)
+		if !ok || source.Lineage == "" {
+			continue
+		}
+		if _, exists := serials[source.Lineage]; !exists {
+			serials[source.Lineage] = make(map[string]uint64)
+		}
+		serials[source.Lineage][source.Source()] = source.Serial
+	}
+
+	shadowed := make(map[string]bool)
+	for lineage, states := range serials {
+		if len(states) < 2 {
+			continue
+		}
+		names := make([]string, 0, len(states))
+		for name := range states {
+			names = append(names, name)
+		}
+		// Newest serial first, ties are broken by name to stay deterministic
+		sort.Slice(names, func(i, j int) bool {
+			if states[names[i]] != states[names[j]] {
+				return states[names[i]] > states[names[j]]
+			}
+			return names[i] < names[j]
+		})
+		for _, name := range names[1:] {
+			shadowed[name] = true
+		}
+		r.alerter.SendAlert("", NewShadowedStatesAlert(lineage, names[0], names[1:]))
+	}
+
+	managedBy := make(map[string][]string)
+	alertKeys := make(map[string]string)
+	for _, res := range results {
+		source, ok := res.Source.(*resource.TerraformStateSource)
+		if !ok || source.Lineage == "" || shadowed[source.Source()] {
+			continue
+		}
+		key := managedResourceKey(res)
+		alertKeys[key] = fmt.Sprintf("%s.%s", res.ResourceType(), res.ResourceId())
+		if !contains(managedBy[key], source.Source()) {
+			managedBy[key] = append(managedBy[key], source.Source())
+		}
+	}
+	for key, states := range managedBy {
+		if len(states) < 2 {
+			continue
+		}
+		sort.Strings(states)
+		r.alerter.SendAlert(alertKeys[key], NewManagedByMultipleStatesAlert(states))
+	}
+
+	deduplicated := make([]*resource.Resource, 0, len(results))
+	for _, res := range results {
+		source, ok := res.Source.(*resource.TerraformStateSource)
+		if !ok || source.Lineage == "" {
+			deduplicated = append(deduplicated, res)
+			continue
+		}
+		if shadowed[source.Source()] {
+			continue
+		}
+		// Resources managed by multiple states are only kept from the first state by name
+		if managedBy[managedResourceKey(res)][0] != source.Source() {
+			continue
+		}
+		deduplicated = append(deduplicated, res)
+	}
+
+	return deduplicated
+}
+
+// managedResourceKey identifies a resource across states, the same identifier may be used in several accounts
+// or regions (e.g. a table name) by states that are each applied to one of them
+func managedResourceKey(res *resource.Resource) string {
+	key := fmt.Sprintf("%s.%s", res.ResourceType(), res.ResourceId())
+	if account, region := res.Scope(); account != "" || region != "" {
+		key = fmt.Sprintf("%s@%s/%s", key, account, region)
+	}
+	return key
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
 }
 
 type result struct {
@@ -5,7 +5,9 @@ import (
 	"testing"
 
 	"github.com/pkg/errors"
+	"github.com/snyk/driftctl/pkg/alerter"
 	"github.com/snyk/driftctl/pkg/resource"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestIacChainSupplier_Resources(t *testing.T) {
@@ -66,7 +68,7 @@ func TestIacChainSupplier_Resources(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			r := NewIacChainSupplier()
+			r := NewIacChainSupplier(alerter.NewAlerter())
 			suppliers := make([]resource.IaCSupplier, 0)
 			tt.initSuppliers(&suppliers)
 
@@ -85,3 +87,131 @@ func TestIacChainSupplier_Resources(t *testing.T) {
 		})
 	}
 }
+
+func TestIacChainSupplier_Resources_Deduplicate(t *testing.T) {
+	stateSource := func(state, lineage string, serial uint64) *resource.TerraformStateSource {
+		source := resource.NewTerraformStateSource(state, "", "res")
+		source.Lineage = lineage
+		source.Serial = serial
+		return source
+	}
+
+	tests := []struct {
+		name          string
+		initSuppliers func(suppliers *[]resource.IaCSupplier)
+		want          []*resource.Resource
+		alerts        alerter.Alerts
+	}{
+		{
+			name: "older copies of a state are ignored",
+			initSuppliers: func(suppliers *[]resource.IaCSupplier) {
+				sup := &resource.MockIaCSupplier{}
+				sup.On("Resources").Return([]*resource.Resource{
+					{Id: "bucket", Type: "aws_s3_bucket", Source: stateSource("tfstate://terraform.tfstate", "lineage-1", 3)},
+					{Id: "bucket", Type: "aws_s3_bucket", Source: stateSource("tfstate://terraform.tfstate.backup", "lineage-1", 2)},
+					{Id: "old-bucket", Type: "aws_s3_bucket", Source: stateSource("tfstate://terraform.tfstate.backup", "lineage-1", 2)},
+					{Id: "bucket", Type: "aws_s3_bucket", Source: stateSource("tfstate://copy/terraform.tfstate", "lineage-1", 3)},
+				}, nil)
+				*suppliers = append(*suppliers, sup)
+			},
+			want: []*resource.Resource{
+				{Id: "bucket", Type: "aws_s3_bucket", Source: stateSource("tfstate://copy/terraform.tfstate", "lineage-1", 3)},
+			},
+			alerts: alerter.Alerts{
+				"": {
+					NewShadowedStatesAlert("lineage-1", "tfstate://copy/terraform.tfstate", []string{"tfstate://terraform.tfstate", "tfstate://terraform.tfstate.backup"}),
+				},
+			},
+		},
+		{
+			name: "resources managed by states of different lineages are kept once",
+			initSuppliers: func(suppliers *[]resource.IaCSupplier) {
+				sup := &resource.MockIaCSupplier{}
+				sup.On("Resources").Return([]*resource.Resource{
+					{Id: "bucket", Type: "aws_s3_bucket", Source: stateSource("tfstate://network.tfstate", "lineage-2", 1)},
+					{Id: "role", Type: "aws_iam_role", Source: stateSource("tfstate://network.tfstate", "lineage-2", 1)},
+				}, nil)
+				*suppliers = append(*suppliers, sup)
+
+				sup = &resource.MockIaCSupplier{}
+				sup.On("Resources").Return([]*resource.Resource{
+					{Id: "bucket", Type: "aws_s3_bucket", Source: stateSource("tfstate://app.tfstate", "lineage-1", 5)},
+					{Id: "bucket", Type: "aws_s3_bucket", Source: resource.NewPulumiStackSource("pulumi://app/prod", "urn")},
+				}, nil)
+				*suppliers = append(*suppliers, sup)
+			},
+			want: []*resource.Resource{
+				{Id: "bucket", Type: "aws_s3_bucket", Source: stateSource("tfstate://app.tfstate", "lineage-1", 5)},
+				{Id: "bucket", Type: "aws_s3_bucket", Source: resource.NewPulumiStackSource("pulumi://app/prod", "urn")},
+				{Id: "role", Type: "aws_iam_role", Source: stateSource("tfstate://network.tfstate", "lineage-2", 1)},
+			},
+			alerts: alerter.Alerts{
+				"aws_s3_bucket.bucket": {
+					NewManagedByMultipleStatesAlert([]string{"tfstate://app.tfstate", "tfstate://network.tfstate"}),
+				},
+			},
+		},
+		{
+			name: "resources with the same name in different regions are kept",
+			initSuppliers: func(suppliers *[]resource.IaCSupplier) {
+				sup := &resource.MockIaCSupplier{}
+				sup.On("Resources").Return([]*resource.Resource{
+					{Id: "users", Type: "aws_dynamodb_table", Attrs: &resource.Attributes{"arn": "arn:aws:dynamodb:eu-west-1:123456789012:table/users"}, Source: stateSource("tfstate://eu-west-1.tfstate", "lineage-1", 1)},
+				}, nil)
+				*suppliers = append(*suppliers, sup)
+
+				sup = &resource.MockIaCSupplier{}
+				sup.On("Resources").Return([]*resource.Resource{
+					{Id: "users", Type: "aws_dynamodb_table", Attrs: &resource.Attributes{"arn": "arn:aws:dynamodb:us-east-1:123456789012:table/users"}, Source: stateSource("tfstate://us-east-1.tfstate", "lineage-2", 1)},
+				}, nil)
+				*suppliers = append(*suppliers, sup)
+			},
+			want: []*resource.Resource{
+				{Id: "users", Type: "aws_dynamodb_table", Attrs: &resource.Attributes{"arn": "arn:aws:dynamodb:eu-west-1:123456789012:table/users"}, Source: stateSource("tfstate://eu-west-1.tfstate", "lineage-1", 1)},
+				{Id: "users", Type: "aws_dynamodb_table", Attrs: &resource.Attributes{"arn": "arn:aws:dynamodb:us-east-1:123456789012:table/users"}, Source: stateSource("tfstate://us-east-1.tfstate", "lineage-2", 1)},
+			},
+			alerts: alerter.Alerts{},
+		},
+		{
+			name: "resources with the same ARN in different lineages are kept once",
+			initSuppliers: func(suppliers *[]resource.IaCSupplier) {
+				sup := &resource.MockIaCSupplier{}
+				sup.On("Resources").Return([]*resource.Resource{
+					{Id: "users", Type: "aws_dynamodb_table", Attrs: &resource.Attributes{"arn": "arn:aws:dynamodb:eu-west-1:123456789012:table/users"}, Source: stateSource("tfstate://app.tfstate", "lineage-1", 1)},
+				}, nil)
+				*suppliers = append(*suppliers, sup)
+
+				sup = &resource.MockIaCSupplier{}
+				sup.On("Resources").Return([]*resource.Resource{
+					{Id: "users", Type: "aws_dynamodb_table", Attrs: &resource.Attributes{"arn": "arn:aws:dynamodb:eu-west-1:123456789012:table/users"}, Source: stateSource("tfstate://legacy.tfstate", "lineage-2", 1)},
+				}, nil)
+				*suppliers = append(*suppliers, sup)
+			},
+			want: []*resource.Resource{
+				{Id: "users", Type: "aws_dynamodb_table", Attrs: &resource.Attributes{"arn": "arn:aws:dynamodb:eu-west-1:123456789012:table/users"}, Source: stateSource("tfstate://app.tfstate", "lineage-1", 1)},
+			},
+			alerts: alerter.Alerts{
+				"aws_dynamodb_table.users": {
+					NewManagedByMultipleStatesAlert([]string{"tfstate://app.tfstate", "tfstate://legacy.tfstate"}),
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			alerter := alerter.NewAlerter()
+			r := NewIacChainSupplier(alerter)
+			suppliers := make([]resource.IaCSupplier, 0)
+			tt.initSuppliers(&suppliers)
+
+			for _, supplier := range suppliers {
+				r.AddSupplier(supplier)
+			}
+
+			got, err := r.Resources()
+			assert.NoError(t, err)
+			assert.ElementsMatch(t, tt.want, got)
+			assert.Equal(t, tt.alerts, alerter.Retrieve())
+		})
+	}
+}
@@ -0,0 +1,45 @@
+package supplier
+
+import (
+	"fmt"
+	"strings"
+)
+
+type ShadowedStatesAlert struct {
+	lineage  string
+	state    string
+	shadowed []string
+}
+
+func NewShadowedStatesAlert(lineage, state string, shadowed []string) *ShadowedStatesAlert {
+	return &ShadowedStatesAlert{lineage: lineage, state: state, shadowed: shadowed}
+}
+
+func (s *ShadowedStatesAlert) Message() string {
+	return fmt.Sprintf(
+		"Older copies of state '%s' (lineage %s) were ignored: %s",
+		s.state,
+		s.lineage,
+		strings.Join(s.shadowed, ", "),
+	)
+}
+
+func (s *ShadowedStatesAlert) ShouldIgnoreResource() bool {
+	return false
+}
+
+type ManagedByMultipleStatesAlert struct {
+	states []string
+}
+
+func NewManagedByMultipleStatesAlert(states []string) *ManagedByMultipleStatesAlert {
+	return &ManagedByMultipleStatesAlert{states: states}
+}
+
+func (m *ManagedByMultipleStatesAlert) Message() string {
+	return fmt.Sprintf("Resource is managed by multiple states: %s", strings.Join(m.states, ", "))
+}
+
+func (m *ManagedByMultipleStatesAlert) ShouldIgnoreResource() bool {
+	return false
+}
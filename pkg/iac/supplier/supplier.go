@@ -11,8 +11,11 @@ import (
 	"github.com/snyk/driftctl/pkg/output"
 	"github.com/snyk/driftctl/pkg/terraform"
 
+	"github.com/snyk/driftctl/pkg/iac/cloudformation"
 	"github.com/snyk/driftctl/pkg/iac/config"
+	"github.com/snyk/driftctl/pkg/iac/pulumi"
 
+	"github.com/snyk/driftctl/pkg/iac/terraform/plan"
 	"github.com/snyk/driftctl/pkg/iac/terraform/state"
 
 	"github.com/snyk/driftctl/pkg/resource"
@@ -20,6 +23,9 @@ import (
 
 var supportedSuppliers = []string{
 	state.TerraformStateReaderSupplier,
+	plan.TerraformPlanReaderSupplier,
+	cloudformation.CloudformationReaderSupplier,
+	pulumi.PulumiReaderSupplier,
 }
 
 func IsSupplierSupported(supplierKey string) bool {
@@ -31,6 +37,32 @@ func IsSupplierSupported(supplierKey string) bool {
 	return false
 }
 
+// IsBackendSupported returns true if the given backend can be used with the given supplier
+func IsBackendSupported(supplierKey, backendKey string) bool {
+	if supplierKey == cloudformation.CloudformationReaderSupplier {
+		return cloudformation.IsBackendSupported(backendKey)
+	}
+	// Terraform only pulls states, not plans nor pulumi stacks, and terragrunt only configures states
+	if backendKey == backend.BackendKeyTFExec || backendKey == backend.BackendKeyTerragrunt {
+		return supplierKey == state.TerraformStateReaderSupplier
+	}
+	return backend.IsSupported(backendKey)
+}
+
+// GetSupportedBackends returns the backends that can be used with the given supplier
+func GetSupportedBackends(supplierKey string) []string {
+	if supplierKey == cloudformation.CloudformationReaderSupplier {
+		return cloudformation.GetSupportedBackends()
+	}
+	backends := make([]string, 0)
+	for _, b := range backend.GetSupportedBackends() {
+		if IsBackendSupported(supplierKey, b) {
+			backends = append(backends, b)
+		}
+	}
+	return backends
+}
+
 func GetIACSupplier(configs []config.SupplierConfig,
 	library *terraform.ProviderLibrary,
 	backendOpts *backend.Options,
@@ -39,19 +71,26 @@ func GetIACSupplier(configs []config.SupplierConfig,
 	factory resource.ResourceFactory,
 	filter filter.Filter) (resource.IaCSupplier, error) {
 
-	chainSupplier := NewIacChainSupplier()
+	chainSupplier := NewIacChainSupplier(alerter)
 	for _, config := range configs {
 		if !IsSupplierSupported(config.Key) {
 			return nil, errors.Errorf("Unsupported supplier '%s'", config.Key)
 		}
 
 		deserializer := resource.NewDeserializer(factory)
+		sourceOpts := backendOpts.ForSource(config.Options)
 
 		var supplier resource.IaCSupplier
 		var err error
 		switch config.Key {
 		case state.TerraformStateReaderSupplier:
-			supplier, err = state.NewReader(config, library, backendOpts, progress, alerter, deserializer, filter)
+			supplier, err = state.NewReader(config, library, sourceOpts, progress, alerter, deserializer, filter)
+		case plan.TerraformPlanReaderSupplier:
+			supplier, err = plan.NewReader(config, library, sourceOpts, progress, deserializer, filter)
+		case cloudformation.CloudformationReaderSupplier:
+			supplier, err = cloudformation.NewReader(config, progress, factory, filter)
+		case pulumi.PulumiReaderSupplier:
+			supplier, err = pulumi.NewReader(config, sourceOpts, progress, factory, filter)
 		default:
 			return nil, errors.Errorf("Unsupported supplier '%s'", config.Key)
 		}
@@ -78,9 +117,12 @@ func GetSupportedSuppliers() []string {
 func GetSupportedSchemes() []string {
 	schemes := []string{
 		"tfstate://",
+		"tfplan://",
+		"cfn://",
+		"pulumi://",
 	}
 	for _, supplier := range supportedSuppliers {
-		for _, backend := range backend.GetSupportedBackends() {
+		for _, backend := range GetSupportedBackends(supplier) {
 			schemes = append(schemes, fmt.Sprintf("%s+%s://", supplier, backend))
 		}
 	}
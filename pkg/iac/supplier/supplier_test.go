@@ -69,6 +69,18 @@ func TestGetIACSupplier(t *testing.T) {
 			},
 			wantErr: nil,
 		},
+		{
+			name: "test valid tfplan://plan.json",
+			args: args{
+				config: []config.SupplierConfig{
+					{Key: "tfplan", Backend: "", Path: "plan.json"},
+				},
+				options: &backend.Options{
+					Headers: map[string]string{},
+				},
+			},
+			wantErr: nil,
+		},
 		{
 			name: "test valid multiples states",
 			args: args{
@@ -109,12 +121,42 @@ func TestGetSupportedSchemes(t *testing.T) {
 
 	want := []string{
 		"tfstate://",
+		"tfplan://",
+		"cfn://",
+		"pulumi://",
 		"tfstate+s3://",
 		"tfstate+http://",
 		"tfstate+https://",
 		"tfstate+tfcloud://",
 		"tfstate+gs://",
 		"tfstate+azurerm://",
+		"tfstate+consul://",
+		"tfstate+pg://",
+		"tfstate+kubernetes://",
+		"tfstate+stdin://",
+		"tfstate+tfexec://",
+		"tfstate+terragrunt://",
+		"tfplan+s3://",
+		"tfplan+http://",
+		"tfplan+https://",
+		"tfplan+tfcloud://",
+		"tfplan+gs://",
+		"tfplan+azurerm://",
+		"tfplan+consul://",
+		"tfplan+pg://",
+		"tfplan+kubernetes://",
+		"tfplan+stdin://",
+		"cfn+file://",
+		"pulumi+s3://",
+		"pulumi+http://",
+		"pulumi+https://",
+		"pulumi+tfcloud://",
+		"pulumi+gs://",
+		"pulumi+azurerm://",
+		"pulumi+consul://",
+		"pulumi+pg://",
+		"pulumi+kubernetes://",
+		"pulumi+stdin://",
 	}
 
 	if got := GetSupportedSchemes(); !reflect.DeepEqual(got, want) {
@@ -0,0 +1,352 @@
+package plan
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform/addrs"
+	"github.com/hashicorp/terraform/states"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/snyk/driftctl/pkg/filter"
+	"github.com/snyk/driftctl/pkg/output"
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/snyk/driftctl/pkg/iac/config"
+	"github.com/snyk/driftctl/pkg/iac/terraform/state"
+	"github.com/snyk/driftctl/pkg/iac/terraform/state/backend"
+	"github.com/snyk/driftctl/pkg/resource"
+	"github.com/snyk/driftctl/pkg/terraform"
+)
+
+const TerraformPlanReaderSupplier = "tfplan"
+
+// plan is the subset of the `terraform show -json` output we rely on
+type plan struct {
+	FormatVersion    string       `json:"format_version"`
+	TerraformVersion string       `json:"terraform_version"`
+	PlannedValues    *stateValues `json:"planned_values"`
+}
+
+type stateValues struct {
+	RootModule *stateModule `json:"root_module"`
+}
+
+type stateModule struct {
+	Address      string           `json:"address"`
+	Resources    []*stateResource `json:"resources"`
+	ChildModules []*stateModule   `json:"child_modules"`
+}
+
+type stateResource struct {
+	Address      string          `json:"address"`
+	Mode         string          `json:"mode"`
+	Type         string          `json:"type"`
+	Name         string          `json:"name"`
+	Index        json.RawMessage `json:"index"`
+	ProviderName string          `json:"provider_name"`
+	Values       json.RawMessage `json:"values"`
+}
+
+type decodedRes struct {
+	source resource.Source
+	val    cty.Value
+}
+
+// TerraformPlanReader reads the planned values of a JSON plan and uses them as the desired state
+type TerraformPlanReader struct {
+	library        *terraform.ProviderLibrary
+	config         config.SupplierConfig
+	deserializer   *resource.Deserializer
+	backendOptions *backend.Options
+	progress       output.Progress
+	filter         filter.Filter
+	sourceCount    uint
+	dataSources    []*resource.Resource
+}
+
+func NewReader(config config.SupplierConfig, library *terraform.ProviderLibrary, backendOpts *backend.Options, progress output.Progress, deserializer *resource.Deserializer, filter filter.Filter) (*TerraformPlanReader, error) {
+	return &TerraformPlanReader{
+		library:        library,
+		config:         config,
+		deserializer:   deserializer,
+		backendOptions: backendOpts,
+		progress:       progress,
+		filter:         filter,
+		sourceCount:    0,
+	}, nil
+}
+
+func (r *TerraformPlanReader) Resources() ([]*resource.Resource, error) {
+	r.sourceCount += 1
+	logrus.WithFields(logrus.Fields{
+		"path":    r.config.Path,
+		"backend": r.config.Backend,
+	}).Debug("Reading resources from plan")
+	r.progress.Inc()
+	values, err := r.retrieve()
+	if err != nil {
+		return nil, errors.Wrap(err, r.config.String())
+	}
+	return r.decode(values), nil
+}
+
+func (r *TerraformPlanReader) SourceCount() uint {
+	return r.sourceCount
+}
+
+func (r *TerraformPlanReader) DataSources() []*resource.Resource {
+	return r.dataSources
+}
+
+func (r *TerraformPlanReader) retrieve() (map[string][]decodedRes, error) {
+	b, err := backend.GetBackend(r.config, r.backendOptions)
+	if err != nil {
+		return nil, err
+	}
+	defer b.Close()
+
+	p, err := read(b)
+	if err != nil {
+		return nil, err
+	}
+
+	resMap := make(map[string][]decodedRes)
+	if p.PlannedValues == nil || p.PlannedValues.RootModule == nil {
+		return resMap, nil
+	}
+
+	err = r.retrieveModule(p.PlannedValues.RootModule, resMap)
+	if err != nil {
+		return nil, err
+	}
+
+	return resMap, nil
+}
+
+func (r *TerraformPlanReader) retrieveModule(module *stateModule, resMap map[string][]decodedRes) error {
+	logrus.WithFields(logrus.Fields{
+		"module":        module.Address,
+		"resourceCount": fmt.Sprintf("%d", len(module.Resources)),
+	}).Debug("Found module in plan")
+
+	for _, planRes := range module.Resources {
+		if planRes.Mode == resource.TerraformDataMode {
+			if err := r.retrieveDataSource(module, planRes); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if !resource.IsResourceTypeSupported(planRes.Type) {
+			logrus.WithFields(logrus.Fields{
+				"name": planRes.Name,
+				"type": planRes.Type,
+			}).Debug("Ignored unsupported resource from plan")
+			continue
+		}
+
+		if r.filter != nil && r.filter.IsTypeIgnored(resource.ResourceType(planRes.Type)) {
+			logrus.WithFields(logrus.Fields{
+				"name": planRes.Name,
+				"type": planRes.Type,
+			}).Debug("Ignored resource from plan since it is ignored in filter")
+			continue
+		}
+
+		if planRes.Mode != "managed" {
+			logrus.WithFields(logrus.Fields{
+				"mode": planRes.Mode,
+				"name": planRes.Name,
+				"type": planRes.Type,
+			}).Debug("Skipping plan entry as it is not a managed resource")
+			continue
+		}
+
+		providerAddr, diags := addrs.ParseProviderSourceString(planRes.ProviderName)
+		if diags.HasErrors() {
+			return diags.Err()
+		}
+		provider := r.library.Provider(providerAddr.Type)
+		if provider == nil {
+			logrus.WithFields(logrus.Fields{
+				"providerKey": providerAddr.Type,
+			}).Debug("Unsupported provider found in plan")
+			continue
+		}
+		schema, exists := provider.Schema()[planRes.Type]
+		if !exists {
+			logrus.WithFields(logrus.Fields{
+				"name":    planRes.Name,
+				"type":    planRes.Type,
+				"version": provider.Version(),
+			}).Warn("Ignored resource from plan since its type is unknown to this provider version")
+			continue
+		}
+
+		decodedVal, err := decodeValues(planRes.Values, schema.Block.ImpliedType())
+		if err != nil {
+			logrus.WithFields(logrus.Fields{
+				"name": planRes.Name,
+				"type": planRes.Type,
+			}).Error("Unable to decode resource from plan")
+			return err
+		}
+
+		// Resources that are about to be created have an unknown id, they cannot be matched
+		// against remote resources so there is no point in keeping them
+		if id := decodedVal.GetAttr("id"); id.IsNull() {
+			logrus.WithFields(logrus.Fields{
+				"address": planRes.Address,
+			}).Debug("Skipping plan entry as its id is not known yet")
+			continue
+		}
+
+		source := resource.NewTerraformStateSource(r.config.String(), module.Address, planRes.Name)
+		key, err := instanceKey(planRes.Index)
+		if err != nil {
+			return err
+		}
+		if key != addrs.NoKey {
+			source.Key = key.String()
+		}
+		resMap[planRes.Type] = append(resMap[planRes.Type], decodedRes{
+			source: source,
+			val:    decodedVal,
+		})
+	}
+
+	for _, child := range module.ChildModules {
+		if err := r.retrieveModule(child, resMap); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// retrieveDataSource keeps the values of data sources as they are in the plan, they are only reported
+// so they are neither decoded against the provider schema nor restricted to the supported resource types
+func (r *TerraformPlanReader) retrieveDataSource(module *stateModule, planRes *stateResource) error {
+	attrs := resource.Attributes{}
+	if len(planRes.Values) > 0 {
+		if err := json.Unmarshal(planRes.Values, &attrs); err != nil {
+			logrus.WithFields(logrus.Fields{
+				"name": planRes.Name,
+				"type": planRes.Type,
+			}).Error("Unable to decode data source from plan")
+			return err
+		}
+	}
+	source := resource.NewTerraformStateSource(r.config.String(), module.Address, planRes.Name)
+	source.Mode = resource.TerraformDataMode
+	key, err := instanceKey(planRes.Index)
+	if err != nil {
+		return err
+	}
+	if key != addrs.NoKey {
+		source.Key = key.String()
+	}
+	id, _ := attrs["id"].(string)
+	r.dataSources = append(r.dataSources, &resource.Resource{
+		Id:     id,
+		Type:   planRes.Type,
+		Attrs:  &attrs,
+		Source: source,
+	})
+	return nil
+}
+
+// instanceKey converts the index of a count or for_each resource instance to its key
+func instanceKey(index json.RawMessage) (addrs.InstanceKey, error) {
+	if len(index) == 0 {
+		return addrs.NoKey, nil
+	}
+	var value interface{}
+	if err := json.Unmarshal(index, &value); err != nil {
+		return nil, err
+	}
+	switch v := value.(type) {
+	case string:
+		return addrs.StringKey(v), nil
+	case float64:
+		return addrs.IntKey(int(v)), nil
+	}
+	return nil, errors.Errorf("unsupported resource instance index: %s", string(index))
+}
+
+func (r *TerraformPlanReader) decode(valFromPlan map[string][]decodedRes) []*resource.Resource {
+	results := make([]*resource.Resource, 0)
+
+	for ty, val := range valFromPlan {
+		for _, planVal := range val {
+			res, err := r.deserializer.DeserializeOne(ty, planVal.val)
+			if err != nil {
+				logrus.WithFields(logrus.Fields{
+					"type": ty,
+					"name": planVal.source.InternalName(),
+					"plan": planVal.source.Source(),
+				}).Warnf("Could not read from plan: %+v", err)
+				continue
+			}
+			res.Source = planVal.source
+			results = append(results, res)
+		}
+	}
+
+	return results
+}
+
+// decodeValues decodes planned values against the provider schema.
+// Unknown values are omitted from plans, so missing attributes are decoded as null. Like for states,
+// attributes that are not part of the schema are dropped to allow reading a plan generated with a
+// superior version of provider than the actually supported one.
+func decodeValues(values json.RawMessage, ty cty.Type) (cty.Value, error) {
+	src := &states.ResourceInstanceObjectSrc{AttrsJSON: values}
+	obj, err := src.Decode(ty)
+	if err == nil {
+		return obj.Value, nil
+	}
+	if _, isPathError := err.(cty.PathError); !isPathError {
+		return cty.NilVal, err
+	}
+
+	var attrs map[string]json.RawMessage
+	if err := json.Unmarshal(values, &attrs); err != nil {
+		return cty.NilVal, err
+	}
+	for name := range attrs {
+		if !ty.HasAttribute(name) {
+			delete(attrs, name)
+		}
+	}
+	src.AttrsJSON, err = json.Marshal(attrs)
+	if err != nil {
+		return cty.NilVal, err
+	}
+	obj, err = src.Decode(ty)
+	if err != nil {
+		return cty.NilVal, err
+	}
+	return obj.Value, nil
+}
+
+func read(reader backend.Backend) (*plan, error) {
+	var p plan
+	if err := json.NewDecoder(reader).Decode(&p); err != nil {
+		return nil, errors.Errorf("given file is not a valid JSON plan: %s", err)
+	}
+	if p.FormatVersion == "" {
+		return nil, errors.New("given file is not a valid JSON plan: format version is missing, please use the output of `terraform show -json`")
+	}
+
+	supported, err := state.IsVersionSupported(p.TerraformVersion)
+	if err != nil {
+		return nil, err
+	}
+	if !supported {
+		return nil, errors.Errorf("plan was generated using Terraform %s which is currently not supported by driftctl", p.TerraformVersion)
+	}
+
+	return &p, nil
+}
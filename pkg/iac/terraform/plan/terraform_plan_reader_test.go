@@ -0,0 +1,131 @@
+package plan
+
+import (
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/providers"
+	"github.com/snyk/driftctl/pkg/iac/config"
+	"github.com/snyk/driftctl/pkg/output"
+	"github.com/snyk/driftctl/pkg/remote/google"
+	"github.com/snyk/driftctl/pkg/resource"
+	resourcegoogle "github.com/snyk/driftctl/pkg/resource/google"
+	"github.com/snyk/driftctl/pkg/terraform"
+	testresource "github.com/snyk/driftctl/test/resource"
+	terraform2 "github.com/snyk/driftctl/test/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+// olderProvider hides resource types from the schema, as a provider version released before them would
+type olderProvider struct {
+	*terraform2.FakeTerraformProvider
+	unknownTypes []string
+}
+
+func (p olderProvider) Schema() map[string]providers.Schema {
+	schema := p.FakeTerraformProvider.Schema()
+	for _, ty := range p.unknownTypes {
+		delete(schema, ty)
+	}
+	return schema
+}
+
+func TestTerraformPlanReader_Resources(t *testing.T) {
+	tests := []struct {
+		name            string
+		path            string
+		unknownTypes    []string
+		want            map[string]*resource.TerraformStateSource
+		wantDataSources map[string]string
+		wantErr         string
+	}{
+		{
+			name: "read planned values",
+			path: "testdata/plan.json",
+			want: map[string]*resource.TerraformStateSource{
+				"driftctl-bucket": {
+					State:  "tfplan://testdata/plan.json",
+					Module: "",
+					Name:   "bucket",
+					Key:    `["driftctl"]`,
+				},
+				"projects/driftctl-qa-1/global/networks/vpc": {
+					State:  "tfplan://testdata/plan.json",
+					Module: "module.network",
+					Name:   "vpc",
+					Key:    "[0]",
+				},
+			},
+			wantDataSources: map[string]string{
+				"existing-bucket": "data.google_storage_bucket.existing",
+			},
+		},
+		{
+			name:         "read a plan with a type unknown to the provider",
+			path:         "testdata/plan_unknown_type.json",
+			unknownTypes: []string{"google_compute_router"},
+			want: map[string]*resource.TerraformStateSource{
+				"driftctl-bucket": {
+					State:  "tfplan://testdata/plan_unknown_type.json",
+					Module: "",
+					Name:   "bucket",
+				},
+			},
+		},
+		{
+			name:    "read a state instead of a plan",
+			path:    "testdata/not_a_plan.json",
+			wantErr: "tfplan://testdata/not_a_plan.json: given file is not a valid JSON plan: format version is missing, please use the output of `terraform show -json`",
+		},
+		{
+			name:    "read a missing file",
+			path:    "testdata/missing.json",
+			wantErr: "tfplan://testdata/missing.json: open testdata/missing.json: no such file or directory",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			progress := &output.MockProgress{}
+			progress.On("Inc").Return().Times(1)
+
+			realProvider, err := google.NewGCPTerraformProvider("3.78.0", progress, os.TempDir())
+			if err != nil {
+				t.Fatal(err)
+			}
+			library := terraform.NewProviderLibrary()
+			library.AddProvider(terraform.GOOGLE, olderProvider{terraform2.NewFakeTerraformProvider(realProvider), tt.unknownTypes})
+
+			repo := testresource.InitFakeSchemaRepository(terraform.GOOGLE, "3.78.0")
+			resourcegoogle.InitResourcesMetadata(repo)
+			factory := terraform.NewTerraformResourceFactory(repo)
+
+			r, err := NewReader(
+				config.SupplierConfig{Key: TerraformPlanReaderSupplier, Path: tt.path},
+				library,
+				nil,
+				progress,
+				resource.NewDeserializer(factory),
+				nil,
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			got, err := r.Resources()
+			assert.Equal(t, uint(1), r.SourceCount())
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Len(t, got, len(tt.want))
+			for _, res := range got {
+				assert.Equal(t, tt.want[res.ResourceId()], res.Source)
+			}
+			assert.Len(t, r.DataSources(), len(tt.wantDataSources))
+			for _, res := range r.DataSources() {
+				assert.Equal(t, tt.wantDataSources[res.ResourceId()], res.SourceString())
+			}
+		})
+	}
+}
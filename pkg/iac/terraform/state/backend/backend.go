@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/snyk/driftctl/pkg/iac/config"
@@ -18,15 +19,41 @@ var supportedBackends = []string{
 	BackendKeyTFCloud,
 	BackendKeyGS,
 	BackendKeyAzureRM,
+	BackendKeyConsul,
+	BackendKeyPg,
+	BackendKeyKubernetes,
+	BackendKeyStdin,
+	BackendKeyTFExec,
+	BackendKeyTerragrunt,
 }
 
+// BackendKeyTerragrunt only enumerates states, which are then read from the backends configured in terragrunt
+const BackendKeyTerragrunt = "terragrunt"
+
 type Backend io.ReadCloser
 
+// VersionedBackend is implemented by backends able to read a past version of a state
+type VersionedBackend interface {
+	Version() string
+}
+
 type Options struct {
 	Headers         map[string]string
 	TFCloudToken    string
 	TFCloudEndpoint string
+	// TFCloudWorkspaceTags filters workspaces enumerated from a glob pattern
+	TFCloudWorkspaceTags []string
+	// HTTPManifest makes http(s) urls point to a JSON manifest listing states urls
+	HTTPManifest bool
+	// At makes versioned backends read the state as it was at that time
+	At time.Time
+	options.AWSBackendOptions
 	options.AzureRMBackendOptions
+	options.ConsulBackendOptions
+	options.PgBackendOptions
+	options.KubernetesBackendOptions
+	options.TFExecBackendOptions
+	options.DecryptionOptions
 }
 
 func IsSupported(backend string) bool {
@@ -50,7 +77,7 @@ func GetBackend(config config.SupplierConfig, opts *Options) (Backend, error) {
 	case BackendKeyFile:
 		return NewFileReader(config.Path)
 	case BackendKeyS3:
-		return NewS3Reader(config.Path)
+		return NewS3Reader(config.Path, opts)
 	case BackendKeyHTTP:
 		fallthrough
 	case BackendKeyHTTPS:
@@ -58,9 +85,19 @@ func GetBackend(config config.SupplierConfig, opts *Options) (Backend, error) {
 	case BackendKeyTFCloud:
 		return NewTFCloudReader(config.Path, opts), nil
 	case BackendKeyGS:
-		return NewGSReader(config.Path)
+		return NewGSReader(config.Path, opts)
 	case BackendKeyAzureRM:
 		return NewAzureRMReader(config.Path, opts.AzureRMBackendOptions)
+	case BackendKeyConsul:
+		return NewConsulReader(&http.Client{}, config.Path, opts.ConsulBackendOptions)
+	case BackendKeyPg:
+		return NewPgReader(config.Path, opts.PgBackendOptions)
+	case BackendKeyKubernetes:
+		return NewKubernetesReader(config.Path, opts.KubernetesBackendOptions)
+	case BackendKeyStdin:
+		return NewStdinReader(), nil
+	case BackendKeyTFExec:
+		return NewTFExecReader(config.Path, opts.TFExecBackendOptions)
 	default:
 		return nil, errors.Errorf("Unsupported backend '%s'", backend)
 	}
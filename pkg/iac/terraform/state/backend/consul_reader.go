@@ -0,0 +1,164 @@
+package backend
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	pkghttp "github.com/snyk/driftctl/pkg/http"
+	"github.com/snyk/driftctl/pkg/iac/terraform/state/backend/options"
+)
+
+const BackendKeyConsul = "consul"
+
+const consulDefaultAddress = "127.0.0.1:8500"
+
+// consulChunkedPayload is stored by terraform in place of states too large for a single consul key
+type consulChunkedPayload struct {
+	Hash   string   `json:"hash"`
+	Chunks []string `json:"chunks"`
+}
+
+// ConsulKVClient is a minimal client for the consul KV HTTP API
+type ConsulKVClient struct {
+	client  pkghttp.HTTPClient
+	address string
+	token   string
+}
+
+func NewConsulKVClient(client pkghttp.HTTPClient, opts options.ConsulBackendOptions) *ConsulKVClient {
+	address := opts.Address
+	if address == "" {
+		address = consulDefaultAddress
+	}
+	if !strings.Contains(address, "://") {
+		address = fmt.Sprintf("http://%s", address)
+	}
+	return &ConsulKVClient{
+		client:  client,
+		address: strings.TrimSuffix(address, "/"),
+		token:   opts.Token,
+	}
+}
+
+func (c *ConsulKVClient) do(key string, query url.Values) ([]byte, error) {
+	req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("%s/v1/kv/%s?%s", c.address, key, query.Encode()), nil)
+	if err != nil {
+		return nil, err
+	}
+	if c.token != "" {
+		req.Header.Add("X-Consul-Token", c.token)
+	}
+
+	res, err := c.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+	if res.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	if res.StatusCode < 200 || res.StatusCode >= 400 {
+		logrus.WithFields(logrus.Fields{"body": string(body)}).Trace("Consul backend response")
+		return nil, errors.Errorf("error requesting consul backend: status code: %d", res.StatusCode)
+	}
+	return body, nil
+}
+
+// Get returns the raw value of a key, or nil if the key does not exist
+func (c *ConsulKVClient) Get(key string) ([]byte, error) {
+	return c.do(key, url.Values{"raw": []string{""}})
+}
+
+// Keys lists every key starting with the given prefix
+func (c *ConsulKVClient) Keys(prefix string) ([]string, error) {
+	body, err := c.do(prefix, url.Values{"keys": []string{""}})
+	if err != nil || body == nil {
+		return nil, err
+	}
+	var keys []string
+	if err := json.Unmarshal(body, &keys); err != nil {
+		return nil, err
+	}
+	return keys, nil
+}
+
+type ConsulBackend struct {
+	client *ConsulKVClient
+	key    string
+	reader io.ReadCloser
+}
+
+func NewConsulReader(client pkghttp.HTTPClient, path string, opts options.ConsulBackendOptions) (*ConsulBackend, error) {
+	key := strings.Trim(path, "/")
+	if key == "" {
+		return nil, errors.Errorf("Unable to parse consul path: %s. Must be PATH/TO/KEY", path)
+	}
+	return &ConsulBackend{
+		client: NewConsulKVClient(client, opts),
+		key:    key,
+	}, nil
+}
+
+func (c *ConsulBackend) get(key string) ([]byte, error) {
+	payload, err := c.client.Get(key)
+	if err != nil {
+		return nil, err
+	}
+	if payload == nil {
+		return nil, errors.Errorf("Error reading state '%s' from consul: key not found", key)
+	}
+	return payload, nil
+}
+
+func (c *ConsulBackend) Read(p []byte) (n int, err error) {
+	if c.reader == nil {
+		payload, err := c.get(c.key)
+		if err != nil {
+			return 0, err
+		}
+
+		chunked := consulChunkedPayload{}
+		if err := json.Unmarshal(payload, &chunked); err == nil && len(chunked.Chunks) > 0 {
+			payload = []byte{}
+			for _, chunk := range chunked.Chunks {
+				data, err := c.get(chunk)
+				if err != nil {
+					return 0, err
+				}
+				payload = append(payload, data...)
+			}
+		}
+
+		// States are gzipped when the gzip option of the terraform backend is enabled
+		if len(payload) > 1 && payload[0] == 0x1f && payload[1] == 0x8b {
+			reader, err := gzip.NewReader(bytes.NewReader(payload))
+			if err != nil {
+				return 0, err
+			}
+			c.reader = reader
+		} else {
+			c.reader = io.NopCloser(bytes.NewReader(payload))
+		}
+	}
+	return c.reader.Read(p)
+}
+
+func (c *ConsulBackend) Close() error {
+	if c.reader != nil {
+		return c.reader.Close()
+	}
+	return errors.New("Unable to close reader as nothing was opened")
+}
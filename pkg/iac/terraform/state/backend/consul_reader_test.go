@@ -0,0 +1,107 @@
+package backend
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/snyk/driftctl/pkg/iac/terraform/state/backend/options"
+	"github.com/stretchr/testify/assert"
+)
+
+func gzipped(t *testing.T, content string) []byte {
+	var b bytes.Buffer
+	w := gzip.NewWriter(&b)
+	if _, err := w.Write([]byte(content)); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return b.Bytes()
+}
+
+func TestNewConsulReader(t *testing.T) {
+	reader, err := NewConsulReader(&http.Client{}, "terraform/network/", options.ConsulBackendOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, "terraform/network", reader.key)
+	assert.Equal(t, "http://127.0.0.1:8500", reader.client.address)
+
+	reader, err = NewConsulReader(&http.Client{}, "terraform/network", options.ConsulBackendOptions{Address: "https://consul.example.com/"})
+	assert.NoError(t, err)
+	assert.Equal(t, "https://consul.example.com", reader.client.address)
+
+	_, err = NewConsulReader(&http.Client{}, "/", options.ConsulBackendOptions{})
+	assert.EqualError(t, err, "Unable to parse consul path: /. Must be PATH/TO/KEY")
+}
+
+func TestConsulBackend_Read(t *testing.T) {
+	tests := []struct {
+		name     string
+		kv       map[string][]byte
+		expected string
+		wantErr  string
+	}{
+		{
+			name: "should read a plain state",
+			kv: map[string][]byte{
+				"terraform/network": []byte(`{"version": 4}`),
+			},
+			expected: `{"version": 4}`,
+		},
+		{
+			name: "should read a gzipped state",
+			kv: map[string][]byte{
+				"terraform/network": gzipped(t, `{"version": 4}`),
+			},
+			expected: `{"version": 4}`,
+		},
+		{
+			name: "should read a chunked state",
+			kv: map[string][]byte{
+				"terraform/network":                []byte(`{"hash": "abcd", "chunks": ["terraform/network/tfstate.abcd/0", "terraform/network/tfstate.abcd/1"]}`),
+				"terraform/network/tfstate.abcd/0": []byte(`{"vers`),
+				"terraform/network/tfstate.abcd/1": []byte(`ion": 4}`),
+			},
+			expected: `{"version": 4}`,
+		},
+		{
+			name:    "should fail when the key does not exist",
+			kv:      map[string][]byte{},
+			wantErr: "Error reading state 'terraform/network' from consul: key not found",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "TOKEN", r.Header.Get("X-Consul-Token"))
+				assert.True(t, r.URL.Query().Has("raw"))
+				value, exists := tt.kv[r.URL.Path[len("/v1/kv/"):]]
+				if !exists {
+					w.WriteHeader(http.StatusNotFound)
+					return
+				}
+				_, _ = w.Write(value)
+			}))
+			defer server.Close()
+
+			reader, err := NewConsulReader(&http.Client{}, "terraform/network", options.ConsulBackendOptions{
+				Address: server.URL,
+				Token:   "TOKEN",
+			})
+			assert.NoError(t, err)
+
+			got, err := io.ReadAll(reader)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, string(got))
+			assert.NoError(t, reader.Close())
+		})
+	}
+}
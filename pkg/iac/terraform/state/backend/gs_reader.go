@@ -3,10 +3,13 @@ package backend
 import (
 	"context"
 	"io"
+	"strconv"
 	"strings"
+	"time"
 
 	"cloud.google.com/go/storage"
 	"github.com/pkg/errors"
+	"google.golang.org/api/iterator"
 )
 
 const BackendKeyGS = "gs"
@@ -15,10 +18,12 @@ type GSBackend struct {
 	bucketName    string
 	path          string
 	reader        io.ReadCloser
+	at            time.Time
+	generation    int64
 	storageClient *storage.Client
 }
 
-func NewGSReader(path string) (*GSBackend, error) {
+func NewGSReader(path string, opts *Options) (*GSBackend, error) {
 	bucketPath := strings.Split(path, "/")
 	if len(bucketPath) < 2 {
 		return nil, errors.Errorf("Unable to parse Google Storage path: %s. Must be BUCKET_NAME/PATH/TO/OBJECT", path)
@@ -29,9 +34,52 @@ func NewGSReader(path string) (*GSBackend, error) {
 	return &GSBackend{
 		bucketName: bucketName,
 		path:       key,
+		at:         opts.At,
 	}, nil
 }
 
+// Version returns the generation of the state object that was current at the requested time
+func (s *GSBackend) Version() string {
+	if s.generation == 0 {
+		return ""
+	}
+	return strconv.FormatInt(s.generation, 10)
+}
+
+func (s *GSBackend) findGenerationAt(ctx context.Context) (int64, error) {
+	var generation int64
+	it := s.storageClient.Bucket(s.bucketName).Objects(ctx, &storage.Query{
+		Prefix:   s.path,
+		Versions: true,
+	})
+	for {
+		attrs, err := it.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return 0, err
+		}
+		if attrs.Name != s.path || attrs.Created.After(s.at) {
+			continue
+		}
+		// A generation stops being live when it is overwritten or deleted
+		if !attrs.Deleted.IsZero() && !attrs.Deleted.After(s.at) {
+			continue
+		}
+		generation = attrs.Generation
+	}
+	if generation == 0 {
+		return 0, errors.Errorf(
+			"No generation of state '%s' from Google Storage bucket '%s' existed at %s",
+			s.path,
+			s.bucketName,
+			s.at.Format(time.RFC3339),
+		)
+	}
+	return generation, nil
+}
+
 func (s *GSBackend) Read(p []byte) (int, error) {
 	if s.reader == nil {
 		if s.storageClient == nil {
@@ -43,7 +91,18 @@ func (s *GSBackend) Read(p []byte) (int, error) {
 		}
 
 		ctx := context.Background()
-		rc, err := s.storageClient.Bucket(s.bucketName).Object(s.path).NewReader(ctx)
+		object := s.storageClient.Bucket(s.bucketName).Object(s.path)
+		if !s.at.IsZero() {
+			if s.generation == 0 {
+				generation, err := s.findGenerationAt(ctx)
+				if err != nil {
+					return 0, err
+				}
+				s.generation = generation
+			}
+			object = object.Generation(s.generation)
+		}
+		rc, err := object.NewReader(ctx)
 		if err != nil {
 			return 0, err
 		}
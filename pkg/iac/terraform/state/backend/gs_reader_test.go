@@ -7,6 +7,7 @@ import (
 	"net/http"
 	"reflect"
 	"testing"
+	"time"
 
 	"cloud.google.com/go/storage"
 	googletest "github.com/snyk/driftctl/test/google"
@@ -44,7 +45,7 @@ func TestGSBackend_NewGSReader(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got, err := NewGSReader(tt.args.path)
+			got, err := NewGSReader(tt.args.path, &Options{})
 			if tt.wantErr != nil {
 				assert.EqualError(t, err, tt.wantErr.Error())
 				return
@@ -172,3 +173,79 @@ func TestGSBackend_Close(t *testing.T) {
 		})
 	}
 }
+
+func TestGSBackend_ReadAt(t *testing.T) {
+	at := time.Date(2021, 11, 23, 12, 0, 0, 0, time.UTC)
+	generations := `{
+		"kind": "storage#objects",
+		"items": [
+			{"name": "terraform.tfstate", "generation": "1", "timeCreated": "2021-11-20T12:00:00Z", "timeDeleted": "2021-11-23T11:00:00Z"},
+			{"name": "terraform.tfstate", "generation": "2", "timeCreated": "2021-11-23T11:00:00Z", "timeDeleted": "2021-11-23T13:00:00Z"},
+			{"name": "terraform.tfstate", "generation": "3", "timeCreated": "2021-11-23T13:00:00Z"},
+			{"name": "terraform.tfstate.backup", "generation": "4", "timeCreated": "2021-11-23T11:30:00Z"}
+		]
+	}`
+
+	tests := []struct {
+		name        string
+		at          time.Time
+		wantErr     error
+		handlerFunc map[string]http.HandlerFunc
+		expected    string
+		generation  string
+	}{
+		{
+			name: "should read the generation live at the given time",
+			at:   at,
+			handlerFunc: map[string]http.HandlerFunc{
+				"/storage/v1/b/bucket-1/o": func(w http.ResponseWriter, r *http.Request) {
+					assert.Equal(t, "true", r.URL.Query().Get("versions"))
+					_, _ = w.Write([]byte(generations))
+				},
+				"/bucket-1/terraform.tfstate?generation=2": func(w http.ResponseWriter, r *http.Request) {
+					_, _ = w.Write([]byte(`{"version": "2.0.0"}`))
+				},
+			},
+			expected:   `{"version": "2.0.0"}`,
+			generation: "2",
+		},
+		{
+			name: "should fail when no generation was live at the given time",
+			at:   at.Add(-7 * 24 * time.Hour),
+			handlerFunc: map[string]http.HandlerFunc{
+				"/storage/v1/b/bucket-1/o": func(w http.ResponseWriter, r *http.Request) {
+					_, _ = w.Write([]byte(generations))
+				},
+			},
+			wantErr: errors.New("No generation of state 'terraform.tfstate' from Google Storage bucket 'bucket-1' existed at 2021-11-16T12:00:00Z"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, server, err := googletest.NewFakeStorageServer(tt.handlerFunc)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer client.Close()
+			defer server.Close()
+
+			reader := &GSBackend{
+				bucketName:    "bucket-1",
+				path:          "terraform.tfstate",
+				at:            tt.at,
+				storageClient: client,
+			}
+
+			got := make([]byte, len(tt.expected))
+			_, err = reader.Read(got)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+				return
+			} else {
+				assert.Equal(t, io.EOF, err)
+			}
+			assert.Equal(t, tt.expected, string(got))
+			assert.Equal(t, tt.generation, reader.Version())
+		})
+	}
+}
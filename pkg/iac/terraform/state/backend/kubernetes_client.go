@@ -0,0 +1,319 @@
+package backend
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/ghodss/yaml"
+	"github.com/mitchellh/go-homedir"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/snyk/driftctl/pkg/iac/terraform/state/backend/options"
+)
+
+const kubernetesServiceAccountDir = "/var/run/secrets/kubernetes.io/serviceaccount"
+
+// kubeconfig is the subset of a kubectl configuration file needed to reach the API server
+type kubeconfig struct {
+	CurrentContext string `json:"current-context"`
+	Clusters       []struct {
+		Name    string `json:"name"`
+		Cluster struct {
+			Server                   string `json:"server"`
+			CertificateAuthority     string `json:"certificate-authority"`
+			CertificateAuthorityData []byte `json:"certificate-authority-data"`
+			InsecureSkipTLSVerify    bool   `json:"insecure-skip-tls-verify"`
+			ProxyURL                 string `json:"proxy-url"`
+		} `json:"cluster"`
+	} `json:"clusters"`
+	Contexts []struct {
+		Name    string `json:"name"`
+		Context struct {
+			Cluster string `json:"cluster"`
+			User    string `json:"user"`
+		} `json:"context"`
+	} `json:"contexts"`
+	Users []struct {
+		Name string `json:"name"`
+		User struct {
+			Token                 string          `json:"token"`
+			TokenFile             string          `json:"tokenFile"`
+			ClientCertificate     string          `json:"client-certificate"`
+			ClientCertificateData []byte          `json:"client-certificate-data"`
+			ClientKey             string          `json:"client-key"`
+			ClientKeyData         []byte          `json:"client-key-data"`
+			Exec                  *kubeExecConfig `json:"exec"`
+			AuthProvider          *struct {
+				Name   string            `json:"name"`
+				Config map[string]string `json:"config"`
+			} `json:"auth-provider"`
+		} `json:"user"`
+	} `json:"users"`
+}
+
+type KubernetesSecret struct {
+	Metadata struct {
+		Name   string            `json:"name"`
+		Labels map[string]string `json:"labels"`
+	} `json:"metadata"`
+	Data map[string][]byte `json:"data"`
+}
+
+type kubernetesSecretList struct {
+	Items    []KubernetesSecret `json:"items"`
+	Metadata struct {
+		Continue string `json:"continue"`
+	} `json:"metadata"`
+}
+
+// KubernetesClient is a minimal client for the kubernetes secrets API
+type KubernetesClient struct {
+	host   string
+	token  string
+	exec   *kubernetesExecPlugin
+	client *http.Client
+}
+
+func NewKubernetesClient(opts options.KubernetesBackendOptions) (*KubernetesClient, error) {
+	configPath := opts.ConfigPath
+	if configPath == "" {
+		if env := os.Getenv("KUBECONFIG"); env != "" {
+			configPath = filepath.SplitList(env)[0]
+		} else if home, err := homedir.Dir(); err == nil {
+			configPath = filepath.Join(home, ".kube", "config")
+		}
+	}
+
+	if _, err := os.Stat(configPath); err != nil && opts.ConfigPath == "" && os.Getenv("KUBERNETES_SERVICE_HOST") != "" {
+		logrus.Debug("No kubeconfig found, using in cluster configuration")
+		return newInClusterKubernetesClient()
+	}
+
+	content, err := os.ReadFile(configPath)
+	if err != nil {
+		return nil, errors.Errorf("unable to read kubeconfig: %s", err)
+	}
+	return newKubernetesClientFromConfig(content, opts.ConfigContext)
+}
+
+func newInClusterKubernetesClient() (*KubernetesClient, error) {
+	token, err := os.ReadFile(filepath.Join(kubernetesServiceAccountDir, "token"))
+	if err != nil {
+		return nil, err
+	}
+	ca, err := os.ReadFile(filepath.Join(kubernetesServiceAccountDir, "ca.crt"))
+	if err != nil {
+		return nil, err
+	}
+	pool := x509.NewCertPool()
+	pool.AppendCertsFromPEM(ca)
+
+	return &KubernetesClient{
+		host:  fmt.Sprintf("https://%s", net.JoinHostPort(os.Getenv("KUBERNETES_SERVICE_HOST"), os.Getenv("KUBERNETES_SERVICE_PORT"))),
+		token: strings.TrimSpace(string(token)),
+		client: &http.Client{
+			Transport: &http.Transport{
+				Proxy:           http.ProxyFromEnvironment,
+				TLSClientConfig: &tls.Config{RootCAs: pool},
+			},
+		},
+	}, nil
+}
+
+func newKubernetesClientFromConfig(content []byte, contextName string) (*KubernetesClient, error) {
+	config := kubeconfig{}
+	if err := yaml.Unmarshal(content, &config); err != nil {
+		return nil, errors.Errorf("unable to parse kubeconfig: %s", err)
+	}
+
+	if contextName == "" {
+		contextName = config.CurrentContext
+	}
+	var clusterName, userName string
+	found := false
+	for _, c := range config.Contexts {
+		if c.Name == contextName {
+			clusterName, userName, found = c.Context.Cluster, c.Context.User, true
+		}
+	}
+	if !found {
+		return nil, errors.Errorf("context '%s' not found in kubeconfig", contextName)
+	}
+
+	client := &KubernetesClient{}
+	tlsConfig := &tls.Config{}
+	transport := &http.Transport{Proxy: http.ProxyFromEnvironment, TLSClientConfig: tlsConfig}
+	execCluster := kubeExecCluster{}
+	for _, c := range config.Clusters {
+		if c.Name != clusterName {
+			continue
+		}
+		client.host = strings.TrimSuffix(c.Cluster.Server, "/")
+		tlsConfig.InsecureSkipVerify = c.Cluster.InsecureSkipTLSVerify
+		if c.Cluster.ProxyURL != "" {
+			proxyURL, err := url.Parse(c.Cluster.ProxyURL)
+			if err != nil {
+				return nil, errors.Errorf("invalid proxy-url for cluster '%s': %s", clusterName, err)
+			}
+			transport.Proxy = http.ProxyURL(proxyURL)
+		}
+		ca := c.Cluster.CertificateAuthorityData
+		if c.Cluster.CertificateAuthority != "" {
+			data, err := os.ReadFile(c.Cluster.CertificateAuthority)
+			if err != nil {
+				return nil, err
+			}
+			ca = data
+		}
+		if len(ca) > 0 {
+			pool := x509.NewCertPool()
+			pool.AppendCertsFromPEM(ca)
+			tlsConfig.RootCAs = pool
+		}
+		execCluster = kubeExecCluster{
+			Server:                   c.Cluster.Server,
+			CertificateAuthorityData: ca,
+			InsecureSkipTLSVerify:    c.Cluster.InsecureSkipTLSVerify,
+		}
+	}
+	if client.host == "" {
+		return nil, errors.Errorf("cluster '%s' not found in kubeconfig", clusterName)
+	}
+
+	for _, u := range config.Users {
+		if u.Name != userName {
+			continue
+		}
+		client.token = u.User.Token
+		if u.User.TokenFile != "" {
+			token, err := os.ReadFile(u.User.TokenFile)
+			if err != nil {
+				return nil, err
+			}
+			client.token = strings.TrimSpace(string(token))
+		}
+		cert, key := u.User.ClientCertificateData, u.User.ClientKeyData
+		if u.User.ClientCertificate != "" {
+			data, err := os.ReadFile(u.User.ClientCertificate)
+			if err != nil {
+				return nil, err
+			}
+			cert = data
+		}
+		if u.User.ClientKey != "" {
+			data, err := os.ReadFile(u.User.ClientKey)
+			if err != nil {
+				return nil, err
+			}
+			key = data
+		}
+		if len(cert) > 0 && len(key) > 0 {
+			keyPair, err := tls.X509KeyPair(cert, key)
+			if err != nil {
+				return nil, err
+			}
+			tlsConfig.Certificates = []tls.Certificate{keyPair}
+		}
+		if u.User.Exec != nil {
+			plugin, err := newKubernetesExecPlugin(*u.User.Exec, execCluster)
+			if err != nil {
+				return nil, err
+			}
+			client.exec = plugin
+			tlsConfig.GetClientCertificate = plugin.clientCertificate
+		}
+		if provider := u.User.AuthProvider; provider != nil {
+			// Refreshing auth provider tokens needs the provider SDKs, only the token cached by kubectl is used
+			token := provider.Config["id-token"]
+			if token == "" {
+				token = provider.Config["access-token"]
+			}
+			if token == "" {
+				return nil, errors.Errorf("user '%s' from kubeconfig uses the '%s' auth provider without a cached token, please run kubectl once to refresh it or use an exec plugin, a token or a client certificate", userName, provider.Name)
+			}
+			client.token = token
+		}
+	}
+
+	client.client = &http.Client{Transport: transport}
+	return client, nil
+}
+
+func (c *KubernetesClient) get(path string, query url.Values, out interface{}) error {
+	res, err := c.do(path, query)
+	if err != nil {
+		return err
+	}
+	// Credentials returned by an exec plugin may be revoked before their expiration, retry once with fresh ones
+	if res.StatusCode == http.StatusUnauthorized && c.exec != nil {
+		res.Body.Close()
+		c.exec.invalidate()
+		res, err = c.do(path, query)
+		if err != nil {
+			return err
+		}
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode < 200 || res.StatusCode >= 400 {
+		body, _ := io.ReadAll(res.Body)
+		logrus.WithFields(logrus.Fields{"body": string(body)}).Trace("Kubernetes API response")
+		return errors.Errorf("error requesting kubernetes API: status code: %d", res.StatusCode)
+	}
+	return json.NewDecoder(res.Body).Decode(out)
+}
+
+func (c *KubernetesClient) do(path string, query url.Values) (*http.Response, error) {
+	req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("%s%s?%s", c.host, path, query.Encode()), nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Add("Accept", "application/json")
+	token := c.token
+	if c.exec != nil {
+		token, err = c.exec.token()
+		if err != nil {
+			return nil, err
+		}
+	}
+	if token != "" {
+		req.Header.Add("Authorization", fmt.Sprintf("Bearer %s", token))
+	}
+	return c.client.Do(req)
+}
+
+func (c *KubernetesClient) GetSecret(namespace, name string) (*KubernetesSecret, error) {
+	secret := KubernetesSecret{}
+	err := c.get(fmt.Sprintf("/api/v1/namespaces/%s/secrets/%s", url.PathEscape(namespace), url.PathEscape(name)), url.Values{}, &secret)
+	if err != nil {
+		return nil, err
+	}
+	return &secret, nil
+}
+
+func (c *KubernetesClient) ListSecrets(namespace, labelSelector string) ([]KubernetesSecret, error) {
+	secrets := make([]KubernetesSecret, 0)
+	query := url.Values{"labelSelector": []string{labelSelector}}
+	for {
+		list := kubernetesSecretList{}
+		err := c.get(fmt.Sprintf("/api/v1/namespaces/%s/secrets", url.PathEscape(namespace)), query, &list)
+		if err != nil {
+			return nil, err
+		}
+		secrets = append(secrets, list.Items...)
+		if list.Metadata.Continue == "" {
+			break
+		}
+		query.Set("continue", list.Metadata.Continue)
+	}
+	return secrets, nil
+}
@@ -0,0 +1,160 @@
+package backend
+
+import (
+	"bytes"
+	"crypto/tls"
+	"encoding/json"
+	"os"
+	"os/exec"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+var kubernetesExecAPIVersions = []string{
+	"client.authentication.k8s.io/v1alpha1",
+	"client.authentication.k8s.io/v1beta1",
+	"client.authentication.k8s.io/v1",
+}
+
+// kubeExecConfig is the exec section of a kubeconfig user, it runs a credential plugin like aws eks get-token
+type kubeExecConfig struct {
+	APIVersion string   `json:"apiVersion"`
+	Command    string   `json:"command"`
+	Args       []string `json:"args"`
+	Env        []struct {
+		Name  string `json:"name"`
+		Value string `json:"value"`
+	} `json:"env"`
+	InstallHint        string `json:"installHint"`
+	ProvideClusterInfo bool   `json:"provideClusterInfo"`
+}
+
+// kubeExecCluster is the cluster given to plugins asking for it through KUBERNETES_EXEC_INFO
+type kubeExecCluster struct {
+	Server                   string `json:"server"`
+	CertificateAuthorityData []byte `json:"certificate-authority-data,omitempty"`
+	InsecureSkipTLSVerify    bool   `json:"insecure-skip-tls-verify,omitempty"`
+}
+
+type kubeExecCredentialStatus struct {
+	Token                 string     `json:"token"`
+	ClientCertificateData string     `json:"clientCertificateData"`
+	ClientKeyData         string     `json:"clientKeyData"`
+	ExpirationTimestamp   *time.Time `json:"expirationTimestamp"`
+}
+
+type kubeExecCredential struct {
+	APIVersion string `json:"apiVersion"`
+	Kind       string `json:"kind"`
+	Spec       struct {
+		Cluster     *kubeExecCluster `json:"cluster,omitempty"`
+		Interactive bool             `json:"interactive"`
+	} `json:"spec"`
+	Status *kubeExecCredentialStatus `json:"status,omitempty"`
+}
+
+// kubernetesExecPlugin runs a credential plugin and caches its credentials until they expire
+type kubernetesExecPlugin struct {
+	config  kubeExecConfig
+	cluster *kubeExecCluster
+	mu      sync.Mutex
+	cached  *kubeExecCredentialStatus
+}
+
+func newKubernetesExecPlugin(config kubeExecConfig, cluster kubeExecCluster) (*kubernetesExecPlugin, error) {
+	supported := false
+	for _, version := range kubernetesExecAPIVersions {
+		if config.APIVersion == version {
+			supported = true
+		}
+	}
+	if !supported {
+		return nil, errors.Errorf("exec plugin '%s' uses unsupported API version '%s', supported versions are %s", config.Command, config.APIVersion, strings.Join(kubernetesExecAPIVersions, ", "))
+	}
+	plugin := &kubernetesExecPlugin{config: config}
+	if config.ProvideClusterInfo {
+		plugin.cluster = &cluster
+	}
+	return plugin, nil
+}
+
+func (p *kubernetesExecPlugin) credentials() (*kubeExecCredentialStatus, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.cached != nil && (p.cached.ExpirationTimestamp == nil || time.Now().Before(*p.cached.ExpirationTimestamp)) {
+		return p.cached, nil
+	}
+
+	input := kubeExecCredential{APIVersion: p.config.APIVersion, Kind: "ExecCredential"}
+	input.Spec.Cluster = p.cluster
+	info, err := json.Marshal(input)
+	if err != nil {
+		return nil, err
+	}
+
+	cmd := exec.Command(p.config.Command, p.config.Args...)
+	cmd.Env = append(os.Environ(), "KUBERNETES_EXEC_INFO="+string(info))
+	for _, env := range p.config.Env {
+		cmd.Env = append(cmd.Env, env.Name+"="+env.Value)
+	}
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	logrus.WithFields(logrus.Fields{"command": p.config.Command}).Debug("Running kubeconfig exec plugin")
+	if err := cmd.Run(); err != nil {
+		if errors.Is(err, exec.ErrNotFound) && p.config.InstallHint != "" {
+			return nil, errors.Errorf("unable to run exec plugin '%s': %s\n%s", p.config.Command, err, p.config.InstallHint)
+		}
+		return nil, errors.Errorf("unable to run exec plugin '%s': %s", p.config.Command, strings.TrimSpace(err.Error()+" "+stderr.String()))
+	}
+
+	output := kubeExecCredential{}
+	if err := json.Unmarshal(stdout.Bytes(), &output); err != nil {
+		return nil, errors.Errorf("unable to parse output of exec plugin '%s': %s", p.config.Command, err)
+	}
+	if output.APIVersion != p.config.APIVersion {
+		return nil, errors.Errorf("exec plugin '%s' is configured to use API version %s, plugin returned version %s", p.config.Command, p.config.APIVersion, output.APIVersion)
+	}
+	if output.Status == nil || (output.Status.Token == "" && output.Status.ClientCertificateData == "") {
+		return nil, errors.Errorf("exec plugin '%s' did not return any credentials", p.config.Command)
+	}
+	p.cached = output.Status
+	return p.cached, nil
+}
+
+// invalidate forces the plugin to run again, e.g. when the API server rejected its token
+func (p *kubernetesExecPlugin) invalidate() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.cached = nil
+}
+
+func (p *kubernetesExecPlugin) token() (string, error) {
+	credentials, err := p.credentials()
+	if err != nil {
+		return "", err
+	}
+	return credentials.Token, nil
+}
+
+// clientCertificate is used as tls.Config.GetClientCertificate so renewed certificates are picked up
+func (p *kubernetesExecPlugin) clientCertificate(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+	credentials, err := p.credentials()
+	if err != nil {
+		return nil, err
+	}
+	if credentials.ClientCertificateData == "" || credentials.ClientKeyData == "" {
+		return &tls.Certificate{}, nil
+	}
+	keyPair, err := tls.X509KeyPair([]byte(credentials.ClientCertificateData), []byte(credentials.ClientKeyData))
+	if err != nil {
+		return nil, err
+	}
+	return &keyPair, nil
+}
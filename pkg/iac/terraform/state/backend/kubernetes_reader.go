@@ -0,0 +1,74 @@
+package backend
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/snyk/driftctl/pkg/iac/terraform/state/backend/options"
+)
+
+const BackendKeyKubernetes = "kubernetes"
+
+// KubernetesStateLabelSelector selects secrets holding a state written by terraform
+const KubernetesStateLabelSelector = "tfstate=true"
+
+const kubernetesStateDataKey = "tfstate"
+
+type KubernetesBackend struct {
+	namespace  string
+	secretName string
+	opts       options.KubernetesBackendOptions
+	client     *KubernetesClient
+	reader     io.ReadCloser
+}
+
+func NewKubernetesReader(path string, opts options.KubernetesBackendOptions) (*KubernetesBackend, error) {
+	namespaceSecret := strings.Split(path, "/")
+	if len(namespaceSecret) != 2 || namespaceSecret[0] == "" || namespaceSecret[1] == "" {
+		return nil, errors.Errorf("Unable to parse kubernetes path: %s. Must be NAMESPACE/SECRET_NAME", path)
+	}
+	return &KubernetesBackend{
+		namespace:  namespaceSecret[0],
+		secretName: namespaceSecret[1],
+		opts:       opts,
+	}, nil
+}
+
+func (k *KubernetesBackend) Read(p []byte) (int, error) {
+	if k.reader == nil {
+		if k.client == nil {
+			client, err := NewKubernetesClient(k.opts)
+			if err != nil {
+				return 0, err
+			}
+			k.client = client
+		}
+
+		secret, err := k.client.GetSecret(k.namespace, k.secretName)
+		if err != nil {
+			return 0, errors.Errorf("Error reading state '%s' from kubernetes namespace '%s': %s", k.secretName, k.namespace, err)
+		}
+		data, exists := secret.Data[kubernetesStateDataKey]
+		if !exists {
+			return 0, errors.Errorf("Error reading state '%s' from kubernetes namespace '%s': secret has no %s key", k.secretName, k.namespace, kubernetesStateDataKey)
+		}
+
+		// Terraform always gzip states stored in secrets
+		reader, err := gzip.NewReader(bytes.NewReader(data))
+		if err != nil {
+			return 0, err
+		}
+		k.reader = reader
+	}
+	return k.reader.Read(p)
+}
+
+func (k *KubernetesBackend) Close() error {
+	if k.reader != nil {
+		return k.reader.Close()
+	}
+	return errors.New("Unable to close reader as nothing was opened")
+}
@@ -0,0 +1,253 @@
+package backend
+
+import (
+	"encoding/base64"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/snyk/driftctl/pkg/iac/terraform/state/backend/options"
+	"github.com/stretchr/testify/assert"
+)
+
+const testKubeconfig = `apiVersion: v1
+kind: Config
+current-context: dev
+clusters:
+- name: dev
+  cluster:
+    server: %[1]s
+- name: prod
+  cluster:
+    server: https://prod.example.com
+- name: gke
+  cluster:
+    server: https://gke.example.com
+    proxy-url: http://proxy.example.com:3128
+contexts:
+- name: dev
+  context:
+    cluster: dev
+    user: dev
+- name: prod
+  context:
+    cluster: prod
+    user: prod
+- name: gke
+  context:
+    cluster: gke
+    user: gke
+- name: legacy
+  context:
+    cluster: gke
+    user: legacy
+users:
+- name: dev
+  user:
+    token: TOKEN
+- name: prod
+  user:
+    exec:
+      apiVersion: client.authentication.k8s.io/v1beta1
+      command: aws
+- name: gke
+  user:
+    auth-provider:
+      name: gcp
+      config:
+        access-token: GCP_TOKEN
+- name: legacy
+  user:
+    auth-provider:
+      name: oidc
+      config:
+        idp-issuer-url: https://accounts.example.com
+`
+
+func writeTestKubeconfig(t *testing.T, server string) string {
+	path := filepath.Join(t.TempDir(), "config")
+	if err := os.WriteFile(path, []byte(fmt.Sprintf(testKubeconfig, server)), 0600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestNewKubernetesClient(t *testing.T) {
+	configPath := writeTestKubeconfig(t, "https://dev.example.com/")
+
+	client, err := NewKubernetesClient(options.KubernetesBackendOptions{ConfigPath: configPath})
+	assert.NoError(t, err)
+	assert.Equal(t, "https://dev.example.com", client.host)
+	assert.Equal(t, "TOKEN", client.token)
+
+	client, err = NewKubernetesClient(options.KubernetesBackendOptions{ConfigPath: configPath, ConfigContext: "prod"})
+	assert.NoError(t, err)
+	assert.Equal(t, "aws", client.exec.config.Command)
+
+	client, err = NewKubernetesClient(options.KubernetesBackendOptions{ConfigPath: configPath, ConfigContext: "gke"})
+	assert.NoError(t, err)
+	assert.Equal(t, "GCP_TOKEN", client.token)
+
+	_, err = NewKubernetesClient(options.KubernetesBackendOptions{ConfigPath: configPath, ConfigContext: "legacy"})
+	assert.EqualError(t, err, "user 'legacy' from kubeconfig uses the 'oidc' auth provider without a cached token, please run kubectl once to refresh it or use an exec plugin, a token or a client certificate")
+
+	_, err = NewKubernetesClient(options.KubernetesBackendOptions{ConfigPath: configPath, ConfigContext: "staging"})
+	assert.EqualError(t, err, "context 'staging' not found in kubeconfig")
+}
+
+func TestKubernetesBackend_Read(t *testing.T) {
+	tests := []struct {
+		name     string
+		path     string
+		expected string
+		wantErr  string
+	}{
+		{
+			name:     "should read state from secret",
+			path:     "terraform/tfstate-default-network",
+			expected: `{"version": 4}`,
+		},
+		{
+			name:    "should fail when secret does not exist",
+			path:    "terraform/tfstate-default-unknown",
+			wantErr: "Error reading state 'tfstate-default-unknown' from kubernetes namespace 'terraform': error requesting kubernetes API: status code: 404",
+		},
+		{
+			name:    "should fail when secret does not hold a state",
+			path:    "terraform/credentials",
+			wantErr: "Error reading state 'credentials' from kubernetes namespace 'terraform': secret has no tfstate key",
+		},
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer TOKEN", r.Header.Get("Authorization"))
+		switch r.URL.Path {
+		case "/api/v1/namespaces/terraform/secrets/tfstate-default-network":
+			_, _ = fmt.Fprintf(w, `{"metadata": {"name": "tfstate-default-network"}, "data": {"tfstate": "%s"}}`, base64.StdEncoding.EncodeToString(gzipped(t, `{"version": 4}`)))
+		case "/api/v1/namespaces/terraform/secrets/credentials":
+			_, _ = w.Write([]byte(`{"metadata": {"name": "credentials"}, "data": {"password": "c2VjcmV0"}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+	configPath := writeTestKubeconfig(t, server.URL)
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reader, err := NewKubernetesReader(tt.path, options.KubernetesBackendOptions{ConfigPath: configPath})
+			assert.NoError(t, err)
+
+			got, err := io.ReadAll(reader)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, string(got))
+			assert.NoError(t, reader.Close())
+		})
+	}
+}
+
+func TestKubernetesClient_ExecPlugin(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("exec plugin test relies on a shell script")
+	}
+
+	dir := t.TempDir()
+	plugin := filepath.Join(dir, "get-token")
+	script := `#!/bin/sh
+echo "{\"apiVersion\": \"client.authentication.k8s.io/v1beta1\", \"kind\": \"ExecCredential\", \"status\": {\"token\": \"$PLUGIN_TOKEN\"}}"
+`
+	if err := os.WriteFile(plugin, []byte(script), 0700); err != nil {
+		t.Fatal(err)
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer EXEC_TOKEN", r.Header.Get("Authorization"))
+		_, _ = w.Write([]byte(`{"metadata": {"name": "tfstate-default-network"}, "data": {}}`))
+	}))
+	defer server.Close()
+
+	configPath := filepath.Join(dir, "config")
+	config := fmt.Sprintf(`apiVersion: v1
+kind: Config
+current-context: eks
+clusters:
+- name: eks
+  cluster:
+    server: %s
+contexts:
+- name: eks
+  context:
+    cluster: eks
+    user: eks
+users:
+- name: eks
+  user:
+    exec:
+      apiVersion: client.authentication.k8s.io/v1beta1
+      command: %s
+      env:
+      - name: PLUGIN_TOKEN
+        value: EXEC_TOKEN
+`, server.URL, plugin)
+	if err := os.WriteFile(configPath, []byte(config), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	client, err := NewKubernetesClient(options.KubernetesBackendOptions{ConfigPath: configPath})
+	assert.NoError(t, err)
+	secret, err := client.GetSecret("terraform", "tfstate-default-network")
+	assert.NoError(t, err)
+	assert.Equal(t, "tfstate-default-network", secret.Metadata.Name)
+}
+
+func TestKubernetesClient_ProxyURL(t *testing.T) {
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "cluster.example.com", r.URL.Host)
+		assert.Equal(t, "/api/v1/namespaces/terraform/secrets/tfstate-default-network", r.URL.Path)
+		_, _ = w.Write([]byte(`{"metadata": {"name": "tfstate-default-network"}, "data": {}}`))
+	}))
+	defer proxy.Close()
+
+	configPath := filepath.Join(t.TempDir(), "config")
+	config := fmt.Sprintf(`apiVersion: v1
+kind: Config
+current-context: default
+clusters:
+- name: default
+  cluster:
+    server: http://cluster.example.com
+    proxy-url: %s
+contexts:
+- name: default
+  context:
+    cluster: default
+    user: default
+users:
+- name: default
+  user:
+    token: TOKEN
+`, proxy.URL)
+	if err := os.WriteFile(configPath, []byte(config), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	client, err := NewKubernetesClient(options.KubernetesBackendOptions{ConfigPath: configPath})
+	assert.NoError(t, err)
+	secret, err := client.GetSecret("terraform", "tfstate-default-network")
+	assert.NoError(t, err)
+	assert.Equal(t, "tfstate-default-network", secret.Metadata.Name)
+}
+
+func TestNewKubernetesReader_InvalidPath(t *testing.T) {
+	_, err := NewKubernetesReader("tfstate-default-network", options.KubernetesBackendOptions{})
+	assert.EqualError(t, err, "Unable to parse kubernetes path: tfstate-default-network. Must be NAMESPACE/SECRET_NAME")
+}
@@ -0,0 +1,8 @@
+package options
+
+type AWSBackendOptions struct {
+	// Profile is the shared config profile used to read states, the default credentials chain is used when empty
+	Profile string
+	// Region overrides the region found in the environment or in the shared config
+	Region string
+}
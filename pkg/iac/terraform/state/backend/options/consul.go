@@ -0,0 +1,5 @@
+package options
+
+type ConsulBackendOptions struct {
+	Address, Token string
+}
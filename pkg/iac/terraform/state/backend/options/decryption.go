@@ -0,0 +1,8 @@
+package options
+
+type DecryptionOptions struct {
+	// AgeKeyFile is the path of a file holding age identities, one per line
+	AgeKeyFile string
+	// AgeKey holds age identities, one per line
+	AgeKey string
+}
@@ -0,0 +1,5 @@
+package options
+
+type KubernetesBackendOptions struct {
+	ConfigPath, ConfigContext string
+}
@@ -0,0 +1,5 @@
+package options
+
+type PgBackendOptions struct {
+	ConnStr string
+}
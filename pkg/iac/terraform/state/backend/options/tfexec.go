@@ -0,0 +1,5 @@
+package options
+
+type TFExecBackendOptions struct {
+	ExecPath string
+}
@@ -0,0 +1,72 @@
+package backend
+
+import (
+	"database/sql"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+	"github.com/snyk/driftctl/pkg/iac/terraform/state/backend/options"
+)
+
+const BackendKeyPg = "pg"
+
+// PgStatesTable returns the quoted name of the table in which terraform stores states for a given schema
+func PgStatesTable(schema string) string {
+	return fmt.Sprintf("%s.states", pq.QuoteIdentifier(schema))
+}
+
+type PgBackend struct {
+	db     *sql.DB
+	schema string
+	name   string
+	reader io.ReadCloser
+}
+
+func NewPgReader(path string, opts options.PgBackendOptions) (*PgBackend, error) {
+	schemaName := strings.Split(path, "/")
+	if len(schemaName) != 2 || schemaName[0] == "" || schemaName[1] == "" {
+		return nil, errors.Errorf("Unable to parse pg path: %s. Must be SCHEMA/WORKSPACE", path)
+	}
+
+	if opts.ConnStr == "" {
+		return nil, errors.New("PG_CONN_STR should be defined to be able to read state from pg backend")
+	}
+	db, err := sql.Open("postgres", opts.ConnStr)
+	if err != nil {
+		return nil, err
+	}
+
+	return &PgBackend{
+		db:     db,
+		schema: schemaName[0],
+		name:   schemaName[1],
+	}, nil
+}
+
+func (p *PgBackend) Read(b []byte) (int, error) {
+	if p.reader == nil {
+		var data string
+		err := p.db.QueryRow(fmt.Sprintf("SELECT data FROM %s WHERE name = $1", PgStatesTable(p.schema)), p.name).Scan(&data)
+		if err == sql.ErrNoRows {
+			return 0, errors.Errorf("Error reading state '%s' from pg schema '%s': state not found", p.name, p.schema)
+		}
+		if err != nil {
+			return 0, errors.Errorf("Error reading state '%s' from pg schema '%s': %s", p.name, p.schema, err)
+		}
+		p.reader = io.NopCloser(strings.NewReader(data))
+	}
+	return p.reader.Read(b)
+}
+
+func (p *PgBackend) Close() error {
+	if err := p.db.Close(); err != nil {
+		return err
+	}
+	if p.reader != nil {
+		return p.reader.Close()
+	}
+	return errors.New("Unable to close reader as nothing was opened")
+}
@@ -0,0 +1,122 @@
+package backend
+
+import (
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/snyk/driftctl/pkg/iac/terraform/state/backend/options"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewPgReader(t *testing.T) {
+	tests := []struct {
+		name    string
+		path    string
+		opts    options.PgBackendOptions
+		wantErr string
+	}{
+		{
+			name: "valid path",
+			path: "terraform_remote_state/default",
+			opts: options.PgBackendOptions{ConnStr: "postgres://localhost/terraform"},
+		},
+		{
+			name:    "invalid path",
+			path:    "default",
+			opts:    options.PgBackendOptions{ConnStr: "postgres://localhost/terraform"},
+			wantErr: "Unable to parse pg path: default. Must be SCHEMA/WORKSPACE",
+		},
+		{
+			name:    "missing connection string",
+			path:    "terraform_remote_state/default",
+			wantErr: "PG_CONN_STR should be defined to be able to read state from pg backend",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewPgReader(tt.path, tt.opts)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, "terraform_remote_state", got.schema)
+			assert.Equal(t, "default", got.name)
+		})
+	}
+}
+
+func TestPgBackend_Read(t *testing.T) {
+	tests := []struct {
+		name     string
+		mocks    func(mock sqlmock.Sqlmock)
+		expected string
+		wantErr  string
+	}{
+		{
+			name: "should read state",
+			mocks: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`SELECT data FROM "terraform_remote_state".states WHERE name = $1`).
+					WithArgs("default").
+					WillReturnRows(sqlmock.NewRows([]string{"data"}).AddRow(`{"version": 4}`))
+			},
+			expected: `{"version": 4}`,
+		},
+		{
+			name: "should fail when state does not exist",
+			mocks: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`SELECT data FROM "terraform_remote_state".states WHERE name = $1`).
+					WithArgs("default").
+					WillReturnRows(sqlmock.NewRows([]string{"data"}))
+			},
+			wantErr: "Error reading state 'default' from pg schema 'terraform_remote_state': state not found",
+		},
+		{
+			name: "should fail when query fails",
+			mocks: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`SELECT data FROM "terraform_remote_state".states WHERE name = $1`).
+					WithArgs("default").
+					WillReturnError(errors.New(`pq: relation "terraform_remote_state.states" does not exist`))
+			},
+			wantErr: `Error reading state 'default' from pg schema 'terraform_remote_state': pq: relation "terraform_remote_state.states" does not exist`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			if err != nil {
+				t.Fatal(err)
+			}
+			tt.mocks(mock)
+
+			reader := &PgBackend{
+				db:     db,
+				schema: "terraform_remote_state",
+				name:   "default",
+			}
+			got, err := io.ReadAll(reader)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected, string(got))
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestPgBackend_Close(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	mock.ExpectClose()
+
+	reader := &PgBackend{db: db}
+	assert.EqualError(t, reader.Close(), "Unable to close reader as nothing was opened")
+	assert.NoError(t, mock.ExpectationsWereMet())
+	assert.EqualError(t, db.Ping(), "sql: database is closed")
+}
@@ -3,10 +3,13 @@ package backend
 import (
 	"io"
 	"strings"
+	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/pkg/errors"
 	"github.com/snyk/driftctl/pkg/envproxy"
+	"github.com/snyk/driftctl/pkg/iac/terraform/state/backend/options"
 
 	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/aws/aws-sdk-go/service/s3/s3iface"
@@ -17,12 +20,13 @@ const BackendKeyS3 = "s3"
 type S3Backend struct {
 	input    s3.GetObjectInput
 	reader   io.ReadCloser
+	at       time.Time
 	S3Client s3iface.S3API
 }
 
-func NewS3Reader(path string) (*S3Backend, error) {
+func NewS3Reader(path string, opts *Options) (*S3Backend, error) {
 
-	backend := S3Backend{}
+	backend := S3Backend{at: opts.At}
 	bucketPath := strings.Split(path, "/")
 	if len(bucketPath) < 2 {
 		return nil, errors.Errorf("Unable to parse S3 path: %s. Must be BUCKET_NAME/PATH/TO/OBJECT", path)
@@ -34,18 +38,83 @@ func NewS3Reader(path string) (*S3Backend, error) {
 		Key:    &key,
 		Bucket: &bucket,
 	}
+	backend.S3Client = s3.New(NewS3Session(opts.AWSBackendOptions))
+	return &backend, nil
+}
+
+// NewS3Session creates the session used to access states stored in s3,
+// DCTL_S3_ prefixed variables take precedence over the AWS_ ones
+func NewS3Session(opts options.AWSBackendOptions) *session.Session {
 	envProxy := envproxy.NewEnvProxy("DCTL_S3_", "AWS_")
 	envProxy.Apply()
-	sess := session.Must(session.NewSessionWithOptions(session.Options{
+	defer envProxy.Restore()
+
+	config := aws.Config{}
+	if opts.Region != "" {
+		config.Region = aws.String(opts.Region)
+	}
+	return session.Must(session.NewSessionWithOptions(session.Options{
+		Config:            config,
+		Profile:           opts.Profile,
 		SharedConfigState: session.SharedConfigEnable,
 	}))
-	envProxy.Restore()
-	backend.S3Client = s3.New(sess)
-	return &backend, nil
+}
+
+// Version returns the version id of the state object that was current at the requested time
+func (s *S3Backend) Version() string {
+	return aws.StringValue(s.input.VersionId)
+}
+
+func (s *S3Backend) findVersionAt() (*string, error) {
+	var version *string
+	var lastModified time.Time
+	var isDeleted bool
+	input := &s3.ListObjectVersionsInput{
+		Bucket: s.input.Bucket,
+		Prefix: s.input.Key,
+	}
+	err := s.S3Client.ListObjectVersionsPages(input, func(output *s3.ListObjectVersionsOutput, lastPage bool) bool {
+		for _, v := range output.Versions {
+			if aws.StringValue(v.Key) != *s.input.Key || v.LastModified == nil || v.LastModified.After(s.at) {
+				continue
+			}
+			if version == nil || v.LastModified.After(lastModified) {
+				version, lastModified, isDeleted = v.VersionId, *v.LastModified, false
+			}
+		}
+		for _, m := range output.DeleteMarkers {
+			if aws.StringValue(m.Key) != *s.input.Key || m.LastModified == nil || m.LastModified.After(s.at) {
+				continue
+			}
+			if version == nil || m.LastModified.After(lastModified) {
+				version, lastModified, isDeleted = m.VersionId, *m.LastModified, true
+			}
+		}
+		return !lastPage
+	})
+	if err != nil {
+		return nil, err
+	}
+	if version == nil || isDeleted {
+		return nil, errors.Errorf(
+			"No version of state '%s' from s3 bucket '%s' existed at %s",
+			*s.input.Key,
+			*s.input.Bucket,
+			s.at.Format(time.RFC3339),
+		)
+	}
+	return version, nil
 }
 
 func (s *S3Backend) Read(p []byte) (n int, err error) {
 	if s.reader == nil {
+		if !s.at.IsZero() && s.input.VersionId == nil {
+			version, err := s.findVersionAt()
+			if err != nil {
+				return 0, err
+			}
+			s.input.VersionId = version
+		}
 		response, err := s.S3Client.GetObject(&s.input)
 		if err != nil {
 			requestFailure, ok := err.(s3.RequestFailure)
@@ -6,6 +6,7 @@ import (
 	"os"
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	awstest "github.com/snyk/driftctl/test/aws"
@@ -38,7 +39,7 @@ func TestNewS3ReaderInvalid(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got, err := NewS3Reader(tt.args.path)
+			got, err := NewS3Reader(tt.args.path, &Options{})
 			if err.Error() != tt.wantErr.Error() {
 				t.Errorf("NewS3Reader() error = '%s', wantErr '%s'", err, tt.wantErr)
 				return
@@ -52,7 +53,7 @@ func TestNewS3ReaderInvalid(t *testing.T) {
 
 func TestNewS3Reader(t *testing.T) {
 	assert := assert.New(t)
-	reader, err := NewS3Reader("sample_bucket/path/to/state.tfstate")
+	reader, err := NewS3Reader("sample_bucket/path/to/state.tfstate", &Options{})
 	if err != nil {
 		t.Error(err)
 	}
@@ -71,7 +72,7 @@ func TestNewS3ReaderWithEnvProxy(t *testing.T) {
 	assert := assert.New(t)
 	os.Setenv("AWS_DEFAULT_REGION", "us-east-1")
 	os.Setenv("DCTL_S3_DEFAULT_REGION", "eu-west-3")
-	reader, err := NewS3Reader("sample_bucket/path/to/state.tfstate")
+	reader, err := NewS3Reader("sample_bucket/path/to/state.tfstate", &Options{})
 
 	got := reader.S3Client.(*s3.S3).Config.Region
 	if aws.StringValue(got) != "eu-west-3" {
@@ -99,7 +100,7 @@ func TestS3Backend_ReadWithError(t *testing.T) {
 	fakeErr.On("Message").Return("Request failed on aws side")
 	fakeS3.On("GetObject", mock.Anything).Return(nil, fakeErr)
 
-	reader, err := NewS3Reader("foobar/path/to/state")
+	reader, err := NewS3Reader("foobar/path/to/state", &Options{})
 	if err != nil {
 		t.Error(err)
 	}
@@ -120,7 +121,7 @@ func TestS3Backend_Read(t *testing.T) {
 		Key:    aws.String("path/to/state"),
 	}).Return(&s3.GetObjectOutput{Body: fakeResponse}, nil).Once()
 
-	reader, err := NewS3Reader("foobar/path/to/state")
+	reader, err := NewS3Reader("foobar/path/to/state", &Options{})
 	if err != nil {
 		t.Error(err)
 	}
@@ -128,3 +129,87 @@ func TestS3Backend_Read(t *testing.T) {
 	_, err = ioutil.ReadAll(reader)
 	assert.Nil(err)
 }
+
+func TestS3Backend_ReadAt(t *testing.T) {
+	at := time.Date(2021, 11, 23, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		output  *s3.ListObjectVersionsOutput
+		version string
+		err     string
+	}{
+		{
+			name: "should read the version current at the given time",
+			output: &s3.ListObjectVersionsOutput{
+				Versions: []*s3.ObjectVersion{
+					{Key: aws.String("path/to/state"), VersionId: aws.String("v1"), LastModified: aws.Time(at.Add(-48 * time.Hour))},
+					{Key: aws.String("path/to/state"), VersionId: aws.String("v2"), LastModified: aws.Time(at.Add(-1 * time.Hour))},
+					{Key: aws.String("path/to/state"), VersionId: aws.String("v3"), LastModified: aws.Time(at.Add(time.Hour))},
+					{Key: aws.String("path/to/state.backup"), VersionId: aws.String("v4"), LastModified: aws.Time(at.Add(-30 * time.Minute))},
+				},
+			},
+			version: "v2",
+		},
+		{
+			name: "should fail when the state was deleted at the given time",
+			output: &s3.ListObjectVersionsOutput{
+				Versions: []*s3.ObjectVersion{
+					{Key: aws.String("path/to/state"), VersionId: aws.String("v1"), LastModified: aws.Time(at.Add(-48 * time.Hour))},
+				},
+				DeleteMarkers: []*s3.DeleteMarkerEntry{
+					{Key: aws.String("path/to/state"), VersionId: aws.String("v2"), LastModified: aws.Time(at.Add(-1 * time.Hour))},
+				},
+			},
+			err: "No version of state 'path/to/state' from s3 bucket 'foobar' existed at 2021-11-23T12:00:00Z",
+		},
+		{
+			name: "should fail when the state did not exist yet",
+			output: &s3.ListObjectVersionsOutput{
+				Versions: []*s3.ObjectVersion{
+					{Key: aws.String("path/to/state"), VersionId: aws.String("v1"), LastModified: aws.Time(at.Add(time.Hour))},
+				},
+			},
+			err: "No version of state 'path/to/state' from s3 bucket 'foobar' existed at 2021-11-23T12:00:00Z",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeS3 := &awstest.MockFakeS3{}
+			fakeS3.On(
+				"ListObjectVersionsPages",
+				&s3.ListObjectVersionsInput{
+					Bucket: aws.String("foobar"),
+					Prefix: aws.String("path/to/state"),
+				},
+				mock.MatchedBy(func(callback func(res *s3.ListObjectVersionsOutput, lastPage bool) bool) bool {
+					callback(tt.output, true)
+					return true
+				}),
+			).Return(nil).Once()
+			if tt.version != "" {
+				fakeResponse, _ := os.Open("testdata/valid.tfstate")
+				defer fakeResponse.Close()
+				fakeS3.On("GetObject", &s3.GetObjectInput{
+					Bucket:    aws.String("foobar"),
+					Key:       aws.String("path/to/state"),
+					VersionId: aws.String(tt.version),
+				}).Return(&s3.GetObjectOutput{Body: fakeResponse}, nil).Once()
+			}
+
+			reader, err := NewS3Reader("foobar/path/to/state", &Options{At: at})
+			if err != nil {
+				t.Fatal(err)
+			}
+			reader.S3Client = fakeS3
+			_, err = ioutil.ReadAll(reader)
+			if tt.err != "" {
+				assert.EqualError(t, err, tt.err)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, tt.version, reader.Version())
+			fakeS3.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,143 @@
+package backend
+
+import (
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+// SourceOptionHeaderPrefix prefixes source options holding an HTTP header, e.g. header.Authorization
+const SourceOptionHeaderPrefix = "header."
+
+// supportedSourceOptions are the options a single IaC source can override, named after their global flag
+var supportedSourceOptions = map[string]func(opts *Options, value string){
+	"profile":                 func(opts *Options, value string) { opts.AWSBackendOptions.Profile = value },
+	"region":                  func(opts *Options, value string) { opts.AWSBackendOptions.Region = value },
+	"tfc-token":               func(opts *Options, value string) { opts.TFCloudToken = value },
+	"tfc-endpoint":            func(opts *Options, value string) { opts.TFCloudEndpoint = value },
+	"tfc-workspace-tags":      func(opts *Options, value string) { opts.TFCloudWorkspaceTags = strings.Split(value, ",") },
+	"azurerm-storage-account": func(opts *Options, value string) { opts.AzureRMBackendOptions.StorageAccount = value },
+	"azurerm-account-key":     func(opts *Options, value string) { opts.AzureRMBackendOptions.StorageKey = value },
+	"consul-address":          func(opts *Options, value string) { opts.ConsulBackendOptions.Address = value },
+	"consul-token":            func(opts *Options, value string) { opts.ConsulBackendOptions.Token = value },
+	"pg-conn-str":             func(opts *Options, value string) { opts.PgBackendOptions.ConnStr = value },
+	"kube-config-path":        func(opts *Options, value string) { opts.KubernetesBackendOptions.ConfigPath = value },
+	"kube-context":            func(opts *Options, value string) { opts.KubernetesBackendOptions.ConfigContext = value },
+	"age-key-file":            func(opts *Options, value string) { opts.DecryptionOptions.AgeKeyFile = value },
+	"age-key":                 func(opts *Options, value string) { opts.DecryptionOptions.AgeKey = value },
+}
+
+func isSourceOption(key string) bool {
+	if strings.HasPrefix(key, SourceOptionHeaderPrefix) {
+		return len(key) > len(SourceOptionHeaderPrefix)
+	}
+	_, exists := supportedSourceOptions[key]
+	return exists
+}
+
+// GetSupportedSourceOptions returns the options that can be given in the query string of an IaC source
+func GetSupportedSourceOptions() []string {
+	opts := make([]string, 0, len(supportedSourceOptions)+1)
+	for key := range supportedSourceOptions {
+		opts = append(opts, key)
+	}
+	sort.Strings(opts)
+	return append(opts, SourceOptionHeaderPrefix+"<NAME>")
+}
+
+// ParseSourceOptions extracts the options of an IaC source from the query string of its path,
+// e.g. bucket/terraform.tfstate?profile=prod&region=eu-west-1.
+// Query parameters of http(s) urls that are not driftctl options are kept in the returned path.
+// As ? is also a glob wildcard, the path of other backends is left untouched unless every parameter
+// of its suffix is a supported key=value option.
+func ParseSourceOptions(backendKey, path string) (string, map[string]string, error) {
+	idx := strings.LastIndex(path, "?")
+	if idx == -1 {
+		return path, nil, nil
+	}
+
+	keepUnknown := backendKey == BackendKeyHTTP || backendKey == BackendKeyHTTPS
+	options := map[string]string{}
+	remaining := make([]string, 0)
+	for _, param := range strings.Split(path[idx+1:], "&") {
+		if param == "" {
+			continue
+		}
+		rawKey, rawValue := param, ""
+		i := strings.Index(param, "=")
+		if i != -1 {
+			rawKey, rawValue = param[:i], param[i+1:]
+		}
+		key, keyErr := url.QueryUnescape(rawKey)
+		value, valueErr := url.QueryUnescape(rawValue)
+		if keepUnknown {
+			if keyErr != nil || valueErr != nil {
+				return "", nil, errors.Errorf("Unable to parse source option '%s'", param)
+			}
+			if !isSourceOption(key) {
+				remaining = append(remaining, param)
+				continue
+			}
+		} else if i == -1 || keyErr != nil || valueErr != nil || !isSourceOption(key) {
+			if looksLikeSourceOptions(path[idx+1:]) {
+				logrus.WithFields(logrus.Fields{
+					"path":  path,
+					"param": param,
+				}).Warnf("Unsupported source option, the query string is read as part of the path. Accepted source options are: %s", strings.Join(GetSupportedSourceOptions(), ","))
+			}
+			return path, nil, nil
+		}
+		options[key] = value
+	}
+
+	path = path[:idx]
+	if len(remaining) > 0 {
+		path += "?" + strings.Join(remaining, "&")
+	}
+	if len(options) == 0 {
+		options = nil
+	}
+	return path, options, nil
+}
+
+// looksLikeSourceOptions tells whether a suffix was probably meant as source options rather than a glob
+func looksLikeSourceOptions(query string) bool {
+	if strings.Contains(query, "/") {
+		return false
+	}
+	for _, param := range strings.Split(query, "&") {
+		if !strings.Contains(param, "=") {
+			return false
+		}
+	}
+	return true
+}
+
+// ForSource returns a copy of the options overridden by the options of a single IaC source
+func (o *Options) ForSource(sourceOptions map[string]string) *Options {
+	if o == nil {
+		o = &Options{}
+	}
+	if len(sourceOptions) == 0 {
+		return o
+	}
+
+	opts := *o
+	opts.Headers = make(map[string]string, len(o.Headers))
+	for name, value := range o.Headers {
+		opts.Headers[name] = value
+	}
+	for key, value := range sourceOptions {
+		if strings.HasPrefix(key, SourceOptionHeaderPrefix) {
+			opts.Headers[strings.TrimPrefix(key, SourceOptionHeaderPrefix)] = value
+			continue
+		}
+		if apply, exists := supportedSourceOptions[key]; exists {
+			apply(&opts, value)
+		}
+	}
+	return &opts
+}
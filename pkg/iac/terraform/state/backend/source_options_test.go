@@ -0,0 +1,144 @@
+package backend
+
+import (
+	"testing"
+
+	"github.com/snyk/driftctl/pkg/iac/terraform/state/backend/options"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseSourceOptions(t *testing.T) {
+	tests := []struct {
+		name        string
+		backend     string
+		path        string
+		wantPath    string
+		wantOptions map[string]string
+		wantErr     string
+	}{
+		{
+			name:     "without options",
+			backend:  BackendKeyS3,
+			path:     "bucket/terraform.tfstate",
+			wantPath: "bucket/terraform.tfstate",
+		},
+		{
+			name:        "with aws options",
+			backend:     BackendKeyS3,
+			path:        "bucket/terraform.tfstate?profile=prod&region=eu-west-1",
+			wantPath:    "bucket/terraform.tfstate",
+			wantOptions: map[string]string{"profile": "prod", "region": "eu-west-1"},
+		},
+		{
+			name:        "with escaped values",
+			backend:     BackendKeyAzureRM,
+			path:        "container/terraform.tfstate?azurerm-storage-account=prod&azurerm-account-key=a%2Bb%3D%3D",
+			wantPath:    "container/terraform.tfstate",
+			wantOptions: map[string]string{"azurerm-storage-account": "prod", "azurerm-account-key": "a+b=="},
+		},
+		{
+			name:        "with http query parameters",
+			backend:     BackendKeyHTTPS,
+			path:        "example.com/state?ref=main&header.Authorization=Bearer%20token&format=raw",
+			wantPath:    "example.com/state?ref=main&format=raw",
+			wantOptions: map[string]string{"header.Authorization": "Bearer token"},
+		},
+		{
+			name:     "with only http query parameters",
+			backend:  BackendKeyHTTP,
+			path:     "example.com/state?ref=main",
+			wantPath: "example.com/state?ref=main",
+		},
+		{
+			name:     "with unsupported option",
+			backend:  BackendKeyS3,
+			path:     "bucket/terraform.tfstate?role=admin",
+			wantPath: "bucket/terraform.tfstate?role=admin",
+		},
+		{
+			name:     "with empty header name",
+			backend:  BackendKeyTFCloud,
+			path:     "workspace?header.=value",
+			wantPath: "workspace?header.=value",
+		},
+		{
+			name:     "with glob wildcard",
+			backend:  BackendKeyS3,
+			path:     "bucket/env-?/terraform.tfstate",
+			wantPath: "bucket/env-?/terraform.tfstate",
+		},
+		{
+			name:        "with glob wildcard and options",
+			backend:     BackendKeyS3,
+			path:        "bucket/env-?/terraform.tfstate?region=eu-west-1",
+			wantPath:    "bucket/env-?/terraform.tfstate",
+			wantOptions: map[string]string{"region": "eu-west-1"},
+		},
+		{
+			name:     "with glob wildcard in a key=value name",
+			backend:  BackendKeyGS,
+			path:     "bucket/states/env=?/terraform.tfstate",
+			wantPath: "bucket/states/env=?/terraform.tfstate",
+		},
+		{
+			name:    "with invalid escaped http option",
+			backend: BackendKeyHTTPS,
+			path:    "example.com/state?header.Authorization=%zz",
+			wantErr: "Unable to parse source option 'header.Authorization=%zz'",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path, opts, err := ParseSourceOptions(tt.backend, tt.path)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantPath, path)
+			assert.Equal(t, tt.wantOptions, opts)
+		})
+	}
+}
+
+func TestOptions_ForSource(t *testing.T) {
+	global := &Options{
+		Headers:         map[string]string{"Accept": "application/json"},
+		TFCloudToken:    "global-token",
+		TFCloudEndpoint: "https://app.terraform.io/api/v2",
+		AzureRMBackendOptions: options.AzureRMBackendOptions{
+			StorageAccount: "global",
+			StorageKey:     "global-key",
+		},
+	}
+
+	got := global.ForSource(map[string]string{
+		"header.Authorization":    "Bearer token",
+		"tfc-token":               "prod-token",
+		"azurerm-storage-account": "prod",
+		"profile":                 "prod",
+		"region":                  "eu-west-1",
+		"tfc-workspace-tags":      "app,prod",
+	})
+
+	assert.Equal(t, &Options{
+		Headers:              map[string]string{"Accept": "application/json", "Authorization": "Bearer token"},
+		TFCloudToken:         "prod-token",
+		TFCloudEndpoint:      "https://app.terraform.io/api/v2",
+		TFCloudWorkspaceTags: []string{"app", "prod"},
+		AWSBackendOptions: options.AWSBackendOptions{
+			Profile: "prod",
+			Region:  "eu-west-1",
+		},
+		AzureRMBackendOptions: options.AzureRMBackendOptions{
+			StorageAccount: "prod",
+			StorageKey:     "global-key",
+		},
+	}, got)
+	// global options are left untouched
+	assert.Equal(t, map[string]string{"Accept": "application/json"}, global.Headers)
+	assert.Equal(t, "global-token", global.TFCloudToken)
+
+	assert.Same(t, global, global.ForSource(nil))
+	assert.Equal(t, &Options{}, (*Options)(nil).ForSource(nil))
+}
@@ -0,0 +1,26 @@
+package backend
+
+import (
+	"io"
+	"os"
+)
+
+const BackendKeyStdin = "stdin"
+
+// StdinBackend reads a state piped into driftctl
+type StdinBackend struct {
+	reader io.Reader
+}
+
+func NewStdinReader() *StdinBackend {
+	return &StdinBackend{reader: os.Stdin}
+}
+
+func (s *StdinBackend) Read(p []byte) (int, error) {
+	return s.reader.Read(p)
+}
+
+// Close does not close stdin as it is not owned by the backend
+func (s *StdinBackend) Close() error {
+	return nil
+}
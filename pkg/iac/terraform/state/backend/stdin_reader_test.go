@@ -0,0 +1,19 @@
+package backend
+
+import (
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStdinBackend_Read(t *testing.T) {
+	reader := NewStdinReader()
+	reader.reader = strings.NewReader(`{"version": 4}`)
+
+	content, err := io.ReadAll(reader)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"version": 4}`, string(content))
+	assert.NoError(t, reader.Close())
+}
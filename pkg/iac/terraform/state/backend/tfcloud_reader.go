@@ -38,8 +38,9 @@ func NewTFCloudReader(workspacePath string, opts *Options) *TFCloudBackend {
 	return &TFCloudBackend{opts: opts, workspacePath: workspacePath}
 }
 
-func (t *TFCloudBackend) getToken() (string, error) {
-	token := t.opts.TFCloudToken
+// GetTFCloudToken returns the token given in options, or the one found in the terraform CLI configuration file
+func GetTFCloudToken(opts *Options) (string, error) {
+	token := opts.TFCloudToken
 	if token == "" {
 		tfConfigFile, err := getTerraformConfigFile()
 		if err != nil {
@@ -53,7 +54,7 @@ func (t *TFCloudBackend) getToken() (string, error) {
 		defer file.Close()
 		reader := NewTFCloudConfigReader(file)
 
-		u, err := url.Parse(t.opts.TFCloudEndpoint)
+		u, err := url.Parse(opts.TFCloudEndpoint)
 		if err != nil {
 			return "", err
 		}
@@ -86,7 +87,7 @@ func (t *TFCloudBackend) getWorkspaceId() (string, error) {
 }
 
 func (t *TFCloudBackend) initTFEClient() error {
-	token, err := t.getToken()
+	token, err := GetTFCloudToken(t.opts)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,100 @@
+package backend
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"os"
+	"os/exec"
+	"strings"
+
+	"github.com/hashicorp/terraform-exec/tfexec"
+	"github.com/hashicorp/terraform-exec/tfinstall"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/snyk/driftctl/pkg/iac/terraform/state/backend/options"
+)
+
+const BackendKeyTFExec = "tfexec"
+
+// TFExecWorkspaceSeparator separates the working directory from the workspace to pull the state of
+const TFExecWorkspaceSeparator = "#"
+
+// NewTerraformExec returns a terraform executor for the given working directory, the binary is
+// looked up in the PATH unless an explicit path is given
+func NewTerraformExec(workdir string, opts options.TFExecBackendOptions) (*tfexec.Terraform, error) {
+	var finder tfinstall.ExecPathFinder = tfinstall.LookPath()
+	if opts.ExecPath != "" {
+		finder = tfinstall.ExactPath(opts.ExecPath)
+	}
+	execPath, err := tfinstall.Find(context.Background(), finder)
+	if err != nil {
+		return nil, errors.Errorf("unable to find terraform binary: %s", err)
+	}
+	return tfexec.NewTerraform(workdir, execPath)
+}
+
+// TFExecBackend pulls a state with terraform, which makes any backend configured in the working directory usable
+type TFExecBackend struct {
+	workdir   string
+	workspace string
+	opts      options.TFExecBackendOptions
+	reader    io.ReadCloser
+}
+
+func NewTFExecReader(path string, opts options.TFExecBackendOptions) (*TFExecBackend, error) {
+	workdirWorkspace := strings.SplitN(path, TFExecWorkspaceSeparator, 2)
+	if workdirWorkspace[0] == "" {
+		return nil, errors.Errorf("Unable to parse tfexec path: %s. Must be PATH/TO/WORKDIR or PATH/TO/WORKDIR#WORKSPACE", path)
+	}
+	backend := &TFExecBackend{
+		workdir: workdirWorkspace[0],
+		opts:    opts,
+	}
+	if len(workdirWorkspace) == 2 {
+		backend.workspace = workdirWorkspace[1]
+	}
+	return backend, nil
+}
+
+func (t *TFExecBackend) Read(p []byte) (int, error) {
+	if t.reader == nil {
+		tf, err := NewTerraformExec(t.workdir, t.opts)
+		if err != nil {
+			return 0, err
+		}
+
+		// terraform-exec does not wrap state pull, the binary it found is run directly
+		cmd := exec.Command(tf.ExecPath(), "state", "pull")
+		cmd.Dir = tf.WorkingDir()
+		cmd.Env = append(os.Environ(), "TF_IN_AUTOMATION=1")
+		if t.workspace != "" {
+			// Selecting the workspace through the environment leaves the working directory untouched
+			cmd.Env = append(cmd.Env, "TF_WORKSPACE="+t.workspace)
+		}
+		var stdout, stderr bytes.Buffer
+		cmd.Stdout = &stdout
+		cmd.Stderr = &stderr
+
+		logrus.WithFields(logrus.Fields{
+			"workdir":   t.workdir,
+			"workspace": t.workspace,
+		}).Debug("Pulling state with terraform")
+
+		if err := cmd.Run(); err != nil {
+			return 0, errors.Errorf("Error pulling state from terraform working directory '%s': %s", t.workdir, strings.TrimSpace(stderr.String()))
+		}
+		if stdout.Len() == 0 {
+			return 0, errors.Errorf("Error pulling state from terraform working directory '%s': state is empty", t.workdir)
+		}
+		t.reader = io.NopCloser(&stdout)
+	}
+	return t.reader.Read(p)
+}
+
+func (t *TFExecBackend) Close() error {
+	if t.reader != nil {
+		return t.reader.Close()
+	}
+	return errors.New("Unable to close reader as nothing was opened")
+}
@@ -0,0 +1,113 @@
+package backend
+
+import (
+	"fmt"
+	"io"
+	"path/filepath"
+	"testing"
+
+	"github.com/snyk/driftctl/pkg/iac/terraform/state/backend/options"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewTFExecReader(t *testing.T) {
+	tests := []struct {
+		name          string
+		path          string
+		wantWorkdir   string
+		wantWorkspace string
+		wantErr       string
+	}{
+		{
+			name:        "current workspace",
+			path:        "infra/production",
+			wantWorkdir: "infra/production",
+		},
+		{
+			name:          "given workspace",
+			path:          "infra#staging",
+			wantWorkdir:   "infra",
+			wantWorkspace: "staging",
+		},
+		{
+			name:    "missing working directory",
+			path:    "#staging",
+			wantErr: "Unable to parse tfexec path: #staging. Must be PATH/TO/WORKDIR or PATH/TO/WORKDIR#WORKSPACE",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewTFExecReader(tt.path, options.TFExecBackendOptions{})
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantWorkdir, got.workdir)
+			assert.Equal(t, tt.wantWorkspace, got.workspace)
+		})
+	}
+}
+
+func TestTFExecBackend_Read(t *testing.T) {
+	execPath, err := filepath.Abs("testdata/tfexec/terraform")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name      string
+		workspace string
+		expected  string
+		wantErr   string
+	}{
+		{
+			name:     "should pull state of the current workspace",
+			expected: `{"version": 4, "serial": 3, "lineage": "default"}`,
+		},
+		{
+			name:      "should pull state of the given workspace",
+			workspace: "staging",
+			expected:  `{"version": 4, "serial": 3, "lineage": "staging"}`,
+		},
+		{
+			name:      "should fail when terraform fails",
+			workspace: "missing",
+			wantErr:   "Error pulling state from terraform working directory '%s': Workspace \"missing\" doesn't exist.",
+		},
+		{
+			name:      "should fail on empty state",
+			workspace: "empty",
+			wantErr:   "Error pulling state from terraform working directory '%s': state is empty",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			workdir := t.TempDir()
+			path := workdir
+			if tt.workspace != "" {
+				path += TFExecWorkspaceSeparator + tt.workspace
+			}
+			reader, err := NewTFExecReader(path, options.TFExecBackendOptions{ExecPath: execPath})
+			assert.NoError(t, err)
+
+			content, err := io.ReadAll(reader)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, fmt.Sprintf(tt.wantErr, workdir))
+				return
+			}
+			assert.NoError(t, err)
+			assert.JSONEq(t, tt.expected, string(content))
+			assert.NoError(t, reader.Close())
+		})
+	}
+}
+
+func TestTFExecBackend_ReadWithoutTerraform(t *testing.T) {
+	reader, err := NewTFExecReader(t.TempDir(), options.TFExecBackendOptions{ExecPath: "testdata/valid.tfstate"})
+	assert.NoError(t, err)
+
+	_, err = io.ReadAll(reader)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "unable to find terraform binary")
+}
@@ -0,0 +1,41 @@
+package encryption
+
+import (
+	"bytes"
+	"io"
+
+	"filippo.io/age"
+	"filippo.io/age/armor"
+	"github.com/pkg/errors"
+)
+
+const ageBinaryHeader = "age-encryption.org/v1"
+
+func isAgeEncrypted(content []byte) bool {
+	content = bytes.TrimSpace(content)
+	return bytes.HasPrefix(content, []byte(armor.Header)) || bytes.HasPrefix(content, []byte(ageBinaryHeader))
+}
+
+func (d *Decrypter) ageDecrypt(content []byte) ([]byte, error) {
+	if len(d.identities) == 0 {
+		return nil, errors.New("no age identity was given, use --age-key-file or --age-key")
+	}
+
+	var src io.Reader = bytes.NewReader(content)
+	if trimmed := bytes.TrimSpace(content); bytes.HasPrefix(trimmed, []byte(armor.Header)) {
+		src = armor.NewReader(bytes.NewReader(trimmed))
+	}
+	reader, err := age.Decrypt(src, d.identities...)
+	if err != nil {
+		return nil, err
+	}
+	return io.ReadAll(reader)
+}
+
+func (d *Decrypter) decryptAge(content []byte) ([]byte, error) {
+	plain, err := d.ageDecrypt(content)
+	if err != nil {
+		return nil, errors.Errorf("unable to decrypt age encrypted state: %s", err)
+	}
+	return plain, nil
+}
@@ -0,0 +1,91 @@
+package encryption
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"strings"
+
+	"filippo.io/age"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/aws/aws-sdk-go/service/kms/kmsiface"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/snyk/driftctl/pkg/iac/terraform/state/backend/options"
+)
+
+// Decrypter detects states encrypted at rest and decrypts them before they are parsed
+type Decrypter struct {
+	identities   []age.Identity
+	newKMSClient func(region, profile string) kmsiface.KMSAPI
+}
+
+func NewDecrypter(opts options.DecryptionOptions) (*Decrypter, error) {
+	identities := make([]age.Identity, 0)
+	if opts.AgeKeyFile != "" {
+		file, err := os.Open(opts.AgeKeyFile)
+		if err != nil {
+			return nil, errors.Errorf("unable to read age key file: %s", err)
+		}
+		defer file.Close()
+		fileIdentities, err := age.ParseIdentities(file)
+		if err != nil {
+			return nil, errors.Errorf("unable to parse age key file %s: %s", opts.AgeKeyFile, err)
+		}
+		identities = append(identities, fileIdentities...)
+	}
+	if opts.AgeKey != "" {
+		keyIdentities, err := age.ParseIdentities(strings.NewReader(opts.AgeKey))
+		if err != nil {
+			return nil, errors.Errorf("unable to parse age key: %s", err)
+		}
+		identities = append(identities, keyIdentities...)
+	}
+
+	return &Decrypter{
+		identities:   identities,
+		newKMSClient: newKMSClient,
+	}, nil
+}
+
+func newKMSClient(region, profile string) kmsiface.KMSAPI {
+	sess := session.Must(session.NewSessionWithOptions(session.Options{
+		SharedConfigState: session.SharedConfigEnable,
+		Profile:           profile,
+	}))
+	config := aws.NewConfig()
+	if region != "" {
+		config = config.WithRegion(region)
+	}
+	return kms.New(sess, config)
+}
+
+// Decrypt returns the plain state read from the given reader, states that are not encrypted are returned untouched
+func (d *Decrypter) Decrypt(reader io.Reader) (io.Reader, error) {
+	content, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, err
+	}
+
+	var plain []byte
+	switch {
+	case isAgeEncrypted(content):
+		logrus.Debug("Found age encrypted state")
+		plain, err = d.decryptAge(content)
+	case isSopsEncrypted(content):
+		logrus.Debug("Found SOPS encrypted state")
+		plain, err = d.decryptSops(content)
+	case isKMSEnvelope(content):
+		logrus.Debug("Found KMS envelope encrypted state")
+		plain, err = d.decryptKMSEnvelope(content)
+	default:
+		plain = content
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return bytes.NewReader(plain), nil
+}
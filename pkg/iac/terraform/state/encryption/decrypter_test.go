@@ -0,0 +1,471 @@
+package encryption
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha512"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"hash"
+	"io"
+	"os"
+	"path"
+	"sort"
+	"strings"
+	"testing"
+
+	"filippo.io/age"
+	"filippo.io/age/armor"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/aws/aws-sdk-go/service/kms/kmsiface"
+	"github.com/pkg/errors"
+	"github.com/snyk/driftctl/pkg/iac/terraform/state/backend/options"
+	awstest "github.com/snyk/driftctl/test/aws"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+const plainState = `{"version":4,"terraform_version":"1.0.11","serial":3,"lineage":"b2a8b2d4","outputs":{},"resources":[{"mode":"managed","type":"aws_s3_bucket","name":"bucket","instances":[{"attributes":{"id":"my-bucket"}}]}]}`
+
+func ageEncrypt(t *testing.T, recipient age.Recipient, content []byte, armored bool) []byte {
+	out := &bytes.Buffer{}
+	var dst io.Writer = out
+	var armorWriter io.WriteCloser
+	if armored {
+		armorWriter = armor.NewWriter(out)
+		dst = armorWriter
+	}
+	w, err := age.Encrypt(dst, recipient)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := w.Write(content); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if armorWriter != nil {
+		if err := armorWriter.Close(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return out.Bytes()
+}
+
+func gcmSeal(t *testing.T, key, nonce, plain, additionalData []byte) []byte {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	gcm, err := cipher.NewGCMWithNonceSize(block, len(nonce))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return gcm.Seal(nil, nonce, plain, additionalData)
+}
+
+func randomBytes(t *testing.T, size int) []byte {
+	b := make([]byte, size)
+	if _, err := rand.Read(b); err != nil {
+		t.Fatal(err)
+	}
+	return b
+}
+
+// sopsEncrypt encrypts every leaf of a document the way SOPS does, keys are walked in the order json.Marshal writes them
+func sopsEncrypt(t *testing.T, value interface{}, path []string, dataKey []byte, mac hash.Hash) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		keys := make([]string, 0, len(v))
+		for key := range v {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			v[key] = sopsEncrypt(t, v[key], append(path, key), dataKey, mac)
+		}
+		return v
+	case []interface{}:
+		for i, child := range v {
+			v[i] = sopsEncrypt(t, child, path, dataKey, mac)
+		}
+		return v
+	}
+
+	plain, valueType := fmt.Sprintf("%v", value), "str"
+	switch v := value.(type) {
+	case json.Number:
+		valueType = "int"
+		if strings.Contains(v.String(), ".") {
+			valueType = "float"
+		}
+	case bool:
+		valueType, plain = "bool", "False"
+		if v {
+			plain = "True"
+		}
+	}
+	mac.Write([]byte(plain))
+	return sopsEncryptString(t, fmt.Sprintf("%v", value), valueType, strings.Join(path, ":")+":", dataKey)
+}
+
+func sopsEncryptString(t *testing.T, plain, valueType, additionalData string, dataKey []byte) string {
+	iv := randomBytes(t, 32)
+	sealed := gcmSeal(t, dataKey, iv, []byte(plain), []byte(additionalData))
+	data, tag := sealed[:len(sealed)-16], sealed[len(sealed)-16:]
+	return fmt.Sprintf(
+		"ENC[AES256_GCM,data:%s,iv:%s,tag:%s,type:%s]",
+		base64.StdEncoding.EncodeToString(data),
+		base64.StdEncoding.EncodeToString(iv),
+		base64.StdEncoding.EncodeToString(tag),
+		valueType,
+	)
+}
+
+// sopsDocument encrypts the plain state, tamper is called on the encrypted document before it is marshalled
+func sopsDocument(t *testing.T, dataKey []byte, metadata map[string]interface{}, tamper func(document map[string]interface{})) []byte {
+	document := map[string]interface{}{}
+	decoder := json.NewDecoder(strings.NewReader(plainState))
+	decoder.UseNumber()
+	if err := decoder.Decode(&document); err != nil {
+		t.Fatal(err)
+	}
+	mac := sha512.New()
+	document = sopsEncrypt(t, document, nil, dataKey, mac).(map[string]interface{})
+	metadata["version"] = "3.7.1"
+	metadata["lastmodified"] = "2022-04-08T10:35:00Z"
+	metadata["mac"] = sopsEncryptString(t, fmt.Sprintf("%X", mac.Sum(nil)), "str", "2022-04-08T10:35:00Z", dataKey)
+	if tamper != nil {
+		tamper(document)
+	}
+	document[sopsMetadataKey] = metadata
+	content, err := json.Marshal(document)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return content
+}
+
+func TestNewDecrypter(t *testing.T) {
+	identity, err := age.GenerateX25519Identity()
+	if err != nil {
+		t.Fatal(err)
+	}
+	other, err := age.GenerateX25519Identity()
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyFile := path.Join(t.TempDir(), "keys.txt")
+	if err := os.WriteFile(keyFile, []byte(fmt.Sprintf("# created: 2022-04-08T10:35:00Z\n%s\n", identity)), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name           string
+		opts           options.DecryptionOptions
+		wantIdentities int
+		wantErr        string
+	}{
+		{
+			name:           "without keys",
+			opts:           options.DecryptionOptions{},
+			wantIdentities: 0,
+		},
+		{
+			name:           "with key file and key",
+			opts:           options.DecryptionOptions{AgeKeyFile: keyFile, AgeKey: other.String()},
+			wantIdentities: 2,
+		},
+		{
+			name:    "with missing key file",
+			opts:    options.DecryptionOptions{AgeKeyFile: "testdata/missing.txt"},
+			wantErr: "unable to read age key file: open testdata/missing.txt: no such file or directory",
+		},
+		{
+			name:    "with invalid key",
+			opts:    options.DecryptionOptions{AgeKey: "not a key"},
+			wantErr: "unable to parse age key: error at line 1: malformed secret key: separator '1' at invalid position: pos=-1, len=9",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewDecrypter(tt.opts)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Len(t, got.identities, tt.wantIdentities)
+		})
+	}
+}
+
+func TestDecrypter_Decrypt(t *testing.T) {
+	identity, err := age.GenerateX25519Identity()
+	if err != nil {
+		t.Fatal(err)
+	}
+	unknownIdentity, err := age.GenerateX25519Identity()
+	if err != nil {
+		t.Fatal(err)
+	}
+	dataKey := randomBytes(t, 32)
+	encryptedDataKey := []byte("encrypted data key")
+	nonce := randomBytes(t, 12)
+
+	tests := []struct {
+		name       string
+		identities []age.Identity
+		content    []byte
+		mocks      func(client *awstest.MockFakeKMS)
+		wantErr    string
+	}{
+		{
+			name:    "plain state",
+			content: []byte(plainState),
+		},
+		{
+			name:       "age armored state",
+			identities: []age.Identity{unknownIdentity, identity},
+			content:    ageEncrypt(t, identity.Recipient(), []byte(plainState), true),
+		},
+		{
+			name:       "age binary state",
+			identities: []age.Identity{identity},
+			content:    ageEncrypt(t, identity.Recipient(), []byte(plainState), false),
+		},
+		{
+			name:    "age state without identity",
+			content: ageEncrypt(t, identity.Recipient(), []byte(plainState), true),
+			wantErr: "unable to decrypt age encrypted state: no age identity was given, use --age-key-file or --age-key",
+		},
+		{
+			name:       "age state with wrong identity",
+			identities: []age.Identity{unknownIdentity},
+			content:    ageEncrypt(t, identity.Recipient(), []byte(plainState), true),
+			wantErr:    "unable to decrypt age encrypted state: no identity matched any of the recipients",
+		},
+		{
+			name:       "SOPS state with age key",
+			identities: []age.Identity{identity},
+			content: sopsDocument(t, dataKey, map[string]interface{}{
+				"age": []map[string]string{
+					{
+						"recipient": identity.Recipient().String(),
+						"enc":       string(ageEncrypt(t, identity.Recipient(), dataKey, true)),
+					},
+				},
+			}, nil),
+		},
+		{
+			name: "SOPS state with KMS key",
+			content: sopsDocument(t, dataKey, map[string]interface{}{
+				"kms": []map[string]interface{}{
+					{
+						"arn":     "arn:aws:kms:eu-west-3:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab",
+						"enc":     base64.StdEncoding.EncodeToString(encryptedDataKey),
+						"context": map[string]string{"app": "driftctl"},
+					},
+				},
+			}, nil),
+			mocks: func(client *awstest.MockFakeKMS) {
+				client.On("Decrypt", &kms.DecryptInput{
+					CiphertextBlob:    encryptedDataKey,
+					EncryptionContext: map[string]*string{"app": aws.String("driftctl")},
+					KeyId:             aws.String("arn:aws:kms:eu-west-3:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab"),
+				}).Return(&kms.DecryptOutput{Plaintext: dataKey}, nil).Once()
+			},
+		},
+		{
+			name:       "SOPS state without any available key",
+			identities: []age.Identity{unknownIdentity},
+			content: sopsDocument(t, dataKey, map[string]interface{}{
+				"age": []map[string]string{
+					{
+						"recipient": identity.Recipient().String(),
+						"enc":       string(ageEncrypt(t, identity.Recipient(), dataKey, true)),
+					},
+				},
+			}, nil),
+			wantErr: "unable to decrypt data key of SOPS encrypted state, none of its age or KMS keys is available",
+		},
+		{
+			name:       "SOPS state with modified value",
+			identities: []age.Identity{identity},
+			content: sopsDocument(t, dataKey, map[string]interface{}{
+				"age": []map[string]string{
+					{
+						"recipient": identity.Recipient().String(),
+						"enc":       string(ageEncrypt(t, identity.Recipient(), dataKey, true)),
+					},
+				},
+			}, func(document map[string]interface{}) {
+				document["serial"] = sopsEncryptString(t, "2", "int", "serial:", dataKey)
+			}),
+			wantErr: "MAC mismatch, SOPS encrypted state was modified after its encryption",
+		},
+		{
+			name:       "SOPS state with removed value",
+			identities: []age.Identity{identity},
+			content: sopsDocument(t, dataKey, map[string]interface{}{
+				"age": []map[string]string{
+					{
+						"recipient": identity.Recipient().String(),
+						"enc":       string(ageEncrypt(t, identity.Recipient(), dataKey, true)),
+					},
+				},
+			}, func(document map[string]interface{}) {
+				delete(document, "lineage")
+			}),
+			wantErr: "MAC mismatch, SOPS encrypted state was modified after its encryption",
+		},
+		{
+			name: "KMS envelope state",
+			content: func() []byte {
+				content, _ := json.Marshal(kmsEnvelope{
+					EncryptedDataKey: encryptedDataKey,
+					Nonce:            nonce,
+					Ciphertext:       gcmSeal(t, dataKey, nonce, []byte(plainState), nil),
+				})
+				return content
+			}(),
+			mocks: func(client *awstest.MockFakeKMS) {
+				client.On("Decrypt", &kms.DecryptInput{
+					CiphertextBlob: encryptedDataKey,
+				}).Return(&kms.DecryptOutput{Plaintext: dataKey}, nil).Once()
+			},
+		},
+		{
+			name: "KMS envelope state with denied key",
+			content: func() []byte {
+				content, _ := json.Marshal(kmsEnvelope{
+					EncryptedDataKey: encryptedDataKey,
+					Nonce:            nonce,
+					Ciphertext:       gcmSeal(t, dataKey, nonce, []byte(plainState), nil),
+				})
+				return content
+			}(),
+			mocks: func(client *awstest.MockFakeKMS) {
+				client.On("Decrypt", mock.Anything).Return(nil, errors.New("AccessDeniedException")).Once()
+			},
+			wantErr: "unable to decrypt data key of KMS envelope: AccessDeniedException",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := &awstest.MockFakeKMS{}
+			if tt.mocks != nil {
+				tt.mocks(client)
+			}
+			d := &Decrypter{
+				identities: tt.identities,
+				newKMSClient: func(region, profile string) kmsiface.KMSAPI {
+					return client
+				},
+			}
+
+			reader, err := d.Decrypt(bytes.NewReader(tt.content))
+			client.AssertExpectations(t)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			got, err := io.ReadAll(reader)
+			assert.NoError(t, err)
+			assert.JSONEq(t, plainState, string(got))
+		})
+	}
+}
+
+// The envelope is built from its documented format only, to catch changes breaking files produced by other tools
+func TestDecrypter_Decrypt_KMSEnvelopeFormat(t *testing.T) {
+	keyArn := "arn:aws:kms:eu-west-3:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab"
+	encryptedDataKey := []byte("ciphertext blob returned by GenerateDataKey")
+
+	seal := func(dataKey, nonce []byte) []byte {
+		block, err := aes.NewCipher(dataKey)
+		if err != nil {
+			t.Fatal(err)
+		}
+		gcm, err := cipher.NewGCMWithNonceSize(block, len(nonce))
+		if err != nil {
+			t.Fatal(err)
+		}
+		sealed := gcm.Seal(nil, nonce, []byte(plainState), nil)
+		return []byte(fmt.Sprintf(
+			`{"kms_key_id": %q, "encrypted_data_key": %q, "encryption_context": {"workspace": "production"}, "nonce": %q, "ciphertext": %q}`,
+			keyArn,
+			base64.StdEncoding.EncodeToString(encryptedDataKey),
+			base64.StdEncoding.EncodeToString(nonce),
+			base64.StdEncoding.EncodeToString(sealed),
+		))
+	}
+
+	tests := []struct {
+		name        string
+		dataKeySize int
+		nonceSize   int
+		wantKMSCall bool
+		wantErr     string
+	}{
+		{
+			name:        "AES-256-GCM with a 12 bytes nonce",
+			dataKeySize: 32,
+			nonceSize:   12,
+			wantKMSCall: true,
+		},
+		{
+			name:        "nonce of another size",
+			dataKeySize: 32,
+			nonceSize:   16,
+			wantErr:     "invalid KMS envelope, nonce must be 12 bytes, got 16 bytes",
+		},
+		{
+			name:        "data key of another spec",
+			dataKeySize: 16,
+			nonceSize:   12,
+			wantKMSCall: true,
+			wantErr:     "invalid KMS envelope, data key must be an AES_256 key of 32 bytes, got 16 bytes",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dataKey := randomBytes(t, tt.dataKeySize)
+			content := seal(dataKey, randomBytes(t, tt.nonceSize))
+
+			client := &awstest.MockFakeKMS{}
+			if tt.wantKMSCall {
+				client.On("Decrypt", &kms.DecryptInput{
+					CiphertextBlob:    encryptedDataKey,
+					EncryptionContext: map[string]*string{"workspace": aws.String("production")},
+					KeyId:             aws.String(keyArn),
+				}).Return(&kms.DecryptOutput{Plaintext: dataKey}, nil).Once()
+			}
+			var region string
+			d := &Decrypter{
+				newKMSClient: func(r, profile string) kmsiface.KMSAPI {
+					region = r
+					return client
+				},
+			}
+
+			reader, err := d.Decrypt(bytes.NewReader(content))
+			client.AssertExpectations(t)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, "eu-west-3", region)
+			got, err := io.ReadAll(reader)
+			assert.NoError(t, err)
+			assert.JSONEq(t, plainState, string(got))
+		})
+	}
+}
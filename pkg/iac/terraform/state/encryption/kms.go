@@ -0,0 +1,98 @@
+package encryption
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"encoding/json"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/pkg/errors"
+)
+
+// kmsEnvelope is a state sealed with a data key generated by AWS KMS, it is a JSON document with the fields:
+//   - kms_key_id: optional id or ARN of the KMS key, the region of an ARN is the one KMS is called in
+//   - encrypted_data_key: CiphertextBlob returned by KMS GenerateDataKey with the AES_256 key spec
+//   - encryption_context: optional encryption context given to GenerateDataKey, a map of strings
+//   - nonce: 12 random bytes
+//   - ciphertext: the state sealed with AES-256-GCM using the plaintext data key, the nonce and no additional data,
+//     followed by the 16 bytes GCM tag
+//
+// Binary fields are encoded in standard base64 with padding, see the "Encrypted states" section of the README.
+type kmsEnvelope struct {
+	KeyId             string             `json:"kms_key_id"`
+	EncryptedDataKey  []byte             `json:"encrypted_data_key"`
+	EncryptionContext map[string]*string `json:"encryption_context"`
+	Nonce             []byte             `json:"nonce"`
+	Ciphertext        []byte             `json:"ciphertext"`
+}
+
+const (
+	kmsEnvelopeDataKeySize = 32
+	kmsEnvelopeNonceSize   = 12
+)
+
+func isKMSEnvelope(content []byte) bool {
+	if !bytes.Contains(content, []byte(`"encrypted_data_key"`)) {
+		return false
+	}
+	envelope := kmsEnvelope{}
+	if err := json.Unmarshal(content, &envelope); err != nil {
+		return false
+	}
+	return len(envelope.EncryptedDataKey) > 0 && len(envelope.Ciphertext) > 0
+}
+
+// kmsDecrypt decrypts a data key, the region of the key is used when given as an ARN
+func (d *Decrypter) kmsDecrypt(keyId, profile string, encryptedKey []byte, context map[string]*string) ([]byte, error) {
+	region := ""
+	if keyArn, err := arn.Parse(keyId); err == nil {
+		region = keyArn.Region
+	}
+	input := &kms.DecryptInput{
+		CiphertextBlob:    encryptedKey,
+		EncryptionContext: context,
+	}
+	if keyId != "" {
+		input.KeyId = aws.String(keyId)
+	}
+	output, err := d.newKMSClient(region, profile).Decrypt(input)
+	if err != nil {
+		return nil, err
+	}
+	return output.Plaintext, nil
+}
+
+func (d *Decrypter) decryptKMSEnvelope(content []byte) ([]byte, error) {
+	envelope := kmsEnvelope{}
+	if err := json.Unmarshal(content, &envelope); err != nil {
+		return nil, err
+	}
+	if len(envelope.Nonce) != kmsEnvelopeNonceSize {
+		return nil, errors.Errorf("invalid KMS envelope, nonce must be %d bytes, got %d bytes", kmsEnvelopeNonceSize, len(envelope.Nonce))
+	}
+
+	dataKey, err := d.kmsDecrypt(envelope.KeyId, "", envelope.EncryptedDataKey, envelope.EncryptionContext)
+	if err != nil {
+		return nil, errors.Errorf("unable to decrypt data key of KMS envelope: %s", err)
+	}
+
+	if len(dataKey) != kmsEnvelopeDataKeySize {
+		return nil, errors.Errorf("invalid KMS envelope, data key must be an AES_256 key of %d bytes, got %d bytes", kmsEnvelopeDataKeySize, len(dataKey))
+	}
+	block, err := aes.NewCipher(dataKey)
+	if err != nil {
+		return nil, err
+	}
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+	plain, err := gcm.Open(nil, envelope.Nonce, envelope.Ciphertext, nil)
+	if err != nil {
+		return nil, errors.Errorf("unable to decrypt KMS envelope: %s", err)
+	}
+	return plain, nil
+}
@@ -0,0 +1,325 @@
+package encryption
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/sha512"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"hash"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+const sopsMetadataKey = "sops"
+
+var sopsEncryptedValue = regexp.MustCompile(`^ENC\[AES256_GCM,data:(.*),iv:(.+),tag:(.+),type:(.+)\]$`)
+
+// sopsMetadata is the subset of the metadata SOPS stores next to encrypted values that is needed to retrieve the data key
+type sopsMetadata struct {
+	Version          string `json:"version"`
+	LastModified     string `json:"lastmodified"`
+	MAC              string `json:"mac"`
+	MACOnlyEncrypted bool   `json:"mac_only_encrypted"`
+	KMS              []struct {
+		Arn        string             `json:"arn"`
+		Enc        string             `json:"enc"`
+		Context    map[string]*string `json:"context"`
+		AwsProfile string             `json:"aws_profile"`
+	} `json:"kms"`
+	Age []struct {
+		Recipient string `json:"recipient"`
+		Enc       string `json:"enc"`
+	} `json:"age"`
+}
+
+// sopsBranch is a JSON object whose keys keep the order of the document, SOPS computes its MAC in this order
+type sopsBranch []sopsItem
+
+type sopsItem struct {
+	Key   string
+	Value interface{}
+}
+
+func (b sopsBranch) MarshalJSON() ([]byte, error) {
+	buf := bytes.Buffer{}
+	buf.WriteByte('{')
+	for i, item := range b {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		key, err := json.Marshal(item.Key)
+		if err != nil {
+			return nil, err
+		}
+		value, err := json.Marshal(item.Value)
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(key)
+		buf.WriteByte(':')
+		buf.Write(value)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+func sopsDecode(decoder *json.Decoder) (interface{}, error) {
+	token, err := decoder.Token()
+	if err != nil {
+		return nil, err
+	}
+	switch token {
+	case json.Delim('{'):
+		branch := sopsBranch{}
+		for decoder.More() {
+			key, err := decoder.Token()
+			if err != nil {
+				return nil, err
+			}
+			value, err := sopsDecode(decoder)
+			if err != nil {
+				return nil, err
+			}
+			branch = append(branch, sopsItem{Key: key.(string), Value: value})
+		}
+		_, err = decoder.Token()
+		return branch, err
+	case json.Delim('['):
+		list := make([]interface{}, 0)
+		for decoder.More() {
+			value, err := sopsDecode(decoder)
+			if err != nil {
+				return nil, err
+			}
+			list = append(list, value)
+		}
+		_, err = decoder.Token()
+		return list, err
+	}
+	return token, nil
+}
+
+// sopsMAC hashes the plain leaves of the document like SOPS does to compute its message authentication code
+type sopsMAC struct {
+	hash          hash.Hash
+	onlyEncrypted bool
+}
+
+func (m *sopsMAC) add(value interface{}, encrypted bool) {
+	if m.onlyEncrypted && !encrypted {
+		return
+	}
+	switch v := value.(type) {
+	case string:
+		m.hash.Write([]byte(v))
+	case int:
+		m.hash.Write([]byte(strconv.Itoa(v)))
+	case float64:
+		m.hash.Write([]byte(strconv.FormatFloat(v, 'f', -1, 64)))
+	case json.Number:
+		if i, err := v.Int64(); err == nil {
+			m.hash.Write([]byte(strconv.FormatInt(i, 10)))
+		} else if f, err := v.Float64(); err == nil {
+			m.hash.Write([]byte(strconv.FormatFloat(f, 'f', -1, 64)))
+		}
+	case bool:
+		if v {
+			m.hash.Write([]byte("True"))
+		} else {
+			m.hash.Write([]byte("False"))
+		}
+	}
+}
+
+// verify compares the computed MAC with the one stored in the metadata, which is encrypted with the last modification date as additional data
+func (m *sopsMAC) verify(metadata sopsMetadata, dataKey []byte) error {
+	if metadata.MAC == "" {
+		return errors.New("SOPS encrypted state has no MAC, unable to verify its integrity")
+	}
+	lastModified, err := time.Parse(time.RFC3339, metadata.LastModified)
+	if err != nil {
+		return errors.Errorf("invalid lastmodified in SOPS metadata: %s", err)
+	}
+	if !sopsEncryptedValue.MatchString(metadata.MAC) {
+		return errors.New("invalid MAC in SOPS metadata")
+	}
+	expected, err := sopsDecryptString(metadata.MAC, lastModified.Format(time.RFC3339), dataKey)
+	if err != nil {
+		return errors.Errorf("unable to decrypt MAC of SOPS encrypted state: %s", err)
+	}
+	if expected != fmt.Sprintf("%X", m.hash.Sum(nil)) {
+		return errors.New("MAC mismatch, SOPS encrypted state was modified after its encryption")
+	}
+	return nil
+}
+
+func isSopsEncrypted(content []byte) bool {
+	if !bytes.Contains(content, []byte(`"sops"`)) {
+		return false
+	}
+	document := map[string]json.RawMessage{}
+	if err := json.Unmarshal(content, &document); err != nil {
+		return false
+	}
+	metadata := sopsMetadata{}
+	if err := json.Unmarshal(document[sopsMetadataKey], &metadata); err != nil {
+		return false
+	}
+	return metadata.Version != ""
+}
+
+func (d *Decrypter) decryptSops(content []byte) ([]byte, error) {
+	decoder := json.NewDecoder(bytes.NewReader(content))
+	decoder.UseNumber()
+	decoded, err := sopsDecode(decoder)
+	if err != nil {
+		return nil, err
+	}
+	document, ok := decoded.(sopsBranch)
+	if !ok {
+		return nil, errors.New("SOPS encrypted state is not a JSON object")
+	}
+
+	metadata := sopsMetadata{}
+	plainDocument := make(sopsBranch, 0, len(document))
+	for _, item := range document {
+		if item.Key != sopsMetadataKey {
+			plainDocument = append(plainDocument, item)
+			continue
+		}
+		rawMetadata, err := json.Marshal(item.Value)
+		if err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal(rawMetadata, &metadata); err != nil {
+			return nil, err
+		}
+	}
+
+	dataKey, err := d.sopsDataKey(metadata)
+	if err != nil {
+		return nil, err
+	}
+
+	mac := &sopsMAC{hash: sha512.New(), onlyEncrypted: metadata.MACOnlyEncrypted}
+	plain, err := sopsDecryptValue(plainDocument, nil, dataKey, mac)
+	if err != nil {
+		return nil, errors.Errorf("unable to decrypt SOPS encrypted state: %s", err)
+	}
+	if err := mac.verify(metadata, dataKey); err != nil {
+		return nil, err
+	}
+	return json.Marshal(plain)
+}
+
+// sopsDataKey tries every key group SOPS encrypted the data key with until one can be decrypted
+func (d *Decrypter) sopsDataKey(metadata sopsMetadata) ([]byte, error) {
+	if len(d.identities) > 0 {
+		for _, key := range metadata.Age {
+			dataKey, err := d.ageDecrypt([]byte(key.Enc))
+			if err == nil {
+				return dataKey, nil
+			}
+			logrus.WithFields(logrus.Fields{
+				"recipient": key.Recipient,
+				"err":       err,
+			}).Debug("Unable to decrypt SOPS data key with age")
+		}
+	}
+	for _, key := range metadata.KMS {
+		encryptedKey, err := base64.StdEncoding.DecodeString(key.Enc)
+		if err != nil {
+			return nil, err
+		}
+		dataKey, err := d.kmsDecrypt(key.Arn, key.AwsProfile, encryptedKey, key.Context)
+		if err == nil {
+			return dataKey, nil
+		}
+		logrus.WithFields(logrus.Fields{
+			"arn": key.Arn,
+			"err": err,
+		}).Debug("Unable to decrypt SOPS data key with KMS")
+	}
+	return nil, errors.New("unable to decrypt data key of SOPS encrypted state, none of its age or KMS keys is available")
+}
+
+// sopsDecryptValue walks the document the way SOPS does, values are authenticated with the path of their keys
+func sopsDecryptValue(value interface{}, path []string, dataKey []byte, mac *sopsMAC) (interface{}, error) {
+	switch v := value.(type) {
+	case sopsBranch:
+		for i, item := range v {
+			plain, err := sopsDecryptValue(item.Value, append(path, item.Key), dataKey, mac)
+			if err != nil {
+				return nil, err
+			}
+			v[i].Value = plain
+		}
+		return v, nil
+	case []interface{}:
+		for i, child := range v {
+			plain, err := sopsDecryptValue(child, path, dataKey, mac)
+			if err != nil {
+				return nil, err
+			}
+			v[i] = plain
+		}
+		return v, nil
+	case string:
+		if sopsEncryptedValue.MatchString(v) {
+			plain, err := sopsDecryptString(v, strings.Join(path, ":")+":", dataKey)
+			if err != nil {
+				return nil, err
+			}
+			mac.add(plain, true)
+			return plain, nil
+		}
+	}
+	mac.add(value, false)
+	return value, nil
+}
+
+func sopsDecryptString(value, additionalData string, dataKey []byte) (interface{}, error) {
+	matches := sopsEncryptedValue.FindStringSubmatch(value)
+	parts := make([][]byte, 3)
+	for i := range parts {
+		decoded, err := base64.StdEncoding.DecodeString(matches[i+1])
+		if err != nil {
+			return nil, err
+		}
+		parts[i] = decoded
+	}
+	data, iv, tag, valueType := parts[0], parts[1], parts[2], matches[4]
+
+	block, err := aes.NewCipher(dataKey)
+	if err != nil {
+		return nil, err
+	}
+	gcm, err := cipher.NewGCMWithNonceSize(block, len(iv))
+	if err != nil {
+		return nil, err
+	}
+	plain, err := gcm.Open(nil, iv, append(data, tag...), []byte(additionalData))
+	if err != nil {
+		return nil, errors.Errorf("value at %s: %s", additionalData, err)
+	}
+
+	switch valueType {
+	case "str", "bytes":
+		return string(plain), nil
+	case "int":
+		return strconv.Atoi(string(plain))
+	case "float":
+		return strconv.ParseFloat(string(plain), 64)
+	case "bool":
+		return strconv.ParseBool(string(plain))
+	}
+	return nil, errors.Errorf("unknown type %s of value at %s", valueType, additionalData)
+}
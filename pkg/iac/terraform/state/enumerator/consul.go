@@ -0,0 +1,62 @@
+package enumerator
+
+import (
+	"net/http"
+	"regexp"
+	"strings"
+
+	"github.com/bmatcuk/doublestar/v4"
+	"github.com/pkg/errors"
+	"github.com/snyk/driftctl/pkg/iac/config"
+	"github.com/snyk/driftctl/pkg/iac/terraform/state/backend"
+	"github.com/snyk/driftctl/pkg/iac/terraform/state/backend/options"
+)
+
+// Keys written by terraform next to states for locking and for chunked states
+var consulInternalKey = regexp.MustCompile(`(/\.lock|/\.lockinfo|/tfstate\.[^/]+/\d+)$`)
+
+type ConsulEnumerator struct {
+	config config.SupplierConfig
+	client *backend.ConsulKVClient
+}
+
+func NewConsulEnumerator(config config.SupplierConfig, opts options.ConsulBackendOptions) *ConsulEnumerator {
+	return &ConsulEnumerator{
+		config: config,
+		client: backend.NewConsulKVClient(&http.Client{}, opts),
+	}
+}
+
+func (s *ConsulEnumerator) Origin() string {
+	return s.config.String()
+}
+
+func (s *ConsulEnumerator) Enumerate() ([]string, error) {
+	// prefix should contains everything that does not have a glob pattern
+	// Pattern should be the glob matcher string
+	prefix, pattern := GlobS3(strings.Trim(s.config.Path, "/"))
+
+	fullPattern := strings.Join([]string{prefix, pattern}, "/")
+	fullPattern = strings.Trim(fullPattern, "/")
+
+	keys, err := s.client.Keys(prefix)
+	if err != nil {
+		return nil, err
+	}
+
+	files := make([]string, 0)
+	for _, key := range keys {
+		if strings.HasSuffix(key, "/") || consulInternalKey.MatchString(key) {
+			continue
+		}
+		if match, _ := doublestar.Match(fullPattern, key); match {
+			files = append(files, key)
+		}
+	}
+
+	if len(files) == 0 {
+		return nil, errors.Errorf("no Terraform state was found in %s, exiting", s.config.Path)
+	}
+
+	return files, nil
+}
@@ -0,0 +1,93 @@
+package enumerator
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/snyk/driftctl/pkg/iac/config"
+	"github.com/snyk/driftctl/pkg/iac/terraform/state/backend/options"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConsulEnumerator_Enumerate(t *testing.T) {
+	keys := []string{
+		"terraform/",
+		"terraform/network",
+		"terraform/network/.lock",
+		"terraform/network/.lockinfo",
+		"terraform/network-env:staging",
+		"terraform/app",
+		"terraform/app/tfstate.abcd/0",
+		"terraform/app/tfstate.abcd/1",
+		"other/state",
+	}
+
+	tests := []struct {
+		name   string
+		config config.SupplierConfig
+		prefix string
+		want   []string
+		err    string
+	}{
+		{
+			name: "every state under a prefix",
+			config: config.SupplierConfig{
+				Path: "terraform/*",
+			},
+			prefix: "terraform",
+			want: []string{
+				"terraform/network",
+				"terraform/network-env:staging",
+				"terraform/app",
+			},
+		},
+		{
+			name: "every workspace of a state",
+			config: config.SupplierConfig{
+				Path: "terraform/network*",
+			},
+			prefix: "terraform",
+			want: []string{
+				"terraform/network",
+				"terraform/network-env:staging",
+			},
+		},
+		{
+			name: "no state matching the pattern",
+			config: config.SupplierConfig{
+				Path: "terraform/database*",
+			},
+			prefix: "terraform",
+			err:    "no Terraform state was found in terraform/database*, exiting",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/v1/kv/"+tt.prefix, r.URL.Path)
+				assert.True(t, r.URL.Query().Has("keys"))
+				matching := make([]string, 0)
+				for _, key := range keys {
+					if strings.HasPrefix(key, tt.prefix) {
+						matching = append(matching, key)
+					}
+				}
+				_ = json.NewEncoder(w).Encode(matching)
+			}))
+			defer server.Close()
+
+			enumerator := NewConsulEnumerator(tt.config, options.ConsulBackendOptions{Address: server.URL})
+			got, err := enumerator.Enumerate()
+			if err != nil {
+				assert.EqualError(t, err, tt.err)
+				return
+			} else {
+				assert.Empty(t, tt.err)
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
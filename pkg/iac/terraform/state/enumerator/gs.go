@@ -0,0 +1,99 @@
+package enumerator
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"cloud.google.com/go/storage"
+	"github.com/bmatcuk/doublestar/v4"
+	"github.com/pkg/errors"
+	"github.com/snyk/driftctl/pkg/iac/config"
+	"google.golang.org/api/iterator"
+)
+
+type GSEnumerator struct {
+	config        config.SupplierConfig
+	at            time.Time
+	storageClient *storage.Client
+}
+
+func NewGSEnumerator(config config.SupplierConfig, at time.Time) *GSEnumerator {
+	return &GSEnumerator{
+		config: config,
+		at:     at,
+	}
+}
+
+func (s *GSEnumerator) Origin() string {
+	return s.config.String()
+}
+
+func (s *GSEnumerator) Enumerate() ([]string, error) {
+	bucketPath := strings.Split(s.config.Path, "/")
+	if len(bucketPath) < 2 || bucketPath[1] == "" {
+		return nil, errors.Errorf("Unable to parse Google Storage path: %s. Must be BUCKET_NAME/PREFIX", s.config.Path)
+	}
+
+	client := s.storageClient
+	if client == nil {
+		c, err := storage.NewClient(context.Background())
+		if err != nil {
+			return nil, err
+		}
+		defer c.Close()
+		client = c
+	}
+
+	bucket := bucketPath[0]
+	// prefix should contains everything that does not have a glob pattern
+	// Pattern should be the glob matcher string
+	prefix, pattern := GlobS3(strings.Join(bucketPath[1:], "/"))
+
+	fullPattern := strings.Join([]string{prefix, pattern}, "/")
+	fullPattern = strings.Trim(fullPattern, "/")
+
+	files := make([]string, 0)
+	// With a point in time every generation is listed, so states deleted since then are still found
+	seen := make(map[string]struct{})
+	it := client.Bucket(bucket).Objects(context.Background(), &storage.Query{
+		Prefix:   prefix,
+		Versions: !s.at.IsZero(),
+	})
+	for {
+		attrs, err := it.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if attrs.Size == 0 || !s.liveAt(attrs) {
+			continue
+		}
+		if _, exists := seen[attrs.Name]; exists {
+			continue
+		}
+		seen[attrs.Name] = struct{}{}
+		if match, _ := doublestar.Match(fullPattern, attrs.Name); match {
+			files = append(files, strings.Join([]string{bucket, attrs.Name}, "/"))
+		}
+	}
+
+	if len(files) == 0 {
+		return nil, errors.Errorf("no Terraform state was found in %s, exiting", s.config.Path)
+	}
+
+	return files, nil
+}
+
+// liveAt tells whether the generation was the current one at the requested time, a generation stops being live when it is overwritten or deleted
+func (s *GSEnumerator) liveAt(attrs *storage.ObjectAttrs) bool {
+	if s.at.IsZero() {
+		return true
+	}
+	if attrs.Created.After(s.at) {
+		return false
+	}
+	return attrs.Deleted.IsZero() || attrs.Deleted.After(s.at)
+}
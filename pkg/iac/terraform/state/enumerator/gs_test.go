@@ -0,0 +1,134 @@
+package enumerator
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/snyk/driftctl/pkg/iac/config"
+	googletest "github.com/snyk/driftctl/test/google"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGSEnumerator_Enumerate(t *testing.T) {
+	objects := `{
+		"kind": "storage#objects",
+		"items": [
+			{"name": "a/nested/prefix/1/state1.tfstate", "size": "5"},
+			{"name": "a/nested/folder1/2/state2.tfstate", "size": "5"},
+			{"name": "a/nested/prefix/state3.tfstate", "size": "5"},
+			{"name": "a/nested/prefix/empty.tfstate", "size": "0"},
+			{"name": "a/nested/prefix/state4.tfstate.backup", "size": "5"}
+		]
+	}`
+
+	tests := []struct {
+		name        string
+		config      config.SupplierConfig
+		at          time.Time
+		handlerFunc map[string]http.HandlerFunc
+		want        []string
+		err         string
+	}{
+		{
+			name: "test results with simple doublestar glob",
+			config: config.SupplierConfig{
+				Path: "bucket-name/**/*.tfstate",
+			},
+			handlerFunc: map[string]http.HandlerFunc{
+				"/storage/v1/b/bucket-name/o": func(w http.ResponseWriter, r *http.Request) {
+					assert.Equal(t, "", r.URL.Query().Get("prefix"))
+					_, _ = w.Write([]byte(objects))
+				},
+			},
+			want: []string{
+				"bucket-name/a/nested/prefix/1/state1.tfstate",
+				"bucket-name/a/nested/folder1/2/state2.tfstate",
+				"bucket-name/a/nested/prefix/state3.tfstate",
+			},
+		},
+		{
+			name: "test results with prefix and glob",
+			config: config.SupplierConfig{
+				Path: "bucket-name/a/nested/prefix/*.tfstate",
+			},
+			handlerFunc: map[string]http.HandlerFunc{
+				"/storage/v1/b/bucket-name/o": func(w http.ResponseWriter, r *http.Request) {
+					assert.Equal(t, "a/nested/prefix", r.URL.Query().Get("prefix"))
+					_, _ = w.Write([]byte(objects))
+				},
+			},
+			want: []string{
+				"bucket-name/a/nested/prefix/state3.tfstate",
+			},
+		},
+		{
+			name: "test results with glob at a point in time",
+			config: config.SupplierConfig{
+				Path: "bucket-name/a/nested/*.tfstate",
+			},
+			at: time.Date(2022, 4, 1, 12, 0, 0, 0, time.UTC),
+			handlerFunc: map[string]http.HandlerFunc{
+				"/storage/v1/b/bucket-name/o": func(w http.ResponseWriter, r *http.Request) {
+					assert.Equal(t, "a/nested", r.URL.Query().Get("prefix"))
+					assert.Equal(t, "true", r.URL.Query().Get("versions"))
+					_, _ = w.Write([]byte(`{
+						"kind": "storage#objects",
+						"items": [
+							{"name": "a/nested/deleted-since.tfstate", "size": "5", "generation": "1", "timeCreated": "2022-03-01T12:00:00Z", "timeDeleted": "2022-04-02T12:00:00Z"},
+							{"name": "a/nested/deleted-before.tfstate", "size": "5", "generation": "2", "timeCreated": "2022-02-01T12:00:00Z", "timeDeleted": "2022-03-01T12:00:00Z"},
+							{"name": "a/nested/created-since.tfstate", "size": "5", "generation": "3", "timeCreated": "2022-05-01T12:00:00Z"},
+							{"name": "a/nested/updated.tfstate", "size": "5", "generation": "4", "timeCreated": "2022-03-01T12:00:00Z", "timeDeleted": "2022-05-01T12:00:00Z"},
+							{"name": "a/nested/updated.tfstate", "size": "6", "generation": "5", "timeCreated": "2022-05-01T12:00:00Z"}
+						]
+					}`))
+				},
+			},
+			want: []string{
+				"bucket-name/a/nested/deleted-since.tfstate",
+				"bucket-name/a/nested/updated.tfstate",
+			},
+		},
+		{
+			name: "no state matching the glob",
+			config: config.SupplierConfig{
+				Path: "bucket-name/**/*.json",
+			},
+			handlerFunc: map[string]http.HandlerFunc{
+				"/storage/v1/b/bucket-name/o": func(w http.ResponseWriter, r *http.Request) {
+					_, _ = w.Write([]byte(objects))
+				},
+			},
+			err: "no Terraform state was found in bucket-name/**/*.json, exiting",
+		},
+		{
+			name: "invalid path",
+			config: config.SupplierConfig{
+				Path: "bucket-name",
+			},
+			err: "Unable to parse Google Storage path: bucket-name. Must be BUCKET_NAME/PREFIX",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, server, err := googletest.NewFakeStorageServer(tt.handlerFunc)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer client.Close()
+			defer server.Close()
+
+			enumerator := NewGSEnumerator(tt.config, tt.at)
+			enumerator.storageClient = client
+
+			got, err := enumerator.Enumerate()
+			if err != nil {
+				assert.EqualError(t, err, tt.err)
+				return
+			} else {
+				assert.Empty(t, tt.err)
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
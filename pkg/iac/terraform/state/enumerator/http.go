@@ -0,0 +1,107 @@
+package enumerator
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	pkghttp "github.com/snyk/driftctl/pkg/http"
+	"github.com/snyk/driftctl/pkg/iac/config"
+	"github.com/snyk/driftctl/pkg/iac/terraform/state/backend"
+)
+
+// httpManifest lists states URLs, either as a plain JSON array or under a "states" key
+type httpManifest struct {
+	States []string `json:"states"`
+}
+
+func (m *httpManifest) UnmarshalJSON(data []byte) error {
+	var states []string
+	if err := json.Unmarshal(data, &states); err == nil {
+		m.States = states
+		return nil
+	}
+	type manifest httpManifest
+	return json.Unmarshal(data, (*manifest)(m))
+}
+
+type HTTPEnumerator struct {
+	config config.SupplierConfig
+	opts   *backend.Options
+	client pkghttp.HTTPClient
+}
+
+func NewHTTPEnumerator(config config.SupplierConfig, opts *backend.Options) *HTTPEnumerator {
+	return &HTTPEnumerator{
+		config: config,
+		opts:   opts,
+		client: &http.Client{},
+	}
+}
+
+func (h *HTTPEnumerator) Origin() string {
+	return h.config.String()
+}
+
+func (h *HTTPEnumerator) Enumerate() ([]string, error) {
+	manifestURL, err := url.Parse(fmt.Sprintf("%s://%s", h.config.Backend, h.config.Path))
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodGet, manifestURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	for key, value := range h.opts.Headers {
+		req.Header.Add(key, value)
+	}
+
+	res, err := h.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode < 200 || res.StatusCode >= 400 {
+		body, _ := io.ReadAll(res.Body)
+		logrus.WithFields(logrus.Fields{"body": string(body)}).Trace("HTTP(s) manifest response")
+
+		return nil, errors.Errorf("error requesting HTTP(s) manifest: status code: %d", res.StatusCode)
+	}
+
+	manifest := httpManifest{}
+	if err := json.NewDecoder(res.Body).Decode(&manifest); err != nil {
+		return nil, errors.Errorf("given url is not a valid manifest: %s", err)
+	}
+
+	files := make([]string, 0, len(manifest.States))
+	for _, state := range manifest.States {
+		// Relative entries are resolved against the manifest location
+		stateURL, err := manifestURL.Parse(state)
+		if err != nil {
+			logrus.WithFields(logrus.Fields{
+				"url": state,
+			}).Warnf("Ignoring invalid state url from manifest: %s", err)
+			continue
+		}
+		if stateURL.Scheme != h.config.Backend {
+			logrus.WithFields(logrus.Fields{
+				"url": stateURL.String(),
+			}).Warnf("Ignoring state url from manifest as its scheme does not match %s", h.config.Backend)
+			continue
+		}
+		files = append(files, strings.TrimPrefix(stateURL.String(), stateURL.Scheme+"://"))
+	}
+
+	if len(files) == 0 {
+		return nil, errors.Errorf("no Terraform state was found in %s, exiting", h.config.String())
+	}
+
+	return files, nil
+}
@@ -0,0 +1,135 @@
+package enumerator
+
+import (
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	pkghttp "github.com/snyk/driftctl/pkg/http"
+	"github.com/snyk/driftctl/pkg/iac/config"
+	"github.com/snyk/driftctl/pkg/iac/terraform/state/backend"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestHTTPEnumerator_Enumerate(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  config.SupplierConfig
+		headers map[string]string
+		mocks   func(client *pkghttp.MockHTTPClient)
+		want    []string
+		err     string
+	}{
+		{
+			name: "manifest as a list of urls",
+			config: config.SupplierConfig{
+				Backend: backend.BackendKeyHTTPS,
+				Path:    "example.com/states/index.json",
+			},
+			headers: map[string]string{
+				"Authorization": "Basic Test",
+			},
+			mocks: func(client *pkghttp.MockHTTPClient) {
+				client.On("Do", mock.MatchedBy(func(req *http.Request) bool {
+					return req.URL.String() == "https://example.com/states/index.json" &&
+						req.Header.Get("Authorization") == "Basic Test"
+				})).Return(&http.Response{
+					StatusCode: 200,
+					Body: io.NopCloser(strings.NewReader(`[
+						"https://example.com/states/network.tfstate",
+						"https://other.example.com/app.tfstate?version=2"
+					]`)),
+				}, nil)
+			},
+			want: []string{
+				"example.com/states/network.tfstate",
+				"other.example.com/app.tfstate?version=2",
+			},
+		},
+		{
+			name: "manifest as an object with relative urls",
+			config: config.SupplierConfig{
+				Backend: backend.BackendKeyHTTPS,
+				Path:    "example.com/states/index.json",
+			},
+			mocks: func(client *pkghttp.MockHTTPClient) {
+				client.On("Do", mock.Anything).Return(&http.Response{
+					StatusCode: 200,
+					Body: io.NopCloser(strings.NewReader(`{"states": [
+						"network.tfstate",
+						"/prod/app.tfstate",
+						"http://example.com/insecure.tfstate"
+					]}`)),
+				}, nil)
+			},
+			want: []string{
+				"example.com/states/network.tfstate",
+				"example.com/prod/app.tfstate",
+			},
+		},
+		{
+			name: "empty manifest",
+			config: config.SupplierConfig{
+				Backend: backend.BackendKeyHTTPS,
+				Path:    "example.com/states/index.json",
+			},
+			mocks: func(client *pkghttp.MockHTTPClient) {
+				client.On("Do", mock.Anything).Return(&http.Response{
+					StatusCode: 200,
+					Body:       io.NopCloser(strings.NewReader(`{"states": []}`)),
+				}, nil)
+			},
+			err: "no Terraform state was found in tfstate+https://example.com/states/index.json, exiting",
+		},
+		{
+			name: "invalid manifest",
+			config: config.SupplierConfig{
+				Backend: backend.BackendKeyHTTPS,
+				Path:    "example.com/states/index.json",
+			},
+			mocks: func(client *pkghttp.MockHTTPClient) {
+				client.On("Do", mock.Anything).Return(&http.Response{
+					StatusCode: 200,
+					Body:       io.NopCloser(strings.NewReader(`<html></html>`)),
+				}, nil)
+			},
+			err: "given url is not a valid manifest: invalid character '<' looking for beginning of value",
+		},
+		{
+			name: "bad status code",
+			config: config.SupplierConfig{
+				Backend: backend.BackendKeyHTTPS,
+				Path:    "example.com/states/index.json",
+			},
+			mocks: func(client *pkghttp.MockHTTPClient) {
+				client.On("Do", mock.Anything).Return(&http.Response{
+					StatusCode: 404,
+					Body:       io.NopCloser(strings.NewReader(`Not Found`)),
+				}, nil)
+			},
+			err: "error requesting HTTP(s) manifest: status code: 404",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.config.Key = "tfstate"
+			client := &pkghttp.MockHTTPClient{}
+			tt.mocks(client)
+
+			enumerator := NewHTTPEnumerator(tt.config, &backend.Options{Headers: tt.headers, HTTPManifest: true})
+			enumerator.client = client
+
+			got, err := enumerator.Enumerate()
+			if err != nil {
+				assert.EqualError(t, err, tt.err)
+				return
+			} else {
+				assert.Empty(t, tt.err)
+			}
+			assert.Equal(t, tt.want, got)
+			client.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,62 @@
+package enumerator
+
+import (
+	"strings"
+
+	"github.com/bmatcuk/doublestar/v4"
+	"github.com/pkg/errors"
+	"github.com/snyk/driftctl/pkg/iac/config"
+	"github.com/snyk/driftctl/pkg/iac/terraform/state/backend"
+	"github.com/snyk/driftctl/pkg/iac/terraform/state/backend/options"
+)
+
+type KubernetesEnumerator struct {
+	config config.SupplierConfig
+	opts   options.KubernetesBackendOptions
+	client *backend.KubernetesClient
+}
+
+func NewKubernetesEnumerator(config config.SupplierConfig, opts options.KubernetesBackendOptions) *KubernetesEnumerator {
+	return &KubernetesEnumerator{
+		config: config,
+		opts:   opts,
+	}
+}
+
+func (s *KubernetesEnumerator) Origin() string {
+	return s.config.String()
+}
+
+func (s *KubernetesEnumerator) Enumerate() ([]string, error) {
+	namespacePattern := strings.Split(s.config.Path, "/")
+	if len(namespacePattern) != 2 || namespacePattern[0] == "" || namespacePattern[1] == "" {
+		return nil, errors.Errorf("Unable to parse kubernetes path: %s. Must be NAMESPACE/SECRET_NAME_PATTERN", s.config.Path)
+	}
+	namespace, pattern := namespacePattern[0], namespacePattern[1]
+
+	if s.client == nil {
+		client, err := backend.NewKubernetesClient(s.opts)
+		if err != nil {
+			return nil, err
+		}
+		s.client = client
+	}
+
+	secrets, err := s.client.ListSecrets(namespace, backend.KubernetesStateLabelSelector)
+	if err != nil {
+		return nil, errors.Errorf("unable to list secrets from kubernetes namespace '%s': %s", namespace, err)
+	}
+
+	files := make([]string, 0)
+	for _, secret := range secrets {
+		if match, _ := doublestar.Match(pattern, secret.Metadata.Name); match {
+			files = append(files, strings.Join([]string{namespace, secret.Metadata.Name}, "/"))
+		}
+	}
+
+	if len(files) == 0 {
+		return nil, errors.Errorf("no Terraform state was found in %s, exiting", s.config.Path)
+	}
+
+	return files, nil
+}
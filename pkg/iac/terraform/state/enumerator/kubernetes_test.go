@@ -0,0 +1,111 @@
+package enumerator
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/snyk/driftctl/pkg/iac/config"
+	"github.com/snyk/driftctl/pkg/iac/terraform/state/backend/options"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKubernetesEnumerator_Enumerate(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v1/namespaces/terraform/secrets" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		assert.Equal(t, "tfstate=true", r.URL.Query().Get("labelSelector"))
+		if r.URL.Query().Get("continue") == "" {
+			_, _ = w.Write([]byte(`{"metadata": {"continue": "next"}, "items": [
+				{"metadata": {"name": "tfstate-default-network"}},
+				{"metadata": {"name": "tfstate-prod-network"}}
+			]}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"metadata": {}, "items": [
+			{"metadata": {"name": "tfstate-default-app"}}
+		]}`))
+	}))
+	defer server.Close()
+
+	configPath := filepath.Join(t.TempDir(), "config")
+	kubeconfig := fmt.Sprintf(`current-context: test
+clusters:
+- name: test
+  cluster:
+    server: %s
+contexts:
+- name: test
+  context:
+    cluster: test
+    user: test
+users:
+- name: test
+  user:
+    token: TOKEN
+`, server.URL)
+	if err := os.WriteFile(configPath, []byte(kubeconfig), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name   string
+		config config.SupplierConfig
+		want   []string
+		err    string
+	}{
+		{
+			name: "every state of a namespace",
+			config: config.SupplierConfig{
+				Path: "terraform/*",
+			},
+			want: []string{
+				"terraform/tfstate-default-network",
+				"terraform/tfstate-prod-network",
+				"terraform/tfstate-default-app",
+			},
+		},
+		{
+			name: "every workspace of a state",
+			config: config.SupplierConfig{
+				Path: "terraform/tfstate-*-network",
+			},
+			want: []string{
+				"terraform/tfstate-default-network",
+				"terraform/tfstate-prod-network",
+			},
+		},
+		{
+			name: "unknown namespace",
+			config: config.SupplierConfig{
+				Path: "unknown/*",
+			},
+			err: "unable to list secrets from kubernetes namespace 'unknown': error requesting kubernetes API: status code: 404",
+		},
+		{
+			name: "invalid path",
+			config: config.SupplierConfig{
+				Path: "tfstate-*",
+			},
+			err: "Unable to parse kubernetes path: tfstate-*. Must be NAMESPACE/SECRET_NAME_PATTERN",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			enumerator := NewKubernetesEnumerator(tt.config, options.KubernetesBackendOptions{ConfigPath: configPath})
+			got, err := enumerator.Enumerate()
+			if err != nil {
+				assert.EqualError(t, err, tt.err)
+				return
+			} else {
+				assert.Empty(t, tt.err)
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,72 @@
+package enumerator
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/bmatcuk/doublestar/v4"
+	"github.com/pkg/errors"
+	"github.com/snyk/driftctl/pkg/iac/config"
+	"github.com/snyk/driftctl/pkg/iac/terraform/state/backend"
+	"github.com/snyk/driftctl/pkg/iac/terraform/state/backend/options"
+)
+
+type PgEnumerator struct {
+	config config.SupplierConfig
+	db     *sql.DB
+}
+
+func NewPgEnumerator(config config.SupplierConfig, opts options.PgBackendOptions) (*PgEnumerator, error) {
+	if opts.ConnStr == "" {
+		return nil, errors.New("PG_CONN_STR should be defined to be able to read state from pg backend")
+	}
+	db, err := sql.Open("postgres", opts.ConnStr)
+	if err != nil {
+		return nil, err
+	}
+	return &PgEnumerator{
+		config: config,
+		db:     db,
+	}, nil
+}
+
+func (s *PgEnumerator) Origin() string {
+	return s.config.String()
+}
+
+func (s *PgEnumerator) Enumerate() ([]string, error) {
+	defer s.db.Close()
+
+	schemaPattern := strings.Split(s.config.Path, "/")
+	if len(schemaPattern) != 2 || schemaPattern[0] == "" || schemaPattern[1] == "" {
+		return nil, errors.Errorf("Unable to parse pg path: %s. Must be SCHEMA/WORKSPACE_NAME_PATTERN", s.config.Path)
+	}
+	schema, pattern := schemaPattern[0], schemaPattern[1]
+
+	rows, err := s.db.Query(fmt.Sprintf("SELECT name FROM %s ORDER BY name", backend.PgStatesTable(schema)))
+	if err != nil {
+		return nil, errors.Errorf("unable to list states from pg schema '%s': %s", schema, err)
+	}
+	defer rows.Close()
+
+	files := make([]string, 0)
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			return nil, err
+		}
+		if match, _ := doublestar.Match(pattern, name); match {
+			files = append(files, strings.Join([]string{schema, name}, "/"))
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if len(files) == 0 {
+		return nil, errors.Errorf("no Terraform state was found in %s, exiting", s.config.Path)
+	}
+
+	return files, nil
+}
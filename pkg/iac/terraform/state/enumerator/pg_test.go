@@ -0,0 +1,88 @@
+package enumerator
+
+import (
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/snyk/driftctl/pkg/iac/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPgEnumerator_Enumerate(t *testing.T) {
+	tests := []struct {
+		name   string
+		config config.SupplierConfig
+		mocks  func(mock sqlmock.Sqlmock)
+		want   []string
+		err    string
+	}{
+		{
+			name: "every workspace of a schema",
+			config: config.SupplierConfig{
+				Path: "terraform_remote_state/*",
+			},
+			mocks: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`SELECT name FROM "terraform_remote_state".states ORDER BY name`).
+					WillReturnRows(sqlmock.NewRows([]string{"name"}).AddRow("default").AddRow("prod").AddRow("staging"))
+			},
+			want: []string{
+				"terraform_remote_state/default",
+				"terraform_remote_state/prod",
+				"terraform_remote_state/staging",
+			},
+		},
+		{
+			name: "workspaces matching a pattern",
+			config: config.SupplierConfig{
+				Path: "terraform_remote_state/prod*",
+			},
+			mocks: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`SELECT name FROM "terraform_remote_state".states ORDER BY name`).
+					WillReturnRows(sqlmock.NewRows([]string{"name"}).AddRow("default").AddRow("prod").AddRow("prod-eu"))
+			},
+			want: []string{
+				"terraform_remote_state/prod",
+				"terraform_remote_state/prod-eu",
+			},
+		},
+		{
+			name: "no workspace matching the pattern",
+			config: config.SupplierConfig{
+				Path: "terraform_remote_state/prod*",
+			},
+			mocks: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`SELECT name FROM "terraform_remote_state".states ORDER BY name`).
+					WillReturnRows(sqlmock.NewRows([]string{"name"}).AddRow("default"))
+			},
+			err: "no Terraform state was found in terraform_remote_state/prod*, exiting",
+		},
+		{
+			name: "invalid path",
+			config: config.SupplierConfig{
+				Path: "*",
+			},
+			mocks: func(mock sqlmock.Sqlmock) {},
+			err:   "Unable to parse pg path: *. Must be SCHEMA/WORKSPACE_NAME_PATTERN",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			if err != nil {
+				t.Fatal(err)
+			}
+			tt.mocks(mock)
+			mock.ExpectClose()
+
+			enumerator := &PgEnumerator{config: tt.config, db: db}
+			got, err := enumerator.Enumerate()
+			if err != nil {
+				assert.EqualError(t, err, tt.err)
+			} else {
+				assert.Empty(t, tt.err)
+				assert.Equal(t, tt.want, got)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
@@ -3,13 +3,14 @@ package enumerator
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/aws/aws-sdk-go/service/s3/s3iface"
 	"github.com/pkg/errors"
-	"github.com/snyk/driftctl/pkg/envproxy"
+	"github.com/snyk/driftctl/pkg/iac/terraform/state/backend"
+	"github.com/snyk/driftctl/pkg/iac/terraform/state/backend/options"
 
 	"github.com/bmatcuk/doublestar/v4"
 	"github.com/snyk/driftctl/pkg/iac/config"
@@ -18,18 +19,14 @@ import (
 type S3Enumerator struct {
 	config config.SupplierConfig
 	client s3iface.S3API
+	at     time.Time
 }
 
-func NewS3Enumerator(config config.SupplierConfig) *S3Enumerator {
-	envProxy := envproxy.NewEnvProxy("DCTL_S3_", "AWS_")
-	envProxy.Apply()
-	sess := session.Must(session.NewSessionWithOptions(session.Options{
-		SharedConfigState: session.SharedConfigEnable,
-	}))
-	envProxy.Restore()
+func NewS3Enumerator(config config.SupplierConfig, opts options.AWSBackendOptions, at time.Time) *S3Enumerator {
 	return &S3Enumerator{
 		config,
-		s3.New(sess),
+		s3.New(backend.NewS3Session(opts)),
+		at,
 	}
 }
 
@@ -51,7 +48,31 @@ func (s *S3Enumerator) Enumerate() ([]string, error) {
 	fullPattern := strings.Join([]string{prefix, pattern}, "/")
 	fullPattern = strings.Trim(fullPattern, "/")
 
+	list := s.listObjects
+	if !s.at.IsZero() {
+		list = s.listObjectsAt
+	}
+	keys, err := list(bucket, prefix)
+	if err != nil {
+		return nil, err
+	}
+
 	files := make([]string, 0)
+	for _, key := range keys {
+		if match, _ := doublestar.Match(fullPattern, key); match {
+			files = append(files, strings.Join([]string{bucket, key}, "/"))
+		}
+	}
+
+	if len(files) == 0 {
+		return files, fmt.Errorf("no Terraform state was found in %s, exiting", s.config.Path)
+	}
+
+	return files, nil
+}
+
+func (s *S3Enumerator) listObjects(bucket, prefix string) ([]string, error) {
+	keys := make([]string, 0)
 	input := &s3.ListObjectsV2Input{
 		Bucket: &bucket,
 		Prefix: &prefix,
@@ -59,21 +80,60 @@ func (s *S3Enumerator) Enumerate() ([]string, error) {
 	err := s.client.ListObjectsV2Pages(input, func(output *s3.ListObjectsV2Output, lastPage bool) bool {
 		for _, metadata := range output.Contents {
 			if aws.Int64Value(metadata.Size) > 0 {
-				key := *metadata.Key
-				if match, _ := doublestar.Match(fullPattern, key); match {
-					files = append(files, strings.Join([]string{bucket, key}, "/"))
-				}
+				keys = append(keys, *metadata.Key)
 			}
 		}
 		return !lastPage
 	})
+	return keys, err
+}
+
+// listObjectsAt lists the keys whose latest version at the requested time was a non empty object,
+// states deleted or emptied since then are still returned and read from that version by the backend
+func (s *S3Enumerator) listObjectsAt(bucket, prefix string) ([]string, error) {
+	type latestVersion struct {
+		lastModified time.Time
+		live         bool
+	}
+	versions := make(map[string]*latestVersion)
+	keys := make([]string, 0)
+	track := func(key *string, lastModified *time.Time, live bool) {
+		if lastModified == nil || lastModified.After(s.at) {
+			return
+		}
+		latest, exists := versions[*key]
+		if !exists {
+			keys = append(keys, *key)
+			versions[*key] = &latestVersion{*lastModified, live}
+			return
+		}
+		if lastModified.After(latest.lastModified) {
+			latest.lastModified, latest.live = *lastModified, live
+		}
+	}
+
+	input := &s3.ListObjectVersionsInput{
+		Bucket: &bucket,
+		Prefix: &prefix,
+	}
+	err := s.client.ListObjectVersionsPages(input, func(output *s3.ListObjectVersionsOutput, lastPage bool) bool {
+		for _, version := range output.Versions {
+			track(version.Key, version.LastModified, aws.Int64Value(version.Size) > 0)
+		}
+		for _, marker := range output.DeleteMarkers {
+			track(marker.Key, marker.LastModified, false)
+		}
+		return !lastPage
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	if len(files) == 0 {
-		return files, fmt.Errorf("no Terraform state was found in %s, exiting", s.config.Path)
+	liveKeys := make([]string, 0, len(keys))
+	for _, key := range keys {
+		if versions[key].live {
+			liveKeys = append(liveKeys, key)
+		}
 	}
-
-	return files, nil
+	return liveKeys, nil
 }
@@ -5,10 +5,12 @@ import (
 	"os"
 	"reflect"
 	"testing"
+	"time"
 
 	awssdk "github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/snyk/driftctl/pkg/iac/config"
+	"github.com/snyk/driftctl/pkg/iac/terraform/state/backend/options"
 	awstest "github.com/snyk/driftctl/test/aws"
 	"github.com/stretchr/testify/mock"
 )
@@ -17,6 +19,7 @@ func TestS3Enumerator_NewS3Enumerator(t *testing.T) {
 	tests := []struct {
 		name   string
 		config config.SupplierConfig
+		opts   options.AWSBackendOptions
 		setEnv map[string]string
 		want   string
 	}{
@@ -45,13 +48,27 @@ func TestS3Enumerator_NewS3Enumerator(t *testing.T) {
 			},
 			want: "eu-west-3",
 		},
+		{
+			name: "test with region option",
+			config: config.SupplierConfig{
+				Key:     "tfstate",
+				Backend: "s3",
+				Path:    "terraform.tfstate",
+			},
+			opts: options.AWSBackendOptions{Region: "ap-south-1"},
+			setEnv: map[string]string{
+				"AWS_DEFAULT_REGION":     "us-east-1",
+				"DCTL_S3_DEFAULT_REGION": "eu-west-3",
+			},
+			want: "ap-south-1",
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			for key, value := range tt.setEnv {
 				os.Setenv(key, value)
 			}
-			got := NewS3Enumerator(tt.config).client.(*s3.S3).Config.Region
+			got := NewS3Enumerator(tt.config, tt.opts, time.Time{}).client.(*s3.S3).Config.Region
 			if awssdk.StringValue(got) != tt.want {
 				t.Errorf("NewS3Enumerator().client.Config.Region got = %v, want %v", got, tt.want)
 			}
@@ -63,6 +80,7 @@ func TestS3Enumerator_Enumerate(t *testing.T) {
 	tests := []struct {
 		name   string
 		config config.SupplierConfig
+		at     time.Time
 		mocks  func(client *awstest.MockFakeS3)
 		want   []string
 		err    string
@@ -530,6 +548,73 @@ func TestS3Enumerator_Enumerate(t *testing.T) {
 			},
 			want: []string{"bucket-name/a/nested/prefix/terraform.tfstate/terraform.tfstate"},
 		},
+		{
+			name: "test results with glob at a point in time",
+			config: config.SupplierConfig{
+				Path: "bucket-name/a/nested/**/*.tfstate",
+			},
+			at: time.Date(2022, 4, 1, 12, 0, 0, 0, time.UTC),
+			mocks: func(client *awstest.MockFakeS3) {
+				input := &s3.ListObjectVersionsInput{
+					Bucket: awssdk.String("bucket-name"),
+					Prefix: awssdk.String("a/nested"),
+				}
+				client.On(
+					"ListObjectVersionsPages",
+					input,
+					mock.MatchedBy(func(callback func(res *s3.ListObjectVersionsOutput, lastPage bool) bool) bool {
+						callback(&s3.ListObjectVersionsOutput{
+							Versions: []*s3.ObjectVersion{
+								{
+									Key:          awssdk.String("a/nested/deleted-since.tfstate"),
+									Size:         awssdk.Int64(5),
+									LastModified: awssdk.Time(time.Date(2022, 3, 1, 12, 0, 0, 0, time.UTC)),
+								},
+								{
+									Key:          awssdk.String("a/nested/deleted-before.tfstate"),
+									Size:         awssdk.Int64(5),
+									LastModified: awssdk.Time(time.Date(2022, 2, 1, 12, 0, 0, 0, time.UTC)),
+								},
+								{
+									Key:          awssdk.String("a/nested/created-since.tfstate"),
+									Size:         awssdk.Int64(5),
+									LastModified: awssdk.Time(time.Date(2022, 5, 1, 12, 0, 0, 0, time.UTC)),
+								},
+							},
+						}, false)
+						callback(&s3.ListObjectVersionsOutput{
+							Versions: []*s3.ObjectVersion{
+								{
+									Key:          awssdk.String("a/nested/updated.tfstate"),
+									Size:         awssdk.Int64(0),
+									LastModified: awssdk.Time(time.Date(2022, 5, 1, 12, 0, 0, 0, time.UTC)),
+								},
+								{
+									Key:          awssdk.String("a/nested/updated.tfstate"),
+									Size:         awssdk.Int64(5),
+									LastModified: awssdk.Time(time.Date(2022, 3, 1, 12, 0, 0, 0, time.UTC)),
+								},
+							},
+							DeleteMarkers: []*s3.DeleteMarkerEntry{
+								{
+									Key:          awssdk.String("a/nested/deleted-since.tfstate"),
+									LastModified: awssdk.Time(time.Date(2022, 4, 2, 12, 0, 0, 0, time.UTC)),
+								},
+								{
+									Key:          awssdk.String("a/nested/deleted-before.tfstate"),
+									LastModified: awssdk.Time(time.Date(2022, 3, 1, 12, 0, 0, 0, time.UTC)),
+								},
+							},
+						}, true)
+						return true
+					}),
+				).Return(nil)
+			},
+			want: []string{
+				"bucket-name/a/nested/deleted-since.tfstate",
+				"bucket-name/a/nested/updated.tfstate",
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -538,6 +623,7 @@ func TestS3Enumerator_Enumerate(t *testing.T) {
 			s := &S3Enumerator{
 				config: tt.config,
 				client: &fakeS3,
+				at:     tt.at,
 			}
 			got, err := s.Enumerate()
 			if err != nil && err.Error() != tt.err {
@@ -1,6 +1,8 @@
 package enumerator
 
 import (
+	"strings"
+
 	"github.com/sirupsen/logrus"
 	"github.com/snyk/driftctl/pkg/iac/config"
 	"github.com/snyk/driftctl/pkg/iac/terraform/state/backend"
@@ -17,9 +19,42 @@ func GetEnumerator(config config.SupplierConfig, opts *backend.Options) (StateEn
 	case backend.BackendKeyFile:
 		return NewFileEnumerator(config), nil
 	case backend.BackendKeyS3:
-		return NewS3Enumerator(config), nil
+		return NewS3Enumerator(config, opts.AWSBackendOptions, opts.At), nil
 	case backend.BackendKeyAzureRM:
 		return NewAzureRMEnumerator(config, opts.AzureRMBackendOptions)
+	case backend.BackendKeyGS:
+		// A single object is read directly by the backend
+		if HasMeta(config.Path) {
+			return NewGSEnumerator(config, opts.At), nil
+		}
+	case backend.BackendKeyHTTP, backend.BackendKeyHTTPS:
+		if opts.HTTPManifest {
+			return NewHTTPEnumerator(config, opts), nil
+		}
+	case backend.BackendKeyConsul:
+		if HasMeta(config.Path) {
+			return NewConsulEnumerator(config, opts.ConsulBackendOptions), nil
+		}
+	case backend.BackendKeyPg:
+		if HasMeta(config.Path) {
+			return NewPgEnumerator(config, opts.PgBackendOptions)
+		}
+	case backend.BackendKeyKubernetes:
+		if HasMeta(config.Path) {
+			return NewKubernetesEnumerator(config, opts.KubernetesBackendOptions), nil
+		}
+	case backend.BackendKeyTFExec:
+		// A workspace is pulled directly by the backend
+		if !strings.Contains(config.Path, backend.TFExecWorkspaceSeparator) {
+			return NewTFExecEnumerator(config, opts.TFExecBackendOptions), nil
+		}
+	case backend.BackendKeyTerragrunt:
+		return NewTerragruntEnumerator(config), nil
+	case backend.BackendKeyTFCloud:
+		// A single workspace is read directly by the backend
+		if HasMeta(config.Path) {
+			return NewTFCloudEnumerator(config, opts), nil
+		}
 	}
 
 	logrus.WithFields(logrus.Fields{
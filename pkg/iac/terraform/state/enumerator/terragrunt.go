@@ -0,0 +1,444 @@
+package enumerator
+
+import (
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/sts"
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclparse"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/hashicorp/terraform/lang"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/snyk/driftctl/pkg/iac/config"
+	"github.com/snyk/driftctl/pkg/iac/terraform/state/backend"
+	"github.com/zclconf/go-cty/cty"
+	"github.com/zclconf/go-cty/cty/function"
+)
+
+const terragruntConfigFile = "terragrunt.hcl"
+
+// Schemas only hold the attributes needed to find states, other ones like generate or expose are ignored
+var terragruntIncludeSchema = &hcl.BodySchema{
+	Attributes: []hcl.AttributeSchema{{Name: "path", Required: true}},
+}
+
+var terragruntRemoteStateSchema = &hcl.BodySchema{
+	Attributes: []hcl.AttributeSchema{
+		{Name: "backend", Required: true},
+		{Name: "config", Required: true},
+	},
+}
+
+// TerragruntEnumerator finds the states configured by the remote_state blocks of terragrunt configurations
+type TerragruntEnumerator struct {
+	config config.SupplierConfig
+	parser *hclparse.Parser
+	// awsAccountId resolves get_aws_account_id() calls
+	awsAccountId func() (string, error)
+}
+
+func NewTerragruntEnumerator(config config.SupplierConfig) *TerragruntEnumerator {
+	return &TerragruntEnumerator{
+		config:       config,
+		parser:       hclparse.NewParser(),
+		awsAccountId: callerAccountId,
+	}
+}
+
+func callerAccountId() (string, error) {
+	sess := session.Must(session.NewSessionWithOptions(session.Options{
+		SharedConfigState: session.SharedConfigEnable,
+	}))
+	identity, err := sts.New(sess).GetCallerIdentity(&sts.GetCallerIdentityInput{})
+	if err != nil {
+		return "", err
+	}
+	return *identity.Account, nil
+}
+
+func (s *TerragruntEnumerator) Origin() string {
+	return s.config.String()
+}
+
+func (s *TerragruntEnumerator) Enumerate() ([]string, error) {
+	files, err := s.findConfigs()
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make([]string, 0, len(files))
+	included := make(map[string]bool)
+	stateByFile := make(map[string]string)
+	var evaluationErr error
+	for _, file := range files {
+		includes, key, err := s.evaluate(file)
+		for _, include := range includes {
+			included[include] = true
+		}
+		// A configuration using an unsupported terragrunt feature should not hide the states of the other ones
+		if err != nil {
+			evaluationErr = errors.Errorf("unable to evaluate %s: %s", file, err)
+			logrus.WithFields(logrus.Fields{
+				"file":  file,
+				"error": err,
+			}).Warn("Unable to evaluate terragrunt configuration, its state will be ignored")
+			continue
+		}
+		if key != "" {
+			stateByFile[file] = key
+		}
+	}
+
+	for _, file := range files {
+		key, exists := stateByFile[file]
+		// Configurations included by others only hold the shared settings of the modules
+		if !exists || included[file] {
+			continue
+		}
+		keys = append(keys, key)
+	}
+
+	if len(keys) == 0 {
+		if evaluationErr != nil {
+			return nil, evaluationErr
+		}
+		return nil, errors.Errorf("no terragrunt remote state was found in %s, exiting", s.config.Path)
+	}
+
+	sort.Strings(keys)
+	return keys, nil
+}
+
+func (s *TerragruntEnumerator) findConfigs() ([]string, error) {
+	root, err := filepath.Abs(s.config.Path)
+	if err != nil {
+		return nil, err
+	}
+	info, err := os.Stat(root)
+	if err != nil {
+		return nil, err
+	}
+	if !info.IsDir() {
+		return []string{root}, nil
+	}
+
+	files := make([]string, 0)
+	err = filepath.Walk(root, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() && (info.Name() == ".terragrunt-cache" || info.Name() == ".terraform") {
+			return filepath.SkipDir
+		}
+		if !info.IsDir() && info.Name() == terragruntConfigFile {
+			files = append(files, path)
+		}
+		return nil
+	})
+	return files, err
+}
+
+func (s *TerragruntEnumerator) body(file string) (*hclsyntax.Body, error) {
+	f, diags := s.parser.ParseHCLFile(file)
+	if diags.HasErrors() {
+		return nil, diags
+	}
+	return f.Body.(*hclsyntax.Body), nil
+}
+
+// blocksOfType returns blocks whatever their labels, include blocks may be named since terragrunt 0.32
+func blocksOfType(body *hclsyntax.Body, blockType string) []*hclsyntax.Block {
+	blocks := make([]*hclsyntax.Block, 0)
+	for _, block := range body.Blocks {
+		if block.Type == blockType {
+			blocks = append(blocks, block)
+		}
+	}
+	return blocks
+}
+
+// evaluate returns the configurations included by a file and the state configured for it, if any
+func (s *TerragruntEnumerator) evaluate(file string) ([]string, string, error) {
+	body, err := s.body(file)
+	if err != nil {
+		return nil, "", err
+	}
+
+	ctx := s.evalContext(file, "")
+	includes := make([]string, 0)
+	for _, block := range blocksOfType(body, "include") {
+		include, _, diags := block.Body.PartialContent(terragruntIncludeSchema)
+		if diags.HasErrors() {
+			return includes, "", diags
+		}
+		var path string
+		if diags := decodeString(include.Attributes["path"].Expr, ctx, &path); diags.HasErrors() {
+			return includes, "", diags
+		}
+		if !filepath.IsAbs(path) {
+			path = filepath.Join(filepath.Dir(file), path)
+		}
+		includes = append(includes, filepath.Clean(path))
+	}
+
+	// A remote_state block in the file itself takes precedence over the included ones
+	if blocks := blocksOfType(body, "remote_state"); len(blocks) > 0 {
+		key, err := s.stateKey(file, body, blocks[0], ctx)
+		return includes, key, err
+	}
+
+	for _, include := range includes {
+		includeBody, err := s.body(include)
+		if err != nil {
+			return includes, "", err
+		}
+		blocks := blocksOfType(includeBody, "remote_state")
+		if len(blocks) == 0 {
+			continue
+		}
+		key, err := s.stateKey(file, includeBody, blocks[0], s.evalContext(file, include))
+		return includes, key, err
+	}
+
+	logrus.WithFields(logrus.Fields{
+		"file": file,
+	}).Debug("No remote_state block found in terragrunt configuration")
+	return includes, "", nil
+}
+
+func (s *TerragruntEnumerator) stateKey(file string, body *hclsyntax.Body, block *hclsyntax.Block, ctx *hcl.EvalContext) (string, error) {
+	remoteState, _, diags := block.Body.PartialContent(terragruntRemoteStateSchema)
+	if diags.HasErrors() {
+		return "", diags
+	}
+	if err := evalLocals(body, ctx, remoteState.Attributes["backend"].Expr, remoteState.Attributes["config"].Expr); err != nil {
+		return "", err
+	}
+	var backendType string
+	if diags := decodeString(remoteState.Attributes["backend"].Expr, ctx, &backendType); diags.HasErrors() {
+		return "", diags
+	}
+	backendConfig, diags := remoteState.Attributes["config"].Expr.Value(ctx)
+	if diags.HasErrors() {
+		return "", diags
+	}
+	attrs := make(map[string]string)
+	if backendConfig.CanIterateElements() {
+		for it := backendConfig.ElementIterator(); it.Next(); {
+			k, v := it.Element()
+			if v.Type() == cty.String && v.IsKnown() && !v.IsNull() {
+				attrs[k.AsString()] = v.AsString()
+			}
+		}
+	}
+
+	switch backendType {
+	case "s3":
+		if attrs["role_arn"] != "" {
+			logrus.WithFields(logrus.Fields{
+				"file":     file,
+				"role_arn": attrs["role_arn"],
+			}).Warn("Role of terragrunt remote state is not assumed, the state is read with the current credentials")
+		}
+		key := fmt.Sprintf("%s://%s/%s", backend.BackendKeyS3, attrs["bucket"], attrs["key"])
+		return withSourceOptions(key, map[string]string{
+			"region":  attrs["region"],
+			"profile": attrs["profile"],
+		}), nil
+	case "gcs":
+		// The gcs backend stores the state of each workspace under the prefix
+		path := []string{attrs["bucket"]}
+		if prefix := strings.Trim(attrs["prefix"], "/"); prefix != "" {
+			path = append(path, prefix)
+		}
+		return fmt.Sprintf("%s://%s", backend.BackendKeyGS, strings.Join(append(path, "default.tfstate"), "/")), nil
+	case "azurerm":
+		key := fmt.Sprintf("%s://%s/%s", backend.BackendKeyAzureRM, attrs["container_name"], attrs["key"])
+		return withSourceOptions(key, map[string]string{
+			"azurerm-storage-account": attrs["storage_account_name"],
+		}), nil
+	case "local":
+		path := attrs["path"]
+		if path == "" {
+			path = "terraform.tfstate"
+		}
+		if !filepath.IsAbs(path) {
+			path = filepath.Join(filepath.Dir(file), path)
+		}
+		return fmt.Sprintf("%s://%s", backend.BackendKeyFile, path), nil
+	}
+	return "", errors.Errorf("unsupported remote_state backend %s", backendType)
+}
+
+// withSourceOptions adds the backend settings of a remote state to its key, so that states living in different
+// accounts or regions are each read with their own settings
+func withSourceOptions(key string, options map[string]string) string {
+	query := url.Values{}
+	for name, value := range options {
+		if value != "" {
+			query.Set(name, value)
+		}
+	}
+	if len(query) == 0 {
+		return key
+	}
+	return key + "?" + query.Encode()
+}
+
+func decodeString(expr hcl.Expression, ctx *hcl.EvalContext, out *string) hcl.Diagnostics {
+	value, diags := expr.Value(ctx)
+	if diags.HasErrors() {
+		return diags
+	}
+	if value.Type() != cty.String || value.IsNull() || !value.IsKnown() {
+		return hcl.Diagnostics{{
+			Severity: hcl.DiagError,
+			Summary:  "Invalid value",
+			Detail:   "A string is required.",
+			Subject:  expr.Range().Ptr(),
+		}}
+	}
+	*out = value.AsString()
+	return nil
+}
+
+// evalLocals adds the locals used by the given expressions to the context, other locals may call terragrunt
+// functions we do not support and are left out. Locals referencing each other are evaluated until none of
+// them can be resolved anymore
+func evalLocals(body *hclsyntax.Body, ctx *hcl.EvalContext, exprs ...hcl.Expression) error {
+	attrs := make(map[string]*hcl.Attribute)
+	for _, block := range blocksOfType(body, "locals") {
+		for name, attr := range block.Body.Attributes {
+			attrs[name] = attr.AsHCLAttribute()
+		}
+	}
+
+	pending := make(map[string]*hcl.Attribute)
+	var addReferences func(expr hcl.Expression)
+	addReferences = func(expr hcl.Expression) {
+		for _, traversal := range expr.Variables() {
+			if traversal.RootName() != "local" || len(traversal) < 2 {
+				continue
+			}
+			attr, isAttr := traversal[1].(hcl.TraverseAttr)
+			if !isAttr {
+				continue
+			}
+			local, exists := attrs[attr.Name]
+			if _, isPending := pending[attr.Name]; !exists || isPending {
+				continue
+			}
+			pending[attr.Name] = local
+			addReferences(local.Expr)
+		}
+	}
+	for _, expr := range exprs {
+		addReferences(expr)
+	}
+
+	locals := make(map[string]cty.Value)
+	for len(pending) > 0 {
+		var lastDiags hcl.Diagnostics
+		resolved := false
+		for name, attr := range pending {
+			ctx.Variables["local"] = cty.ObjectVal(locals)
+			value, diags := attr.Expr.Value(ctx)
+			if diags.HasErrors() {
+				lastDiags = diags
+				continue
+			}
+			locals[name] = value
+			delete(pending, name)
+			resolved = true
+		}
+		if !resolved {
+			return lastDiags
+		}
+	}
+	ctx.Variables["local"] = cty.ObjectVal(locals)
+	return nil
+}
+
+// evalContext exposes terraform functions and the terragrunt ones used to configure remote states
+func (s *TerragruntEnumerator) evalContext(file, include string) *hcl.EvalContext {
+	dir := filepath.Dir(file)
+	includeDir := dir
+	if include != "" {
+		includeDir = filepath.Dir(include)
+	}
+
+	stringFunc := func(impl func() (string, error)) function.Function {
+		return function.New(&function.Spec{
+			Type: function.StaticReturnType(cty.String),
+			Impl: func(args []cty.Value, retType cty.Type) (cty.Value, error) {
+				value, err := impl()
+				if err != nil {
+					return cty.NilVal, err
+				}
+				return cty.StringVal(value), nil
+			},
+		})
+	}
+	relativePath := func(from, to string) func() (string, error) {
+		return func() (string, error) {
+			rel, err := filepath.Rel(from, to)
+			return filepath.ToSlash(rel), err
+		}
+	}
+
+	functions := (&lang.Scope{BaseDir: dir}).Functions()
+	functions["get_terragrunt_dir"] = stringFunc(func() (string, error) { return dir, nil })
+	functions["get_parent_terragrunt_dir"] = stringFunc(func() (string, error) { return includeDir, nil })
+	functions["path_relative_to_include"] = stringFunc(relativePath(includeDir, dir))
+	functions["path_relative_from_include"] = stringFunc(relativePath(dir, includeDir))
+	functions["get_aws_account_id"] = stringFunc(s.awsAccountId)
+	functions["get_env"] = function.New(&function.Spec{
+		Params:   []function.Parameter{{Name: "name", Type: cty.String}},
+		VarParam: &function.Parameter{Name: "default", Type: cty.String},
+		Type:     function.StaticReturnType(cty.String),
+		Impl: func(args []cty.Value, retType cty.Type) (cty.Value, error) {
+			if value, exists := os.LookupEnv(args[0].AsString()); exists {
+				return cty.StringVal(value), nil
+			}
+			if len(args) > 1 {
+				return args[1], nil
+			}
+			return cty.StringVal(""), nil
+		},
+	})
+	functions["find_in_parent_folders"] = function.New(&function.Spec{
+		VarParam: &function.Parameter{Name: "args", Type: cty.String},
+		Type:     function.StaticReturnType(cty.String),
+		Impl: func(args []cty.Value, retType cty.Type) (cty.Value, error) {
+			name := terragruntConfigFile
+			if len(args) > 0 {
+				name = args[0].AsString()
+			}
+			for current := filepath.Dir(dir); ; current = filepath.Dir(current) {
+				candidate := filepath.Join(current, name)
+				if _, err := os.Stat(candidate); err == nil {
+					return cty.StringVal(candidate), nil
+				}
+				if current == filepath.Dir(current) {
+					break
+				}
+			}
+			if len(args) > 1 {
+				return args[1], nil
+			}
+			return cty.NilVal, errors.Errorf("could not find %s in any of the parent folders of %s", name, dir)
+		},
+	})
+
+	return &hcl.EvalContext{
+		Variables: map[string]cty.Value{},
+		Functions: functions,
+	}
+}
@@ -0,0 +1,105 @@
+package enumerator
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/snyk/driftctl/pkg/iac/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTerragruntEnumerator_Enumerate(t *testing.T) {
+	live, err := filepath.Abs("testdata/terragrunt/live")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name         string
+		path         string
+		env          map[string]string
+		awsAccountId func() (string, error)
+		want         []string
+		err          string
+	}{
+		{
+			name: "test states of a live directory",
+			path: "testdata/terragrunt/live",
+			env:  map[string]string{"TG_TEST_STATE_NAME": "dev"},
+			awsAccountId: func() (string, error) {
+				return "123456789012", nil
+			},
+			want: []string{
+				"://" + filepath.Join(live, "local", "dev.tfstate"),
+				"azurerm://tfstate/azure/terraform.tfstate?azurerm-storage-account=driftctltfstate",
+				"gs://driftctl-tfstate/gcp/gcp/default.tfstate",
+				"s3://tfstate-123456789012/app/api/terraform.tfstate?region=eu-west-3",
+				"s3://tfstate-123456789012/app/web/terraform.tfstate?region=eu-west-3",
+				"s3://tfstate-123456789012/vpc/terraform.tfstate?region=eu-west-3",
+				"s3://tfstate-prod/prod/terraform.tfstate?profile=prod&region=us-east-1",
+			},
+		},
+		{
+			name: "test state of a single configuration",
+			path: "testdata/terragrunt/live/gcp/terragrunt.hcl",
+			want: []string{
+				"gs://driftctl-tfstate/gcp/gcp/default.tfstate",
+			},
+		},
+		{
+			name: "test failing function",
+			path: "testdata/terragrunt/live/vpc",
+			awsAccountId: func() (string, error) {
+				return "", errors.New("no valid credential sources found")
+			},
+			err: `Call to function "get_aws_account_id" failed: no valid credential sources found.`,
+		},
+		{
+			name: "test unsupported function",
+			path: "testdata/terragrunt/live/broken",
+			err:  `There is no function named "run_cmd".`,
+		},
+		{
+			name: "test unsupported backend",
+			path: "testdata/terragrunt/unsupported",
+			err:  "unsupported remote_state backend etcdv3",
+		},
+		{
+			name: "test invalid configuration",
+			path: "testdata/terragrunt/invalid",
+			err:  "Unsupported attribute",
+		},
+		{
+			name: "test without remote state",
+			path: "testdata/terragrunt/empty",
+			err:  "no terragrunt remote state was found in testdata/terragrunt/empty, exiting",
+		},
+		{
+			name: "test missing directory",
+			path: "testdata/terragrunt/missing",
+			err:  "no such file or directory",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for k, v := range tt.env {
+				t.Setenv(k, v)
+			}
+			s := NewTerragruntEnumerator(config.SupplierConfig{Key: "tfstate", Backend: "terragrunt", Path: tt.path})
+			if tt.awsAccountId != nil {
+				s.awsAccountId = tt.awsAccountId
+			}
+			assert.Equal(t, "tfstate+terragrunt://"+tt.path, s.Origin())
+
+			got, err := s.Enumerate()
+			if tt.err != "" {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,9 @@
+remote_state {
+  backend = "azurerm"
+  config = {
+    resource_group_name  = "driftctl"
+    storage_account_name = "driftctltfstate"
+    container_name       = "tfstate"
+    key                  = "azure/terraform.tfstate"
+  }
+}
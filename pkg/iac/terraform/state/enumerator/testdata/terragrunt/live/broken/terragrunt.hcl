@@ -0,0 +1,7 @@
+remote_state {
+  backend = "s3"
+  config = {
+    bucket = run_cmd("./bucket.sh")
+    key    = "broken/terraform.tfstate"
+  }
+}
@@ -0,0 +1,12 @@
+locals {
+  project = "driftctl"
+  bucket  = "${local.project}-tfstate"
+}
+
+remote_state {
+  backend = "gcs"
+  config = {
+    bucket = local.bucket
+    prefix = "gcp/${basename(get_terragrunt_dir())}"
+  }
+}
@@ -0,0 +1,6 @@
+remote_state {
+  backend = "local"
+  config = {
+    path = "${get_env("TG_TEST_STATE_NAME", "local")}.tfstate"
+  }
+}
@@ -0,0 +1,10 @@
+remote_state {
+  backend = "s3"
+  config = {
+    bucket   = "tfstate-prod"
+    key      = "prod/terraform.tfstate"
+    region   = "us-east-1"
+    profile  = "prod"
+    role_arn = "arn:aws:iam::210987654321:role/terraform"
+  }
+}
@@ -0,0 +1,20 @@
+locals {
+  region = "eu-west-3"
+  # Only the locals used by remote_state are evaluated
+  common = read_terragrunt_config(find_in_parent_folders("common.hcl"))
+}
+
+remote_state {
+  backend = "s3"
+  generate = {
+    path      = "backend.tf"
+    if_exists = "overwrite_terragrunt"
+  }
+  disable_init                    = false
+  disable_dependency_optimization = true
+  config = {
+    bucket = "tfstate-${get_aws_account_id()}"
+    key    = "${path_relative_to_include()}/terraform.tfstate"
+    region = local.region
+  }
+}
@@ -0,0 +1,7 @@
+include {
+  path = find_in_parent_folders()
+}
+
+terraform {
+  source = "git::git@github.com:acme/infrastructure-modules.git//vpc?ref=v0.0.1"
+}
@@ -0,0 +1,6 @@
+remote_state {
+  backend = "etcdv3"
+  config = {
+    endpoints = ["etcd:2379"]
+  }
+}
@@ -0,0 +1,89 @@
+package enumerator
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/bmatcuk/doublestar/v4"
+	tfe "github.com/hashicorp/go-tfe"
+	"github.com/pkg/errors"
+	"github.com/snyk/driftctl/pkg/iac/config"
+	"github.com/snyk/driftctl/pkg/iac/terraform/state/backend"
+)
+
+type TFCloudEnumerator struct {
+	config     config.SupplierConfig
+	opts       *backend.Options
+	httpClient *http.Client
+}
+
+func NewTFCloudEnumerator(config config.SupplierConfig, opts *backend.Options) *TFCloudEnumerator {
+	return &TFCloudEnumerator{
+		config:     config,
+		opts:       opts,
+		httpClient: &http.Client{},
+	}
+}
+
+func (s *TFCloudEnumerator) Origin() string {
+	return s.config.String()
+}
+
+func (s *TFCloudEnumerator) Enumerate() ([]string, error) {
+	orgPattern := strings.Split(s.config.Path, "/")
+	if len(orgPattern) != 2 || orgPattern[0] == "" || orgPattern[1] == "" {
+		return nil, errors.Errorf("Unable to parse terraform cloud workspace pattern: %s. Must be ORGANIZATION/WORKSPACE_NAME_PATTERN", s.config.Path)
+	}
+	organization, pattern := orgPattern[0], orgPattern[1]
+
+	token, err := backend.GetTFCloudToken(s.opts)
+	if err != nil {
+		return nil, err
+	}
+	client, err := tfe.NewClient(&tfe.Config{
+		Token:      token,
+		Address:    s.opts.TFCloudEndpoint,
+		HTTPClient: s.httpClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	options := tfe.WorkspaceListOptions{}
+	// The part of the pattern before any glob char is used to search workspaces by name
+	search := pattern
+	if i := strings.IndexAny(pattern, `?*[]`); i >= 0 {
+		search = pattern[:i]
+	}
+	if search != "" {
+		options.Search = &search
+	}
+	if len(s.opts.TFCloudWorkspaceTags) > 0 {
+		tags := strings.Join(s.opts.TFCloudWorkspaceTags, ",")
+		options.Tags = &tags
+	}
+
+	workspaces := make([]string, 0)
+	for {
+		list, err := client.Workspaces.List(context.Background(), organization, options)
+		if err != nil {
+			return nil, errors.Errorf("unable to list terraform cloud workspaces: %s", err.Error())
+		}
+		for _, workspace := range list.Items {
+			if match, _ := doublestar.Match(pattern, workspace.Name); match {
+				workspaces = append(workspaces, strings.Join([]string{organization, workspace.Name}, "/"))
+			}
+		}
+		if list.Pagination == nil || list.NextPage == 0 {
+			break
+		}
+		options.PageNumber = list.NextPage
+	}
+
+	if len(workspaces) == 0 {
+		return nil, errors.Errorf("no terraform cloud workspace was found for %s, exiting", s.config.Path)
+	}
+
+	return workspaces, nil
+}
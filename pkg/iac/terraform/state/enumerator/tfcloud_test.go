@@ -0,0 +1,156 @@
+package enumerator
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/snyk/driftctl/pkg/iac/config"
+	"github.com/snyk/driftctl/pkg/iac/terraform/state/backend"
+	"github.com/stretchr/testify/assert"
+)
+
+const tfcloudTestEndpoint = "https://app.terraform.io/api/v2"
+
+func tfcloudWorkspacesResponse(names []string, nextPage int) string {
+	data := make([]string, 0, len(names))
+	for i, name := range names {
+		data = append(data, fmt.Sprintf(`{"id":"ws-%d","type":"workspaces","attributes":{"name":"%s"}}`, i, name))
+	}
+	next := "null"
+	if nextPage != 0 {
+		next = fmt.Sprintf("%d", nextPage)
+	}
+	return fmt.Sprintf(
+		`{"data":[%s],"meta":{"pagination":{"current-page":1,"next-page":%s,"prev-page":null,"total-pages":2,"total-count":%d}}}`,
+		strings.Join(data, ","),
+		next,
+		len(names),
+	)
+}
+
+func TestTFCloudEnumerator_Enumerate(t *testing.T) {
+	tests := []struct {
+		name   string
+		config config.SupplierConfig
+		tags   []string
+		mocks  func(transport *httpmock.MockTransport)
+		want   []string
+		err    string
+	}{
+		{
+			name: "workspaces matching a glob pattern",
+			config: config.SupplierConfig{
+				Path: "my-org/prod-*",
+			},
+			mocks: func(transport *httpmock.MockTransport) {
+				transport.RegisterResponderWithQuery(
+					"GET",
+					tfcloudTestEndpoint+"/organizations/my-org/workspaces",
+					map[string]string{"search[name]": "prod-"},
+					httpmock.NewStringResponder(200, tfcloudWorkspacesResponse([]string{"prod-network", "prod-app", "preprod-app"}, 0)),
+				)
+			},
+			want: []string{"my-org/prod-network", "my-org/prod-app"},
+		},
+		{
+			name: "workspaces filtered by tags",
+			config: config.SupplierConfig{
+				Path: "my-org/*",
+			},
+			tags: []string{"team:infra", "prod"},
+			mocks: func(transport *httpmock.MockTransport) {
+				transport.RegisterResponderWithQuery(
+					"GET",
+					tfcloudTestEndpoint+"/organizations/my-org/workspaces",
+					map[string]string{"search[tags]": "team:infra,prod"},
+					httpmock.NewStringResponder(200, tfcloudWorkspacesResponse([]string{"network", "app"}, 0)),
+				)
+			},
+			want: []string{"my-org/network", "my-org/app"},
+		},
+		{
+			name: "workspaces listed across multiple pages",
+			config: config.SupplierConfig{
+				Path: "my-org/*",
+			},
+			mocks: func(transport *httpmock.MockTransport) {
+				transport.RegisterResponderWithQuery(
+					"GET",
+					tfcloudTestEndpoint+"/organizations/my-org/workspaces",
+					"",
+					httpmock.NewStringResponder(200, tfcloudWorkspacesResponse([]string{"network", "app"}, 2)),
+				)
+				transport.RegisterResponderWithQuery(
+					"GET",
+					tfcloudTestEndpoint+"/organizations/my-org/workspaces",
+					map[string]string{"page[number]": "2"},
+					httpmock.NewStringResponder(200, tfcloudWorkspacesResponse([]string{"database"}, 0)),
+				)
+			},
+			want: []string{"my-org/network", "my-org/app", "my-org/database"},
+		},
+		{
+			name: "no workspace matching the pattern",
+			config: config.SupplierConfig{
+				Path: "my-org/prod-*",
+			},
+			mocks: func(transport *httpmock.MockTransport) {
+				transport.RegisterResponderWithQuery(
+					"GET",
+					tfcloudTestEndpoint+"/organizations/my-org/workspaces",
+					map[string]string{"search[name]": "prod-"},
+					httpmock.NewStringResponder(200, tfcloudWorkspacesResponse([]string{}, 0)),
+				)
+			},
+			err: "no terraform cloud workspace was found for my-org/prod-*, exiting",
+		},
+		{
+			name: "unable to list workspaces",
+			config: config.SupplierConfig{
+				Path: "unknown-org/*",
+			},
+			mocks: func(transport *httpmock.MockTransport) {
+				transport.RegisterResponder(
+					"GET",
+					tfcloudTestEndpoint+"/organizations/unknown-org/workspaces",
+					httpmock.NewStringResponder(404, `{"errors":[{"status":"404","title":"not found"}]}`),
+				)
+			},
+			err: "unable to list terraform cloud workspaces: resource not found",
+		},
+		{
+			name: "invalid workspace pattern",
+			config: config.SupplierConfig{
+				Path: "my-org/nested/*",
+			},
+			mocks: func(transport *httpmock.MockTransport) {},
+			err:   "Unable to parse terraform cloud workspace pattern: my-org/nested/*. Must be ORGANIZATION/WORKSPACE_NAME_PATTERN",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			enumerator := NewTFCloudEnumerator(tt.config, &backend.Options{
+				TFCloudToken:         "TOKEN",
+				TFCloudEndpoint:      tfcloudTestEndpoint,
+				TFCloudWorkspaceTags: tt.tags,
+			})
+
+			transport := httpmock.NewMockTransport()
+			transport.RegisterResponder("GET", tfcloudTestEndpoint+"/ping", httpmock.NewStringResponder(204, ""))
+			tt.mocks(transport)
+			enumerator.httpClient = &http.Client{Transport: transport}
+
+			got, err := enumerator.Enumerate()
+			if err != nil {
+				assert.EqualError(t, err, tt.err)
+				return
+			} else {
+				assert.Empty(t, tt.err)
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
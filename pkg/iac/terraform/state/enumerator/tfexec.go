@@ -0,0 +1,47 @@
+package enumerator
+
+import (
+	"context"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/snyk/driftctl/pkg/iac/config"
+	"github.com/snyk/driftctl/pkg/iac/terraform/state/backend"
+	"github.com/snyk/driftctl/pkg/iac/terraform/state/backend/options"
+)
+
+// TFExecEnumerator lists the workspaces of a terraform working directory
+type TFExecEnumerator struct {
+	config config.SupplierConfig
+	opts   options.TFExecBackendOptions
+}
+
+func NewTFExecEnumerator(config config.SupplierConfig, opts options.TFExecBackendOptions) *TFExecEnumerator {
+	return &TFExecEnumerator{
+		config: config,
+		opts:   opts,
+	}
+}
+
+func (s *TFExecEnumerator) Origin() string {
+	return s.config.String()
+}
+
+func (s *TFExecEnumerator) Enumerate() ([]string, error) {
+	tf, err := backend.NewTerraformExec(s.config.Path, s.opts)
+	if err != nil {
+		return nil, err
+	}
+
+	workspaces, _, err := tf.WorkspaceList(context.Background())
+	if err != nil {
+		return nil, errors.Errorf("unable to list workspaces of terraform working directory '%s': %s", s.config.Path, err)
+	}
+
+	keys := make([]string, 0, len(workspaces))
+	for _, workspace := range workspaces {
+		keys = append(keys, strings.Join([]string{s.config.Path, workspace}, backend.TFExecWorkspaceSeparator))
+	}
+
+	return keys, nil
+}
@@ -0,0 +1,47 @@
+package enumerator
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/snyk/driftctl/pkg/iac/config"
+	"github.com/snyk/driftctl/pkg/iac/terraform/state/backend/options"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTFExecEnumerator_Enumerate(t *testing.T) {
+	execPath, err := filepath.Abs("testdata/tfexec/terraform")
+	if err != nil {
+		t.Fatal(err)
+	}
+	workdir := t.TempDir()
+
+	s := NewTFExecEnumerator(
+		config.SupplierConfig{Key: "tfstate", Backend: "tfexec", Path: workdir},
+		options.TFExecBackendOptions{ExecPath: execPath},
+	)
+	assert.Equal(t, "tfstate+tfexec://"+workdir, s.Origin())
+
+	got, err := s.Enumerate()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{
+		workdir + "#default",
+		workdir + "#production",
+		workdir + "#staging",
+	}, got)
+}
+
+func TestTFExecEnumerator_EnumerateMissingWorkdir(t *testing.T) {
+	execPath, err := filepath.Abs("testdata/tfexec/terraform")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	s := NewTFExecEnumerator(
+		config.SupplierConfig{Key: "tfstate", Backend: "tfexec", Path: "testdata/missing"},
+		options.TFExecBackendOptions{ExecPath: execPath},
+	)
+
+	_, err = s.Enumerate()
+	assert.Error(t, err)
+}
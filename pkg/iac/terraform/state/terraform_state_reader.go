@@ -1,7 +1,9 @@
 package state
 
 import (
+	"encoding/json"
 	"fmt"
+	"io"
 	"strings"
 
 	"github.com/hashicorp/terraform/addrs"
@@ -19,6 +21,8 @@ import (
 
 	"github.com/snyk/driftctl/pkg/iac/config"
 	"github.com/snyk/driftctl/pkg/iac/terraform/state/backend"
+	"github.com/snyk/driftctl/pkg/iac/terraform/state/backend/options"
+	"github.com/snyk/driftctl/pkg/iac/terraform/state/encryption"
 	"github.com/snyk/driftctl/pkg/iac/terraform/state/enumerator"
 	"github.com/snyk/driftctl/pkg/resource"
 	"github.com/snyk/driftctl/pkg/terraform"
@@ -36,12 +40,14 @@ type TerraformStateReader struct {
 	config         config.SupplierConfig
 	backend        backend.Backend
 	enumerator     enumerator.StateEnumerator
+	decrypter      *encryption.Decrypter
 	deserializer   *resource.Deserializer
 	backendOptions *backend.Options
 	progress       output.Progress
 	filter         filter.Filter
 	alerter        *alerter.Alerter
 	sourceCount    uint
+	dataSources    []*resource.Resource
 }
 
 func (r *TerraformStateReader) initReader() error {
@@ -50,6 +56,16 @@ func (r *TerraformStateReader) initReader() error {
 		return err
 	}
 	r.enumerator = enumerator
+
+	decryptionOpts := options.DecryptionOptions{}
+	if r.backendOptions != nil {
+		decryptionOpts = r.backendOptions.DecryptionOptions
+	}
+	decrypter, err := encryption.NewDecrypter(decryptionOpts)
+	if err != nil {
+		return err
+	}
+	r.decrypter = decrypter
 	return nil
 }
 
@@ -78,11 +94,17 @@ func (r *TerraformStateReader) retrieve() (map[string][]decodedRes, error) {
 	}
 	r.backend = b
 
-	state, err := read(r.config.Path, r.backend)
+	file, err := read(r.config.Path, r.backend, r.decrypter)
 	defer r.backend.Close()
 	if err != nil {
 		return nil, err
 	}
+	state := file.State
+
+	var version string
+	if versioned, ok := r.backend.(backend.VersionedBackend); ok {
+		version = versioned.Version()
+	}
 
 	resMap := make(map[string][]decodedRes)
 	for moduleName, module := range state.Modules {
@@ -94,6 +116,13 @@ func (r *TerraformStateReader) retrieve() (map[string][]decodedRes, error) {
 			resName := stateRes.Addr.Resource.Name
 			resType := stateRes.Addr.Resource.Type
 
+			if stateRes.Addr.Resource.Mode == addrs.DataResourceMode {
+				if err := r.retrieveDataSource(stateRes, moduleName, version, file); err != nil {
+					return nil, err
+				}
+				continue
+			}
+
 			if !resource.IsResourceTypeSupported(resType) {
 				logrus.WithFields(logrus.Fields{
 					"name": resName,
@@ -126,8 +155,16 @@ func (r *TerraformStateReader) retrieve() (map[string][]decodedRes, error) {
 				}).Debug("Unsupported provider found in state")
 				continue
 			}
-			schema := provider.Schema()[stateRes.Addr.Resource.Type]
-			for _, instance := range stateRes.Instances {
+			schema, exists := provider.Schema()[stateRes.Addr.Resource.Type]
+			if !exists {
+				logrus.WithFields(logrus.Fields{
+					"name":    resName,
+					"type":    resType,
+					"version": provider.Version(),
+				}).Warn("Ignored resource from state since its type is unknown to this provider version")
+				continue
+			}
+			for key, instance := range stateRes.Instances {
 				decodedVal, err := instance.Current.Decode(schema.Block.ImpliedType())
 				if err != nil {
 					// Try to do a manual type conversion if we got a path error
@@ -155,7 +192,7 @@ func (r *TerraformStateReader) retrieve() (map[string][]decodedRes, error) {
 				}
 				_, exists := resMap[stateRes.Addr.Resource.Type]
 				val := decodedRes{
-					source: resource.NewTerraformStateSource(r.config.String(), moduleName, resName),
+					source: newSource(r.config.String(), moduleName, resName, key, version, file),
 					val:    decodedVal.Value,
 				}
 				if !exists {
@@ -170,6 +207,45 @@ func (r *TerraformStateReader) retrieve() (map[string][]decodedRes, error) {
 	return resMap, nil
 }
 
+func newSource(state, module, name string, key addrs.InstanceKey, version string, file *statefile.File) *resource.TerraformStateSource {
+	source := resource.NewTerraformStateSource(state, module, name)
+	source.Version = version
+	source.Lineage = file.Lineage
+	source.Serial = file.Serial
+	if key != addrs.NoKey {
+		source.Key = key.String()
+	}
+	return source
+}
+
+// retrieveDataSource keeps the attributes of data sources as they are in the state, they are only reported
+// so they are neither decoded against the provider schema nor restricted to the supported resource types
+func (r *TerraformStateReader) retrieveDataSource(stateRes *states.Resource, moduleName, version string, file *statefile.File) error {
+	for key, instance := range stateRes.Instances {
+		if instance.Current == nil || instance.Current.AttrsJSON == nil {
+			continue
+		}
+		attrs := resource.Attributes{}
+		if err := json.Unmarshal(instance.Current.AttrsJSON, &attrs); err != nil {
+			logrus.WithFields(logrus.Fields{
+				"name": stateRes.Addr.Resource.Name,
+				"type": stateRes.Addr.Resource.Type,
+			}).Error("Unable to decode data source from state")
+			return err
+		}
+		source := newSource(r.config.String(), moduleName, stateRes.Addr.Resource.Name, key, version, file)
+		source.Mode = resource.TerraformDataMode
+		id, _ := attrs["id"].(string)
+		r.dataSources = append(r.dataSources, &resource.Resource{
+			Id:     id,
+			Type:   stateRes.Addr.Resource.Type,
+			Attrs:  &attrs,
+			Source: source,
+		})
+	}
+	return nil
+}
+
 func (r *TerraformStateReader) convertInstance(instance *states.ResourceInstanceObjectSrc, ty cty.Type) (*states.ResourceInstanceObject, error) {
 	inputType, err := ctyjson.ImpliedType(instance.AttrsJSON)
 	if err != nil {
@@ -232,8 +308,28 @@ func (r *TerraformStateReader) SourceCount() uint {
 	return r.sourceCount
 }
 
+func (r *TerraformStateReader) DataSources() []*resource.Resource {
+	return r.dataSources
+}
+
 func (r *TerraformStateReader) retrieveForState(path string) ([]*resource.Resource, error) {
 	r.config.Path = path
+	// Terragrunt enumerates states living in the backends configured by its remote_state blocks,
+	// their settings are given as source options
+	if r.config.Backend == backend.BackendKeyTerragrunt {
+		if backendPath := strings.SplitN(path, "://", 2); len(backendPath) == 2 {
+			statePath, sourceOptions, err := backend.ParseSourceOptions(backendPath[0], backendPath[1])
+			if err != nil {
+				return nil, err
+			}
+			r.config.Backend, r.config.Path = backendPath[0], statePath
+			r.backendOptions = r.backendOptions.ForSource(sourceOptions)
+		}
+	}
+	// Terragrunt states may live in any backend, only the versioned ones can be read at a given time
+	if r.backendOptions != nil && !r.backendOptions.At.IsZero() && r.config.Backend != backend.BackendKeyS3 && r.config.Backend != backend.BackendKeyGS {
+		return nil, errors.Errorf("unable to read %s at a given time, only s3 and gs backends are versioned", r.config.String())
+	}
 	r.sourceCount += 1
 	logrus.WithFields(logrus.Fields{
 		"path":    r.config.Path,
@@ -263,7 +359,11 @@ func (r *TerraformStateReader) retrieveMultiplesStates() ([]*resource.Resource,
 	isSuccess := false
 	readingError := iac.NewStateReadingError()
 
+	config := r.config
+	backendOptions := r.backendOptions
 	for _, key := range keys {
+		r.config = config
+		r.backendOptions = backendOptions
 		resources, err := r.retrieveForState(key)
 		if err != nil {
 			readingError.Add(err)
@@ -282,8 +382,16 @@ func (r *TerraformStateReader) retrieveMultiplesStates() ([]*resource.Resource,
 	return results, nil
 }
 
-func read(path string, reader backend.Backend) (*states.State, error) {
-	state, err := readState(path, reader)
+func read(path string, reader backend.Backend, decrypter *encryption.Decrypter) (*statefile.File, error) {
+	var content io.Reader = reader
+	if decrypter != nil {
+		decrypted, err := decrypter.Decrypt(reader)
+		if err != nil {
+			return nil, err
+		}
+		content = decrypted
+	}
+	state, err := readState(path, content)
 	if err != nil {
 		if _, ok := reader.(*backend.HTTPBackend); ok && strings.Contains(err.Error(), "The state file could not be parsed as JSON") {
 			return nil, errors.Errorf("given url is not a valid state file")
@@ -293,7 +401,7 @@ func read(path string, reader backend.Backend) (*states.State, error) {
 	return state, nil
 }
 
-func readState(path string, reader backend.Backend) (*states.State, error) {
+func readState(path string, reader io.Reader) (*statefile.File, error) {
 	state, err := statefile.Read(reader)
 	if err != nil {
 		return nil, err
@@ -311,5 +419,5 @@ func readState(path string, reader backend.Backend) (*states.State, error) {
 		}
 	}
 
-	return state.State, nil
+	return state, nil
 }
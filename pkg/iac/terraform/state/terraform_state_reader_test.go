@@ -1,12 +1,17 @@
 package state
 
 import (
+	"bytes"
 	"encoding/json"
+	"io"
 	"os"
 	"path"
 	"strings"
 	"testing"
+	"time"
 
+	"filippo.io/age"
+	"filippo.io/age/armor"
 	"github.com/pkg/errors"
 	"github.com/snyk/driftctl/pkg/filter"
 	"github.com/snyk/driftctl/pkg/output"
@@ -21,6 +26,9 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	"github.com/snyk/driftctl/pkg/iac/config"
+	"github.com/snyk/driftctl/pkg/iac/terraform/state/backend"
+	"github.com/snyk/driftctl/pkg/iac/terraform/state/backend/options"
+	"github.com/snyk/driftctl/pkg/iac/terraform/state/encryption"
 	"github.com/snyk/driftctl/pkg/remote/aws"
 	"github.com/snyk/driftctl/pkg/remote/github"
 	"github.com/snyk/driftctl/pkg/resource"
@@ -40,6 +48,34 @@ func TestReadStateValid(t *testing.T) {
 	}
 }
 
+func TestReadEncryptedState(t *testing.T) {
+	identity, err := age.GenerateX25519Identity()
+	if err != nil {
+		t.Fatal(err)
+	}
+	content, err := os.ReadFile("testdata/v4/valid.tfstate")
+	if err != nil {
+		t.Fatal(err)
+	}
+	encrypted := &bytes.Buffer{}
+	armorWriter := armor.NewWriter(encrypted)
+	w, err := age.Encrypt(armorWriter, identity.Recipient())
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, _ = w.Write(content)
+	_ = w.Close()
+	_ = armorWriter.Close()
+
+	decrypter, err := encryption.NewDecrypter(options.DecryptionOptions{AgeKey: identity.String()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	state, err := read("terraform.tfstate", io.NopCloser(encrypted), decrypter)
+	assert.NoError(t, err)
+	assert.NotNil(t, state)
+}
+
 func TestReadStateInvalid(t *testing.T) {
 	reader, _ := os.Open("testdata/v4/invalid.tfstate")
 	state, err := readState("terraform.tfstate", reader)
@@ -79,16 +115,21 @@ func TestTerraformStateReader_Source(t *testing.T) {
 	for _, res := range got {
 		if res.ResourceType() == resourceaws.AwsS3BucketResourceType {
 			assert.Equal(t, &resource.TerraformStateSource{
-				State:  "tfstate://test/source/terraform.tfstate",
-				Module: "",
-				Name:   "bucket",
+				State:   "tfstate://test/source/terraform.tfstate",
+				Module:  "",
+				Name:    "bucket",
+				Lineage: "dcb149dc-5e8b-bb81-e690-3980485675f5",
+				Serial:  88,
 			}, res.Source)
 		}
 		if res.ResourceType() == resourceaws.AwsIamUserResourceType {
 			assert.Equal(t, &resource.TerraformStateSource{
-				State:  "tfstate://test/source/terraform.tfstate",
-				Module: "module.iam_iam-user",
-				Name:   "this_no_pgp",
+				State:   "tfstate://test/source/terraform.tfstate",
+				Module:  "module.iam_iam-user",
+				Name:    "this_no_pgp",
+				Lineage: "dcb149dc-5e8b-bb81-e690-3980485675f5",
+				Serial:  88,
+				Key:     "[0]",
 			}, res.Source)
 		}
 	}
@@ -195,6 +236,15 @@ func TestTerraformStateReader_AWS_Resources(t *testing.T) {
 		{name: "Launch configuration", dirName: "aws_launch_configuration", wantErr: false},
 		{name: "EBS encryption by default", dirName: "aws_ebs_encryption_by_default", wantErr: false},
 		{name: "LoadBalancer", dirName: "aws_lb", wantErr: false},
+		{name: "ECS cluster", dirName: "aws_ecs_cluster", wantErr: false},
+		{name: "ECS service", dirName: "aws_ecs_service", wantErr: false},
+		{name: "ECS task definition", dirName: "aws_ecs_task_definition", wantErr: false},
+		{name: "EKS cluster", dirName: "aws_eks_cluster", wantErr: false},
+		{name: "EKS node group", dirName: "aws_eks_node_group", wantErr: false},
+		// Unknown to the 3.19.0 provider, the addon is ignored instead of failing the whole state
+		{name: "EKS addon", dirName: "aws_eks_addon", wantErr: false},
+		{name: "SSM parameter", dirName: "aws_ssm_parameter", wantErr: false},
+		{name: "CloudWatch log group", dirName: "aws_cloudwatch_log_group", wantErr: false},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -628,3 +678,56 @@ func TestTerraformStateReader_WithIgnoredResource(t *testing.T) {
 	assert.Nil(t, err)
 	assert.Len(t, got, 0)
 }
+
+func TestTerraformStateReader_DataSources(t *testing.T) {
+	progress := &output.MockProgress{}
+	progress.On("Inc").Return().Times(1)
+
+	provider := mocks.NewMockedGoldenTFProvider("data_source", nil, false)
+	library := terraform.NewProviderLibrary()
+	library.AddProvider(terraform.AWS, provider)
+
+	statePath := path.Join(goldenfile.GoldenFilePath, "data_source", "terraform.tfstate")
+	r := &TerraformStateReader{
+		config: config.SupplierConfig{
+			Key:  TerraformStateReaderSupplier,
+			Path: statePath,
+		},
+		library:  library,
+		progress: progress,
+	}
+
+	got, err := r.Resources()
+	assert.Nil(t, err)
+	assert.Len(t, got, 0)
+
+	dataSources := r.DataSources()
+	assert.Len(t, dataSources, 1)
+	assert.Equal(t, "ami-0d3f551818b21ed81", dataSources[0].ResourceId())
+	assert.Equal(t, "aws_ami", dataSources[0].ResourceType())
+	assert.Equal(t, "x86_64", *dataSources[0].Attributes().GetString("architecture"))
+	assert.Equal(t, &resource.TerraformStateSource{
+		State:   "tfstate://" + statePath,
+		Name:    "ubuntu",
+		Lineage: "c1bb6946-ebdb-0cd0-b5e1-943feef31964",
+		Serial:  144,
+		Mode:    resource.TerraformDataMode,
+	}, dataSources[0].Source)
+	assert.Equal(t, "data.aws_ami.ubuntu", dataSources[0].SourceString())
+}
+
+func TestTerraformStateReader_TerragruntStateAt(t *testing.T) {
+	r := &TerraformStateReader{
+		config: config.SupplierConfig{
+			Key:     TerraformStateReaderSupplier,
+			Backend: backend.BackendKeyTerragrunt,
+			Path:    "terragrunt.hcl",
+		},
+		backendOptions: &backend.Options{At: time.Date(2021, 11, 23, 15, 4, 5, 0, time.UTC)},
+		progress:       &output.MockProgress{},
+	}
+
+	_, err := r.retrieveForState("azurerm://states/prod/terraform.tfstate")
+	assert.EqualError(t, err, "unable to read tfstate+azurerm://states/prod/terraform.tfstate at a given time, only s3 and gs backends are versioned")
+	assert.Equal(t, uint(0), r.SourceCount())
+}
@@ -0,0 +1,78 @@
+package middlewares
+
+import (
+	"github.com/sirupsen/logrus"
+	"github.com/snyk/driftctl/pkg/resource"
+	"github.com/snyk/driftctl/pkg/resource/aws"
+)
+
+// EKS creates a security group for each cluster, exposed by the cluster as vpc_config.cluster_security_group_id.
+// This security group and its rules are not managed by IaC but are owned by a managed cluster,
+// we remove them from remote resources unless they are managed by IaC.
+type AwsEksClusterSecurityGroup struct{}
+
+func NewAwsEksClusterSecurityGroup() AwsEksClusterSecurityGroup {
+	return AwsEksClusterSecurityGroup{}
+}
+
+func (m AwsEksClusterSecurityGroup) Execute(remoteResources, resourcesFromState *[]*resource.Resource) error {
+	clusterSecurityGroups := make(map[string]struct{})
+	for _, stateResource := range *resourcesFromState {
+		if stateResource.ResourceType() != aws.AwsEksClusterResourceType {
+			continue
+		}
+		for _, vpcConfig := range stateResource.Attributes().GetSlice("vpc_config") {
+			config, ok := vpcConfig.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			if id, ok := config["cluster_security_group_id"].(string); ok && id != "" {
+				clusterSecurityGroups[id] = struct{}{}
+			}
+		}
+	}
+
+	if len(clusterSecurityGroups) == 0 {
+		return nil
+	}
+
+	newRemoteResources := make([]*resource.Resource, 0, len(*remoteResources))
+	for _, remoteResource := range *remoteResources {
+		var securityGroupID string
+		switch remoteResource.ResourceType() {
+		case aws.AwsSecurityGroupResourceType:
+			securityGroupID = remoteResource.ResourceId()
+		case aws.AwsSecurityGroupRuleResourceType:
+			if id := remoteResource.Attributes().GetString("security_group_id"); id != nil {
+				securityGroupID = *id
+			}
+		}
+
+		if _, isClusterSecurityGroup := clusterSecurityGroups[securityGroupID]; !isClusterSecurityGroup {
+			newRemoteResources = append(newRemoteResources, remoteResource)
+			continue
+		}
+
+		existInState := false
+		for _, stateResource := range *resourcesFromState {
+			if remoteResource.Equal(stateResource) {
+				existInState = true
+				break
+			}
+		}
+
+		if existInState {
+			newRemoteResources = append(newRemoteResources, remoteResource)
+			continue
+		}
+
+		logrus.WithFields(logrus.Fields{
+			"id":   remoteResource.ResourceId(),
+			"type": remoteResource.ResourceType(),
+		}).Debug("Ignoring unmanaged security group created by EKS for a managed cluster")
+	}
+
+	*remoteResources = newRemoteResources
+
+	return nil
+}
@@ -0,0 +1,96 @@
+package middlewares
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/awsutil"
+	"github.com/r3labs/diff/v2"
+
+	"github.com/snyk/driftctl/pkg/resource"
+	"github.com/snyk/driftctl/pkg/resource/aws"
+)
+
+func TestAwsEksClusterSecurityGroup_Execute(t *testing.T) {
+	managedCluster := &resource.Resource{
+		Id:   "foo",
+		Type: aws.AwsEksClusterResourceType,
+		Attrs: &resource.Attributes{
+			"name": "foo",
+			"vpc_config": []interface{}{
+				map[string]interface{}{
+					"cluster_security_group_id": "sg-cluster",
+				},
+			},
+		},
+	}
+	clusterSecurityGroup := &resource.Resource{
+		Id:    "sg-cluster",
+		Type:  aws.AwsSecurityGroupResourceType,
+		Attrs: &resource.Attributes{},
+	}
+	clusterSecurityGroupRule := &resource.Resource{
+		Id:   "sgrule-1707973622",
+		Type: aws.AwsSecurityGroupRuleResourceType,
+		Attrs: &resource.Attributes{
+			"security_group_id": "sg-cluster",
+		},
+	}
+	otherSecurityGroup := &resource.Resource{
+		Id:    "sg-other",
+		Type:  aws.AwsSecurityGroupResourceType,
+		Attrs: &resource.Attributes{},
+	}
+	otherSecurityGroupRule := &resource.Resource{
+		Id:   "sgrule-3970541193",
+		Type: aws.AwsSecurityGroupRuleResourceType,
+		Attrs: &resource.Attributes{
+			"security_group_id": "sg-other",
+		},
+	}
+
+	tests := []struct {
+		name               string
+		remoteResources    []*resource.Resource
+		resourcesFromState []*resource.Resource
+		expected           []*resource.Resource
+	}{
+		{
+			"cluster security group of a managed cluster is ignored",
+			[]*resource.Resource{managedCluster, clusterSecurityGroup, clusterSecurityGroupRule, otherSecurityGroup, otherSecurityGroupRule},
+			[]*resource.Resource{managedCluster},
+			[]*resource.Resource{managedCluster, otherSecurityGroup, otherSecurityGroupRule},
+		},
+		{
+			"cluster security group managed by IaC is kept",
+			[]*resource.Resource{managedCluster, clusterSecurityGroup, clusterSecurityGroupRule},
+			[]*resource.Resource{managedCluster, clusterSecurityGroup},
+			[]*resource.Resource{managedCluster, clusterSecurityGroup},
+		},
+		{
+			"security groups are kept without managed cluster",
+			[]*resource.Resource{clusterSecurityGroup, clusterSecurityGroupRule, otherSecurityGroup},
+			[]*resource.Resource{},
+			[]*resource.Resource{clusterSecurityGroup, clusterSecurityGroupRule, otherSecurityGroup},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := NewAwsEksClusterSecurityGroup()
+			err := m.Execute(&tt.remoteResources, &tt.resourcesFromState)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			changelog, err := diff.Diff(tt.remoteResources, tt.expected)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(changelog) > 0 {
+				for _, change := range changelog {
+					t.Errorf("%s got = %v, want %v", strings.Join(change.Path, "."), awsutil.Prettify(change.From), awsutil.Prettify(change.To))
+				}
+			}
+		})
+	}
+}
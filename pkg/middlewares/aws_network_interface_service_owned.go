@@ -0,0 +1,74 @@
+package middlewares
+
+import (
+	"strings"
+
+	"github.com/sirupsen/logrus"
+	"github.com/snyk/driftctl/pkg/resource"
+	"github.com/snyk/driftctl/pkg/resource/aws"
+)
+
+// Network interfaces created by AWS services on our behalf are owned by those services and can't be managed by IaC.
+// Most of them are ignored by the enumerator, those left are recognized by the description their service gives them,
+// which is the only hint kept when their details are read by the provider.
+var serviceOwnedNetworkInterfaceDescriptionPrefixes = []string{
+	"AWS Lambda VPC ENI",
+	"ELB ",
+	"Interface for NAT Gateway ",
+	"RDSNetworkInterface",
+	"VPC Endpoint Interface ",
+	"EFS mount target for ",
+	"arn:aws:ecs:",
+	"Amazon EKS ",
+	"Network Interface for Transit Gateway Attachment ",
+}
+
+// We remove network interfaces owned by other services from remote resources unless they are managed by IaC.
+type AwsNetworkInterfaceServiceOwned struct{}
+
+func NewAwsNetworkInterfaceServiceOwned() AwsNetworkInterfaceServiceOwned {
+	return AwsNetworkInterfaceServiceOwned{}
+}
+
+func (m AwsNetworkInterfaceServiceOwned) Execute(remoteResources, resourcesFromState *[]*resource.Resource) error {
+	newRemoteResources := make([]*resource.Resource, 0, len(*remoteResources))
+	for _, remoteResource := range *remoteResources {
+		if remoteResource.ResourceType() != aws.AwsNetworkInterfaceResourceType || !m.isServiceOwned(remoteResource) {
+			newRemoteResources = append(newRemoteResources, remoteResource)
+			continue
+		}
+
+		existInState := false
+		for _, stateResource := range *resourcesFromState {
+			if remoteResource.Equal(stateResource) {
+				existInState = true
+				break
+			}
+		}
+
+		if existInState {
+			newRemoteResources = append(newRemoteResources, remoteResource)
+			continue
+		}
+
+		logrus.WithFields(logrus.Fields{
+			"id":   remoteResource.ResourceId(),
+			"type": remoteResource.ResourceType(),
+		}).Debug("Ignoring network interface owned by another service as it is not managed by IaC")
+	}
+
+	*remoteResources = newRemoteResources
+
+	return nil
+}
+
+func (m AwsNetworkInterfaceServiceOwned) isServiceOwned(res *resource.Resource) bool {
+	if description := res.Attributes().GetString("description"); description != nil {
+		for _, prefix := range serviceOwnedNetworkInterfaceDescriptionPrefixes {
+			if strings.HasPrefix(*description, prefix) {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,113 @@
+package middlewares
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/awsutil"
+	"github.com/r3labs/diff/v2"
+
+	"github.com/snyk/driftctl/pkg/resource"
+	"github.com/snyk/driftctl/pkg/resource/aws"
+)
+
+func TestAwsNetworkInterfaceServiceOwned_Execute(t *testing.T) {
+	lambdaInterface := &resource.Resource{
+		Id:   "eni-lambda",
+		Type: aws.AwsNetworkInterfaceResourceType,
+		Attrs: &resource.Attributes{
+			"description":       "AWS Lambda VPC ENI-my-function-4a1bc6c0-0ab1-4cde-b2c3-35d4e5f6a7b8",
+			"interface_type":    "lambda",
+			"requester_managed": true,
+		},
+	}
+	natInterface := &resource.Resource{
+		Id:   "eni-nat",
+		Type: aws.AwsNetworkInterfaceResourceType,
+		Attrs: &resource.Attributes{
+			"description":    "Interface for NAT Gateway nat-0a1b2c3d4e5f6a7b8",
+			"interface_type": "natGateway",
+		},
+	}
+	// Only the description is known when the details of an interface are read by the provider
+	elbInterface := &resource.Resource{
+		Id:   "eni-elb",
+		Type: aws.AwsNetworkInterfaceResourceType,
+		Attrs: &resource.Attributes{
+			"description": "ELB app/my-alb/50dc6c495c0c9188",
+		},
+	}
+	// Attributes read by the provider for an interface requested by RDS, the requester is not part of them
+	rdsInterface := &resource.Resource{
+		Id:   "eni-rds",
+		Type: aws.AwsNetworkInterfaceResourceType,
+		Attrs: &resource.Attributes{
+			"description":       "RDSNetworkInterface",
+			"id":                "eni-rds",
+			"mac_address":       "0a:1b:2c:3d:4e:5f",
+			"private_dns_name":  "ip-10-0-1-25.ec2.internal",
+			"private_ip":        "10.0.1.25",
+			"private_ips":       []interface{}{"10.0.1.25"},
+			"private_ips_count": float64(0),
+			"security_groups":   []interface{}{"sg-0a1b2c3d4e5f6a7b8"},
+			"source_dest_check": true,
+			"subnet_id":         "subnet-0a1b2c3d",
+			"tags":              map[string]interface{}{},
+		},
+	}
+	userInterface := &resource.Resource{
+		Id:   "eni-user",
+		Type: aws.AwsNetworkInterfaceResourceType,
+		Attrs: &resource.Attributes{
+			"description":       "my interface",
+			"interface_type":    "interface",
+			"requester_managed": false,
+		},
+	}
+	otherResource := &resource.Resource{
+		Id:   "sg-elb",
+		Type: aws.AwsSecurityGroupResourceType,
+		Attrs: &resource.Attributes{
+			"description": "ELB security group",
+		},
+	}
+
+	tests := []struct {
+		name               string
+		remoteResources    []*resource.Resource
+		resourcesFromState []*resource.Resource
+		expected           []*resource.Resource
+	}{
+		{
+			"interfaces owned by other services are ignored",
+			[]*resource.Resource{lambdaInterface, natInterface, elbInterface, rdsInterface, userInterface, otherResource},
+			[]*resource.Resource{},
+			[]*resource.Resource{userInterface, otherResource},
+		},
+		{
+			"interfaces owned by other services managed by IaC are kept",
+			[]*resource.Resource{lambdaInterface, natInterface, userInterface},
+			[]*resource.Resource{natInterface, userInterface},
+			[]*resource.Resource{natInterface, userInterface},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := NewAwsNetworkInterfaceServiceOwned()
+			err := m.Execute(&tt.remoteResources, &tt.resourcesFromState)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			changelog, err := diff.Diff(tt.remoteResources, tt.expected)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(changelog) > 0 {
+				for _, change := range changelog {
+					t.Errorf("%s got = %v, want %v", strings.Join(change.Path, "."), awsutil.Prettify(change.From), awsutil.Prettify(change.To))
+				}
+			}
+		})
+	}
+}
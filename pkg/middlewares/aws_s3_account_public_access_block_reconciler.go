@@ -0,0 +1,103 @@
+package middlewares
+
+import (
+	"github.com/snyk/driftctl/pkg/resource"
+	"github.com/snyk/driftctl/pkg/resource/aws"
+)
+
+var s3AccountPublicAccessBlockSettings = []string{
+	"block_public_acls",
+	"block_public_policy",
+	"ignore_public_acls",
+	"restrict_public_buckets",
+}
+
+// AwsS3AccountPublicAccessBlockReconciler is a middleware that either creates an 'aws_s3_account_public_access_block'
+// resource based on its equivalent state one with the settings of the remote one, or removes the resource from our
+// list of remote resources if it is not managed and every setting is disabled (which is the default for an account).
+type AwsS3AccountPublicAccessBlockReconciler struct {
+	resourceFactory resource.ResourceFactory
+}
+
+func NewAwsS3AccountPublicAccessBlockReconciler(resourceFactory resource.ResourceFactory) AwsS3AccountPublicAccessBlockReconciler {
+	return AwsS3AccountPublicAccessBlockReconciler{
+		resourceFactory: resourceFactory,
+	}
+}
+
+func (m AwsS3AccountPublicAccessBlockReconciler) Execute(remoteResources, resourcesFromState *[]*resource.Resource) error {
+	newRemoteResources := make([]*resource.Resource, 0)
+
+	// There is one public access block per scanned account
+	accessBlocks := make([]*resource.Resource, 0)
+	managed := map[string]bool{}
+
+	for _, res := range *remoteResources {
+		// Ignore all resources other than aws_s3_account_public_access_block
+		if res.ResourceType() != aws.AwsS3AccountPublicAccessBlockResourceType {
+			newRemoteResources = append(newRemoteResources, res)
+			continue
+		}
+		accessBlocks = append(accessBlocks, res)
+	}
+
+	// We can encounter this case when we don't have permission to get this setting from AWS.
+	if len(accessBlocks) == 0 {
+		return nil
+	}
+
+	for _, res := range *resourcesFromState {
+		// Ignore all resources other than aws_s3_account_public_access_block
+		if res.ResourceType() != aws.AwsS3AccountPublicAccessBlockResourceType {
+			continue
+		}
+
+		var accessBlock *resource.Resource
+		for _, remoteRes := range accessBlocks {
+			if remoteRes.ResourceId() == res.ResourceId() {
+				accessBlock = remoteRes
+				break
+			}
+		}
+		if accessBlock == nil {
+			continue
+		}
+
+		// Create a new remote resource that will be similar to the state resource but with the settings of the remote one.
+		// The reason why is that the enumerated resource does not come with the Terraform id attribute.
+		attrs := map[string]interface{}{
+			"id":         res.ResourceId(),
+			"account_id": accessBlock.ResourceId(),
+		}
+		for _, setting := range s3AccountPublicAccessBlockSettings {
+			attrs[setting] = isS3AccountPublicAccessBlockSettingEnabled(accessBlock, setting)
+		}
+		newRemoteResources = append(newRemoteResources, m.resourceFactory.CreateAbstractResource(
+			res.ResourceType(),
+			res.ResourceId(),
+			attrs,
+		))
+		managed[res.ResourceId()] = true
+	}
+
+	// Unmanaged public access blocks are only reported when at least one setting is enabled
+	for _, accessBlock := range accessBlocks {
+		if managed[accessBlock.ResourceId()] {
+			continue
+		}
+		for _, setting := range s3AccountPublicAccessBlockSettings {
+			if isS3AccountPublicAccessBlockSettingEnabled(accessBlock, setting) {
+				newRemoteResources = append(newRemoteResources, accessBlock)
+				break
+			}
+		}
+	}
+
+	*remoteResources = newRemoteResources
+	return nil
+}
+
+func isS3AccountPublicAccessBlockSettingEnabled(res *resource.Resource, setting string) bool {
+	enabled := res.Attributes().GetBool(setting)
+	return enabled != nil && *enabled
+}
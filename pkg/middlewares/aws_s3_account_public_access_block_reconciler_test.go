@@ -0,0 +1,324 @@
+package middlewares
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/awsutil"
+	"github.com/r3labs/diff/v2"
+	"github.com/snyk/driftctl/pkg/terraform"
+
+	"github.com/snyk/driftctl/pkg/resource"
+	"github.com/snyk/driftctl/pkg/resource/aws"
+)
+
+func TestAwsS3AccountPublicAccessBlockReconciler_Execute(t *testing.T) {
+	tests := []struct {
+		name                    string
+		mocks                   func(*terraform.MockResourceFactory)
+		remoteResources         []*resource.Resource
+		resourcesFromState      []*resource.Resource
+		expectedRemoteResources []*resource.Resource
+		expectedStateResources  []*resource.Resource
+	}{
+		{
+			name: "test public access block is managed",
+			mocks: func(factory *terraform.MockResourceFactory) {
+				factory.On("CreateAbstractResource",
+					aws.AwsS3AccountPublicAccessBlockResourceType,
+					"123456789012",
+					map[string]interface{}{
+						"id":                      "123456789012",
+						"account_id":              "123456789012",
+						"block_public_acls":       true,
+						"block_public_policy":     true,
+						"ignore_public_acls":      false,
+						"restrict_public_buckets": false,
+					}).Return(&resource.Resource{
+					Id:   "123456789012",
+					Type: aws.AwsS3AccountPublicAccessBlockResourceType,
+					Attrs: &resource.Attributes{
+						"id":                      "123456789012",
+						"account_id":              "123456789012",
+						"block_public_acls":       true,
+						"block_public_policy":     true,
+						"ignore_public_acls":      false,
+						"restrict_public_buckets": false,
+					},
+				}).Once()
+			},
+			remoteResources: []*resource.Resource{
+				{
+					Id:    "bucket-1",
+					Type:  aws.AwsS3BucketResourceType,
+					Attrs: &resource.Attributes{},
+				},
+				{
+					Id:   "123456789012",
+					Type: aws.AwsS3AccountPublicAccessBlockResourceType,
+					Attrs: &resource.Attributes{
+						"account_id":              "123456789012",
+						"block_public_acls":       true,
+						"block_public_policy":     true,
+						"ignore_public_acls":      false,
+						"restrict_public_buckets": false,
+					},
+				},
+			},
+			resourcesFromState: []*resource.Resource{
+				{
+					Id:    "bucket-1",
+					Type:  aws.AwsS3BucketResourceType,
+					Attrs: &resource.Attributes{},
+				},
+				{
+					Id:   "123456789012",
+					Type: aws.AwsS3AccountPublicAccessBlockResourceType,
+					Attrs: &resource.Attributes{
+						"id":                      "123456789012",
+						"account_id":              "123456789012",
+						"block_public_acls":       true,
+						"block_public_policy":     false,
+						"ignore_public_acls":      false,
+						"restrict_public_buckets": false,
+					},
+				},
+			},
+			expectedRemoteResources: []*resource.Resource{
+				{
+					Id:    "bucket-1",
+					Type:  aws.AwsS3BucketResourceType,
+					Attrs: &resource.Attributes{},
+				},
+				{
+					Id:   "123456789012",
+					Type: aws.AwsS3AccountPublicAccessBlockResourceType,
+					Attrs: &resource.Attributes{
+						"id":                      "123456789012",
+						"account_id":              "123456789012",
+						"block_public_acls":       true,
+						"block_public_policy":     true,
+						"ignore_public_acls":      false,
+						"restrict_public_buckets": false,
+					},
+				},
+			},
+			expectedStateResources: []*resource.Resource{
+				{
+					Id:    "bucket-1",
+					Type:  aws.AwsS3BucketResourceType,
+					Attrs: &resource.Attributes{},
+				},
+				{
+					Id:   "123456789012",
+					Type: aws.AwsS3AccountPublicAccessBlockResourceType,
+					Attrs: &resource.Attributes{
+						"id":                      "123456789012",
+						"account_id":              "123456789012",
+						"block_public_acls":       true,
+						"block_public_policy":     false,
+						"ignore_public_acls":      false,
+						"restrict_public_buckets": false,
+					},
+				},
+			},
+		},
+		{
+			name:  "test public access block is enabled and unmanaged",
+			mocks: func(factory *terraform.MockResourceFactory) {},
+			remoteResources: []*resource.Resource{
+				{
+					Id:    "bucket-1",
+					Type:  aws.AwsS3BucketResourceType,
+					Attrs: &resource.Attributes{},
+				},
+				{
+					Id:   "123456789012",
+					Type: aws.AwsS3AccountPublicAccessBlockResourceType,
+					Attrs: &resource.Attributes{
+						"account_id":              "123456789012",
+						"block_public_acls":       false,
+						"block_public_policy":     false,
+						"ignore_public_acls":      true,
+						"restrict_public_buckets": false,
+					},
+				},
+			},
+			resourcesFromState: []*resource.Resource{
+				{
+					Id:    "bucket-1",
+					Type:  aws.AwsS3BucketResourceType,
+					Attrs: &resource.Attributes{},
+				},
+			},
+			expectedRemoteResources: []*resource.Resource{
+				{
+					Id:    "bucket-1",
+					Type:  aws.AwsS3BucketResourceType,
+					Attrs: &resource.Attributes{},
+				},
+				{
+					Id:   "123456789012",
+					Type: aws.AwsS3AccountPublicAccessBlockResourceType,
+					Attrs: &resource.Attributes{
+						"account_id":              "123456789012",
+						"block_public_acls":       false,
+						"block_public_policy":     false,
+						"ignore_public_acls":      true,
+						"restrict_public_buckets": false,
+					},
+				},
+			},
+			expectedStateResources: []*resource.Resource{
+				{
+					Id:    "bucket-1",
+					Type:  aws.AwsS3BucketResourceType,
+					Attrs: &resource.Attributes{},
+				},
+			},
+		},
+		{
+			name:  "test public access block is disabled and unmanaged",
+			mocks: func(factory *terraform.MockResourceFactory) {},
+			remoteResources: []*resource.Resource{
+				{
+					Id:   "123456789012",
+					Type: aws.AwsS3AccountPublicAccessBlockResourceType,
+					Attrs: &resource.Attributes{
+						"account_id":              "123456789012",
+						"block_public_acls":       false,
+						"block_public_policy":     false,
+						"ignore_public_acls":      false,
+						"restrict_public_buckets": false,
+					},
+				},
+				{
+					Id:    "bucket-1",
+					Type:  aws.AwsS3BucketResourceType,
+					Attrs: &resource.Attributes{},
+				},
+			},
+			resourcesFromState: []*resource.Resource{
+				{
+					Id:    "bucket-1",
+					Type:  aws.AwsS3BucketResourceType,
+					Attrs: &resource.Attributes{},
+				},
+			},
+			expectedRemoteResources: []*resource.Resource{
+				{
+					Id:    "bucket-1",
+					Type:  aws.AwsS3BucketResourceType,
+					Attrs: &resource.Attributes{},
+				},
+			},
+			expectedStateResources: []*resource.Resource{
+				{
+					Id:    "bucket-1",
+					Type:  aws.AwsS3BucketResourceType,
+					Attrs: &resource.Attributes{},
+				},
+			},
+		},
+		{
+			name:  "test public access block doesn't exist",
+			mocks: func(factory *terraform.MockResourceFactory) {},
+			remoteResources: []*resource.Resource{
+				{
+					Id:    "bucket-1",
+					Type:  aws.AwsS3BucketResourceType,
+					Attrs: &resource.Attributes{},
+				},
+			},
+			resourcesFromState: []*resource.Resource{
+				{
+					Id:    "bucket-1",
+					Type:  aws.AwsS3BucketResourceType,
+					Attrs: &resource.Attributes{},
+				},
+				{
+					Id:    "bucket-2",
+					Type:  aws.AwsS3BucketResourceType,
+					Attrs: &resource.Attributes{},
+				},
+				{
+					Id:   "123456789012",
+					Type: aws.AwsS3AccountPublicAccessBlockResourceType,
+					Attrs: &resource.Attributes{
+						"id":                      "123456789012",
+						"account_id":              "123456789012",
+						"block_public_acls":       true,
+						"block_public_policy":     true,
+						"ignore_public_acls":      true,
+						"restrict_public_buckets": true,
+					},
+				},
+			},
+			expectedRemoteResources: []*resource.Resource{
+				{
+					Id:    "bucket-1",
+					Type:  aws.AwsS3BucketResourceType,
+					Attrs: &resource.Attributes{},
+				},
+			},
+			expectedStateResources: []*resource.Resource{
+				{
+					Id:    "bucket-1",
+					Type:  aws.AwsS3BucketResourceType,
+					Attrs: &resource.Attributes{},
+				},
+				{
+					Id:    "bucket-2",
+					Type:  aws.AwsS3BucketResourceType,
+					Attrs: &resource.Attributes{},
+				},
+				{
+					Id:   "123456789012",
+					Type: aws.AwsS3AccountPublicAccessBlockResourceType,
+					Attrs: &resource.Attributes{
+						"id":                      "123456789012",
+						"account_id":              "123456789012",
+						"block_public_acls":       true,
+						"block_public_policy":     true,
+						"ignore_public_acls":      true,
+						"restrict_public_buckets": true,
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			factory := &terraform.MockResourceFactory{}
+			if tt.mocks != nil {
+				tt.mocks(factory)
+			}
+
+			m := NewAwsS3AccountPublicAccessBlockReconciler(factory)
+			err := m.Execute(&tt.remoteResources, &tt.resourcesFromState)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			changelog, err := diff.Diff(tt.remoteResources, tt.expectedRemoteResources)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(changelog) > 0 {
+				for _, change := range changelog {
+					t.Errorf("%s got = %v, want %v", strings.Join(change.Path, "."), awsutil.Prettify(change.From), awsutil.Prettify(change.To))
+				}
+			}
+
+			changelog, err = diff.Diff(tt.resourcesFromState, tt.expectedStateResources)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(changelog) > 0 {
+				for _, change := range changelog {
+					t.Errorf("%s got = %v, want %v", strings.Join(change.Path, "."), awsutil.Prettify(change.From), awsutil.Prettify(change.To))
+				}
+			}
+		})
+	}
+}
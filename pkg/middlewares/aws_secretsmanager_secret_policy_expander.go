@@ -0,0 +1,83 @@
+package middlewares
+
+import (
+	"github.com/sirupsen/logrus"
+
+	"github.com/snyk/driftctl/pkg/resource"
+	"github.com/snyk/driftctl/pkg/resource/aws"
+)
+
+// Explodes policy found in aws_secretsmanager_secret from state resources to aws_secretsmanager_secret_policy resources
+type AwsSecretsManagerSecretPolicyExpander struct {
+	resourceFactory resource.ResourceFactory
+}
+
+func NewAwsSecretsManagerSecretPolicyExpander(resourceFactory resource.ResourceFactory) AwsSecretsManagerSecretPolicyExpander {
+	return AwsSecretsManagerSecretPolicyExpander{
+		resourceFactory,
+	}
+}
+
+func (m AwsSecretsManagerSecretPolicyExpander) Execute(remoteResources, resourcesFromState *[]*resource.Resource) error {
+
+	// Policies are enumerated as aws_secretsmanager_secret_policy, remove the copy read with the secret
+	for _, res := range *remoteResources {
+		if res.ResourceType() != aws.AwsSecretsManagerSecretResourceType {
+			continue
+		}
+		res.Attrs.SafeDelete([]string{"policy"})
+	}
+
+	newList := make([]*resource.Resource, 0)
+	for _, res := range *resourcesFromState {
+		// Ignore all resources other than secretsmanager_secret
+		if res.ResourceType() != aws.AwsSecretsManagerSecretResourceType {
+			newList = append(newList, res)
+			continue
+		}
+
+		newList = append(newList, res)
+
+		if m.hasPolicyAttached(res, resourcesFromState) {
+			res.Attrs.SafeDelete([]string{"policy"})
+			continue
+		}
+
+		m.splitPolicy(res, &newList)
+	}
+	*resourcesFromState = newList
+	return nil
+}
+
+func (m *AwsSecretsManagerSecretPolicyExpander) splitPolicy(secret *resource.Resource, results *[]*resource.Resource) {
+	policy, exist := secret.Attrs.Get("policy")
+	if !exist || policy == "" {
+		secret.Attrs.SafeDelete([]string{"policy"})
+		return
+	}
+
+	data := map[string]interface{}{
+		"id":         secret.Id,
+		"secret_arn": secret.Id,
+		"policy":     policy,
+	}
+
+	newPolicy := m.resourceFactory.CreateAbstractResource(aws.AwsSecretsManagerSecretPolicyResourceType, secret.Id, data)
+
+	*results = append(*results, newPolicy)
+	logrus.WithFields(logrus.Fields{
+		"id": newPolicy.ResourceId(),
+	}).Debug("Created new policy from secretsmanager_secret")
+
+	secret.Attrs.SafeDelete([]string{"policy"})
+}
+
+func (m *AwsSecretsManagerSecretPolicyExpander) hasPolicyAttached(secret *resource.Resource, resourcesFromState *[]*resource.Resource) bool {
+	for _, res := range *resourcesFromState {
+		if res.ResourceType() == aws.AwsSecretsManagerSecretPolicyResourceType &&
+			res.ResourceId() == secret.Id {
+			return true
+		}
+	}
+	return false
+}
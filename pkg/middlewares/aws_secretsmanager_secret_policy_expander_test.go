@@ -0,0 +1,185 @@
+package middlewares
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/awsutil"
+	"github.com/r3labs/diff/v2"
+
+	"github.com/snyk/driftctl/pkg/resource"
+	awsresource "github.com/snyk/driftctl/pkg/resource/aws"
+	"github.com/snyk/driftctl/pkg/terraform"
+)
+
+func TestAwsSecretsManagerSecretPolicyExpander_Execute(t *testing.T) {
+	secretArn := "arn:aws:secretsmanager:us-east-1:929327065333:secret:foo-Y6pRnY"
+
+	tests := []struct {
+		name               string
+		remoteResources    *[]*resource.Resource
+		resourcesFromState *[]*resource.Resource
+		expectedRemote     *[]*resource.Resource
+		expected           *[]*resource.Resource
+		mock               func(factory *terraform.MockResourceFactory)
+	}{
+		{
+			name: "Inline policy no attached policy",
+			remoteResources: &[]*resource.Resource{
+				{
+					Id:   secretArn,
+					Type: awsresource.AwsSecretsManagerSecretResourceType,
+					Attrs: &resource.Attributes{
+						"arn":    secretArn,
+						"policy": "{\"policy\":\"coucou\"}",
+					},
+				},
+			},
+			resourcesFromState: &[]*resource.Resource{
+				{
+					Id:   secretArn,
+					Type: awsresource.AwsSecretsManagerSecretResourceType,
+					Attrs: &resource.Attributes{
+						"arn":    secretArn,
+						"policy": "{\"policy\":\"coucou\"}",
+					},
+				},
+			},
+			expectedRemote: &[]*resource.Resource{
+				{
+					Id:   secretArn,
+					Type: awsresource.AwsSecretsManagerSecretResourceType,
+					Attrs: &resource.Attributes{
+						"arn": secretArn,
+					},
+				},
+			},
+			expected: &[]*resource.Resource{
+				{
+					Id:   secretArn,
+					Type: awsresource.AwsSecretsManagerSecretResourceType,
+					Attrs: &resource.Attributes{
+						"arn": secretArn,
+					},
+				},
+				{
+					Id:   secretArn,
+					Type: awsresource.AwsSecretsManagerSecretPolicyResourceType,
+					Attrs: &resource.Attributes{
+						"id":         secretArn,
+						"secret_arn": secretArn,
+						"policy":     "{\"policy\":\"coucou\"}",
+					},
+				},
+			},
+			mock: func(factory *terraform.MockResourceFactory) {
+				factory.On("CreateAbstractResource", awsresource.AwsSecretsManagerSecretPolicyResourceType, secretArn, map[string]interface{}{
+					"id":         secretArn,
+					"secret_arn": secretArn,
+					"policy":     "{\"policy\":\"coucou\"}",
+				}).Once().Return(&resource.Resource{
+					Id:   secretArn,
+					Type: awsresource.AwsSecretsManagerSecretPolicyResourceType,
+					Attrs: &resource.Attributes{
+						"id":         secretArn,
+						"secret_arn": secretArn,
+						"policy":     "{\"policy\":\"coucou\"}",
+					},
+				})
+			},
+		},
+		{
+			name:            "Empty inline policy",
+			remoteResources: &[]*resource.Resource{},
+			resourcesFromState: &[]*resource.Resource{
+				{
+					Id:   secretArn,
+					Type: awsresource.AwsSecretsManagerSecretResourceType,
+					Attrs: &resource.Attributes{
+						"arn":    secretArn,
+						"policy": "",
+					},
+				},
+			},
+			expectedRemote: &[]*resource.Resource{},
+			expected: &[]*resource.Resource{
+				{
+					Id:   secretArn,
+					Type: awsresource.AwsSecretsManagerSecretResourceType,
+					Attrs: &resource.Attributes{
+						"arn": secretArn,
+					},
+				},
+			},
+		},
+		{
+			name:            "Inline policy and attached policy",
+			remoteResources: &[]*resource.Resource{},
+			resourcesFromState: &[]*resource.Resource{
+				{
+					Id:   secretArn,
+					Type: awsresource.AwsSecretsManagerSecretResourceType,
+					Attrs: &resource.Attributes{
+						"arn":    secretArn,
+						"policy": "{\"policy\":\"coucou\"}",
+					},
+				},
+				{
+					Id:   secretArn,
+					Type: awsresource.AwsSecretsManagerSecretPolicyResourceType,
+					Attrs: &resource.Attributes{
+						"id":         secretArn,
+						"secret_arn": secretArn,
+						"policy":     "{\"policy\":\"coucou\"}",
+					},
+				},
+			},
+			expectedRemote: &[]*resource.Resource{},
+			expected: &[]*resource.Resource{
+				{
+					Id:   secretArn,
+					Type: awsresource.AwsSecretsManagerSecretResourceType,
+					Attrs: &resource.Attributes{
+						"arn": secretArn,
+					},
+				},
+				{
+					Id:   secretArn,
+					Type: awsresource.AwsSecretsManagerSecretPolicyResourceType,
+					Attrs: &resource.Attributes{
+						"id":         secretArn,
+						"secret_arn": secretArn,
+						"policy":     "{\"policy\":\"coucou\"}",
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+
+			factory := &terraform.MockResourceFactory{}
+			if tt.mock != nil {
+				tt.mock(factory)
+			}
+
+			m := NewAwsSecretsManagerSecretPolicyExpander(factory)
+			if err := m.Execute(tt.remoteResources, tt.resourcesFromState); err != nil {
+				t.Fatal(err)
+			}
+
+			changelog, err := diff.Diff(tt.expectedRemote, tt.remoteResources)
+			if err != nil {
+				t.Fatal(err)
+			}
+			changelog2, err := diff.Diff(tt.expected, tt.resourcesFromState)
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, change := range append(changelog, changelog2...) {
+				t.Errorf("%s got = %v, want %v", strings.Join(change.Path, "."), awsutil.Prettify(change.From), awsutil.Prettify(change.To))
+			}
+			factory.AssertExpectations(t)
+		})
+	}
+}
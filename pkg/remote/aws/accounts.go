@@ -0,0 +1,89 @@
+package aws
+
+import (
+	"fmt"
+	"sort"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/organizations"
+	"github.com/pkg/errors"
+	"github.com/snyk/driftctl/pkg/remote/aws/repository"
+	"github.com/snyk/driftctl/pkg/remote/common"
+)
+
+// awsAccount is scanned by assuming its role, or using the current credentials when its role is empty
+type awsAccount struct {
+	id      string
+	roleARN string
+}
+
+// scansSeveralAccounts tells whether resources have to be attributed to the account they were found in
+func scansSeveralAccounts(opts common.RemoteOptions) bool {
+	return len(opts.AWSAssumeRoles) > 0 || opts.AWSOrganizationRole != ""
+}
+
+// listAccounts returns the accounts to scan, only the current one is scanned when no role is given
+func listAccounts(orgRepository repository.OrganizationsRepository, stsRepository repository.STSRepository, opts common.RemoteOptions) ([]awsAccount, error) {
+	if !scansSeveralAccounts(opts) {
+		// Some enumerators need the account id, e.g. to read the account public access block
+		identity, err := stsRepository.GetCallerIdentity()
+		if err != nil {
+			return nil, errors.Errorf("unable to get the current AWS account: %s", err)
+		}
+		return []awsAccount{{id: awssdk.StringValue(identity.Account)}}, nil
+	}
+
+	accounts := make([]awsAccount, 0, len(opts.AWSAssumeRoles))
+	seen := make(map[string]struct{})
+	for _, role := range opts.AWSAssumeRoles {
+		roleARN, err := arn.Parse(role)
+		if err != nil {
+			return nil, errors.Errorf("unable to parse role ARN %s: %s", role, err)
+		}
+		if _, exists := seen[roleARN.AccountID]; exists {
+			continue
+		}
+		seen[roleARN.AccountID] = struct{}{}
+		accounts = append(accounts, awsAccount{id: roleARN.AccountID, roleARN: role})
+	}
+
+	if opts.AWSOrganizationRole != "" {
+		identity, err := stsRepository.GetCallerIdentity()
+		if err != nil {
+			return nil, errors.Errorf("unable to get the current AWS account: %s", err)
+		}
+		callerARN, err := arn.Parse(awssdk.StringValue(identity.Arn))
+		if err != nil {
+			return nil, errors.Errorf("unable to parse caller ARN %s: %s", awssdk.StringValue(identity.Arn), err)
+		}
+		orgAccounts, err := orgRepository.ListAllAccounts()
+		if err != nil {
+			return nil, errors.Errorf("unable to list accounts of the AWS organization: %s", err)
+		}
+		for _, account := range orgAccounts {
+			id := awssdk.StringValue(account.Id)
+			if awssdk.StringValue(account.Status) != organizations.AccountStatusActive {
+				continue
+			}
+			if _, exists := seen[id]; exists {
+				continue
+			}
+			seen[id] = struct{}{}
+			// The current account is scanned with the current credentials, it usually does not have the organization role
+			if id == awssdk.StringValue(identity.Account) {
+				accounts = append(accounts, awsAccount{id: id})
+				continue
+			}
+			accounts = append(accounts, awsAccount{
+				id:      id,
+				roleARN: fmt.Sprintf("arn:%s:iam::%s:role/%s", callerARN.Partition, id, opts.AWSOrganizationRole),
+			})
+		}
+	}
+
+	sort.SliceStable(accounts, func(i, j int) bool {
+		return accounts[i].id < accounts[j].id
+	})
+	return accounts, nil
+}
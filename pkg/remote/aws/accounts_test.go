@@ -0,0 +1,122 @@
+package aws
+
+import (
+	"errors"
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/organizations"
+	"github.com/aws/aws-sdk-go/service/sts"
+	"github.com/snyk/driftctl/pkg/remote/aws/repository"
+	"github.com/snyk/driftctl/pkg/remote/common"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListAccounts(t *testing.T) {
+	tests := []struct {
+		name    string
+		opts    common.RemoteOptions
+		mocks   func(orgRepository *repository.MockOrganizationsRepository, stsRepository *repository.MockSTSRepository)
+		want    []awsAccount
+		wantErr string
+	}{
+		{
+			name: "current account only",
+			mocks: func(orgRepository *repository.MockOrganizationsRepository, stsRepository *repository.MockSTSRepository) {
+				stsRepository.On("GetCallerIdentity").Return(&sts.GetCallerIdentityOutput{
+					Account: awssdk.String("111111111111"),
+					Arn:     awssdk.String("arn:aws:iam::111111111111:user/driftctl"),
+				}, nil).Once()
+			},
+			want: []awsAccount{{id: "111111111111"}},
+		},
+		{
+			name: "current account only without identity",
+			mocks: func(orgRepository *repository.MockOrganizationsRepository, stsRepository *repository.MockSTSRepository) {
+				stsRepository.On("GetCallerIdentity").Return(nil, errors.New("ExpiredToken")).Once()
+			},
+			wantErr: "unable to get the current AWS account: ExpiredToken",
+		},
+		{
+			name: "assumed roles",
+			opts: common.RemoteOptions{
+				AWSAssumeRoles: []string{
+					"arn:aws:iam::333333333333:role/driftctl",
+					"arn:aws:iam::222222222222:role/driftctl",
+					"arn:aws:iam::333333333333:role/admin",
+				},
+			},
+			want: []awsAccount{
+				{id: "222222222222", roleARN: "arn:aws:iam::222222222222:role/driftctl"},
+				{id: "333333333333", roleARN: "arn:aws:iam::333333333333:role/driftctl"},
+			},
+		},
+		{
+			name:    "invalid role ARN",
+			opts:    common.RemoteOptions{AWSAssumeRoles: []string{"driftctl"}},
+			wantErr: "unable to parse role ARN driftctl: arn: invalid prefix",
+		},
+		{
+			name: "organization accounts",
+			opts: common.RemoteOptions{
+				AWSAssumeRoles:      []string{"arn:aws:iam::333333333333:role/driftctl"},
+				AWSOrganizationRole: "OrganizationAccountAccessRole",
+			},
+			mocks: func(orgRepository *repository.MockOrganizationsRepository, stsRepository *repository.MockSTSRepository) {
+				stsRepository.On("GetCallerIdentity").Return(&sts.GetCallerIdentityOutput{
+					Account: awssdk.String("111111111111"),
+					Arn:     awssdk.String("arn:aws:iam::111111111111:user/driftctl"),
+				}, nil).Once()
+				orgRepository.On("ListAllAccounts").Return([]*organizations.Account{
+					{Id: awssdk.String("111111111111"), Status: awssdk.String(organizations.AccountStatusActive)},
+					{Id: awssdk.String("222222222222"), Status: awssdk.String(organizations.AccountStatusActive)},
+					{Id: awssdk.String("333333333333"), Status: awssdk.String(organizations.AccountStatusActive)},
+					{Id: awssdk.String("444444444444"), Status: awssdk.String(organizations.AccountStatusSuspended)},
+				}, nil).Once()
+			},
+			want: []awsAccount{
+				{id: "111111111111"},
+				{id: "222222222222", roleARN: "arn:aws:iam::222222222222:role/OrganizationAccountAccessRole"},
+				{id: "333333333333", roleARN: "arn:aws:iam::333333333333:role/driftctl"},
+			},
+		},
+		{
+			name: "organization accounts outside of an organization",
+			opts: common.RemoteOptions{AWSOrganizationRole: "OrganizationAccountAccessRole"},
+			mocks: func(orgRepository *repository.MockOrganizationsRepository, stsRepository *repository.MockSTSRepository) {
+				stsRepository.On("GetCallerIdentity").Return(&sts.GetCallerIdentityOutput{
+					Account: awssdk.String("111111111111"),
+					Arn:     awssdk.String("arn:aws:iam::111111111111:user/driftctl"),
+				}, nil).Once()
+				orgRepository.On("ListAllAccounts").Return(nil, errors.New("AWSOrganizationsNotInUseException")).Once()
+			},
+			wantErr: "unable to list accounts of the AWS organization: AWSOrganizationsNotInUseException",
+		},
+		{
+			name: "organization accounts without credentials",
+			opts: common.RemoteOptions{AWSOrganizationRole: "OrganizationAccountAccessRole"},
+			mocks: func(orgRepository *repository.MockOrganizationsRepository, stsRepository *repository.MockSTSRepository) {
+				stsRepository.On("GetCallerIdentity").Return(nil, errors.New("NoCredentialProviders")).Once()
+			},
+			wantErr: "unable to get the current AWS account: NoCredentialProviders",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			orgRepository := &repository.MockOrganizationsRepository{}
+			stsRepository := &repository.MockSTSRepository{}
+			if tt.mocks != nil {
+				tt.mocks(orgRepository, stsRepository)
+			}
+			got, err := listAccounts(orgRepository, stsRepository, tt.opts)
+			orgRepository.AssertExpectations(t)
+			stsRepository.AssertExpectations(t)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
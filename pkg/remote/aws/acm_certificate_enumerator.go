@@ -0,0 +1,48 @@
+package aws
+
+import (
+	"github.com/snyk/driftctl/pkg/remote/aws/repository"
+	remoteerror "github.com/snyk/driftctl/pkg/remote/error"
+	"github.com/snyk/driftctl/pkg/resource"
+	"github.com/snyk/driftctl/pkg/resource/aws"
+)
+
+type ACMCertificateEnumerator struct {
+	repository repository.ACMRepository
+	factory    resource.ResourceFactory
+}
+
+func NewACMCertificateEnumerator(repo repository.ACMRepository, factory resource.ResourceFactory) *ACMCertificateEnumerator {
+	return &ACMCertificateEnumerator{
+		repository: repo,
+		factory:    factory,
+	}
+}
+
+func (e *ACMCertificateEnumerator) SupportedType() resource.ResourceType {
+	return aws.AwsAcmCertificateResourceType
+}
+
+func (e *ACMCertificateEnumerator) Enumerate() ([]*resource.Resource, error) {
+	certificates, err := e.repository.ListAllCertificates()
+	if err != nil {
+		return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
+	}
+
+	results := make([]*resource.Resource, 0, len(certificates))
+
+	for _, certificate := range certificates {
+		results = append(
+			results,
+			e.factory.CreateAbstractResource(
+				string(e.SupportedType()),
+				*certificate.CertificateArn,
+				map[string]interface{}{
+					"domain_name": *certificate.DomainName,
+				},
+			),
+		)
+	}
+
+	return results, err
+}
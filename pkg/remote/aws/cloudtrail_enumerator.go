@@ -0,0 +1,46 @@
+package aws
+
+import (
+	"github.com/snyk/driftctl/pkg/remote/aws/repository"
+	remoteerror "github.com/snyk/driftctl/pkg/remote/error"
+	"github.com/snyk/driftctl/pkg/resource"
+	"github.com/snyk/driftctl/pkg/resource/aws"
+)
+
+type CloudTrailEnumerator struct {
+	repository repository.CloudTrailRepository
+	factory    resource.ResourceFactory
+}
+
+func NewCloudTrailEnumerator(repo repository.CloudTrailRepository, factory resource.ResourceFactory) *CloudTrailEnumerator {
+	return &CloudTrailEnumerator{
+		repository: repo,
+		factory:    factory,
+	}
+}
+
+func (e *CloudTrailEnumerator) SupportedType() resource.ResourceType {
+	return aws.AwsCloudtrailResourceType
+}
+
+func (e *CloudTrailEnumerator) Enumerate() ([]*resource.Resource, error) {
+	trails, err := e.repository.ListAllTrails()
+	if err != nil {
+		return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
+	}
+
+	results := make([]*resource.Resource, 0, len(trails))
+
+	for _, trail := range trails {
+		results = append(
+			results,
+			e.factory.CreateAbstractResource(
+				string(e.SupportedType()),
+				*trail.Name,
+				map[string]interface{}{},
+			),
+		)
+	}
+
+	return results, err
+}
@@ -0,0 +1,46 @@
+package aws
+
+import (
+	"github.com/snyk/driftctl/pkg/remote/aws/repository"
+	remoteerror "github.com/snyk/driftctl/pkg/remote/error"
+	"github.com/snyk/driftctl/pkg/resource"
+	"github.com/snyk/driftctl/pkg/resource/aws"
+)
+
+type CloudWatchEventRuleEnumerator struct {
+	repository repository.CloudWatchEventsRepository
+	factory    resource.ResourceFactory
+}
+
+func NewCloudWatchEventRuleEnumerator(repo repository.CloudWatchEventsRepository, factory resource.ResourceFactory) *CloudWatchEventRuleEnumerator {
+	return &CloudWatchEventRuleEnumerator{
+		repository: repo,
+		factory:    factory,
+	}
+}
+
+func (e *CloudWatchEventRuleEnumerator) SupportedType() resource.ResourceType {
+	return aws.AwsCloudwatchEventRuleResourceType
+}
+
+func (e *CloudWatchEventRuleEnumerator) Enumerate() ([]*resource.Resource, error) {
+	rules, err := e.repository.ListAllRules()
+	if err != nil {
+		return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
+	}
+
+	results := make([]*resource.Resource, 0, len(rules))
+
+	for _, rule := range rules {
+		results = append(
+			results,
+			e.factory.CreateAbstractResource(
+				string(e.SupportedType()),
+				*rule.Name,
+				map[string]interface{}{},
+			),
+		)
+	}
+
+	return results, err
+}
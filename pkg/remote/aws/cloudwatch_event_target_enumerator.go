@@ -0,0 +1,60 @@
+package aws
+
+import (
+	"fmt"
+
+	"github.com/snyk/driftctl/pkg/remote/aws/repository"
+	remoteerror "github.com/snyk/driftctl/pkg/remote/error"
+	"github.com/snyk/driftctl/pkg/resource"
+	"github.com/snyk/driftctl/pkg/resource/aws"
+)
+
+type CloudWatchEventTargetEnumerator struct {
+	repository repository.CloudWatchEventsRepository
+	factory    resource.ResourceFactory
+}
+
+func NewCloudWatchEventTargetEnumerator(repo repository.CloudWatchEventsRepository, factory resource.ResourceFactory) *CloudWatchEventTargetEnumerator {
+	return &CloudWatchEventTargetEnumerator{
+		repository: repo,
+		factory:    factory,
+	}
+}
+
+func (e *CloudWatchEventTargetEnumerator) SupportedType() resource.ResourceType {
+	return aws.AwsCloudwatchEventTargetResourceType
+}
+
+func (e *CloudWatchEventTargetEnumerator) Enumerate() ([]*resource.Resource, error) {
+	rules, err := e.repository.ListAllRules()
+	if err != nil {
+		return nil, remoteerror.NewResourceListingErrorWithType(err, string(e.SupportedType()), aws.AwsCloudwatchEventRuleResourceType)
+	}
+
+	results := make([]*resource.Resource, 0)
+
+	for _, rule := range rules {
+		targets, err := e.repository.ListAllTargets(*rule.Name)
+		if err != nil {
+			return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
+		}
+
+		for _, target := range targets {
+			results = append(
+				results,
+				e.factory.CreateAbstractResource(
+					string(e.SupportedType()),
+					// Same ID as the one computed by the provider
+					fmt.Sprintf("%s-%s", *rule.Name, *target.Id),
+					map[string]interface{}{
+						"rule":      *rule.Name,
+						"target_id": *target.Id,
+						"arn":       *target.Arn,
+					},
+				),
+			)
+		}
+	}
+
+	return results, err
+}
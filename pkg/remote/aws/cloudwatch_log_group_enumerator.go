@@ -0,0 +1,46 @@
+package aws
+
+import (
+	"github.com/snyk/driftctl/pkg/remote/aws/repository"
+	remoteerror "github.com/snyk/driftctl/pkg/remote/error"
+	"github.com/snyk/driftctl/pkg/resource"
+	"github.com/snyk/driftctl/pkg/resource/aws"
+)
+
+type CloudWatchLogGroupEnumerator struct {
+	repository repository.CloudWatchLogsRepository
+	factory    resource.ResourceFactory
+}
+
+func NewCloudWatchLogGroupEnumerator(repo repository.CloudWatchLogsRepository, factory resource.ResourceFactory) *CloudWatchLogGroupEnumerator {
+	return &CloudWatchLogGroupEnumerator{
+		repository: repo,
+		factory:    factory,
+	}
+}
+
+func (e *CloudWatchLogGroupEnumerator) SupportedType() resource.ResourceType {
+	return aws.AwsCloudwatchLogGroupResourceType
+}
+
+func (e *CloudWatchLogGroupEnumerator) Enumerate() ([]*resource.Resource, error) {
+	logGroups, err := e.repository.ListAllLogGroups()
+	if err != nil {
+		return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
+	}
+
+	results := make([]*resource.Resource, 0, len(logGroups))
+
+	for _, logGroup := range logGroups {
+		results = append(
+			results,
+			e.factory.CreateAbstractResource(
+				string(e.SupportedType()),
+				*logGroup.LogGroupName,
+				map[string]interface{}{},
+			),
+		)
+	}
+
+	return results, err
+}
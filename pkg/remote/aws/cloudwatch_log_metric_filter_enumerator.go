@@ -0,0 +1,49 @@
+package aws
+
+import (
+	"github.com/snyk/driftctl/pkg/remote/aws/repository"
+	remoteerror "github.com/snyk/driftctl/pkg/remote/error"
+	"github.com/snyk/driftctl/pkg/resource"
+	"github.com/snyk/driftctl/pkg/resource/aws"
+)
+
+type CloudWatchLogMetricFilterEnumerator struct {
+	repository repository.CloudWatchLogsRepository
+	factory    resource.ResourceFactory
+}
+
+func NewCloudWatchLogMetricFilterEnumerator(repo repository.CloudWatchLogsRepository, factory resource.ResourceFactory) *CloudWatchLogMetricFilterEnumerator {
+	return &CloudWatchLogMetricFilterEnumerator{
+		repository: repo,
+		factory:    factory,
+	}
+}
+
+func (e *CloudWatchLogMetricFilterEnumerator) SupportedType() resource.ResourceType {
+	return aws.AwsCloudwatchLogMetricFilterResourceType
+}
+
+func (e *CloudWatchLogMetricFilterEnumerator) Enumerate() ([]*resource.Resource, error) {
+	metricFilters, err := e.repository.ListAllMetricFilters()
+	if err != nil {
+		return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
+	}
+
+	results := make([]*resource.Resource, 0, len(metricFilters))
+
+	for _, metricFilter := range metricFilters {
+		results = append(
+			results,
+			e.factory.CreateAbstractResource(
+				string(e.SupportedType()),
+				*metricFilter.FilterName,
+				map[string]interface{}{
+					"name":           *metricFilter.FilterName,
+					"log_group_name": *metricFilter.LogGroupName,
+				},
+			),
+		)
+	}
+
+	return results, err
+}
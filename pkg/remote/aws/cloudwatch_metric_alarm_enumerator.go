@@ -0,0 +1,46 @@
+package aws
+
+import (
+	"github.com/snyk/driftctl/pkg/remote/aws/repository"
+	remoteerror "github.com/snyk/driftctl/pkg/remote/error"
+	"github.com/snyk/driftctl/pkg/resource"
+	"github.com/snyk/driftctl/pkg/resource/aws"
+)
+
+type CloudWatchMetricAlarmEnumerator struct {
+	repository repository.CloudWatchRepository
+	factory    resource.ResourceFactory
+}
+
+func NewCloudWatchMetricAlarmEnumerator(repo repository.CloudWatchRepository, factory resource.ResourceFactory) *CloudWatchMetricAlarmEnumerator {
+	return &CloudWatchMetricAlarmEnumerator{
+		repository: repo,
+		factory:    factory,
+	}
+}
+
+func (e *CloudWatchMetricAlarmEnumerator) SupportedType() resource.ResourceType {
+	return aws.AwsCloudwatchMetricAlarmResourceType
+}
+
+func (e *CloudWatchMetricAlarmEnumerator) Enumerate() ([]*resource.Resource, error) {
+	alarms, err := e.repository.ListAllMetricAlarms()
+	if err != nil {
+		return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
+	}
+
+	results := make([]*resource.Resource, 0, len(alarms))
+
+	for _, alarm := range alarms {
+		results = append(
+			results,
+			e.factory.CreateAbstractResource(
+				string(e.SupportedType()),
+				*alarm.AlarmName,
+				map[string]interface{}{},
+			),
+		)
+	}
+
+	return results, err
+}
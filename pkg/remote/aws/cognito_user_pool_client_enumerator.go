@@ -0,0 +1,56 @@
+package aws
+
+import (
+	"github.com/snyk/driftctl/pkg/remote/aws/repository"
+	remoteerror "github.com/snyk/driftctl/pkg/remote/error"
+	"github.com/snyk/driftctl/pkg/resource"
+	"github.com/snyk/driftctl/pkg/resource/aws"
+)
+
+type CognitoUserPoolClientEnumerator struct {
+	repository repository.CognitoRepository
+	factory    resource.ResourceFactory
+}
+
+func NewCognitoUserPoolClientEnumerator(repo repository.CognitoRepository, factory resource.ResourceFactory) *CognitoUserPoolClientEnumerator {
+	return &CognitoUserPoolClientEnumerator{
+		repository: repo,
+		factory:    factory,
+	}
+}
+
+func (e *CognitoUserPoolClientEnumerator) SupportedType() resource.ResourceType {
+	return aws.AwsCognitoUserPoolClientResourceType
+}
+
+func (e *CognitoUserPoolClientEnumerator) Enumerate() ([]*resource.Resource, error) {
+	userPools, err := e.repository.ListAllUserPools()
+	if err != nil {
+		return nil, remoteerror.NewResourceListingErrorWithType(err, string(e.SupportedType()), aws.AwsCognitoUserPoolResourceType)
+	}
+
+	results := make([]*resource.Resource, 0)
+
+	for _, userPool := range userPools {
+		clients, err := e.repository.ListAllUserPoolClients(*userPool.Id)
+		if err != nil {
+			return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
+		}
+
+		for _, client := range clients {
+			results = append(
+				results,
+				e.factory.CreateAbstractResource(
+					string(e.SupportedType()),
+					*client.ClientId,
+					map[string]interface{}{
+						"name":         *client.ClientName,
+						"user_pool_id": *userPool.Id,
+					},
+				),
+			)
+		}
+	}
+
+	return results, err
+}
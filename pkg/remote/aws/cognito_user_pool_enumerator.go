@@ -0,0 +1,48 @@
+package aws
+
+import (
+	"github.com/snyk/driftctl/pkg/remote/aws/repository"
+	remoteerror "github.com/snyk/driftctl/pkg/remote/error"
+	"github.com/snyk/driftctl/pkg/resource"
+	"github.com/snyk/driftctl/pkg/resource/aws"
+)
+
+type CognitoUserPoolEnumerator struct {
+	repository repository.CognitoRepository
+	factory    resource.ResourceFactory
+}
+
+func NewCognitoUserPoolEnumerator(repo repository.CognitoRepository, factory resource.ResourceFactory) *CognitoUserPoolEnumerator {
+	return &CognitoUserPoolEnumerator{
+		repository: repo,
+		factory:    factory,
+	}
+}
+
+func (e *CognitoUserPoolEnumerator) SupportedType() resource.ResourceType {
+	return aws.AwsCognitoUserPoolResourceType
+}
+
+func (e *CognitoUserPoolEnumerator) Enumerate() ([]*resource.Resource, error) {
+	userPools, err := e.repository.ListAllUserPools()
+	if err != nil {
+		return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
+	}
+
+	results := make([]*resource.Resource, 0, len(userPools))
+
+	for _, userPool := range userPools {
+		results = append(
+			results,
+			e.factory.CreateAbstractResource(
+				string(e.SupportedType()),
+				*userPool.Id,
+				map[string]interface{}{
+					"name": *userPool.Name,
+				},
+			),
+		)
+	}
+
+	return results, err
+}
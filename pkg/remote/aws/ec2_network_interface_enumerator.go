@@ -0,0 +1,83 @@
+package aws
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/sirupsen/logrus"
+	"github.com/snyk/driftctl/pkg/remote/aws/repository"
+	remoteerror "github.com/snyk/driftctl/pkg/remote/error"
+	"github.com/snyk/driftctl/pkg/resource"
+	resourceaws "github.com/snyk/driftctl/pkg/resource/aws"
+)
+
+// Interfaces of those types are created by AWS services on our behalf and can't be managed by IaC
+var serviceOwnedNetworkInterfaceTypes = map[string]struct{}{
+	"lambda":                {},
+	"natGateway":            {},
+	"network_load_balancer": {},
+	"gateway_load_balancer": {},
+	"vpc_endpoint":          {},
+	"transit_gateway":       {},
+}
+
+type EC2NetworkInterfaceEnumerator struct {
+	repository repository.EC2Repository
+	factory    resource.ResourceFactory
+}
+
+func NewEC2NetworkInterfaceEnumerator(repo repository.EC2Repository, factory resource.ResourceFactory) *EC2NetworkInterfaceEnumerator {
+	return &EC2NetworkInterfaceEnumerator{
+		repository: repo,
+		factory:    factory,
+	}
+}
+
+func (e *EC2NetworkInterfaceEnumerator) SupportedType() resource.ResourceType {
+	return resourceaws.AwsNetworkInterfaceResourceType
+}
+
+func (e *EC2NetworkInterfaceEnumerator) Enumerate() ([]*resource.Resource, error) {
+	networkInterfaces, err := e.repository.ListAllNetworkInterfaces()
+	if err != nil {
+		return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
+	}
+
+	results := make([]*resource.Resource, 0, len(networkInterfaces))
+
+	for _, networkInterface := range networkInterfaces {
+		// The provider does not return the requester nor the type of an interface,
+		// so interfaces owned by other services are ignored here rather than after their details are read
+		if isServiceOwnedNetworkInterface(networkInterface) {
+			logrus.WithFields(logrus.Fields{
+				"id":             *networkInterface.NetworkInterfaceId,
+				"interface_type": aws.StringValue(networkInterface.InterfaceType),
+			}).Debug("Ignoring network interface owned by another service")
+			continue
+		}
+
+		// The description is used by middlewares to ignore interfaces owned by other services
+		attrs := map[string]interface{}{}
+		if networkInterface.Description != nil {
+			attrs["description"] = *networkInterface.Description
+		}
+
+		results = append(
+			results,
+			e.factory.CreateAbstractResource(
+				string(e.SupportedType()),
+				*networkInterface.NetworkInterfaceId,
+				attrs,
+			),
+		)
+	}
+
+	return results, err
+}
+
+func isServiceOwnedNetworkInterface(networkInterface *ec2.NetworkInterface) bool {
+	if aws.BoolValue(networkInterface.RequesterManaged) {
+		return true
+	}
+	_, ok := serviceOwnedNetworkInterfaceTypes[aws.StringValue(networkInterface.InterfaceType)]
+	return ok
+}
@@ -0,0 +1,46 @@
+package aws
+
+import (
+	"github.com/snyk/driftctl/pkg/remote/aws/repository"
+	remoteerror "github.com/snyk/driftctl/pkg/remote/error"
+	"github.com/snyk/driftctl/pkg/resource"
+	"github.com/snyk/driftctl/pkg/resource/aws"
+)
+
+type EC2TransitGatewayEnumerator struct {
+	repository repository.EC2Repository
+	factory    resource.ResourceFactory
+}
+
+func NewEC2TransitGatewayEnumerator(repo repository.EC2Repository, factory resource.ResourceFactory) *EC2TransitGatewayEnumerator {
+	return &EC2TransitGatewayEnumerator{
+		repository: repo,
+		factory:    factory,
+	}
+}
+
+func (e *EC2TransitGatewayEnumerator) SupportedType() resource.ResourceType {
+	return aws.AwsEc2TransitGatewayResourceType
+}
+
+func (e *EC2TransitGatewayEnumerator) Enumerate() ([]*resource.Resource, error) {
+	gateways, err := e.repository.ListAllTransitGateways()
+	if err != nil {
+		return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
+	}
+
+	results := make([]*resource.Resource, 0, len(gateways))
+
+	for _, gateway := range gateways {
+		results = append(
+			results,
+			e.factory.CreateAbstractResource(
+				string(e.SupportedType()),
+				*gateway.TransitGatewayId,
+				map[string]interface{}{},
+			),
+		)
+	}
+
+	return results, err
+}
@@ -0,0 +1,54 @@
+package aws
+
+import (
+	"github.com/snyk/driftctl/pkg/remote/aws/repository"
+	remoteerror "github.com/snyk/driftctl/pkg/remote/error"
+	"github.com/snyk/driftctl/pkg/resource"
+	"github.com/snyk/driftctl/pkg/resource/aws"
+)
+
+type EC2TransitGatewayVpcAttachmentEnumerator struct {
+	repository repository.EC2Repository
+	factory    resource.ResourceFactory
+}
+
+func NewEC2TransitGatewayVpcAttachmentEnumerator(repo repository.EC2Repository, factory resource.ResourceFactory) *EC2TransitGatewayVpcAttachmentEnumerator {
+	return &EC2TransitGatewayVpcAttachmentEnumerator{
+		repository: repo,
+		factory:    factory,
+	}
+}
+
+func (e *EC2TransitGatewayVpcAttachmentEnumerator) SupportedType() resource.ResourceType {
+	return aws.AwsEc2TransitGatewayVpcAttachmentResourceType
+}
+
+func (e *EC2TransitGatewayVpcAttachmentEnumerator) Enumerate() ([]*resource.Resource, error) {
+	attachments, err := e.repository.ListAllTransitGatewayVpcAttachments()
+	if err != nil {
+		return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
+	}
+
+	results := make([]*resource.Resource, 0, len(attachments))
+
+	for _, attachment := range attachments {
+		attrs := map[string]interface{}{}
+		if attachment.TransitGatewayId != nil {
+			attrs["transit_gateway_id"] = *attachment.TransitGatewayId
+		}
+		if attachment.VpcId != nil {
+			attrs["vpc_id"] = *attachment.VpcId
+		}
+
+		results = append(
+			results,
+			e.factory.CreateAbstractResource(
+				string(e.SupportedType()),
+				*attachment.TransitGatewayAttachmentId,
+				attrs,
+			),
+		)
+	}
+
+	return results, err
+}
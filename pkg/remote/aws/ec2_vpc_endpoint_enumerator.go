@@ -0,0 +1,54 @@
+package aws
+
+import (
+	"github.com/snyk/driftctl/pkg/remote/aws/repository"
+	remoteerror "github.com/snyk/driftctl/pkg/remote/error"
+	"github.com/snyk/driftctl/pkg/resource"
+	"github.com/snyk/driftctl/pkg/resource/aws"
+)
+
+type EC2VpcEndpointEnumerator struct {
+	repository repository.EC2Repository
+	factory    resource.ResourceFactory
+}
+
+func NewEC2VpcEndpointEnumerator(repo repository.EC2Repository, factory resource.ResourceFactory) *EC2VpcEndpointEnumerator {
+	return &EC2VpcEndpointEnumerator{
+		repository: repo,
+		factory:    factory,
+	}
+}
+
+func (e *EC2VpcEndpointEnumerator) SupportedType() resource.ResourceType {
+	return aws.AwsVpcEndpointResourceType
+}
+
+func (e *EC2VpcEndpointEnumerator) Enumerate() ([]*resource.Resource, error) {
+	endpoints, err := e.repository.ListAllVpcEndpoints()
+	if err != nil {
+		return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
+	}
+
+	results := make([]*resource.Resource, 0, len(endpoints))
+
+	for _, endpoint := range endpoints {
+		attrs := map[string]interface{}{}
+		if endpoint.VpcId != nil {
+			attrs["vpc_id"] = *endpoint.VpcId
+		}
+		if endpoint.ServiceName != nil {
+			attrs["service_name"] = *endpoint.ServiceName
+		}
+
+		results = append(
+			results,
+			e.factory.CreateAbstractResource(
+				string(e.SupportedType()),
+				*endpoint.VpcEndpointId,
+				attrs,
+			),
+		)
+	}
+
+	return results, err
+}
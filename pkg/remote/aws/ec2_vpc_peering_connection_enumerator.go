@@ -0,0 +1,54 @@
+package aws
+
+import (
+	"github.com/snyk/driftctl/pkg/remote/aws/repository"
+	remoteerror "github.com/snyk/driftctl/pkg/remote/error"
+	"github.com/snyk/driftctl/pkg/resource"
+	"github.com/snyk/driftctl/pkg/resource/aws"
+)
+
+type EC2VpcPeeringConnectionEnumerator struct {
+	repository repository.EC2Repository
+	factory    resource.ResourceFactory
+}
+
+func NewEC2VpcPeeringConnectionEnumerator(repo repository.EC2Repository, factory resource.ResourceFactory) *EC2VpcPeeringConnectionEnumerator {
+	return &EC2VpcPeeringConnectionEnumerator{
+		repository: repo,
+		factory:    factory,
+	}
+}
+
+func (e *EC2VpcPeeringConnectionEnumerator) SupportedType() resource.ResourceType {
+	return aws.AwsVpcPeeringConnectionResourceType
+}
+
+func (e *EC2VpcPeeringConnectionEnumerator) Enumerate() ([]*resource.Resource, error) {
+	connections, err := e.repository.ListAllVpcPeeringConnections()
+	if err != nil {
+		return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
+	}
+
+	results := make([]*resource.Resource, 0, len(connections))
+
+	for _, connection := range connections {
+		attrs := map[string]interface{}{}
+		if connection.RequesterVpcInfo != nil && connection.RequesterVpcInfo.VpcId != nil {
+			attrs["vpc_id"] = *connection.RequesterVpcInfo.VpcId
+		}
+		if connection.AccepterVpcInfo != nil && connection.AccepterVpcInfo.VpcId != nil {
+			attrs["peer_vpc_id"] = *connection.AccepterVpcInfo.VpcId
+		}
+
+		results = append(
+			results,
+			e.factory.CreateAbstractResource(
+				string(e.SupportedType()),
+				*connection.VpcPeeringConnectionId,
+				attrs,
+			),
+		)
+	}
+
+	return results, err
+}
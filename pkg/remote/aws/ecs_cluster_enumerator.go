@@ -0,0 +1,48 @@
+package aws
+
+import (
+	"github.com/snyk/driftctl/pkg/remote/aws/repository"
+	remoteerror "github.com/snyk/driftctl/pkg/remote/error"
+	"github.com/snyk/driftctl/pkg/resource"
+	"github.com/snyk/driftctl/pkg/resource/aws"
+)
+
+type ECSClusterEnumerator struct {
+	repository repository.ECSRepository
+	factory    resource.ResourceFactory
+}
+
+func NewECSClusterEnumerator(repo repository.ECSRepository, factory resource.ResourceFactory) *ECSClusterEnumerator {
+	return &ECSClusterEnumerator{
+		repository: repo,
+		factory:    factory,
+	}
+}
+
+func (e *ECSClusterEnumerator) SupportedType() resource.ResourceType {
+	return aws.AwsEcsClusterResourceType
+}
+
+func (e *ECSClusterEnumerator) Enumerate() ([]*resource.Resource, error) {
+	clusters, err := e.repository.ListAllClusters()
+	if err != nil {
+		return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
+	}
+
+	results := make([]*resource.Resource, 0, len(clusters))
+
+	for _, cluster := range clusters {
+		results = append(
+			results,
+			e.factory.CreateAbstractResource(
+				string(e.SupportedType()),
+				*cluster.ClusterArn,
+				map[string]interface{}{
+					"name": *cluster.ClusterName,
+				},
+			),
+		)
+	}
+
+	return results, err
+}
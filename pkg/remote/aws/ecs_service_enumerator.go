@@ -0,0 +1,67 @@
+package aws
+
+import (
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/sirupsen/logrus"
+	"github.com/snyk/driftctl/pkg/remote/aws/repository"
+	remoteerror "github.com/snyk/driftctl/pkg/remote/error"
+	"github.com/snyk/driftctl/pkg/resource"
+	"github.com/snyk/driftctl/pkg/resource/aws"
+)
+
+type ECSServiceEnumerator struct {
+	repository repository.ECSRepository
+	factory    resource.ResourceFactory
+}
+
+func NewECSServiceEnumerator(repo repository.ECSRepository, factory resource.ResourceFactory) *ECSServiceEnumerator {
+	return &ECSServiceEnumerator{
+		repository: repo,
+		factory:    factory,
+	}
+}
+
+func (e *ECSServiceEnumerator) SupportedType() resource.ResourceType {
+	return aws.AwsEcsServiceResourceType
+}
+
+func (e *ECSServiceEnumerator) Enumerate() ([]*resource.Resource, error) {
+	clusters, err := e.repository.ListAllClusters()
+	if err != nil {
+		return nil, remoteerror.NewResourceListingErrorWithType(err, string(e.SupportedType()), aws.AwsEcsClusterResourceType)
+	}
+
+	results := make([]*resource.Resource, 0)
+
+	for _, cluster := range clusters {
+		services, err := e.repository.ListAllServices(*cluster.ClusterArn)
+		if err != nil {
+			return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
+		}
+
+		for _, service := range services {
+			serviceArn, err := arn.Parse(*service)
+			if err != nil {
+				logrus.WithField("arn", *service).Warn("Unable to parse ECS service ARN")
+				continue
+			}
+			// Resource of a service ARN is service/<cluster>/<name> or service/<name> for older services
+			parts := strings.Split(serviceArn.Resource, "/")
+			results = append(
+				results,
+				e.factory.CreateAbstractResource(
+					string(e.SupportedType()),
+					*service,
+					map[string]interface{}{
+						"name":    parts[len(parts)-1],
+						"cluster": *cluster.ClusterArn,
+					},
+				),
+			)
+		}
+	}
+
+	return results, err
+}
@@ -0,0 +1,50 @@
+package aws
+
+import (
+	"github.com/snyk/driftctl/pkg/remote/aws/repository"
+	remoteerror "github.com/snyk/driftctl/pkg/remote/error"
+	"github.com/snyk/driftctl/pkg/resource"
+	"github.com/snyk/driftctl/pkg/resource/aws"
+)
+
+type ECSTaskDefinitionEnumerator struct {
+	repository repository.ECSRepository
+	factory    resource.ResourceFactory
+}
+
+func NewECSTaskDefinitionEnumerator(repo repository.ECSRepository, factory resource.ResourceFactory) *ECSTaskDefinitionEnumerator {
+	return &ECSTaskDefinitionEnumerator{
+		repository: repo,
+		factory:    factory,
+	}
+}
+
+func (e *ECSTaskDefinitionEnumerator) SupportedType() resource.ResourceType {
+	return aws.AwsEcsTaskDefinitionResourceType
+}
+
+func (e *ECSTaskDefinitionEnumerator) Enumerate() ([]*resource.Resource, error) {
+	taskDefinitions, err := e.repository.ListAllTaskDefinitions()
+	if err != nil {
+		return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
+	}
+
+	results := make([]*resource.Resource, 0, len(taskDefinitions))
+
+	for _, taskDefinition := range taskDefinitions {
+		results = append(
+			results,
+			e.factory.CreateAbstractResource(
+				string(e.SupportedType()),
+				*taskDefinition.Family,
+				map[string]interface{}{
+					"family":   *taskDefinition.Family,
+					"arn":      *taskDefinition.TaskDefinitionArn,
+					"revision": int(*taskDefinition.Revision),
+				},
+			),
+		)
+	}
+
+	return results, err
+}
@@ -0,0 +1,46 @@
+package aws
+
+import (
+	"github.com/snyk/driftctl/pkg/remote/aws/repository"
+	remoteerror "github.com/snyk/driftctl/pkg/remote/error"
+	"github.com/snyk/driftctl/pkg/resource"
+	"github.com/snyk/driftctl/pkg/resource/aws"
+)
+
+type EFSFileSystemEnumerator struct {
+	repository repository.EFSRepository
+	factory    resource.ResourceFactory
+}
+
+func NewEFSFileSystemEnumerator(repo repository.EFSRepository, factory resource.ResourceFactory) *EFSFileSystemEnumerator {
+	return &EFSFileSystemEnumerator{
+		repository: repo,
+		factory:    factory,
+	}
+}
+
+func (e *EFSFileSystemEnumerator) SupportedType() resource.ResourceType {
+	return aws.AwsEfsFileSystemResourceType
+}
+
+func (e *EFSFileSystemEnumerator) Enumerate() ([]*resource.Resource, error) {
+	fileSystems, err := e.repository.ListAllFileSystems()
+	if err != nil {
+		return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
+	}
+
+	results := make([]*resource.Resource, 0, len(fileSystems))
+
+	for _, fileSystem := range fileSystems {
+		results = append(
+			results,
+			e.factory.CreateAbstractResource(
+				string(e.SupportedType()),
+				*fileSystem.FileSystemId,
+				map[string]interface{}{},
+			),
+		)
+	}
+
+	return results, err
+}
@@ -0,0 +1,56 @@
+package aws
+
+import (
+	"github.com/snyk/driftctl/pkg/remote/aws/repository"
+	remoteerror "github.com/snyk/driftctl/pkg/remote/error"
+	"github.com/snyk/driftctl/pkg/resource"
+	"github.com/snyk/driftctl/pkg/resource/aws"
+)
+
+type EFSMountTargetEnumerator struct {
+	repository repository.EFSRepository
+	factory    resource.ResourceFactory
+}
+
+func NewEFSMountTargetEnumerator(repo repository.EFSRepository, factory resource.ResourceFactory) *EFSMountTargetEnumerator {
+	return &EFSMountTargetEnumerator{
+		repository: repo,
+		factory:    factory,
+	}
+}
+
+func (e *EFSMountTargetEnumerator) SupportedType() resource.ResourceType {
+	return aws.AwsEfsMountTargetResourceType
+}
+
+func (e *EFSMountTargetEnumerator) Enumerate() ([]*resource.Resource, error) {
+	fileSystems, err := e.repository.ListAllFileSystems()
+	if err != nil {
+		return nil, remoteerror.NewResourceListingErrorWithType(err, string(e.SupportedType()), aws.AwsEfsFileSystemResourceType)
+	}
+
+	results := make([]*resource.Resource, 0)
+
+	for _, fileSystem := range fileSystems {
+		mountTargets, err := e.repository.ListAllMountTargets(*fileSystem.FileSystemId)
+		if err != nil {
+			return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
+		}
+
+		for _, mountTarget := range mountTargets {
+			results = append(
+				results,
+				e.factory.CreateAbstractResource(
+					string(e.SupportedType()),
+					*mountTarget.MountTargetId,
+					map[string]interface{}{
+						"file_system_id": *fileSystem.FileSystemId,
+						"subnet_id":      *mountTarget.SubnetId,
+					},
+				),
+			)
+		}
+	}
+
+	return results, err
+}
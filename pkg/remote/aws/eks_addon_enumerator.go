@@ -0,0 +1,58 @@
+package aws
+
+import (
+	"fmt"
+
+	"github.com/snyk/driftctl/pkg/remote/aws/repository"
+	remoteerror "github.com/snyk/driftctl/pkg/remote/error"
+	"github.com/snyk/driftctl/pkg/resource"
+	"github.com/snyk/driftctl/pkg/resource/aws"
+)
+
+type EKSAddonEnumerator struct {
+	repository repository.EKSRepository
+	factory    resource.ResourceFactory
+}
+
+func NewEKSAddonEnumerator(repo repository.EKSRepository, factory resource.ResourceFactory) *EKSAddonEnumerator {
+	return &EKSAddonEnumerator{
+		repository: repo,
+		factory:    factory,
+	}
+}
+
+func (e *EKSAddonEnumerator) SupportedType() resource.ResourceType {
+	return aws.AwsEksAddonResourceType
+}
+
+func (e *EKSAddonEnumerator) Enumerate() ([]*resource.Resource, error) {
+	clusters, err := e.repository.ListAllClusters()
+	if err != nil {
+		return nil, remoteerror.NewResourceListingErrorWithType(err, string(e.SupportedType()), aws.AwsEksClusterResourceType)
+	}
+
+	results := make([]*resource.Resource, 0)
+
+	for _, cluster := range clusters {
+		addons, err := e.repository.ListAllAddons(*cluster)
+		if err != nil {
+			return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
+		}
+
+		for _, addon := range addons {
+			results = append(
+				results,
+				e.factory.CreateAbstractResource(
+					string(e.SupportedType()),
+					fmt.Sprintf("%s:%s", *cluster, *addon),
+					map[string]interface{}{
+						"cluster_name": *cluster,
+						"addon_name":   *addon,
+					},
+				),
+			)
+		}
+	}
+
+	return results, err
+}
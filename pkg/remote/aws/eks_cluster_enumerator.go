@@ -0,0 +1,48 @@
+package aws
+
+import (
+	"github.com/snyk/driftctl/pkg/remote/aws/repository"
+	remoteerror "github.com/snyk/driftctl/pkg/remote/error"
+	"github.com/snyk/driftctl/pkg/resource"
+	"github.com/snyk/driftctl/pkg/resource/aws"
+)
+
+type EKSClusterEnumerator struct {
+	repository repository.EKSRepository
+	factory    resource.ResourceFactory
+}
+
+func NewEKSClusterEnumerator(repo repository.EKSRepository, factory resource.ResourceFactory) *EKSClusterEnumerator {
+	return &EKSClusterEnumerator{
+		repository: repo,
+		factory:    factory,
+	}
+}
+
+func (e *EKSClusterEnumerator) SupportedType() resource.ResourceType {
+	return aws.AwsEksClusterResourceType
+}
+
+func (e *EKSClusterEnumerator) Enumerate() ([]*resource.Resource, error) {
+	clusters, err := e.repository.ListAllClusters()
+	if err != nil {
+		return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
+	}
+
+	results := make([]*resource.Resource, 0, len(clusters))
+
+	for _, cluster := range clusters {
+		results = append(
+			results,
+			e.factory.CreateAbstractResource(
+				string(e.SupportedType()),
+				*cluster,
+				map[string]interface{}{
+					"name": *cluster,
+				},
+			),
+		)
+	}
+
+	return results, err
+}
@@ -0,0 +1,58 @@
+package aws
+
+import (
+	"fmt"
+
+	"github.com/snyk/driftctl/pkg/remote/aws/repository"
+	remoteerror "github.com/snyk/driftctl/pkg/remote/error"
+	"github.com/snyk/driftctl/pkg/resource"
+	"github.com/snyk/driftctl/pkg/resource/aws"
+)
+
+type EKSNodeGroupEnumerator struct {
+	repository repository.EKSRepository
+	factory    resource.ResourceFactory
+}
+
+func NewEKSNodeGroupEnumerator(repo repository.EKSRepository, factory resource.ResourceFactory) *EKSNodeGroupEnumerator {
+	return &EKSNodeGroupEnumerator{
+		repository: repo,
+		factory:    factory,
+	}
+}
+
+func (e *EKSNodeGroupEnumerator) SupportedType() resource.ResourceType {
+	return aws.AwsEksNodeGroupResourceType
+}
+
+func (e *EKSNodeGroupEnumerator) Enumerate() ([]*resource.Resource, error) {
+	clusters, err := e.repository.ListAllClusters()
+	if err != nil {
+		return nil, remoteerror.NewResourceListingErrorWithType(err, string(e.SupportedType()), aws.AwsEksClusterResourceType)
+	}
+
+	results := make([]*resource.Resource, 0)
+
+	for _, cluster := range clusters {
+		nodeGroups, err := e.repository.ListAllNodeGroups(*cluster)
+		if err != nil {
+			return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
+		}
+
+		for _, nodeGroup := range nodeGroups {
+			results = append(
+				results,
+				e.factory.CreateAbstractResource(
+					string(e.SupportedType()),
+					fmt.Sprintf("%s:%s", *cluster, *nodeGroup),
+					map[string]interface{}{
+						"cluster_name":    *cluster,
+						"node_group_name": *nodeGroup,
+					},
+				),
+			)
+		}
+	}
+
+	return results, err
+}
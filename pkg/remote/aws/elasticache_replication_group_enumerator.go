@@ -0,0 +1,46 @@
+package aws
+
+import (
+	"github.com/snyk/driftctl/pkg/remote/aws/repository"
+	remoteerror "github.com/snyk/driftctl/pkg/remote/error"
+	"github.com/snyk/driftctl/pkg/resource"
+	"github.com/snyk/driftctl/pkg/resource/aws"
+)
+
+type ElastiCacheReplicationGroupEnumerator struct {
+	repository repository.ElastiCacheRepository
+	factory    resource.ResourceFactory
+}
+
+func NewElastiCacheReplicationGroupEnumerator(repo repository.ElastiCacheRepository, factory resource.ResourceFactory) *ElastiCacheReplicationGroupEnumerator {
+	return &ElastiCacheReplicationGroupEnumerator{
+		repository: repo,
+		factory:    factory,
+	}
+}
+
+func (e *ElastiCacheReplicationGroupEnumerator) SupportedType() resource.ResourceType {
+	return aws.AwsElasticacheReplicationGroupResourceType
+}
+
+func (e *ElastiCacheReplicationGroupEnumerator) Enumerate() ([]*resource.Resource, error) {
+	groups, err := e.repository.ListAllReplicationGroups()
+	if err != nil {
+		return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
+	}
+
+	results := make([]*resource.Resource, 0, len(groups))
+
+	for _, group := range groups {
+		results = append(
+			results,
+			e.factory.CreateAbstractResource(
+				string(e.SupportedType()),
+				*group.ReplicationGroupId,
+				map[string]interface{}{},
+			),
+		)
+	}
+
+	return results, err
+}
@@ -0,0 +1,48 @@
+package aws
+
+import (
+	"github.com/snyk/driftctl/pkg/remote/aws/repository"
+	remoteerror "github.com/snyk/driftctl/pkg/remote/error"
+	"github.com/snyk/driftctl/pkg/resource"
+	"github.com/snyk/driftctl/pkg/resource/aws"
+)
+
+type ElasticsearchDomainEnumerator struct {
+	repository repository.ElasticsearchRepository
+	factory    resource.ResourceFactory
+}
+
+func NewElasticsearchDomainEnumerator(repo repository.ElasticsearchRepository, factory resource.ResourceFactory) *ElasticsearchDomainEnumerator {
+	return &ElasticsearchDomainEnumerator{
+		repository: repo,
+		factory:    factory,
+	}
+}
+
+func (e *ElasticsearchDomainEnumerator) SupportedType() resource.ResourceType {
+	return aws.AwsElasticsearchDomainResourceType
+}
+
+func (e *ElasticsearchDomainEnumerator) Enumerate() ([]*resource.Resource, error) {
+	domains, err := e.repository.ListAllDomains()
+	if err != nil {
+		return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
+	}
+
+	results := make([]*resource.Resource, 0, len(domains))
+
+	for _, domain := range domains {
+		results = append(
+			results,
+			e.factory.CreateAbstractResource(
+				string(e.SupportedType()),
+				*domain.ARN,
+				map[string]interface{}{
+					"domain_name": *domain.DomainName,
+				},
+			),
+		)
+	}
+
+	return results, err
+}
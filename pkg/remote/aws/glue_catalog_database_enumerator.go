@@ -0,0 +1,52 @@
+package aws
+
+import (
+	"fmt"
+
+	"github.com/snyk/driftctl/pkg/remote/aws/repository"
+	remoteerror "github.com/snyk/driftctl/pkg/remote/error"
+	"github.com/snyk/driftctl/pkg/resource"
+	"github.com/snyk/driftctl/pkg/resource/aws"
+)
+
+type GlueCatalogDatabaseEnumerator struct {
+	repository repository.GlueRepository
+	factory    resource.ResourceFactory
+}
+
+func NewGlueCatalogDatabaseEnumerator(repo repository.GlueRepository, factory resource.ResourceFactory) *GlueCatalogDatabaseEnumerator {
+	return &GlueCatalogDatabaseEnumerator{
+		repository: repo,
+		factory:    factory,
+	}
+}
+
+func (e *GlueCatalogDatabaseEnumerator) SupportedType() resource.ResourceType {
+	return aws.AwsGlueCatalogDatabaseResourceType
+}
+
+func (e *GlueCatalogDatabaseEnumerator) Enumerate() ([]*resource.Resource, error) {
+	databases, err := e.repository.ListAllDatabases()
+	if err != nil {
+		return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
+	}
+
+	results := make([]*resource.Resource, 0, len(databases))
+
+	for _, database := range databases {
+		// The Terraform ID of a database is prefixed by the ID of its catalog
+		results = append(
+			results,
+			e.factory.CreateAbstractResource(
+				string(e.SupportedType()),
+				fmt.Sprintf("%s:%s", *database.CatalogId, *database.Name),
+				map[string]interface{}{
+					"catalog_id": *database.CatalogId,
+					"name":       *database.Name,
+				},
+			),
+		)
+	}
+
+	return results, err
+}
@@ -0,0 +1,46 @@
+package aws
+
+import (
+	"github.com/snyk/driftctl/pkg/remote/aws/repository"
+	remoteerror "github.com/snyk/driftctl/pkg/remote/error"
+	"github.com/snyk/driftctl/pkg/resource"
+	"github.com/snyk/driftctl/pkg/resource/aws"
+)
+
+type GlueCrawlerEnumerator struct {
+	repository repository.GlueRepository
+	factory    resource.ResourceFactory
+}
+
+func NewGlueCrawlerEnumerator(repo repository.GlueRepository, factory resource.ResourceFactory) *GlueCrawlerEnumerator {
+	return &GlueCrawlerEnumerator{
+		repository: repo,
+		factory:    factory,
+	}
+}
+
+func (e *GlueCrawlerEnumerator) SupportedType() resource.ResourceType {
+	return aws.AwsGlueCrawlerResourceType
+}
+
+func (e *GlueCrawlerEnumerator) Enumerate() ([]*resource.Resource, error) {
+	crawlers, err := e.repository.ListAllCrawlers()
+	if err != nil {
+		return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
+	}
+
+	results := make([]*resource.Resource, 0, len(crawlers))
+
+	for _, crawler := range crawlers {
+		results = append(
+			results,
+			e.factory.CreateAbstractResource(
+				string(e.SupportedType()),
+				*crawler.Name,
+				map[string]interface{}{},
+			),
+		)
+	}
+
+	return results, err
+}
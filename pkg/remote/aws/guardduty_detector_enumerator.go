@@ -0,0 +1,46 @@
+package aws
+
+import (
+	"github.com/snyk/driftctl/pkg/remote/aws/repository"
+	remoteerror "github.com/snyk/driftctl/pkg/remote/error"
+	"github.com/snyk/driftctl/pkg/resource"
+	"github.com/snyk/driftctl/pkg/resource/aws"
+)
+
+type GuardDutyDetectorEnumerator struct {
+	repository repository.GuardDutyRepository
+	factory    resource.ResourceFactory
+}
+
+func NewGuardDutyDetectorEnumerator(repo repository.GuardDutyRepository, factory resource.ResourceFactory) *GuardDutyDetectorEnumerator {
+	return &GuardDutyDetectorEnumerator{
+		repository: repo,
+		factory:    factory,
+	}
+}
+
+func (e *GuardDutyDetectorEnumerator) SupportedType() resource.ResourceType {
+	return aws.AwsGuarddutyDetectorResourceType
+}
+
+func (e *GuardDutyDetectorEnumerator) Enumerate() ([]*resource.Resource, error) {
+	detectorIds, err := e.repository.ListAllDetectors()
+	if err != nil {
+		return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
+	}
+
+	results := make([]*resource.Resource, 0, len(detectorIds))
+
+	for _, detectorId := range detectorIds {
+		results = append(
+			results,
+			e.factory.CreateAbstractResource(
+				string(e.SupportedType()),
+				*detectorId,
+				map[string]interface{}{},
+			),
+		)
+	}
+
+	return results, err
+}
@@ -1,6 +1,9 @@
 package aws
 
 import (
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials/stscreds"
+	"github.com/aws/aws-sdk-go/service/wafv2"
 	"github.com/snyk/driftctl/pkg/alerter"
 	"github.com/snyk/driftctl/pkg/output"
 	"github.com/snyk/driftctl/pkg/remote/aws/client"
@@ -23,7 +26,8 @@ func Init(version string, alerter *alerter.Alerter,
 	progress output.Progress,
 	resourceSchemaRepository *resource.SchemaRepository,
 	factory resource.ResourceFactory,
-	configDir string) error {
+	configDir string,
+	opts common.RemoteOptions) error {
 
 	provider, err := NewAWSTerraformProvider(version, progress, configDir)
 	if err != nil {
@@ -34,204 +38,366 @@ func Init(version string, alerter *alerter.Alerter,
 		return err
 	}
 
-	repositoryCache := cache.New(100)
-
-	s3Repository := repository.NewS3Repository(client.NewAWSClientFactory(provider.session), repositoryCache)
-	ec2repository := repository.NewEC2Repository(provider.session, repositoryCache)
-	elbv2Repository := repository.NewELBV2Repository(provider.session, repositoryCache)
-	route53repository := repository.NewRoute53Repository(provider.session, repositoryCache)
-	lambdaRepository := repository.NewLambdaRepository(provider.session, repositoryCache)
-	rdsRepository := repository.NewRDSRepository(provider.session, repositoryCache)
-	sqsRepository := repository.NewSQSRepository(provider.session, repositoryCache)
-	snsRepository := repository.NewSNSRepository(provider.session, repositoryCache)
-	cloudfrontRepository := repository.NewCloudfrontRepository(provider.session, repositoryCache)
-	dynamoDBRepository := repository.NewDynamoDBRepository(provider.session, repositoryCache)
-	ecrRepository := repository.NewECRRepository(provider.session, repositoryCache)
-	kmsRepository := repository.NewKMSRepository(provider.session, repositoryCache)
-	iamRepository := repository.NewIAMRepository(provider.session, repositoryCache)
-	cloudformationRepository := repository.NewCloudformationRepository(provider.session, repositoryCache)
-	apigatewayRepository := repository.NewApiGatewayRepository(provider.session, repositoryCache)
-	appAutoScalingRepository := repository.NewAppAutoScalingRepository(provider.session, repositoryCache)
-	apigatewayv2Repository := repository.NewApiGatewayV2Repository(provider.session, repositoryCache)
-	autoscalingRepository := repository.NewAutoScalingRepository(provider.session, repositoryCache)
+	regions, err := resolveRegions(provider.session, opts.AWSRegions)
+	if err != nil {
+		return err
+	}
 
 	deserializer := resource.NewDeserializer(factory)
 	providerLibrary.AddProvider(terraform.AWS, provider)
 
-	remoteLibrary.AddEnumerator(NewS3BucketEnumerator(s3Repository, factory, provider.Config, alerter))
-	remoteLibrary.AddDetailsFetcher(aws.AwsS3BucketResourceType, common.NewGenericDetailsFetcher(aws.AwsS3BucketResourceType, provider, deserializer))
-	remoteLibrary.AddEnumerator(NewS3BucketInventoryEnumerator(s3Repository, factory, provider.Config, alerter))
-	remoteLibrary.AddDetailsFetcher(aws.AwsS3BucketInventoryResourceType, common.NewGenericDetailsFetcher(aws.AwsS3BucketInventoryResourceType, provider, deserializer))
-	remoteLibrary.AddEnumerator(NewS3BucketNotificationEnumerator(s3Repository, factory, provider.Config, alerter))
-	remoteLibrary.AddDetailsFetcher(aws.AwsS3BucketNotificationResourceType, common.NewGenericDetailsFetcher(aws.AwsS3BucketNotificationResourceType, provider, deserializer))
-	remoteLibrary.AddEnumerator(NewS3BucketMetricsEnumerator(s3Repository, factory, provider.Config, alerter))
-	remoteLibrary.AddDetailsFetcher(aws.AwsS3BucketMetricResourceType, common.NewGenericDetailsFetcher(aws.AwsS3BucketMetricResourceType, provider, deserializer))
-	remoteLibrary.AddEnumerator(NewS3BucketPolicyEnumerator(s3Repository, factory, provider.Config, alerter))
-	remoteLibrary.AddDetailsFetcher(aws.AwsS3BucketPolicyResourceType, common.NewGenericDetailsFetcher(aws.AwsS3BucketPolicyResourceType, provider, deserializer))
-	remoteLibrary.AddEnumerator(NewS3BucketAnalyticEnumerator(s3Repository, factory, provider.Config, alerter))
-	remoteLibrary.AddDetailsFetcher(aws.AwsS3BucketAnalyticsConfigurationResourceType, common.NewGenericDetailsFetcher(aws.AwsS3BucketAnalyticsConfigurationResourceType, provider, deserializer))
-
-	remoteLibrary.AddEnumerator(NewEC2EbsVolumeEnumerator(ec2repository, factory))
-	remoteLibrary.AddDetailsFetcher(aws.AwsEbsVolumeResourceType, common.NewGenericDetailsFetcher(aws.AwsEbsVolumeResourceType, provider, deserializer))
-	remoteLibrary.AddEnumerator(NewEC2EbsSnapshotEnumerator(ec2repository, factory))
-	remoteLibrary.AddDetailsFetcher(aws.AwsEbsSnapshotResourceType, common.NewGenericDetailsFetcher(aws.AwsEbsSnapshotResourceType, provider, deserializer))
-	remoteLibrary.AddEnumerator(NewEC2EipEnumerator(ec2repository, factory))
-	remoteLibrary.AddDetailsFetcher(aws.AwsEipResourceType, common.NewGenericDetailsFetcher(aws.AwsEipResourceType, provider, deserializer))
-	remoteLibrary.AddEnumerator(NewEC2AmiEnumerator(ec2repository, factory))
-	remoteLibrary.AddDetailsFetcher(aws.AwsAmiResourceType, common.NewGenericDetailsFetcher(aws.AwsAmiResourceType, provider, deserializer))
-	remoteLibrary.AddEnumerator(NewEC2KeyPairEnumerator(ec2repository, factory))
-	remoteLibrary.AddDetailsFetcher(aws.AwsKeyPairResourceType, common.NewGenericDetailsFetcher(aws.AwsKeyPairResourceType, provider, deserializer))
-	remoteLibrary.AddEnumerator(NewEC2EipAssociationEnumerator(ec2repository, factory))
-	remoteLibrary.AddDetailsFetcher(aws.AwsEipAssociationResourceType, common.NewGenericDetailsFetcher(aws.AwsEipAssociationResourceType, provider, deserializer))
-	remoteLibrary.AddEnumerator(NewEC2InstanceEnumerator(ec2repository, factory))
-	remoteLibrary.AddDetailsFetcher(aws.AwsInstanceResourceType, common.NewGenericDetailsFetcher(aws.AwsInstanceResourceType, provider, deserializer))
-	remoteLibrary.AddEnumerator(NewEC2InternetGatewayEnumerator(ec2repository, factory))
-	remoteLibrary.AddDetailsFetcher(aws.AwsInternetGatewayResourceType, common.NewGenericDetailsFetcher(aws.AwsInternetGatewayResourceType, provider, deserializer))
-	remoteLibrary.AddEnumerator(NewVPCEnumerator(ec2repository, factory))
-	remoteLibrary.AddDetailsFetcher(aws.AwsVpcResourceType, common.NewGenericDetailsFetcher(aws.AwsVpcResourceType, provider, deserializer))
-	remoteLibrary.AddEnumerator(NewDefaultVPCEnumerator(ec2repository, factory))
-	remoteLibrary.AddDetailsFetcher(aws.AwsDefaultVpcResourceType, common.NewGenericDetailsFetcher(aws.AwsDefaultVpcResourceType, provider, deserializer))
-	remoteLibrary.AddEnumerator(NewEC2RouteTableEnumerator(ec2repository, factory))
-	remoteLibrary.AddDetailsFetcher(aws.AwsRouteTableResourceType, common.NewGenericDetailsFetcher(aws.AwsRouteTableResourceType, provider, deserializer))
-	remoteLibrary.AddEnumerator(NewEC2DefaultRouteTableEnumerator(ec2repository, factory))
-	remoteLibrary.AddDetailsFetcher(aws.AwsDefaultRouteTableResourceType, common.NewGenericDetailsFetcher(aws.AwsDefaultRouteTableResourceType, provider, deserializer))
-	remoteLibrary.AddEnumerator(NewEC2RouteTableAssociationEnumerator(ec2repository, factory))
-	remoteLibrary.AddDetailsFetcher(aws.AwsRouteTableAssociationResourceType, common.NewGenericDetailsFetcher(aws.AwsRouteTableAssociationResourceType, provider, deserializer))
-	remoteLibrary.AddEnumerator(NewEC2SubnetEnumerator(ec2repository, factory))
-	remoteLibrary.AddDetailsFetcher(aws.AwsSubnetResourceType, common.NewGenericDetailsFetcher(aws.AwsSubnetResourceType, provider, deserializer))
-	remoteLibrary.AddEnumerator(NewEC2DefaultSubnetEnumerator(ec2repository, factory))
-	remoteLibrary.AddDetailsFetcher(aws.AwsDefaultSubnetResourceType, common.NewGenericDetailsFetcher(aws.AwsDefaultSubnetResourceType, provider, deserializer))
-	remoteLibrary.AddEnumerator(NewVPCSecurityGroupEnumerator(ec2repository, factory))
-	remoteLibrary.AddDetailsFetcher(aws.AwsSecurityGroupResourceType, common.NewGenericDetailsFetcher(aws.AwsSecurityGroupResourceType, provider, deserializer))
-	remoteLibrary.AddEnumerator(NewVPCDefaultSecurityGroupEnumerator(ec2repository, factory))
-	remoteLibrary.AddDetailsFetcher(aws.AwsDefaultSecurityGroupResourceType, common.NewGenericDetailsFetcher(aws.AwsDefaultSecurityGroupResourceType, provider, deserializer))
-	remoteLibrary.AddEnumerator(NewEC2NatGatewayEnumerator(ec2repository, factory))
-	remoteLibrary.AddDetailsFetcher(aws.AwsNatGatewayResourceType, common.NewGenericDetailsFetcher(aws.AwsNatGatewayResourceType, provider, deserializer))
-	remoteLibrary.AddEnumerator(NewEC2NetworkACLEnumerator(ec2repository, factory))
-	remoteLibrary.AddDetailsFetcher(aws.AwsNetworkACLResourceType, common.NewGenericDetailsFetcher(aws.AwsNetworkACLResourceType, provider, deserializer))
-	remoteLibrary.AddEnumerator(NewEC2NetworkACLRuleEnumerator(ec2repository, factory))
-	remoteLibrary.AddDetailsFetcher(aws.AwsNetworkACLRuleResourceType, common.NewGenericDetailsFetcher(aws.AwsNetworkACLRuleResourceType, provider, deserializer))
-	remoteLibrary.AddEnumerator(NewEC2DefaultNetworkACLEnumerator(ec2repository, factory))
-	remoteLibrary.AddDetailsFetcher(aws.AwsDefaultNetworkACLResourceType, common.NewGenericDetailsFetcher(aws.AwsDefaultNetworkACLResourceType, provider, deserializer))
-	remoteLibrary.AddEnumerator(NewEC2RouteEnumerator(ec2repository, factory))
-	remoteLibrary.AddDetailsFetcher(aws.AwsRouteResourceType, common.NewGenericDetailsFetcher(aws.AwsRouteResourceType, provider, deserializer))
-	remoteLibrary.AddEnumerator(NewVPCSecurityGroupRuleEnumerator(ec2repository, factory))
-	remoteLibrary.AddDetailsFetcher(aws.AwsSecurityGroupRuleResourceType, common.NewGenericDetailsFetcher(aws.AwsSecurityGroupRuleResourceType, provider, deserializer))
-	remoteLibrary.AddEnumerator(NewLaunchTemplateEnumerator(ec2repository, factory))
-	remoteLibrary.AddDetailsFetcher(aws.AwsLaunchTemplateResourceType, common.NewGenericDetailsFetcher(aws.AwsLaunchTemplateResourceType, provider, deserializer))
-	remoteLibrary.AddEnumerator(NewEC2EbsEncryptionByDefaultEnumerator(ec2repository, factory))
-
-	remoteLibrary.AddEnumerator(NewKMSKeyEnumerator(kmsRepository, factory))
-	remoteLibrary.AddDetailsFetcher(aws.AwsKmsKeyResourceType, common.NewGenericDetailsFetcher(aws.AwsKmsKeyResourceType, provider, deserializer))
-	remoteLibrary.AddEnumerator(NewKMSAliasEnumerator(kmsRepository, factory))
-	remoteLibrary.AddDetailsFetcher(aws.AwsKmsAliasResourceType, common.NewGenericDetailsFetcher(aws.AwsKmsAliasResourceType, provider, deserializer))
-
-	remoteLibrary.AddEnumerator(NewRoute53HealthCheckEnumerator(route53repository, factory))
-	remoteLibrary.AddDetailsFetcher(aws.AwsRoute53HealthCheckResourceType, common.NewGenericDetailsFetcher(aws.AwsRoute53HealthCheckResourceType, provider, deserializer))
-	remoteLibrary.AddEnumerator(NewRoute53ZoneEnumerator(route53repository, factory))
-	remoteLibrary.AddDetailsFetcher(aws.AwsRoute53ZoneResourceType, common.NewGenericDetailsFetcher(aws.AwsRoute53ZoneResourceType, provider, deserializer))
-	remoteLibrary.AddEnumerator(NewRoute53RecordEnumerator(route53repository, factory))
-	remoteLibrary.AddDetailsFetcher(aws.AwsRoute53RecordResourceType, common.NewGenericDetailsFetcher(aws.AwsRoute53RecordResourceType, provider, deserializer))
-
-	remoteLibrary.AddEnumerator(NewCloudfrontDistributionEnumerator(cloudfrontRepository, factory))
-	remoteLibrary.AddDetailsFetcher(aws.AwsCloudfrontDistributionResourceType, common.NewGenericDetailsFetcher(aws.AwsCloudfrontDistributionResourceType, provider, deserializer))
-
-	remoteLibrary.AddEnumerator(NewRDSDBInstanceEnumerator(rdsRepository, factory))
-	remoteLibrary.AddDetailsFetcher(aws.AwsDbInstanceResourceType, common.NewGenericDetailsFetcher(aws.AwsDbInstanceResourceType, provider, deserializer))
-	remoteLibrary.AddEnumerator(NewRDSDBSubnetGroupEnumerator(rdsRepository, factory))
-	remoteLibrary.AddDetailsFetcher(aws.AwsDbSubnetGroupResourceType, common.NewGenericDetailsFetcher(aws.AwsDbSubnetGroupResourceType, provider, deserializer))
-
-	remoteLibrary.AddEnumerator(NewSQSQueueEnumerator(sqsRepository, factory))
-	remoteLibrary.AddDetailsFetcher(aws.AwsSqsQueueResourceType, NewSQSQueueDetailsFetcher(provider, deserializer))
-	remoteLibrary.AddEnumerator(NewSQSQueuePolicyEnumerator(sqsRepository, factory))
-	remoteLibrary.AddDetailsFetcher(aws.AwsSqsQueuePolicyResourceType, common.NewGenericDetailsFetcher(aws.AwsSqsQueuePolicyResourceType, provider, deserializer))
-
-	remoteLibrary.AddEnumerator(NewSNSTopicEnumerator(snsRepository, factory))
-	remoteLibrary.AddDetailsFetcher(aws.AwsSnsTopicResourceType, common.NewGenericDetailsFetcher(aws.AwsSnsTopicResourceType, provider, deserializer))
-	remoteLibrary.AddEnumerator(NewSNSTopicPolicyEnumerator(snsRepository, factory))
-	remoteLibrary.AddDetailsFetcher(aws.AwsSnsTopicPolicyResourceType, common.NewGenericDetailsFetcher(aws.AwsSnsTopicPolicyResourceType, provider, deserializer))
-	remoteLibrary.AddEnumerator(NewSNSTopicSubscriptionEnumerator(snsRepository, factory, alerter))
-	remoteLibrary.AddDetailsFetcher(aws.AwsSnsTopicSubscriptionResourceType, common.NewGenericDetailsFetcher(aws.AwsSnsTopicSubscriptionResourceType, provider, deserializer))
-
-	remoteLibrary.AddEnumerator(NewDynamoDBTableEnumerator(dynamoDBRepository, factory))
-	remoteLibrary.AddDetailsFetcher(aws.AwsDynamodbTableResourceType, common.NewGenericDetailsFetcher(aws.AwsDynamodbTableResourceType, provider, deserializer))
-
-	remoteLibrary.AddEnumerator(NewIamPolicyEnumerator(iamRepository, factory))
-	remoteLibrary.AddDetailsFetcher(aws.AwsIamPolicyResourceType, common.NewGenericDetailsFetcher(aws.AwsIamPolicyResourceType, provider, deserializer))
-
-	remoteLibrary.AddEnumerator(NewLambdaFunctionEnumerator(lambdaRepository, factory))
-	remoteLibrary.AddDetailsFetcher(aws.AwsLambdaFunctionResourceType, common.NewGenericDetailsFetcher(aws.AwsLambdaFunctionResourceType, provider, deserializer))
-	remoteLibrary.AddEnumerator(NewLambdaEventSourceMappingEnumerator(lambdaRepository, factory))
-	remoteLibrary.AddDetailsFetcher(aws.AwsLambdaEventSourceMappingResourceType, common.NewGenericDetailsFetcher(aws.AwsLambdaEventSourceMappingResourceType, provider, deserializer))
-
-	remoteLibrary.AddEnumerator(NewIamUserEnumerator(iamRepository, factory))
-	remoteLibrary.AddDetailsFetcher(aws.AwsIamUserResourceType, common.NewGenericDetailsFetcher(aws.AwsIamUserResourceType, provider, deserializer))
-	remoteLibrary.AddEnumerator(NewIamUserPolicyEnumerator(iamRepository, factory))
-	remoteLibrary.AddDetailsFetcher(aws.AwsIamUserPolicyResourceType, common.NewGenericDetailsFetcher(aws.AwsIamUserPolicyResourceType, provider, deserializer))
-	remoteLibrary.AddEnumerator(NewIamRoleEnumerator(iamRepository, factory))
-	remoteLibrary.AddDetailsFetcher(aws.AwsIamRoleResourceType, common.NewGenericDetailsFetcher(aws.AwsIamRoleResourceType, provider, deserializer))
-	remoteLibrary.AddEnumerator(NewIamAccessKeyEnumerator(iamRepository, factory))
-	remoteLibrary.AddDetailsFetcher(aws.AwsIamAccessKeyResourceType, common.NewGenericDetailsFetcher(aws.AwsIamAccessKeyResourceType, provider, deserializer))
-	remoteLibrary.AddEnumerator(NewIamRolePolicyAttachmentEnumerator(iamRepository, factory))
-	remoteLibrary.AddDetailsFetcher(aws.AwsIamRolePolicyAttachmentResourceType, common.NewGenericDetailsFetcher(aws.AwsIamRolePolicyAttachmentResourceType, provider, deserializer))
-	remoteLibrary.AddEnumerator(NewIamRolePolicyEnumerator(iamRepository, factory))
-	remoteLibrary.AddDetailsFetcher(aws.AwsIamRolePolicyResourceType, common.NewGenericDetailsFetcher(aws.AwsIamRolePolicyResourceType, provider, deserializer))
-	remoteLibrary.AddEnumerator(NewIamUserPolicyAttachmentEnumerator(iamRepository, factory))
-	remoteLibrary.AddDetailsFetcher(aws.AwsIamUserPolicyAttachmentResourceType, common.NewGenericDetailsFetcher(aws.AwsIamUserPolicyAttachmentResourceType, provider, deserializer))
-	remoteLibrary.AddEnumerator(NewIamGroupPolicyEnumerator(iamRepository, factory))
-
-	remoteLibrary.AddEnumerator(NewECRRepositoryEnumerator(ecrRepository, factory))
-	remoteLibrary.AddDetailsFetcher(aws.AwsEcrRepositoryResourceType, common.NewGenericDetailsFetcher(aws.AwsEcrRepositoryResourceType, provider, deserializer))
-
-	remoteLibrary.AddEnumerator(NewRDSClusterEnumerator(rdsRepository, factory))
-	remoteLibrary.AddDetailsFetcher(aws.AwsRDSClusterResourceType, common.NewGenericDetailsFetcher(aws.AwsRDSClusterResourceType, provider, deserializer))
-
-	remoteLibrary.AddEnumerator(NewCloudformationStackEnumerator(cloudformationRepository, factory))
-	remoteLibrary.AddDetailsFetcher(aws.AwsCloudformationStackResourceType, common.NewGenericDetailsFetcher(aws.AwsCloudformationStackResourceType, provider, deserializer))
-
-	remoteLibrary.AddEnumerator(NewApiGatewayRestApiEnumerator(apigatewayRepository, factory))
-	remoteLibrary.AddEnumerator(NewApiGatewayAccountEnumerator(apigatewayRepository, factory))
-	remoteLibrary.AddEnumerator(NewApiGatewayApiKeyEnumerator(apigatewayRepository, factory))
-	remoteLibrary.AddEnumerator(NewApiGatewayAuthorizerEnumerator(apigatewayRepository, factory))
-	remoteLibrary.AddEnumerator(NewApiGatewayStageEnumerator(apigatewayRepository, factory))
-	remoteLibrary.AddEnumerator(NewApiGatewayResourceEnumerator(apigatewayRepository, factory))
-	remoteLibrary.AddEnumerator(NewApiGatewayDomainNameEnumerator(apigatewayRepository, factory))
-	remoteLibrary.AddEnumerator(NewApiGatewayVpcLinkEnumerator(apigatewayRepository, factory))
-	remoteLibrary.AddEnumerator(NewApiGatewayRequestValidatorEnumerator(apigatewayRepository, factory))
-	remoteLibrary.AddEnumerator(NewApiGatewayRestApiPolicyEnumerator(apigatewayRepository, factory))
-	remoteLibrary.AddEnumerator(NewApiGatewayBasePathMappingEnumerator(apigatewayRepository, factory))
-	remoteLibrary.AddEnumerator(NewApiGatewayMethodEnumerator(apigatewayRepository, factory))
-	remoteLibrary.AddEnumerator(NewApiGatewayModelEnumerator(apigatewayRepository, factory))
-	remoteLibrary.AddEnumerator(NewApiGatewayMethodResponseEnumerator(apigatewayRepository, factory))
-	remoteLibrary.AddEnumerator(NewApiGatewayGatewayResponseEnumerator(apigatewayRepository, factory))
-	remoteLibrary.AddEnumerator(NewApiGatewayMethodSettingsEnumerator(apigatewayRepository, factory))
-	remoteLibrary.AddEnumerator(NewApiGatewayIntegrationEnumerator(apigatewayRepository, factory))
-	remoteLibrary.AddEnumerator(NewApiGatewayIntegrationResponseEnumerator(apigatewayRepository, factory))
-
-	remoteLibrary.AddEnumerator(NewApiGatewayV2ApiEnumerator(apigatewayv2Repository, factory))
-	remoteLibrary.AddEnumerator(NewApiGatewayV2RouteEnumerator(apigatewayv2Repository, factory))
-	remoteLibrary.AddEnumerator(NewApiGatewayV2DeploymentEnumerator(apigatewayv2Repository, factory))
-	remoteLibrary.AddEnumerator(NewApiGatewayV2VpcLinkEnumerator(apigatewayv2Repository, factory))
-	remoteLibrary.AddEnumerator(NewApiGatewayV2AuthorizerEnumerator(apigatewayv2Repository, factory))
-	remoteLibrary.AddEnumerator(NewApiGatewayV2IntegrationEnumerator(apigatewayv2Repository, factory))
-	remoteLibrary.AddEnumerator(NewApiGatewayV2ModelEnumerator(apigatewayv2Repository, factory))
-	remoteLibrary.AddEnumerator(NewApiGatewayV2StageEnumerator(apigatewayv2Repository, factory))
-	remoteLibrary.AddEnumerator(NewApiGatewayV2RouteResponseEnumerator(apigatewayv2Repository, factory))
-	remoteLibrary.AddEnumerator(NewApiGatewayV2MappingEnumerator(apigatewayv2Repository, apigatewayRepository, factory))
-	remoteLibrary.AddEnumerator(NewApiGatewayV2DomainNameEnumerator(apigatewayRepository, factory))
-	remoteLibrary.AddEnumerator(NewApiGatewayV2IntegrationResponseEnumerator(apigatewayv2Repository, factory))
-
-	remoteLibrary.AddEnumerator(NewAppAutoscalingTargetEnumerator(appAutoScalingRepository, factory))
-	remoteLibrary.AddDetailsFetcher(aws.AwsAppAutoscalingTargetResourceType, common.NewGenericDetailsFetcher(aws.AwsAppAutoscalingTargetResourceType, provider, deserializer))
-
-	remoteLibrary.AddEnumerator(NewAppAutoscalingPolicyEnumerator(appAutoScalingRepository, factory))
-	remoteLibrary.AddDetailsFetcher(aws.AwsAppAutoscalingPolicyResourceType, common.NewGenericDetailsFetcher(aws.AwsAppAutoscalingPolicyResourceType, provider, deserializer))
-
-	remoteLibrary.AddEnumerator(NewAppAutoscalingScheduledActionEnumerator(appAutoScalingRepository, factory))
-
-	remoteLibrary.AddEnumerator(NewLaunchConfigurationEnumerator(autoscalingRepository, factory))
-
-	remoteLibrary.AddEnumerator(NewLoadBalancerEnumerator(elbv2Repository, factory))
+	accounts, err := listAccounts(
+		repository.NewOrganizationsRepository(provider.session, cache.New(1)),
+		repository.NewSTSRepository(provider.session, cache.New(1)),
+		opts,
+	)
+	if err != nil {
+		return err
+	}
+
+	for _, account := range accounts {
+		accountSession := provider.session
+		if account.roleARN != "" {
+			accountSession = provider.session.Copy(&awssdk.Config{
+				Credentials: stscreds.NewCredentials(provider.session, account.roleARN),
+			})
+		}
+		// Resources are only attributed to their account when several accounts are scanned
+		scope := ""
+		if scansSeveralAccounts(opts) {
+			scope = account.id
+		}
+		provider.addAccount(scope, account.roleARN)
+		accountLibrary := common.NewScopedLibrary(remoteLibrary, scope, "")
+
+		// Global services are scanned once per account, whatever the number of scanned regions
+		globalCache := cache.New(100)
+		route53repository := repository.NewRoute53Repository(accountSession, globalCache)
+		cloudfrontRepository := repository.NewCloudfrontRepository(accountSession, globalCache)
+		iamRepository := repository.NewIAMRepository(accountSession, globalCache)
+		// Buckets are listed once then spread over the scanned regions by their enumerators
+		s3Repository := repository.NewS3Repository(client.NewAWSClientFactory(accountSession), globalCache)
+		s3ControlRepository := repository.NewS3ControlRepository(accountSession, globalCache)
+
+		accountLibrary.AddEnumerator(NewRoute53HealthCheckEnumerator(route53repository, factory))
+		accountLibrary.AddDetailsFetcher(aws.AwsRoute53HealthCheckResourceType, common.NewGenericDetailsFetcher(aws.AwsRoute53HealthCheckResourceType, provider, deserializer))
+		accountLibrary.AddEnumerator(NewRoute53ZoneEnumerator(route53repository, factory))
+		accountLibrary.AddDetailsFetcher(aws.AwsRoute53ZoneResourceType, common.NewGenericDetailsFetcher(aws.AwsRoute53ZoneResourceType, provider, deserializer))
+		accountLibrary.AddEnumerator(NewRoute53RecordEnumerator(route53repository, factory))
+		accountLibrary.AddDetailsFetcher(aws.AwsRoute53RecordResourceType, common.NewGenericDetailsFetcher(aws.AwsRoute53RecordResourceType, provider, deserializer))
+
+		accountLibrary.AddEnumerator(NewCloudfrontDistributionEnumerator(cloudfrontRepository, factory))
+		accountLibrary.AddDetailsFetcher(aws.AwsCloudfrontDistributionResourceType, common.NewGenericDetailsFetcher(aws.AwsCloudfrontDistributionResourceType, provider, deserializer))
+
+		accountLibrary.AddEnumerator(NewIamPolicyEnumerator(iamRepository, factory))
+		accountLibrary.AddDetailsFetcher(aws.AwsIamPolicyResourceType, common.NewGenericDetailsFetcher(aws.AwsIamPolicyResourceType, provider, deserializer))
+
+		accountLibrary.AddEnumerator(NewIamUserEnumerator(iamRepository, factory))
+		accountLibrary.AddDetailsFetcher(aws.AwsIamUserResourceType, common.NewGenericDetailsFetcher(aws.AwsIamUserResourceType, provider, deserializer))
+		accountLibrary.AddEnumerator(NewIamUserPolicyEnumerator(iamRepository, factory))
+		accountLibrary.AddDetailsFetcher(aws.AwsIamUserPolicyResourceType, common.NewGenericDetailsFetcher(aws.AwsIamUserPolicyResourceType, provider, deserializer))
+		accountLibrary.AddEnumerator(NewIamRoleEnumerator(iamRepository, factory))
+		accountLibrary.AddDetailsFetcher(aws.AwsIamRoleResourceType, common.NewGenericDetailsFetcher(aws.AwsIamRoleResourceType, provider, deserializer))
+		accountLibrary.AddEnumerator(NewIamAccessKeyEnumerator(iamRepository, factory))
+		accountLibrary.AddDetailsFetcher(aws.AwsIamAccessKeyResourceType, common.NewGenericDetailsFetcher(aws.AwsIamAccessKeyResourceType, provider, deserializer))
+		accountLibrary.AddEnumerator(NewIamRolePolicyAttachmentEnumerator(iamRepository, factory))
+		accountLibrary.AddDetailsFetcher(aws.AwsIamRolePolicyAttachmentResourceType, common.NewGenericDetailsFetcher(aws.AwsIamRolePolicyAttachmentResourceType, provider, deserializer))
+		accountLibrary.AddEnumerator(NewIamRolePolicyEnumerator(iamRepository, factory))
+		accountLibrary.AddDetailsFetcher(aws.AwsIamRolePolicyResourceType, common.NewGenericDetailsFetcher(aws.AwsIamRolePolicyResourceType, provider, deserializer))
+		accountLibrary.AddEnumerator(NewIamUserPolicyAttachmentEnumerator(iamRepository, factory))
+		accountLibrary.AddDetailsFetcher(aws.AwsIamUserPolicyAttachmentResourceType, common.NewGenericDetailsFetcher(aws.AwsIamUserPolicyAttachmentResourceType, provider, deserializer))
+		accountLibrary.AddEnumerator(NewIamGroupPolicyEnumerator(iamRepository, factory))
+
+		accountLibrary.AddEnumerator(NewS3AccountPublicAccessBlockEnumerator(s3ControlRepository, factory, account.id))
+
+		// Web ACLs attached to CloudFront distributions are global but can only be listed and read from us-east-1
+		cloudfrontWAFV2Repository := repository.NewWAFV2Repository(accountSession.Copy(&awssdk.Config{Region: awssdk.String("us-east-1")}), globalCache)
+		common.NewScopedLibrary(remoteLibrary, scope, "us-east-1").AddEnumerator(NewWAFV2WebACLEnumerator(cloudfrontWAFV2Repository, factory, wafv2.ScopeCloudfront))
+		accountLibrary.AddDetailsFetcher(aws.AwsWafv2WebAclResourceType, common.NewGenericDetailsFetcher(aws.AwsWafv2WebAclResourceType, provider, deserializer))
+
+		for _, region := range regions {
+			// Repositories cache keys are not scoped by region
+			repositoryCache := cache.New(100)
+			sess := accountSession.Copy(&awssdk.Config{Region: awssdk.String(region)})
+			providerConfig := provider.Config
+			providerConfig.DefaultAlias = region
+			regionalLibrary := common.NewScopedLibrary(remoteLibrary, scope, region)
+
+			ec2repository := repository.NewEC2Repository(sess, repositoryCache)
+			elbv2Repository := repository.NewELBV2Repository(sess, repositoryCache)
+			lambdaRepository := repository.NewLambdaRepository(sess, repositoryCache)
+			rdsRepository := repository.NewRDSRepository(sess, repositoryCache)
+			sqsRepository := repository.NewSQSRepository(sess, repositoryCache)
+			snsRepository := repository.NewSNSRepository(sess, repositoryCache)
+			dynamoDBRepository := repository.NewDynamoDBRepository(sess, repositoryCache)
+			ecrRepository := repository.NewECRRepository(sess, repositoryCache)
+			kmsRepository := repository.NewKMSRepository(sess, repositoryCache)
+			cloudformationRepository := repository.NewCloudformationRepository(sess, repositoryCache)
+			apigatewayRepository := repository.NewApiGatewayRepository(sess, repositoryCache)
+			appAutoScalingRepository := repository.NewAppAutoScalingRepository(sess, repositoryCache)
+			apigatewayv2Repository := repository.NewApiGatewayV2Repository(sess, repositoryCache)
+			autoscalingRepository := repository.NewAutoScalingRepository(sess, repositoryCache)
+			ecsRepository := repository.NewECSRepository(sess, repositoryCache)
+			eksRepository := repository.NewEKSRepository(sess, repositoryCache)
+			secretsManagerRepository := repository.NewSecretsManagerRepository(sess, repositoryCache)
+			ssmRepository := repository.NewSSMRepository(sess, repositoryCache)
+			cloudWatchRepository := repository.NewCloudWatchRepository(sess, repositoryCache)
+			cloudWatchLogsRepository := repository.NewCloudWatchLogsRepository(sess, repositoryCache)
+			cloudWatchEventsRepository := repository.NewCloudWatchEventsRepository(sess, repositoryCache)
+			elastiCacheRepository := repository.NewElastiCacheRepository(sess, repositoryCache)
+			efsRepository := repository.NewEFSRepository(sess, repositoryCache)
+			redshiftRepository := repository.NewRedshiftRepository(sess, repositoryCache)
+			elasticsearchRepository := repository.NewElasticsearchRepository(sess, repositoryCache)
+			kinesisRepository := repository.NewKinesisRepository(sess, repositoryCache)
+			wafv2Repository := repository.NewWAFV2Repository(sess, repositoryCache)
+			acmRepository := repository.NewACMRepository(sess, repositoryCache)
+			cloudtrailRepository := repository.NewCloudTrailRepository(sess, repositoryCache)
+			guarddutyRepository := repository.NewGuardDutyRepository(sess, repositoryCache)
+			sfnRepository := repository.NewSFNRepository(sess, repositoryCache)
+			cognitoRepository := repository.NewCognitoRepository(sess, repositoryCache)
+			glueRepository := repository.NewGlueRepository(sess, repositoryCache)
+
+			regionalLibrary.AddEnumerator(NewS3BucketEnumerator(s3Repository, factory, providerConfig, alerter))
+			regionalLibrary.AddDetailsFetcher(aws.AwsS3BucketResourceType, common.NewGenericDetailsFetcher(aws.AwsS3BucketResourceType, provider, deserializer))
+			regionalLibrary.AddEnumerator(NewS3BucketInventoryEnumerator(s3Repository, factory, providerConfig, alerter))
+			regionalLibrary.AddDetailsFetcher(aws.AwsS3BucketInventoryResourceType, common.NewGenericDetailsFetcher(aws.AwsS3BucketInventoryResourceType, provider, deserializer))
+			regionalLibrary.AddEnumerator(NewS3BucketNotificationEnumerator(s3Repository, factory, providerConfig, alerter))
+			regionalLibrary.AddDetailsFetcher(aws.AwsS3BucketNotificationResourceType, common.NewGenericDetailsFetcher(aws.AwsS3BucketNotificationResourceType, provider, deserializer))
+			regionalLibrary.AddEnumerator(NewS3BucketMetricsEnumerator(s3Repository, factory, providerConfig, alerter))
+			regionalLibrary.AddDetailsFetcher(aws.AwsS3BucketMetricResourceType, common.NewGenericDetailsFetcher(aws.AwsS3BucketMetricResourceType, provider, deserializer))
+			regionalLibrary.AddEnumerator(NewS3BucketPolicyEnumerator(s3Repository, factory, providerConfig, alerter))
+			regionalLibrary.AddDetailsFetcher(aws.AwsS3BucketPolicyResourceType, common.NewGenericDetailsFetcher(aws.AwsS3BucketPolicyResourceType, provider, deserializer))
+			regionalLibrary.AddEnumerator(NewS3BucketAnalyticEnumerator(s3Repository, factory, providerConfig, alerter))
+			regionalLibrary.AddDetailsFetcher(aws.AwsS3BucketAnalyticsConfigurationResourceType, common.NewGenericDetailsFetcher(aws.AwsS3BucketAnalyticsConfigurationResourceType, provider, deserializer))
+			regionalLibrary.AddEnumerator(NewS3BucketPublicAccessBlockEnumerator(s3Repository, factory, providerConfig, alerter))
+			regionalLibrary.AddDetailsFetcher(aws.AwsS3BucketPublicAccessBlockResourceType, common.NewGenericDetailsFetcher(aws.AwsS3BucketPublicAccessBlockResourceType, provider, deserializer))
+
+			regionalLibrary.AddEnumerator(NewEC2EbsVolumeEnumerator(ec2repository, factory))
+			regionalLibrary.AddDetailsFetcher(aws.AwsEbsVolumeResourceType, common.NewGenericDetailsFetcher(aws.AwsEbsVolumeResourceType, provider, deserializer))
+			regionalLibrary.AddEnumerator(NewEC2EbsSnapshotEnumerator(ec2repository, factory))
+			regionalLibrary.AddDetailsFetcher(aws.AwsEbsSnapshotResourceType, common.NewGenericDetailsFetcher(aws.AwsEbsSnapshotResourceType, provider, deserializer))
+			regionalLibrary.AddEnumerator(NewEC2EipEnumerator(ec2repository, factory))
+			regionalLibrary.AddDetailsFetcher(aws.AwsEipResourceType, common.NewGenericDetailsFetcher(aws.AwsEipResourceType, provider, deserializer))
+			regionalLibrary.AddEnumerator(NewEC2AmiEnumerator(ec2repository, factory))
+			regionalLibrary.AddDetailsFetcher(aws.AwsAmiResourceType, common.NewGenericDetailsFetcher(aws.AwsAmiResourceType, provider, deserializer))
+			regionalLibrary.AddEnumerator(NewEC2KeyPairEnumerator(ec2repository, factory))
+			regionalLibrary.AddDetailsFetcher(aws.AwsKeyPairResourceType, common.NewGenericDetailsFetcher(aws.AwsKeyPairResourceType, provider, deserializer))
+			regionalLibrary.AddEnumerator(NewEC2EipAssociationEnumerator(ec2repository, factory))
+			regionalLibrary.AddDetailsFetcher(aws.AwsEipAssociationResourceType, common.NewGenericDetailsFetcher(aws.AwsEipAssociationResourceType, provider, deserializer))
+			regionalLibrary.AddEnumerator(NewEC2InstanceEnumerator(ec2repository, factory))
+			regionalLibrary.AddDetailsFetcher(aws.AwsInstanceResourceType, common.NewGenericDetailsFetcher(aws.AwsInstanceResourceType, provider, deserializer))
+			regionalLibrary.AddEnumerator(NewEC2InternetGatewayEnumerator(ec2repository, factory))
+			regionalLibrary.AddDetailsFetcher(aws.AwsInternetGatewayResourceType, common.NewGenericDetailsFetcher(aws.AwsInternetGatewayResourceType, provider, deserializer))
+			regionalLibrary.AddEnumerator(NewVPCEnumerator(ec2repository, factory))
+			regionalLibrary.AddDetailsFetcher(aws.AwsVpcResourceType, common.NewGenericDetailsFetcher(aws.AwsVpcResourceType, provider, deserializer))
+			regionalLibrary.AddEnumerator(NewDefaultVPCEnumerator(ec2repository, factory))
+			regionalLibrary.AddDetailsFetcher(aws.AwsDefaultVpcResourceType, common.NewGenericDetailsFetcher(aws.AwsDefaultVpcResourceType, provider, deserializer))
+			regionalLibrary.AddEnumerator(NewEC2RouteTableEnumerator(ec2repository, factory))
+			regionalLibrary.AddDetailsFetcher(aws.AwsRouteTableResourceType, common.NewGenericDetailsFetcher(aws.AwsRouteTableResourceType, provider, deserializer))
+			regionalLibrary.AddEnumerator(NewEC2DefaultRouteTableEnumerator(ec2repository, factory))
+			regionalLibrary.AddDetailsFetcher(aws.AwsDefaultRouteTableResourceType, common.NewGenericDetailsFetcher(aws.AwsDefaultRouteTableResourceType, provider, deserializer))
+			regionalLibrary.AddEnumerator(NewEC2RouteTableAssociationEnumerator(ec2repository, factory))
+			regionalLibrary.AddDetailsFetcher(aws.AwsRouteTableAssociationResourceType, common.NewGenericDetailsFetcher(aws.AwsRouteTableAssociationResourceType, provider, deserializer))
+			regionalLibrary.AddEnumerator(NewEC2SubnetEnumerator(ec2repository, factory))
+			regionalLibrary.AddDetailsFetcher(aws.AwsSubnetResourceType, common.NewGenericDetailsFetcher(aws.AwsSubnetResourceType, provider, deserializer))
+			regionalLibrary.AddEnumerator(NewEC2DefaultSubnetEnumerator(ec2repository, factory))
+			regionalLibrary.AddDetailsFetcher(aws.AwsDefaultSubnetResourceType, common.NewGenericDetailsFetcher(aws.AwsDefaultSubnetResourceType, provider, deserializer))
+			regionalLibrary.AddEnumerator(NewVPCSecurityGroupEnumerator(ec2repository, factory))
+			regionalLibrary.AddDetailsFetcher(aws.AwsSecurityGroupResourceType, common.NewGenericDetailsFetcher(aws.AwsSecurityGroupResourceType, provider, deserializer))
+			regionalLibrary.AddEnumerator(NewVPCDefaultSecurityGroupEnumerator(ec2repository, factory))
+			regionalLibrary.AddDetailsFetcher(aws.AwsDefaultSecurityGroupResourceType, common.NewGenericDetailsFetcher(aws.AwsDefaultSecurityGroupResourceType, provider, deserializer))
+			regionalLibrary.AddEnumerator(NewEC2NatGatewayEnumerator(ec2repository, factory))
+			regionalLibrary.AddDetailsFetcher(aws.AwsNatGatewayResourceType, common.NewGenericDetailsFetcher(aws.AwsNatGatewayResourceType, provider, deserializer))
+			regionalLibrary.AddEnumerator(NewEC2NetworkACLEnumerator(ec2repository, factory))
+			regionalLibrary.AddDetailsFetcher(aws.AwsNetworkACLResourceType, common.NewGenericDetailsFetcher(aws.AwsNetworkACLResourceType, provider, deserializer))
+			regionalLibrary.AddEnumerator(NewEC2NetworkACLRuleEnumerator(ec2repository, factory))
+			regionalLibrary.AddDetailsFetcher(aws.AwsNetworkACLRuleResourceType, common.NewGenericDetailsFetcher(aws.AwsNetworkACLRuleResourceType, provider, deserializer))
+			regionalLibrary.AddEnumerator(NewEC2DefaultNetworkACLEnumerator(ec2repository, factory))
+			regionalLibrary.AddDetailsFetcher(aws.AwsDefaultNetworkACLResourceType, common.NewGenericDetailsFetcher(aws.AwsDefaultNetworkACLResourceType, provider, deserializer))
+			regionalLibrary.AddEnumerator(NewEC2RouteEnumerator(ec2repository, factory))
+			regionalLibrary.AddDetailsFetcher(aws.AwsRouteResourceType, common.NewGenericDetailsFetcher(aws.AwsRouteResourceType, provider, deserializer))
+			regionalLibrary.AddEnumerator(NewVPCSecurityGroupRuleEnumerator(ec2repository, factory))
+			regionalLibrary.AddDetailsFetcher(aws.AwsSecurityGroupRuleResourceType, common.NewGenericDetailsFetcher(aws.AwsSecurityGroupRuleResourceType, provider, deserializer))
+			regionalLibrary.AddEnumerator(NewLaunchTemplateEnumerator(ec2repository, factory))
+			regionalLibrary.AddDetailsFetcher(aws.AwsLaunchTemplateResourceType, common.NewGenericDetailsFetcher(aws.AwsLaunchTemplateResourceType, provider, deserializer))
+			regionalLibrary.AddEnumerator(NewEC2EbsEncryptionByDefaultEnumerator(ec2repository, factory))
+			regionalLibrary.AddEnumerator(NewEC2VpcEndpointEnumerator(ec2repository, factory))
+			regionalLibrary.AddDetailsFetcher(aws.AwsVpcEndpointResourceType, common.NewGenericDetailsFetcher(aws.AwsVpcEndpointResourceType, provider, deserializer))
+			regionalLibrary.AddEnumerator(NewEC2VpcPeeringConnectionEnumerator(ec2repository, factory))
+			regionalLibrary.AddDetailsFetcher(aws.AwsVpcPeeringConnectionResourceType, common.NewGenericDetailsFetcher(aws.AwsVpcPeeringConnectionResourceType, provider, deserializer))
+			regionalLibrary.AddEnumerator(NewEC2TransitGatewayEnumerator(ec2repository, factory))
+			regionalLibrary.AddDetailsFetcher(aws.AwsEc2TransitGatewayResourceType, common.NewGenericDetailsFetcher(aws.AwsEc2TransitGatewayResourceType, provider, deserializer))
+			regionalLibrary.AddEnumerator(NewEC2TransitGatewayVpcAttachmentEnumerator(ec2repository, factory))
+			regionalLibrary.AddDetailsFetcher(aws.AwsEc2TransitGatewayVpcAttachmentResourceType, common.NewGenericDetailsFetcher(aws.AwsEc2TransitGatewayVpcAttachmentResourceType, provider, deserializer))
+			regionalLibrary.AddEnumerator(NewEC2NetworkInterfaceEnumerator(ec2repository, factory))
+			regionalLibrary.AddDetailsFetcher(aws.AwsNetworkInterfaceResourceType, common.NewGenericDetailsFetcher(aws.AwsNetworkInterfaceResourceType, provider, deserializer))
+			regionalLibrary.AddEnumerator(NewEC2FlowLogEnumerator(ec2repository, factory))
+			regionalLibrary.AddDetailsFetcher(aws.AwsFlowLogResourceType, common.NewGenericDetailsFetcher(aws.AwsFlowLogResourceType, provider, deserializer))
+
+			regionalLibrary.AddEnumerator(NewKMSKeyEnumerator(kmsRepository, factory))
+			regionalLibrary.AddDetailsFetcher(aws.AwsKmsKeyResourceType, common.NewGenericDetailsFetcher(aws.AwsKmsKeyResourceType, provider, deserializer))
+			regionalLibrary.AddEnumerator(NewKMSAliasEnumerator(kmsRepository, factory))
+			regionalLibrary.AddDetailsFetcher(aws.AwsKmsAliasResourceType, common.NewGenericDetailsFetcher(aws.AwsKmsAliasResourceType, provider, deserializer))
+			regionalLibrary.AddEnumerator(NewKMSGrantEnumerator(kmsRepository, factory))
+			regionalLibrary.AddDetailsFetcher(aws.AwsKmsGrantResourceType, common.NewGenericDetailsFetcher(aws.AwsKmsGrantResourceType, provider, deserializer))
+
+			regionalLibrary.AddEnumerator(NewSecretsManagerSecretEnumerator(secretsManagerRepository, factory))
+			regionalLibrary.AddDetailsFetcher(aws.AwsSecretsManagerSecretResourceType, common.NewGenericDetailsFetcher(aws.AwsSecretsManagerSecretResourceType, provider, deserializer))
+			regionalLibrary.AddEnumerator(NewSecretsManagerSecretPolicyEnumerator(secretsManagerRepository, factory))
+			regionalLibrary.AddDetailsFetcher(aws.AwsSecretsManagerSecretPolicyResourceType, common.NewGenericDetailsFetcher(aws.AwsSecretsManagerSecretPolicyResourceType, provider, deserializer))
+
+			// SSM parameters are never read by the provider as it would decrypt their values
+			regionalLibrary.AddEnumerator(NewSSMParameterEnumerator(ssmRepository, factory))
+
+			regionalLibrary.AddEnumerator(NewCloudWatchMetricAlarmEnumerator(cloudWatchRepository, factory))
+			regionalLibrary.AddDetailsFetcher(aws.AwsCloudwatchMetricAlarmResourceType, common.NewGenericDetailsFetcher(aws.AwsCloudwatchMetricAlarmResourceType, provider, deserializer))
+			regionalLibrary.AddEnumerator(NewCloudWatchLogGroupEnumerator(cloudWatchLogsRepository, factory))
+			regionalLibrary.AddDetailsFetcher(aws.AwsCloudwatchLogGroupResourceType, common.NewGenericDetailsFetcher(aws.AwsCloudwatchLogGroupResourceType, provider, deserializer))
+			regionalLibrary.AddEnumerator(NewCloudWatchLogMetricFilterEnumerator(cloudWatchLogsRepository, factory))
+			regionalLibrary.AddDetailsFetcher(aws.AwsCloudwatchLogMetricFilterResourceType, common.NewGenericDetailsFetcher(aws.AwsCloudwatchLogMetricFilterResourceType, provider, deserializer))
+			regionalLibrary.AddEnumerator(NewCloudWatchEventRuleEnumerator(cloudWatchEventsRepository, factory))
+			regionalLibrary.AddDetailsFetcher(aws.AwsCloudwatchEventRuleResourceType, common.NewGenericDetailsFetcher(aws.AwsCloudwatchEventRuleResourceType, provider, deserializer))
+			regionalLibrary.AddEnumerator(NewCloudWatchEventTargetEnumerator(cloudWatchEventsRepository, factory))
+			regionalLibrary.AddDetailsFetcher(aws.AwsCloudwatchEventTargetResourceType, common.NewGenericDetailsFetcher(aws.AwsCloudwatchEventTargetResourceType, provider, deserializer))
+
+			regionalLibrary.AddEnumerator(NewElastiCacheClusterEnumerator(elastiCacheRepository, factory))
+			regionalLibrary.AddDetailsFetcher(aws.AwsElasticacheClusterResourceType, common.NewGenericDetailsFetcher(aws.AwsElasticacheClusterResourceType, provider, deserializer))
+			regionalLibrary.AddEnumerator(NewElastiCacheReplicationGroupEnumerator(elastiCacheRepository, factory))
+			regionalLibrary.AddDetailsFetcher(aws.AwsElasticacheReplicationGroupResourceType, common.NewGenericDetailsFetcher(aws.AwsElasticacheReplicationGroupResourceType, provider, deserializer))
+
+			regionalLibrary.AddEnumerator(NewEFSFileSystemEnumerator(efsRepository, factory))
+			regionalLibrary.AddDetailsFetcher(aws.AwsEfsFileSystemResourceType, common.NewGenericDetailsFetcher(aws.AwsEfsFileSystemResourceType, provider, deserializer))
+			regionalLibrary.AddEnumerator(NewEFSMountTargetEnumerator(efsRepository, factory))
+			regionalLibrary.AddDetailsFetcher(aws.AwsEfsMountTargetResourceType, common.NewGenericDetailsFetcher(aws.AwsEfsMountTargetResourceType, provider, deserializer))
+
+			regionalLibrary.AddEnumerator(NewRedshiftClusterEnumerator(redshiftRepository, factory))
+			regionalLibrary.AddDetailsFetcher(aws.AwsRedshiftClusterResourceType, common.NewGenericDetailsFetcher(aws.AwsRedshiftClusterResourceType, provider, deserializer))
+
+			regionalLibrary.AddEnumerator(NewElasticsearchDomainEnumerator(elasticsearchRepository, factory))
+			regionalLibrary.AddDetailsFetcher(aws.AwsElasticsearchDomainResourceType, common.NewGenericDetailsFetcher(aws.AwsElasticsearchDomainResourceType, provider, deserializer))
+
+			regionalLibrary.AddEnumerator(NewKinesisStreamEnumerator(kinesisRepository, factory))
+			regionalLibrary.AddDetailsFetcher(aws.AwsKinesisStreamResourceType, common.NewGenericDetailsFetcher(aws.AwsKinesisStreamResourceType, provider, deserializer))
+
+			regionalLibrary.AddEnumerator(NewWAFV2WebACLEnumerator(wafv2Repository, factory, wafv2.ScopeRegional))
+
+			regionalLibrary.AddEnumerator(NewACMCertificateEnumerator(acmRepository, factory))
+			regionalLibrary.AddDetailsFetcher(aws.AwsAcmCertificateResourceType, common.NewGenericDetailsFetcher(aws.AwsAcmCertificateResourceType, provider, deserializer))
+
+			regionalLibrary.AddEnumerator(NewCloudTrailEnumerator(cloudtrailRepository, factory))
+			regionalLibrary.AddDetailsFetcher(aws.AwsCloudtrailResourceType, common.NewGenericDetailsFetcher(aws.AwsCloudtrailResourceType, provider, deserializer))
+
+			regionalLibrary.AddEnumerator(NewGuardDutyDetectorEnumerator(guarddutyRepository, factory))
+			regionalLibrary.AddDetailsFetcher(aws.AwsGuarddutyDetectorResourceType, common.NewGenericDetailsFetcher(aws.AwsGuarddutyDetectorResourceType, provider, deserializer))
+
+			regionalLibrary.AddEnumerator(NewSFNStateMachineEnumerator(sfnRepository, factory))
+			regionalLibrary.AddDetailsFetcher(aws.AwsSfnStateMachineResourceType, common.NewGenericDetailsFetcher(aws.AwsSfnStateMachineResourceType, provider, deserializer))
+
+			regionalLibrary.AddEnumerator(NewCognitoUserPoolEnumerator(cognitoRepository, factory))
+			regionalLibrary.AddDetailsFetcher(aws.AwsCognitoUserPoolResourceType, common.NewGenericDetailsFetcher(aws.AwsCognitoUserPoolResourceType, provider, deserializer))
+			regionalLibrary.AddEnumerator(NewCognitoUserPoolClientEnumerator(cognitoRepository, factory))
+			regionalLibrary.AddDetailsFetcher(aws.AwsCognitoUserPoolClientResourceType, common.NewGenericDetailsFetcher(aws.AwsCognitoUserPoolClientResourceType, provider, deserializer))
+
+			regionalLibrary.AddEnumerator(NewGlueJobEnumerator(glueRepository, factory))
+			regionalLibrary.AddDetailsFetcher(aws.AwsGlueJobResourceType, common.NewGenericDetailsFetcher(aws.AwsGlueJobResourceType, provider, deserializer))
+			regionalLibrary.AddEnumerator(NewGlueCatalogDatabaseEnumerator(glueRepository, factory))
+			regionalLibrary.AddDetailsFetcher(aws.AwsGlueCatalogDatabaseResourceType, common.NewGenericDetailsFetcher(aws.AwsGlueCatalogDatabaseResourceType, provider, deserializer))
+			regionalLibrary.AddEnumerator(NewGlueCrawlerEnumerator(glueRepository, factory))
+			regionalLibrary.AddDetailsFetcher(aws.AwsGlueCrawlerResourceType, common.NewGenericDetailsFetcher(aws.AwsGlueCrawlerResourceType, provider, deserializer))
+
+			regionalLibrary.AddEnumerator(NewRDSDBInstanceEnumerator(rdsRepository, factory))
+			regionalLibrary.AddDetailsFetcher(aws.AwsDbInstanceResourceType, common.NewGenericDetailsFetcher(aws.AwsDbInstanceResourceType, provider, deserializer))
+			regionalLibrary.AddEnumerator(NewRDSDBSubnetGroupEnumerator(rdsRepository, factory))
+			regionalLibrary.AddDetailsFetcher(aws.AwsDbSubnetGroupResourceType, common.NewGenericDetailsFetcher(aws.AwsDbSubnetGroupResourceType, provider, deserializer))
+
+			regionalLibrary.AddEnumerator(NewSQSQueueEnumerator(sqsRepository, factory))
+			regionalLibrary.AddDetailsFetcher(aws.AwsSqsQueueResourceType, NewSQSQueueDetailsFetcher(provider, deserializer))
+			regionalLibrary.AddEnumerator(NewSQSQueuePolicyEnumerator(sqsRepository, factory))
+			regionalLibrary.AddDetailsFetcher(aws.AwsSqsQueuePolicyResourceType, common.NewGenericDetailsFetcher(aws.AwsSqsQueuePolicyResourceType, provider, deserializer))
+
+			regionalLibrary.AddEnumerator(NewSNSTopicEnumerator(snsRepository, factory))
+			regionalLibrary.AddDetailsFetcher(aws.AwsSnsTopicResourceType, common.NewGenericDetailsFetcher(aws.AwsSnsTopicResourceType, provider, deserializer))
+			regionalLibrary.AddEnumerator(NewSNSTopicPolicyEnumerator(snsRepository, factory))
+			regionalLibrary.AddDetailsFetcher(aws.AwsSnsTopicPolicyResourceType, common.NewGenericDetailsFetcher(aws.AwsSnsTopicPolicyResourceType, provider, deserializer))
+			regionalLibrary.AddEnumerator(NewSNSTopicSubscriptionEnumerator(snsRepository, factory, alerter))
+			regionalLibrary.AddDetailsFetcher(aws.AwsSnsTopicSubscriptionResourceType, common.NewGenericDetailsFetcher(aws.AwsSnsTopicSubscriptionResourceType, provider, deserializer))
+
+			regionalLibrary.AddEnumerator(NewDynamoDBTableEnumerator(dynamoDBRepository, factory))
+			regionalLibrary.AddDetailsFetcher(aws.AwsDynamodbTableResourceType, common.NewGenericDetailsFetcher(aws.AwsDynamodbTableResourceType, provider, deserializer))
+
+			regionalLibrary.AddEnumerator(NewLambdaFunctionEnumerator(lambdaRepository, factory))
+			regionalLibrary.AddDetailsFetcher(aws.AwsLambdaFunctionResourceType, common.NewGenericDetailsFetcher(aws.AwsLambdaFunctionResourceType, provider, deserializer))
+			regionalLibrary.AddEnumerator(NewLambdaEventSourceMappingEnumerator(lambdaRepository, factory))
+			regionalLibrary.AddDetailsFetcher(aws.AwsLambdaEventSourceMappingResourceType, common.NewGenericDetailsFetcher(aws.AwsLambdaEventSourceMappingResourceType, provider, deserializer))
+
+			regionalLibrary.AddEnumerator(NewECRRepositoryEnumerator(ecrRepository, factory))
+			regionalLibrary.AddDetailsFetcher(aws.AwsEcrRepositoryResourceType, common.NewGenericDetailsFetcher(aws.AwsEcrRepositoryResourceType, provider, deserializer))
+
+			regionalLibrary.AddEnumerator(NewECSClusterEnumerator(ecsRepository, factory))
+			regionalLibrary.AddDetailsFetcher(aws.AwsEcsClusterResourceType, common.NewGenericDetailsFetcher(aws.AwsEcsClusterResourceType, provider, deserializer))
+			regionalLibrary.AddEnumerator(NewECSServiceEnumerator(ecsRepository, factory))
+			regionalLibrary.AddDetailsFetcher(aws.AwsEcsServiceResourceType, common.NewGenericDetailsFetcher(aws.AwsEcsServiceResourceType, provider, deserializer))
+			regionalLibrary.AddEnumerator(NewECSTaskDefinitionEnumerator(ecsRepository, factory))
+			regionalLibrary.AddDetailsFetcher(aws.AwsEcsTaskDefinitionResourceType, common.NewGenericDetailsFetcher(aws.AwsEcsTaskDefinitionResourceType, provider, deserializer))
+
+			regionalLibrary.AddEnumerator(NewEKSClusterEnumerator(eksRepository, factory))
+			regionalLibrary.AddDetailsFetcher(aws.AwsEksClusterResourceType, common.NewGenericDetailsFetcher(aws.AwsEksClusterResourceType, provider, deserializer))
+			regionalLibrary.AddEnumerator(NewEKSNodeGroupEnumerator(eksRepository, factory))
+			regionalLibrary.AddDetailsFetcher(aws.AwsEksNodeGroupResourceType, common.NewGenericDetailsFetcher(aws.AwsEksNodeGroupResourceType, provider, deserializer))
+			regionalLibrary.AddEnumerator(NewEKSAddonEnumerator(eksRepository, factory))
+			regionalLibrary.AddDetailsFetcher(aws.AwsEksAddonResourceType, common.NewGenericDetailsFetcher(aws.AwsEksAddonResourceType, provider, deserializer))
+
+			regionalLibrary.AddEnumerator(NewRDSClusterEnumerator(rdsRepository, factory))
+			regionalLibrary.AddDetailsFetcher(aws.AwsRDSClusterResourceType, common.NewGenericDetailsFetcher(aws.AwsRDSClusterResourceType, provider, deserializer))
+
+			regionalLibrary.AddEnumerator(NewCloudformationStackEnumerator(cloudformationRepository, factory))
+			regionalLibrary.AddDetailsFetcher(aws.AwsCloudformationStackResourceType, common.NewGenericDetailsFetcher(aws.AwsCloudformationStackResourceType, provider, deserializer))
+
+			regionalLibrary.AddEnumerator(NewApiGatewayRestApiEnumerator(apigatewayRepository, factory))
+			regionalLibrary.AddEnumerator(NewApiGatewayAccountEnumerator(apigatewayRepository, factory))
+			regionalLibrary.AddEnumerator(NewApiGatewayApiKeyEnumerator(apigatewayRepository, factory))
+			regionalLibrary.AddEnumerator(NewApiGatewayAuthorizerEnumerator(apigatewayRepository, factory))
+			regionalLibrary.AddEnumerator(NewApiGatewayStageEnumerator(apigatewayRepository, factory))
+			regionalLibrary.AddEnumerator(NewApiGatewayResourceEnumerator(apigatewayRepository, factory))
+			regionalLibrary.AddEnumerator(NewApiGatewayDomainNameEnumerator(apigatewayRepository, factory))
+			regionalLibrary.AddEnumerator(NewApiGatewayVpcLinkEnumerator(apigatewayRepository, factory))
+			regionalLibrary.AddEnumerator(NewApiGatewayRequestValidatorEnumerator(apigatewayRepository, factory))
+			regionalLibrary.AddEnumerator(NewApiGatewayRestApiPolicyEnumerator(apigatewayRepository, factory))
+			regionalLibrary.AddEnumerator(NewApiGatewayBasePathMappingEnumerator(apigatewayRepository, factory))
+			regionalLibrary.AddEnumerator(NewApiGatewayMethodEnumerator(apigatewayRepository, factory))
+			regionalLibrary.AddEnumerator(NewApiGatewayModelEnumerator(apigatewayRepository, factory))
+			regionalLibrary.AddEnumerator(NewApiGatewayMethodResponseEnumerator(apigatewayRepository, factory))
+			regionalLibrary.AddEnumerator(NewApiGatewayGatewayResponseEnumerator(apigatewayRepository, factory))
+			regionalLibrary.AddEnumerator(NewApiGatewayMethodSettingsEnumerator(apigatewayRepository, factory))
+			regionalLibrary.AddEnumerator(NewApiGatewayIntegrationEnumerator(apigatewayRepository, factory))
+			regionalLibrary.AddEnumerator(NewApiGatewayIntegrationResponseEnumerator(apigatewayRepository, factory))
+
+			regionalLibrary.AddEnumerator(NewApiGatewayV2ApiEnumerator(apigatewayv2Repository, factory))
+			regionalLibrary.AddEnumerator(NewApiGatewayV2RouteEnumerator(apigatewayv2Repository, factory))
+			regionalLibrary.AddEnumerator(NewApiGatewayV2DeploymentEnumerator(apigatewayv2Repository, factory))
+			regionalLibrary.AddEnumerator(NewApiGatewayV2VpcLinkEnumerator(apigatewayv2Repository, factory))
+			regionalLibrary.AddEnumerator(NewApiGatewayV2AuthorizerEnumerator(apigatewayv2Repository, factory))
+			regionalLibrary.AddEnumerator(NewApiGatewayV2IntegrationEnumerator(apigatewayv2Repository, factory))
+			regionalLibrary.AddEnumerator(NewApiGatewayV2ModelEnumerator(apigatewayv2Repository, factory))
+			regionalLibrary.AddEnumerator(NewApiGatewayV2StageEnumerator(apigatewayv2Repository, factory))
+			regionalLibrary.AddEnumerator(NewApiGatewayV2RouteResponseEnumerator(apigatewayv2Repository, factory))
+			regionalLibrary.AddEnumerator(NewApiGatewayV2MappingEnumerator(apigatewayv2Repository, apigatewayRepository, factory))
+			regionalLibrary.AddEnumerator(NewApiGatewayV2DomainNameEnumerator(apigatewayRepository, factory))
+			regionalLibrary.AddEnumerator(NewApiGatewayV2IntegrationResponseEnumerator(apigatewayv2Repository, factory))
+
+			regionalLibrary.AddEnumerator(NewAppAutoscalingTargetEnumerator(appAutoScalingRepository, factory))
+			regionalLibrary.AddDetailsFetcher(aws.AwsAppAutoscalingTargetResourceType, common.NewGenericDetailsFetcher(aws.AwsAppAutoscalingTargetResourceType, provider, deserializer))
+
+			regionalLibrary.AddEnumerator(NewAppAutoscalingPolicyEnumerator(appAutoScalingRepository, factory))
+			regionalLibrary.AddDetailsFetcher(aws.AwsAppAutoscalingPolicyResourceType, common.NewGenericDetailsFetcher(aws.AwsAppAutoscalingPolicyResourceType, provider, deserializer))
+
+			regionalLibrary.AddEnumerator(NewAppAutoscalingScheduledActionEnumerator(appAutoScalingRepository, factory))
+
+			regionalLibrary.AddEnumerator(NewLaunchConfigurationEnumerator(autoscalingRepository, factory))
+
+			regionalLibrary.AddEnumerator(NewLoadBalancerEnumerator(elbv2Repository, factory))
+		}
+	}
 
 	err = resourceSchemaRepository.Init(terraform.AWS, provider.Version(), provider.Schema())
 	if err != nil {
@@ -0,0 +1,48 @@
+package aws
+
+import (
+	"github.com/snyk/driftctl/pkg/remote/aws/repository"
+	remoteerror "github.com/snyk/driftctl/pkg/remote/error"
+	"github.com/snyk/driftctl/pkg/resource"
+	"github.com/snyk/driftctl/pkg/resource/aws"
+)
+
+type KinesisStreamEnumerator struct {
+	repository repository.KinesisRepository
+	factory    resource.ResourceFactory
+}
+
+func NewKinesisStreamEnumerator(repo repository.KinesisRepository, factory resource.ResourceFactory) *KinesisStreamEnumerator {
+	return &KinesisStreamEnumerator{
+		repository: repo,
+		factory:    factory,
+	}
+}
+
+func (e *KinesisStreamEnumerator) SupportedType() resource.ResourceType {
+	return aws.AwsKinesisStreamResourceType
+}
+
+func (e *KinesisStreamEnumerator) Enumerate() ([]*resource.Resource, error) {
+	streams, err := e.repository.ListAllStreams()
+	if err != nil {
+		return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
+	}
+
+	results := make([]*resource.Resource, 0, len(streams))
+
+	for _, stream := range streams {
+		results = append(
+			results,
+			e.factory.CreateAbstractResource(
+				string(e.SupportedType()),
+				*stream.StreamARN,
+				map[string]interface{}{
+					"name": *stream.StreamName,
+				},
+			),
+		)
+	}
+
+	return results, err
+}
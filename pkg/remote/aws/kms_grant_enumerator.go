@@ -0,0 +1,62 @@
+package aws
+
+import (
+	"fmt"
+
+	"github.com/snyk/driftctl/pkg/remote/aws/repository"
+	remoteerror "github.com/snyk/driftctl/pkg/remote/error"
+	"github.com/snyk/driftctl/pkg/resource"
+	"github.com/snyk/driftctl/pkg/resource/aws"
+)
+
+type KMSGrantEnumerator struct {
+	repository repository.KMSRepository
+	factory    resource.ResourceFactory
+}
+
+func NewKMSGrantEnumerator(repo repository.KMSRepository, factory resource.ResourceFactory) *KMSGrantEnumerator {
+	return &KMSGrantEnumerator{
+		repository: repo,
+		factory:    factory,
+	}
+}
+
+func (e *KMSGrantEnumerator) SupportedType() resource.ResourceType {
+	return aws.AwsKmsGrantResourceType
+}
+
+func (e *KMSGrantEnumerator) Enumerate() ([]*resource.Resource, error) {
+	keys, err := e.repository.ListAllKeys()
+	if err != nil {
+		return nil, remoteerror.NewResourceListingErrorWithType(err, string(e.SupportedType()), aws.AwsKmsKeyResourceType)
+	}
+
+	results := make([]*resource.Resource, 0)
+
+	for _, key := range keys {
+		grants, err := e.repository.ListAllGrants(*key.KeyId)
+		if err != nil {
+			return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
+		}
+
+		for _, grant := range grants {
+			attrs := map[string]interface{}{
+				"key_id":   *key.KeyId,
+				"grant_id": *grant.GrantId,
+			}
+			if grant.Name != nil {
+				attrs["name"] = *grant.Name
+			}
+			results = append(
+				results,
+				e.factory.CreateAbstractResource(
+					string(e.SupportedType()),
+					fmt.Sprintf("%s:%s", *key.KeyId, *grant.GrantId),
+					attrs,
+				),
+			)
+		}
+	}
+
+	return results, err
+}
@@ -1,8 +1,11 @@
 package aws
 
 import (
+	"strings"
+
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/snyk/driftctl/pkg/output"
+	"github.com/snyk/driftctl/pkg/remote/common"
 	"github.com/snyk/driftctl/pkg/remote/terraform"
 	tf "github.com/snyk/driftctl/pkg/terraform"
 )
@@ -20,6 +23,7 @@ type awsConfig struct {
 	AssumeRoleExternalID  string
 	AssumeRoleSessionName string
 	AssumeRolePolicy      string
+	AssumeRole            []awsAssumeRoleConfig `cty:"assume_role"`
 
 	AllowedAccountIds   []string
 	ForbiddenAccountIds []string
@@ -36,11 +40,18 @@ type awsConfig struct {
 	S3ForcePathStyle        bool
 }
 
+type awsAssumeRoleConfig struct {
+	RoleARN     string `cty:"role_arn"`
+	SessionName string `cty:"session_name"`
+}
+
 type AWSTerraformProvider struct {
 	*terraform.TerraformProvider
 	session *session.Session
 	name    string
 	version string
+	// roles assumed to read resources of other accounts, by account id
+	roles map[string]string
 }
 
 func NewAWSTerraformProvider(version string, progress output.Progress, configDir string) (*AWSTerraformProvider, error) {
@@ -50,6 +61,7 @@ func NewAWSTerraformProvider(version string, progress output.Progress, configDir
 	p := &AWSTerraformProvider{
 		version: version,
 		name:    "aws",
+		roles:   map[string]string{},
 	}
 	installer, err := tf.NewProviderInstaller(tf.ProviderConfig{
 		Key:       p.name,
@@ -66,10 +78,7 @@ func NewAWSTerraformProvider(version string, progress output.Progress, configDir
 		Name:         p.name,
 		DefaultAlias: *p.session.Config.Region,
 		GetProviderConfig: func(alias string) interface{} {
-			return awsConfig{
-				Region:     alias,
-				MaxRetries: 10, // TODO make this configurable
-			}
+			return p.providerConfig(alias)
 		},
 	}, progress)
 	if err != nil {
@@ -86,3 +95,28 @@ func (a *AWSTerraformProvider) Name() string {
 func (p *AWSTerraformProvider) Version() string {
 	return p.version
 }
+
+// addAccount makes resources of an account readable through aliases like 123456789012/eu-west-1
+func (p *AWSTerraformProvider) addAccount(id, roleARN string) {
+	p.roles[id] = roleARN
+}
+
+func (p *AWSTerraformProvider) providerConfig(alias string) awsConfig {
+	config := awsConfig{
+		Region:     alias,
+		MaxRetries: 10, // TODO make this configurable
+	}
+	parts := strings.SplitN(alias, common.AliasAccountSeparator, 2)
+	if len(parts) != 2 {
+		return config
+	}
+	config.Region = parts[1]
+	// Global resources of an account are read from the default region
+	if config.Region == "" {
+		config.Region = p.Config.DefaultAlias
+	}
+	if roleARN := p.roles[parts[0]]; roleARN != "" {
+		config.AssumeRole = []awsAssumeRoleConfig{{RoleARN: roleARN, SessionName: "driftctl"}}
+	}
+	return config
+}
@@ -0,0 +1,59 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/snyk/driftctl/pkg/remote/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAWSTerraformProvider_providerConfig(t *testing.T) {
+	p := &AWSTerraformProvider{
+		TerraformProvider: &terraform.TerraformProvider{
+			Config: terraform.TerraformProviderConfig{DefaultAlias: "eu-west-3"},
+		},
+		roles: map[string]string{},
+	}
+	p.addAccount("111111111111", "")
+	p.addAccount("222222222222", "arn:aws:iam::222222222222:role/driftctl")
+
+	tests := []struct {
+		name  string
+		alias string
+		want  awsConfig
+	}{
+		{
+			name:  "region",
+			alias: "us-east-1",
+			want:  awsConfig{Region: "us-east-1", MaxRetries: 10},
+		},
+		{
+			name:  "region of the current account",
+			alias: "111111111111/us-east-1",
+			want:  awsConfig{Region: "us-east-1", MaxRetries: 10},
+		},
+		{
+			name:  "region of an assumed account",
+			alias: "222222222222/us-east-1",
+			want: awsConfig{
+				Region:     "us-east-1",
+				MaxRetries: 10,
+				AssumeRole: []awsAssumeRoleConfig{{RoleARN: "arn:aws:iam::222222222222:role/driftctl", SessionName: "driftctl"}},
+			},
+		},
+		{
+			name:  "global resources of an assumed account",
+			alias: "222222222222/",
+			want: awsConfig{
+				Region:     "eu-west-3",
+				MaxRetries: 10,
+				AssumeRole: []awsAssumeRoleConfig{{RoleARN: "arn:aws:iam::222222222222:role/driftctl", SessionName: "driftctl"}},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, p.providerConfig(tt.alias))
+		})
+	}
+}
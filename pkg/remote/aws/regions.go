@@ -0,0 +1,51 @@
+package aws
+
+import (
+	"sort"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
+	"github.com/pkg/errors"
+)
+
+// AllRegions scans every region enabled in the account
+const AllRegions = "all"
+
+func resolveRegions(sess *session.Session, regions []string) ([]string, error) {
+	return listRegions(ec2.New(sess), awssdk.StringValue(sess.Config.Region), regions)
+}
+
+func listRegions(client ec2iface.EC2API, defaultRegion string, regions []string) ([]string, error) {
+	if len(regions) == 0 {
+		return []string{defaultRegion}, nil
+	}
+
+	for _, region := range regions {
+		if region != AllRegions {
+			continue
+		}
+		output, err := client.DescribeRegions(&ec2.DescribeRegionsInput{})
+		if err != nil {
+			return nil, errors.Errorf("unable to list enabled AWS regions: %s", err)
+		}
+		result := make([]string, 0, len(output.Regions))
+		for _, r := range output.Regions {
+			result = append(result, awssdk.StringValue(r.RegionName))
+		}
+		sort.Strings(result)
+		return result, nil
+	}
+
+	result := make([]string, 0, len(regions))
+	seen := make(map[string]struct{}, len(regions))
+	for _, region := range regions {
+		if _, exists := seen[region]; exists {
+			continue
+		}
+		seen[region] = struct{}{}
+		result = append(result, region)
+	}
+	return result, nil
+}
@@ -0,0 +1,69 @@
+package aws
+
+import (
+	"errors"
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	awstest "github.com/snyk/driftctl/test/aws"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListRegions(t *testing.T) {
+	tests := []struct {
+		name    string
+		regions []string
+		mocks   func(client *awstest.MockFakeEC2)
+		want    []string
+		wantErr string
+	}{
+		{
+			name: "default region",
+			want: []string{"eu-west-3"},
+		},
+		{
+			name:    "given regions",
+			regions: []string{"us-east-1", "eu-west-1", "us-east-1"},
+			want:    []string{"us-east-1", "eu-west-1"},
+		},
+		{
+			name:    "all regions",
+			regions: []string{"us-east-1", AllRegions},
+			mocks: func(client *awstest.MockFakeEC2) {
+				client.On("DescribeRegions", &ec2.DescribeRegionsInput{}).Return(&ec2.DescribeRegionsOutput{
+					Regions: []*ec2.Region{
+						{RegionName: awssdk.String("us-east-1")},
+						{RegionName: awssdk.String("eu-west-3")},
+						{RegionName: awssdk.String("ap-south-1")},
+					},
+				}, nil).Once()
+			},
+			want: []string{"ap-south-1", "eu-west-3", "us-east-1"},
+		},
+		{
+			name:    "all regions with error",
+			regions: []string{AllRegions},
+			mocks: func(client *awstest.MockFakeEC2) {
+				client.On("DescribeRegions", &ec2.DescribeRegionsInput{}).Return(nil, errors.New("UnauthorizedOperation")).Once()
+			},
+			wantErr: "unable to list enabled AWS regions: UnauthorizedOperation",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := &awstest.MockFakeEC2{}
+			if tt.mocks != nil {
+				tt.mocks(client)
+			}
+			got, err := listRegions(client, "eu-west-3", tt.regions)
+			client.AssertExpectations(t)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,49 @@
+package repository
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/acm"
+	"github.com/aws/aws-sdk-go/service/acm/acmiface"
+	"github.com/snyk/driftctl/pkg/remote/cache"
+)
+
+type ACMRepository interface {
+	ListAllCertificates() ([]*acm.CertificateSummary, error)
+}
+
+type acmRepository struct {
+	client acmiface.ACMAPI
+	cache  cache.Cache
+}
+
+func NewACMRepository(session *session.Session, c cache.Cache) *acmRepository {
+	return &acmRepository{
+		acm.New(session),
+		c,
+	}
+}
+
+func (r *acmRepository) ListAllCertificates() ([]*acm.CertificateSummary, error) {
+	if v := r.cache.Get("acmListAllCertificates"); v != nil {
+		return v.([]*acm.CertificateSummary), nil
+	}
+
+	var certificates []*acm.CertificateSummary
+	input := &acm.ListCertificatesInput{
+		// Only RSA 2048 certificates are listed unless other key types are requested
+		Includes: &acm.Filters{
+			KeyTypes: aws.StringSlice(acm.KeyAlgorithm_Values()),
+		},
+	}
+	err := r.client.ListCertificatesPages(input, func(res *acm.ListCertificatesOutput, lastPage bool) bool {
+		certificates = append(certificates, res.CertificateSummaryList...)
+		return !lastPage
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r.cache.Put("acmListAllCertificates", certificates)
+	return certificates, nil
+}
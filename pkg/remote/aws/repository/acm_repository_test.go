@@ -0,0 +1,97 @@
+package repository
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/acm"
+	"github.com/r3labs/diff/v2"
+	"github.com/snyk/driftctl/pkg/remote/cache"
+	awstest "github.com/snyk/driftctl/test/aws"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_acmRepository_ListAllCertificates(t *testing.T) {
+	tests := []struct {
+		name    string
+		mocks   func(client *awstest.MockFakeACM)
+		want    []*acm.CertificateSummary
+		wantErr error
+	}{
+		{
+			name: "List certificates of every key type with 2 pages",
+			mocks: func(client *awstest.MockFakeACM) {
+				client.On("ListCertificatesPages",
+					&acm.ListCertificatesInput{
+						Includes: &acm.Filters{
+							KeyTypes: aws.StringSlice(acm.KeyAlgorithm_Values()),
+						},
+					},
+					mock.MatchedBy(func(callback func(res *acm.ListCertificatesOutput, lastPage bool) bool) bool {
+						callback(&acm.ListCertificatesOutput{
+							CertificateSummaryList: []*acm.CertificateSummary{
+								{CertificateArn: aws.String("arn:aws:acm:us-east-1:123456789012:certificate/0a1b2c3d"), DomainName: aws.String("example.com")},
+							},
+						}, false)
+						callback(&acm.ListCertificatesOutput{
+							CertificateSummaryList: []*acm.CertificateSummary{
+								{CertificateArn: aws.String("arn:aws:acm:us-east-1:123456789012:certificate/1b2c3d4e"), DomainName: aws.String("api.example.com")},
+							},
+						}, true)
+						return true
+					})).Return(nil).Once()
+			},
+			want: []*acm.CertificateSummary{
+				{CertificateArn: aws.String("arn:aws:acm:us-east-1:123456789012:certificate/0a1b2c3d"), DomainName: aws.String("example.com")},
+				{CertificateArn: aws.String("arn:aws:acm:us-east-1:123456789012:certificate/1b2c3d4e"), DomainName: aws.String("api.example.com")},
+			},
+		},
+		{
+			name: "should return remote error",
+			mocks: func(client *awstest.MockFakeACM) {
+				client.On("ListCertificatesPages",
+					&acm.ListCertificatesInput{
+						Includes: &acm.Filters{
+							KeyTypes: aws.StringSlice(acm.KeyAlgorithm_Values()),
+						},
+					},
+					mock.Anything).Return(errors.New("remote error")).Once()
+			},
+			wantErr: errors.New("remote error"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := cache.New(1)
+			client := awstest.MockFakeACM{}
+			tt.mocks(&client)
+			r := &acmRepository{
+				client: &client,
+				cache:  store,
+			}
+			got, err := r.ListAllCertificates()
+			assert.Equal(t, tt.wantErr, err)
+
+			if err == nil {
+				// Check that results were cached
+				cachedData, err := r.ListAllCertificates()
+				assert.NoError(t, err)
+				assert.Equal(t, got, cachedData)
+				assert.IsType(t, []*acm.CertificateSummary{}, store.Get("acmListAllCertificates"))
+			}
+
+			changelog, err := diff.Diff(got, tt.want)
+			assert.Nil(t, err)
+			if len(changelog) > 0 {
+				for _, change := range changelog {
+					t.Errorf("%s: %v -> %v", strings.Join(change.Path, "."), change.From, change.To)
+				}
+				t.Fail()
+			}
+			client.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,43 @@
+package repository
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/cloudtrail"
+	"github.com/aws/aws-sdk-go/service/cloudtrail/cloudtrailiface"
+	"github.com/snyk/driftctl/pkg/remote/cache"
+)
+
+type CloudTrailRepository interface {
+	ListAllTrails() ([]*cloudtrail.Trail, error)
+}
+
+type cloudTrailRepository struct {
+	client cloudtrailiface.CloudTrailAPI
+	cache  cache.Cache
+}
+
+func NewCloudTrailRepository(session *session.Session, c cache.Cache) *cloudTrailRepository {
+	return &cloudTrailRepository{
+		cloudtrail.New(session),
+		c,
+	}
+}
+
+// ListAllTrails returns the trails created in the current region,
+// multi-region trails are only listed in their home region
+func (r *cloudTrailRepository) ListAllTrails() ([]*cloudtrail.Trail, error) {
+	if v := r.cache.Get("cloudtrailListAllTrails"); v != nil {
+		return v.([]*cloudtrail.Trail), nil
+	}
+
+	res, err := r.client.DescribeTrails(&cloudtrail.DescribeTrailsInput{
+		IncludeShadowTrails: aws.Bool(false),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r.cache.Put("cloudtrailListAllTrails", res.TrailList)
+	return res.TrailList, nil
+}
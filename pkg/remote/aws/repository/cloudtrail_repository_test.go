@@ -0,0 +1,81 @@
+package repository
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudtrail"
+	"github.com/r3labs/diff/v2"
+	"github.com/snyk/driftctl/pkg/remote/cache"
+	awstest "github.com/snyk/driftctl/test/aws"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_cloudTrailRepository_ListAllTrails(t *testing.T) {
+	tests := []struct {
+		name    string
+		mocks   func(client *awstest.MockFakeCloudTrail)
+		want    []*cloudtrail.Trail
+		wantErr error
+	}{
+		{
+			name: "List trails without shadow trails",
+			mocks: func(client *awstest.MockFakeCloudTrail) {
+				client.On("DescribeTrails", &cloudtrail.DescribeTrailsInput{
+					IncludeShadowTrails: aws.Bool(false),
+				}).Return(&cloudtrail.DescribeTrailsOutput{
+					TrailList: []*cloudtrail.Trail{
+						{Name: aws.String("audit"), HomeRegion: aws.String("us-east-1")},
+						{Name: aws.String("data-events"), HomeRegion: aws.String("us-east-1")},
+					},
+				}, nil).Once()
+			},
+			want: []*cloudtrail.Trail{
+				{Name: aws.String("audit"), HomeRegion: aws.String("us-east-1")},
+				{Name: aws.String("data-events"), HomeRegion: aws.String("us-east-1")},
+			},
+		},
+		{
+			name: "should return remote error",
+			mocks: func(client *awstest.MockFakeCloudTrail) {
+				client.On("DescribeTrails", &cloudtrail.DescribeTrailsInput{
+					IncludeShadowTrails: aws.Bool(false),
+				}).Return(nil, errors.New("remote error")).Once()
+			},
+			wantErr: errors.New("remote error"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := cache.New(1)
+			client := awstest.MockFakeCloudTrail{}
+			tt.mocks(&client)
+			r := &cloudTrailRepository{
+				client: &client,
+				cache:  store,
+			}
+			got, err := r.ListAllTrails()
+			assert.Equal(t, tt.wantErr, err)
+
+			if err == nil {
+				// Check that results were cached
+				cachedData, err := r.ListAllTrails()
+				assert.NoError(t, err)
+				assert.Equal(t, got, cachedData)
+				assert.IsType(t, []*cloudtrail.Trail{}, store.Get("cloudtrailListAllTrails"))
+			}
+
+			changelog, err := diff.Diff(got, tt.want)
+			assert.Nil(t, err)
+			if len(changelog) > 0 {
+				for _, change := range changelog {
+					t.Errorf("%s: %v -> %v", strings.Join(change.Path, "."), change.From, change.To)
+				}
+				t.Fail()
+			}
+			client.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,46 @@
+package repository
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/aws/aws-sdk-go/service/cloudwatch/cloudwatchiface"
+	"github.com/snyk/driftctl/pkg/remote/cache"
+)
+
+type CloudWatchRepository interface {
+	ListAllMetricAlarms() ([]*cloudwatch.MetricAlarm, error)
+}
+
+type cloudWatchRepository struct {
+	client cloudwatchiface.CloudWatchAPI
+	cache  cache.Cache
+}
+
+func NewCloudWatchRepository(session *session.Session, c cache.Cache) *cloudWatchRepository {
+	return &cloudWatchRepository{
+		cloudwatch.New(session),
+		c,
+	}
+}
+
+func (r *cloudWatchRepository) ListAllMetricAlarms() ([]*cloudwatch.MetricAlarm, error) {
+	if v := r.cache.Get("cloudwatchListAllMetricAlarms"); v != nil {
+		return v.([]*cloudwatch.MetricAlarm), nil
+	}
+
+	var alarms []*cloudwatch.MetricAlarm
+	input := &cloudwatch.DescribeAlarmsInput{
+		AlarmTypes: []*string{aws.String(cloudwatch.AlarmTypeMetricAlarm)},
+	}
+	err := r.client.DescribeAlarmsPages(input, func(res *cloudwatch.DescribeAlarmsOutput, lastPage bool) bool {
+		alarms = append(alarms, res.MetricAlarms...)
+		return !lastPage
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r.cache.Put("cloudwatchListAllMetricAlarms", alarms)
+	return alarms, nil
+}
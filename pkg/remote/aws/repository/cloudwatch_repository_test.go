@@ -0,0 +1,94 @@
+package repository
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/r3labs/diff/v2"
+	"github.com/snyk/driftctl/pkg/remote/cache"
+	awstest "github.com/snyk/driftctl/test/aws"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_cloudWatchRepository_ListAllMetricAlarms(t *testing.T) {
+	tests := []struct {
+		name    string
+		mocks   func(client *awstest.MockFakeCloudWatch)
+		want    []*cloudwatch.MetricAlarm
+		wantErr error
+	}{
+		{
+			name: "List with 2 pages",
+			mocks: func(client *awstest.MockFakeCloudWatch) {
+				client.On("DescribeAlarmsPages",
+					&cloudwatch.DescribeAlarmsInput{
+						AlarmTypes: []*string{aws.String(cloudwatch.AlarmTypeMetricAlarm)},
+					},
+					mock.MatchedBy(func(callback func(res *cloudwatch.DescribeAlarmsOutput, lastPage bool) bool) bool {
+						callback(&cloudwatch.DescribeAlarmsOutput{
+							MetricAlarms: []*cloudwatch.MetricAlarm{
+								{AlarmName: aws.String("cpu-high")},
+								{AlarmName: aws.String("cpu-low")},
+							},
+						}, false)
+						callback(&cloudwatch.DescribeAlarmsOutput{
+							MetricAlarms: []*cloudwatch.MetricAlarm{
+								{AlarmName: aws.String("disk-full")},
+							},
+						}, true)
+						return true
+					})).Return(nil).Once()
+			},
+			want: []*cloudwatch.MetricAlarm{
+				{AlarmName: aws.String("cpu-high")},
+				{AlarmName: aws.String("cpu-low")},
+				{AlarmName: aws.String("disk-full")},
+			},
+		},
+		{
+			name: "should return remote error",
+			mocks: func(client *awstest.MockFakeCloudWatch) {
+				client.On("DescribeAlarmsPages",
+					&cloudwatch.DescribeAlarmsInput{
+						AlarmTypes: []*string{aws.String(cloudwatch.AlarmTypeMetricAlarm)},
+					},
+					mock.AnythingOfType("func(*cloudwatch.DescribeAlarmsOutput, bool) bool")).Return(errors.New("remote error")).Once()
+			},
+			wantErr: errors.New("remote error"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := cache.New(1)
+			client := awstest.MockFakeCloudWatch{}
+			tt.mocks(&client)
+			r := &cloudWatchRepository{
+				client: &client,
+				cache:  store,
+			}
+			got, err := r.ListAllMetricAlarms()
+			assert.Equal(t, tt.wantErr, err)
+
+			if err == nil {
+				// Check that results were cached
+				cachedData, err := r.ListAllMetricAlarms()
+				assert.NoError(t, err)
+				assert.Equal(t, got, cachedData)
+				assert.IsType(t, []*cloudwatch.MetricAlarm{}, store.Get("cloudwatchListAllMetricAlarms"))
+			}
+
+			changelog, err := diff.Diff(got, tt.want)
+			assert.Nil(t, err)
+			if len(changelog) > 0 {
+				for _, change := range changelog {
+					t.Errorf("%s: %v -> %v", strings.Join(change.Path, "."), change.From, change.To)
+				}
+				t.Fail()
+			}
+		})
+	}
+}
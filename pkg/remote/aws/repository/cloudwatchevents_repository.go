@@ -0,0 +1,83 @@
+package repository
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/cloudwatchevents"
+	"github.com/aws/aws-sdk-go/service/cloudwatchevents/cloudwatcheventsiface"
+	"github.com/snyk/driftctl/pkg/remote/cache"
+)
+
+type CloudWatchEventsRepository interface {
+	ListAllRules() ([]*cloudwatchevents.Rule, error)
+	ListAllTargets(ruleName string) ([]*cloudwatchevents.Target, error)
+}
+
+type cloudWatchEventsRepository struct {
+	client cloudwatcheventsiface.CloudWatchEventsAPI
+	cache  cache.Cache
+}
+
+func NewCloudWatchEventsRepository(session *session.Session, c cache.Cache) *cloudWatchEventsRepository {
+	return &cloudWatchEventsRepository{
+		cloudwatchevents.New(session),
+		c,
+	}
+}
+
+// ListAllRules returns the rules of the default event bus, the SDK does not provide paginators for events APIs
+func (r *cloudWatchEventsRepository) ListAllRules() ([]*cloudwatchevents.Rule, error) {
+	cacheKey := "cloudwatcheventsListAllRules"
+	v := r.cache.GetAndLock(cacheKey)
+	defer r.cache.Unlock(cacheKey)
+	if v != nil {
+		return v.([]*cloudwatchevents.Rule), nil
+	}
+
+	var rules []*cloudwatchevents.Rule
+	var nextToken *string
+	for {
+		res, err := r.client.ListRules(&cloudwatchevents.ListRulesInput{
+			NextToken: nextToken,
+		})
+		if err != nil {
+			return nil, err
+		}
+		rules = append(rules, res.Rules...)
+		if res.NextToken == nil {
+			break
+		}
+		nextToken = res.NextToken
+	}
+
+	r.cache.Put(cacheKey, rules)
+	return rules, nil
+}
+
+func (r *cloudWatchEventsRepository) ListAllTargets(ruleName string) ([]*cloudwatchevents.Target, error) {
+	cacheKey := fmt.Sprintf("cloudwatcheventsListAllTargets_%s", ruleName)
+	if v := r.cache.Get(cacheKey); v != nil {
+		return v.([]*cloudwatchevents.Target), nil
+	}
+
+	var targets []*cloudwatchevents.Target
+	var nextToken *string
+	for {
+		res, err := r.client.ListTargetsByRule(&cloudwatchevents.ListTargetsByRuleInput{
+			Rule:      &ruleName,
+			NextToken: nextToken,
+		})
+		if err != nil {
+			return nil, err
+		}
+		targets = append(targets, res.Targets...)
+		if res.NextToken == nil {
+			break
+		}
+		nextToken = res.NextToken
+	}
+
+	r.cache.Put(cacheKey, targets)
+	return targets, nil
+}
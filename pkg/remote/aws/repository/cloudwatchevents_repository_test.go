@@ -0,0 +1,159 @@
+package repository
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatchevents"
+	"github.com/r3labs/diff/v2"
+	"github.com/snyk/driftctl/pkg/remote/cache"
+	awstest "github.com/snyk/driftctl/test/aws"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_cloudWatchEventsRepository_ListAllRules(t *testing.T) {
+	tests := []struct {
+		name    string
+		mocks   func(client *awstest.MockFakeCloudWatchEvents)
+		want    []*cloudwatchevents.Rule
+		wantErr error
+	}{
+		{
+			name: "List with 2 pages",
+			mocks: func(client *awstest.MockFakeCloudWatchEvents) {
+				client.On("ListRules", &cloudwatchevents.ListRulesInput{}).Return(&cloudwatchevents.ListRulesOutput{
+					Rules: []*cloudwatchevents.Rule{
+						{Name: aws.String("nightly")},
+						{Name: aws.String("hourly")},
+					},
+					NextToken: aws.String("next"),
+				}, nil).Once()
+				client.On("ListRules", &cloudwatchevents.ListRulesInput{NextToken: aws.String("next")}).Return(&cloudwatchevents.ListRulesOutput{
+					Rules: []*cloudwatchevents.Rule{
+						{Name: aws.String("on-push")},
+					},
+				}, nil).Once()
+			},
+			want: []*cloudwatchevents.Rule{
+				{Name: aws.String("nightly")},
+				{Name: aws.String("hourly")},
+				{Name: aws.String("on-push")},
+			},
+		},
+		{
+			name: "should return remote error",
+			mocks: func(client *awstest.MockFakeCloudWatchEvents) {
+				client.On("ListRules", &cloudwatchevents.ListRulesInput{}).Return(nil, errors.New("remote error")).Once()
+			},
+			wantErr: errors.New("remote error"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := cache.New(1)
+			client := awstest.MockFakeCloudWatchEvents{}
+			tt.mocks(&client)
+			r := &cloudWatchEventsRepository{
+				client: &client,
+				cache:  store,
+			}
+			got, err := r.ListAllRules()
+			assert.Equal(t, tt.wantErr, err)
+
+			if err == nil {
+				// Check that results were cached
+				cachedData, err := r.ListAllRules()
+				assert.NoError(t, err)
+				assert.Equal(t, got, cachedData)
+				assert.IsType(t, []*cloudwatchevents.Rule{}, store.Get("cloudwatcheventsListAllRules"))
+			}
+
+			changelog, err := diff.Diff(got, tt.want)
+			assert.Nil(t, err)
+			if len(changelog) > 0 {
+				for _, change := range changelog {
+					t.Errorf("%s: %v -> %v", strings.Join(change.Path, "."), change.From, change.To)
+				}
+				t.Fail()
+			}
+			client.AssertExpectations(t)
+		})
+	}
+}
+
+func Test_cloudWatchEventsRepository_ListAllTargets(t *testing.T) {
+	tests := []struct {
+		name    string
+		mocks   func(client *awstest.MockFakeCloudWatchEvents)
+		want    []*cloudwatchevents.Target
+		wantErr error
+	}{
+		{
+			name: "List with 2 pages",
+			mocks: func(client *awstest.MockFakeCloudWatchEvents) {
+				client.On("ListTargetsByRule", &cloudwatchevents.ListTargetsByRuleInput{
+					Rule: aws.String("nightly"),
+				}).Return(&cloudwatchevents.ListTargetsByRuleOutput{
+					Targets: []*cloudwatchevents.Target{
+						{Id: aws.String("lambda"), Arn: aws.String("arn:aws:lambda:us-east-1:929327065333:function:cleanup")},
+					},
+					NextToken: aws.String("next"),
+				}, nil).Once()
+				client.On("ListTargetsByRule", &cloudwatchevents.ListTargetsByRuleInput{
+					Rule:      aws.String("nightly"),
+					NextToken: aws.String("next"),
+				}).Return(&cloudwatchevents.ListTargetsByRuleOutput{
+					Targets: []*cloudwatchevents.Target{
+						{Id: aws.String("sns"), Arn: aws.String("arn:aws:sns:us-east-1:929327065333:alerts")},
+					},
+				}, nil).Once()
+			},
+			want: []*cloudwatchevents.Target{
+				{Id: aws.String("lambda"), Arn: aws.String("arn:aws:lambda:us-east-1:929327065333:function:cleanup")},
+				{Id: aws.String("sns"), Arn: aws.String("arn:aws:sns:us-east-1:929327065333:alerts")},
+			},
+		},
+		{
+			name: "should return remote error",
+			mocks: func(client *awstest.MockFakeCloudWatchEvents) {
+				client.On("ListTargetsByRule", &cloudwatchevents.ListTargetsByRuleInput{
+					Rule: aws.String("nightly"),
+				}).Return(nil, errors.New("remote error")).Once()
+			},
+			wantErr: errors.New("remote error"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := cache.New(1)
+			client := awstest.MockFakeCloudWatchEvents{}
+			tt.mocks(&client)
+			r := &cloudWatchEventsRepository{
+				client: &client,
+				cache:  store,
+			}
+			got, err := r.ListAllTargets("nightly")
+			assert.Equal(t, tt.wantErr, err)
+
+			if err == nil {
+				// Check that results were cached
+				cachedData, err := r.ListAllTargets("nightly")
+				assert.NoError(t, err)
+				assert.Equal(t, got, cachedData)
+				assert.IsType(t, []*cloudwatchevents.Target{}, store.Get("cloudwatcheventsListAllTargets_nightly"))
+			}
+
+			changelog, err := diff.Diff(got, tt.want)
+			assert.Nil(t, err)
+			if len(changelog) > 0 {
+				for _, change := range changelog {
+					t.Errorf("%s: %v -> %v", strings.Join(change.Path, "."), change.From, change.To)
+				}
+				t.Fail()
+			}
+			client.AssertExpectations(t)
+		})
+	}
+}
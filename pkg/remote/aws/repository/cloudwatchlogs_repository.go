@@ -0,0 +1,64 @@
+package repository
+
+import (
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs/cloudwatchlogsiface"
+	"github.com/snyk/driftctl/pkg/remote/cache"
+)
+
+type CloudWatchLogsRepository interface {
+	ListAllLogGroups() ([]*cloudwatchlogs.LogGroup, error)
+	ListAllMetricFilters() ([]*cloudwatchlogs.MetricFilter, error)
+}
+
+type cloudWatchLogsRepository struct {
+	client cloudwatchlogsiface.CloudWatchLogsAPI
+	cache  cache.Cache
+}
+
+func NewCloudWatchLogsRepository(session *session.Session, c cache.Cache) *cloudWatchLogsRepository {
+	return &cloudWatchLogsRepository{
+		cloudwatchlogs.New(session),
+		c,
+	}
+}
+
+func (r *cloudWatchLogsRepository) ListAllLogGroups() ([]*cloudwatchlogs.LogGroup, error) {
+	if v := r.cache.Get("cloudwatchlogsListAllLogGroups"); v != nil {
+		return v.([]*cloudwatchlogs.LogGroup), nil
+	}
+
+	var logGroups []*cloudwatchlogs.LogGroup
+	input := &cloudwatchlogs.DescribeLogGroupsInput{}
+	err := r.client.DescribeLogGroupsPages(input, func(res *cloudwatchlogs.DescribeLogGroupsOutput, lastPage bool) bool {
+		logGroups = append(logGroups, res.LogGroups...)
+		return !lastPage
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r.cache.Put("cloudwatchlogsListAllLogGroups", logGroups)
+	return logGroups, nil
+}
+
+// ListAllMetricFilters returns the metric filters of every log group
+func (r *cloudWatchLogsRepository) ListAllMetricFilters() ([]*cloudwatchlogs.MetricFilter, error) {
+	if v := r.cache.Get("cloudwatchlogsListAllMetricFilters"); v != nil {
+		return v.([]*cloudwatchlogs.MetricFilter), nil
+	}
+
+	var metricFilters []*cloudwatchlogs.MetricFilter
+	input := &cloudwatchlogs.DescribeMetricFiltersInput{}
+	err := r.client.DescribeMetricFiltersPages(input, func(res *cloudwatchlogs.DescribeMetricFiltersOutput, lastPage bool) bool {
+		metricFilters = append(metricFilters, res.MetricFilters...)
+		return !lastPage
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r.cache.Put("cloudwatchlogsListAllMetricFilters", metricFilters)
+	return metricFilters, nil
+}
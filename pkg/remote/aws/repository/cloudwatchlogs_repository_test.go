@@ -0,0 +1,165 @@
+package repository
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
+	"github.com/r3labs/diff/v2"
+	"github.com/snyk/driftctl/pkg/remote/cache"
+	awstest "github.com/snyk/driftctl/test/aws"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_cloudWatchLogsRepository_ListAllLogGroups(t *testing.T) {
+	tests := []struct {
+		name    string
+		mocks   func(client *awstest.MockFakeCloudWatchLogs)
+		want    []*cloudwatchlogs.LogGroup
+		wantErr error
+	}{
+		{
+			name: "List with 2 pages",
+			mocks: func(client *awstest.MockFakeCloudWatchLogs) {
+				client.On("DescribeLogGroupsPages",
+					&cloudwatchlogs.DescribeLogGroupsInput{},
+					mock.MatchedBy(func(callback func(res *cloudwatchlogs.DescribeLogGroupsOutput, lastPage bool) bool) bool {
+						callback(&cloudwatchlogs.DescribeLogGroupsOutput{
+							LogGroups: []*cloudwatchlogs.LogGroup{
+								{LogGroupName: aws.String("/app/api")},
+								{LogGroupName: aws.String("/app/worker")},
+							},
+						}, false)
+						callback(&cloudwatchlogs.DescribeLogGroupsOutput{
+							LogGroups: []*cloudwatchlogs.LogGroup{
+								{LogGroupName: aws.String("/aws/lambda/foo")},
+							},
+						}, true)
+						return true
+					})).Return(nil).Once()
+			},
+			want: []*cloudwatchlogs.LogGroup{
+				{LogGroupName: aws.String("/app/api")},
+				{LogGroupName: aws.String("/app/worker")},
+				{LogGroupName: aws.String("/aws/lambda/foo")},
+			},
+		},
+		{
+			name: "should return remote error",
+			mocks: func(client *awstest.MockFakeCloudWatchLogs) {
+				client.On("DescribeLogGroupsPages",
+					&cloudwatchlogs.DescribeLogGroupsInput{},
+					mock.AnythingOfType("func(*cloudwatchlogs.DescribeLogGroupsOutput, bool) bool")).Return(errors.New("remote error")).Once()
+			},
+			wantErr: errors.New("remote error"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := cache.New(1)
+			client := awstest.MockFakeCloudWatchLogs{}
+			tt.mocks(&client)
+			r := &cloudWatchLogsRepository{
+				client: &client,
+				cache:  store,
+			}
+			got, err := r.ListAllLogGroups()
+			assert.Equal(t, tt.wantErr, err)
+
+			if err == nil {
+				// Check that results were cached
+				cachedData, err := r.ListAllLogGroups()
+				assert.NoError(t, err)
+				assert.Equal(t, got, cachedData)
+				assert.IsType(t, []*cloudwatchlogs.LogGroup{}, store.Get("cloudwatchlogsListAllLogGroups"))
+			}
+
+			changelog, err := diff.Diff(got, tt.want)
+			assert.Nil(t, err)
+			if len(changelog) > 0 {
+				for _, change := range changelog {
+					t.Errorf("%s: %v -> %v", strings.Join(change.Path, "."), change.From, change.To)
+				}
+				t.Fail()
+			}
+		})
+	}
+}
+
+func Test_cloudWatchLogsRepository_ListAllMetricFilters(t *testing.T) {
+	tests := []struct {
+		name    string
+		mocks   func(client *awstest.MockFakeCloudWatchLogs)
+		want    []*cloudwatchlogs.MetricFilter
+		wantErr error
+	}{
+		{
+			name: "List with 2 pages",
+			mocks: func(client *awstest.MockFakeCloudWatchLogs) {
+				client.On("DescribeMetricFiltersPages",
+					&cloudwatchlogs.DescribeMetricFiltersInput{},
+					mock.MatchedBy(func(callback func(res *cloudwatchlogs.DescribeMetricFiltersOutput, lastPage bool) bool) bool {
+						callback(&cloudwatchlogs.DescribeMetricFiltersOutput{
+							MetricFilters: []*cloudwatchlogs.MetricFilter{
+								{FilterName: aws.String("errors"), LogGroupName: aws.String("/app/api")},
+								{FilterName: aws.String("warnings"), LogGroupName: aws.String("/app/api")},
+							},
+						}, false)
+						callback(&cloudwatchlogs.DescribeMetricFiltersOutput{
+							MetricFilters: []*cloudwatchlogs.MetricFilter{
+								{FilterName: aws.String("errors"), LogGroupName: aws.String("/app/worker")},
+							},
+						}, true)
+						return true
+					})).Return(nil).Once()
+			},
+			want: []*cloudwatchlogs.MetricFilter{
+				{FilterName: aws.String("errors"), LogGroupName: aws.String("/app/api")},
+				{FilterName: aws.String("warnings"), LogGroupName: aws.String("/app/api")},
+				{FilterName: aws.String("errors"), LogGroupName: aws.String("/app/worker")},
+			},
+		},
+		{
+			name: "should return remote error",
+			mocks: func(client *awstest.MockFakeCloudWatchLogs) {
+				client.On("DescribeMetricFiltersPages",
+					&cloudwatchlogs.DescribeMetricFiltersInput{},
+					mock.AnythingOfType("func(*cloudwatchlogs.DescribeMetricFiltersOutput, bool) bool")).Return(errors.New("remote error")).Once()
+			},
+			wantErr: errors.New("remote error"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := cache.New(1)
+			client := awstest.MockFakeCloudWatchLogs{}
+			tt.mocks(&client)
+			r := &cloudWatchLogsRepository{
+				client: &client,
+				cache:  store,
+			}
+			got, err := r.ListAllMetricFilters()
+			assert.Equal(t, tt.wantErr, err)
+
+			if err == nil {
+				// Check that results were cached
+				cachedData, err := r.ListAllMetricFilters()
+				assert.NoError(t, err)
+				assert.Equal(t, got, cachedData)
+				assert.IsType(t, []*cloudwatchlogs.MetricFilter{}, store.Get("cloudwatchlogsListAllMetricFilters"))
+			}
+
+			changelog, err := diff.Diff(got, tt.want)
+			assert.Nil(t, err)
+			if len(changelog) > 0 {
+				for _, change := range changelog {
+					t.Errorf("%s: %v -> %v", strings.Join(change.Path, "."), change.From, change.To)
+				}
+				t.Fail()
+			}
+		})
+	}
+}
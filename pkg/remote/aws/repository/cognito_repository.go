@@ -0,0 +1,75 @@
+package repository
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/cognitoidentityprovider"
+	"github.com/aws/aws-sdk-go/service/cognitoidentityprovider/cognitoidentityprovideriface"
+	"github.com/snyk/driftctl/pkg/remote/cache"
+)
+
+type CognitoRepository interface {
+	ListAllUserPools() ([]*cognitoidentityprovider.UserPoolDescriptionType, error)
+	ListAllUserPoolClients(userPoolId string) ([]*cognitoidentityprovider.UserPoolClientDescription, error)
+}
+
+type cognitoRepository struct {
+	client cognitoidentityprovideriface.CognitoIdentityProviderAPI
+	cache  cache.Cache
+}
+
+func NewCognitoRepository(session *session.Session, c cache.Cache) *cognitoRepository {
+	return &cognitoRepository{
+		cognitoidentityprovider.New(session),
+		c,
+	}
+}
+
+func (r *cognitoRepository) ListAllUserPools() ([]*cognitoidentityprovider.UserPoolDescriptionType, error) {
+	cacheKey := "cognitoListAllUserPools"
+	v := r.cache.GetAndLock(cacheKey)
+	defer r.cache.Unlock(cacheKey)
+	if v != nil {
+		return v.([]*cognitoidentityprovider.UserPoolDescriptionType), nil
+	}
+
+	var userPools []*cognitoidentityprovider.UserPoolDescriptionType
+	// MaxResults is required by the API, 60 is the highest accepted value
+	input := &cognitoidentityprovider.ListUserPoolsInput{
+		MaxResults: aws.Int64(60),
+	}
+	err := r.client.ListUserPoolsPages(input, func(res *cognitoidentityprovider.ListUserPoolsOutput, lastPage bool) bool {
+		userPools = append(userPools, res.UserPools...)
+		return !lastPage
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r.cache.Put(cacheKey, userPools)
+	return userPools, nil
+}
+
+func (r *cognitoRepository) ListAllUserPoolClients(userPoolId string) ([]*cognitoidentityprovider.UserPoolClientDescription, error) {
+	cacheKey := fmt.Sprintf("cognitoListAllUserPoolClients_%s", userPoolId)
+	if v := r.cache.Get(cacheKey); v != nil {
+		return v.([]*cognitoidentityprovider.UserPoolClientDescription), nil
+	}
+
+	var clients []*cognitoidentityprovider.UserPoolClientDescription
+	input := &cognitoidentityprovider.ListUserPoolClientsInput{
+		UserPoolId: aws.String(userPoolId),
+	}
+	err := r.client.ListUserPoolClientsPages(input, func(res *cognitoidentityprovider.ListUserPoolClientsOutput, lastPage bool) bool {
+		clients = append(clients, res.UserPoolClients...)
+		return !lastPage
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r.cache.Put(cacheKey, clients)
+	return clients, nil
+}
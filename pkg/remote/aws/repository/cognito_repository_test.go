@@ -0,0 +1,171 @@
+package repository
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cognitoidentityprovider"
+	"github.com/r3labs/diff/v2"
+	"github.com/snyk/driftctl/pkg/remote/cache"
+	awstest "github.com/snyk/driftctl/test/aws"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_cognitoRepository_ListAllUserPools(t *testing.T) {
+	tests := []struct {
+		name    string
+		mocks   func(client *awstest.MockFakeCognitoIdentityProvider)
+		want    []*cognitoidentityprovider.UserPoolDescriptionType
+		wantErr error
+	}{
+		{
+			name: "List with 2 pages",
+			mocks: func(client *awstest.MockFakeCognitoIdentityProvider) {
+				client.On("ListUserPoolsPages",
+					&cognitoidentityprovider.ListUserPoolsInput{
+						MaxResults: aws.Int64(60),
+					},
+					mock.MatchedBy(func(callback func(res *cognitoidentityprovider.ListUserPoolsOutput, lastPage bool) bool) bool {
+						callback(&cognitoidentityprovider.ListUserPoolsOutput{
+							UserPools: []*cognitoidentityprovider.UserPoolDescriptionType{
+								{Id: aws.String("us-east-1_AbCdEfGhI"), Name: aws.String("customers")},
+							},
+						}, false)
+						callback(&cognitoidentityprovider.ListUserPoolsOutput{
+							UserPools: []*cognitoidentityprovider.UserPoolDescriptionType{
+								{Id: aws.String("us-east-1_JkLmNoPqR"), Name: aws.String("employees")},
+							},
+						}, true)
+						return true
+					})).Return(nil).Once()
+			},
+			want: []*cognitoidentityprovider.UserPoolDescriptionType{
+				{Id: aws.String("us-east-1_AbCdEfGhI"), Name: aws.String("customers")},
+				{Id: aws.String("us-east-1_JkLmNoPqR"), Name: aws.String("employees")},
+			},
+		},
+		{
+			name: "should return remote error",
+			mocks: func(client *awstest.MockFakeCognitoIdentityProvider) {
+				client.On("ListUserPoolsPages",
+					&cognitoidentityprovider.ListUserPoolsInput{
+						MaxResults: aws.Int64(60),
+					},
+					mock.Anything).Return(errors.New("remote error")).Once()
+			},
+			wantErr: errors.New("remote error"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := cache.New(1)
+			client := awstest.MockFakeCognitoIdentityProvider{}
+			tt.mocks(&client)
+			r := &cognitoRepository{
+				client: &client,
+				cache:  store,
+			}
+			got, err := r.ListAllUserPools()
+			assert.Equal(t, tt.wantErr, err)
+
+			if err == nil {
+				// Check that results were cached
+				cachedData, err := r.ListAllUserPools()
+				assert.NoError(t, err)
+				assert.Equal(t, got, cachedData)
+				assert.IsType(t, []*cognitoidentityprovider.UserPoolDescriptionType{}, store.Get("cognitoListAllUserPools"))
+			}
+
+			changelog, err := diff.Diff(got, tt.want)
+			assert.Nil(t, err)
+			if len(changelog) > 0 {
+				for _, change := range changelog {
+					t.Errorf("%s: %v -> %v", strings.Join(change.Path, "."), change.From, change.To)
+				}
+				t.Fail()
+			}
+			client.AssertExpectations(t)
+		})
+	}
+}
+
+func Test_cognitoRepository_ListAllUserPoolClients(t *testing.T) {
+	tests := []struct {
+		name    string
+		mocks   func(client *awstest.MockFakeCognitoIdentityProvider)
+		want    []*cognitoidentityprovider.UserPoolClientDescription
+		wantErr error
+	}{
+		{
+			name: "List with 2 pages",
+			mocks: func(client *awstest.MockFakeCognitoIdentityProvider) {
+				client.On("ListUserPoolClientsPages",
+					&cognitoidentityprovider.ListUserPoolClientsInput{
+						UserPoolId: aws.String("us-east-1_AbCdEfGhI"),
+					},
+					mock.MatchedBy(func(callback func(res *cognitoidentityprovider.ListUserPoolClientsOutput, lastPage bool) bool) bool {
+						callback(&cognitoidentityprovider.ListUserPoolClientsOutput{
+							UserPoolClients: []*cognitoidentityprovider.UserPoolClientDescription{
+								{ClientId: aws.String("1example23456789"), ClientName: aws.String("web"), UserPoolId: aws.String("us-east-1_AbCdEfGhI")},
+							},
+						}, false)
+						callback(&cognitoidentityprovider.ListUserPoolClientsOutput{
+							UserPoolClients: []*cognitoidentityprovider.UserPoolClientDescription{
+								{ClientId: aws.String("2example23456789"), ClientName: aws.String("mobile"), UserPoolId: aws.String("us-east-1_AbCdEfGhI")},
+							},
+						}, true)
+						return true
+					})).Return(nil).Once()
+			},
+			want: []*cognitoidentityprovider.UserPoolClientDescription{
+				{ClientId: aws.String("1example23456789"), ClientName: aws.String("web"), UserPoolId: aws.String("us-east-1_AbCdEfGhI")},
+				{ClientId: aws.String("2example23456789"), ClientName: aws.String("mobile"), UserPoolId: aws.String("us-east-1_AbCdEfGhI")},
+			},
+		},
+		{
+			name: "should return remote error",
+			mocks: func(client *awstest.MockFakeCognitoIdentityProvider) {
+				client.On("ListUserPoolClientsPages",
+					&cognitoidentityprovider.ListUserPoolClientsInput{
+						UserPoolId: aws.String("us-east-1_AbCdEfGhI"),
+					},
+					mock.Anything).Return(errors.New("remote error")).Once()
+			},
+			wantErr: errors.New("remote error"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := cache.New(1)
+			client := awstest.MockFakeCognitoIdentityProvider{}
+			tt.mocks(&client)
+			r := &cognitoRepository{
+				client: &client,
+				cache:  store,
+			}
+			got, err := r.ListAllUserPoolClients("us-east-1_AbCdEfGhI")
+			assert.Equal(t, tt.wantErr, err)
+
+			if err == nil {
+				// Check that results were cached
+				cachedData, err := r.ListAllUserPoolClients("us-east-1_AbCdEfGhI")
+				assert.NoError(t, err)
+				assert.Equal(t, got, cachedData)
+				assert.IsType(t, []*cognitoidentityprovider.UserPoolClientDescription{}, store.Get("cognitoListAllUserPoolClients_us-east-1_AbCdEfGhI"))
+			}
+
+			changelog, err := diff.Diff(got, tt.want)
+			assert.Nil(t, err)
+			if len(changelog) > 0 {
+				for _, change := range changelog {
+					t.Errorf("%s: %v -> %v", strings.Join(change.Path, "."), change.From, change.To)
+				}
+				t.Fail()
+			}
+			client.AssertExpectations(t)
+		})
+	}
+}
@@ -1,6 +1,8 @@
 package repository
 
 import (
+	"strings"
+
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/ec2"
@@ -25,6 +27,12 @@ type EC2Repository interface {
 	ListAllNetworkACLs() ([]*ec2.NetworkAcl, error)
 	DescribeLaunchTemplates() ([]*ec2.LaunchTemplate, error)
 	IsEbsEncryptionEnabledByDefault() (bool, error)
+	ListAllVpcEndpoints() ([]*ec2.VpcEndpoint, error)
+	ListAllVpcPeeringConnections() ([]*ec2.VpcPeeringConnection, error)
+	ListAllTransitGateways() ([]*ec2.TransitGateway, error)
+	ListAllTransitGatewayVpcAttachments() ([]*ec2.TransitGatewayVpcAttachment, error)
+	ListAllNetworkInterfaces() ([]*ec2.NetworkInterface, error)
+	ListAllFlowLogs() ([]*ec2.FlowLog, error)
 }
 
 type ec2Repository struct {
@@ -406,3 +414,157 @@ func (r *ec2Repository) IsEbsEncryptionEnabledByDefault() (bool, error) {
 	r.cache.Put("ec2IsEbsEncryptionEnabledByDefault", *resp.EbsEncryptionByDefault)
 	return *resp.EbsEncryptionByDefault, err
 }
+
+func (r *ec2Repository) ListAllVpcEndpoints() ([]*ec2.VpcEndpoint, error) {
+	if v := r.cache.Get("ec2ListAllVpcEndpoints"); v != nil {
+		return v.([]*ec2.VpcEndpoint), nil
+	}
+
+	var endpoints []*ec2.VpcEndpoint
+	input := ec2.DescribeVpcEndpointsInput{}
+	err := r.client.DescribeVpcEndpointsPages(&input,
+		func(resp *ec2.DescribeVpcEndpointsOutput, lastPage bool) bool {
+			for _, endpoint := range resp.VpcEndpoints {
+				// Deleted endpoints are still listed for a while after their deletion
+				if endpoint.State != nil && strings.EqualFold(*endpoint.State, ec2.StateDeleted) {
+					continue
+				}
+				endpoints = append(endpoints, endpoint)
+			}
+			return !lastPage
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	r.cache.Put("ec2ListAllVpcEndpoints", endpoints)
+	return endpoints, nil
+}
+
+func (r *ec2Repository) ListAllVpcPeeringConnections() ([]*ec2.VpcPeeringConnection, error) {
+	if v := r.cache.Get("ec2ListAllVpcPeeringConnections"); v != nil {
+		return v.([]*ec2.VpcPeeringConnection), nil
+	}
+
+	var connections []*ec2.VpcPeeringConnection
+	input := ec2.DescribeVpcPeeringConnectionsInput{}
+	err := r.client.DescribeVpcPeeringConnectionsPages(&input,
+		func(resp *ec2.DescribeVpcPeeringConnectionsOutput, lastPage bool) bool {
+			for _, connection := range resp.VpcPeeringConnections {
+				// Connections in a final state are still listed for a while but can no longer be managed
+				if connection.Status != nil && connection.Status.Code != nil {
+					switch *connection.Status.Code {
+					case ec2.VpcPeeringConnectionStateReasonCodeDeleted,
+						ec2.VpcPeeringConnectionStateReasonCodeRejected,
+						ec2.VpcPeeringConnectionStateReasonCodeFailed,
+						ec2.VpcPeeringConnectionStateReasonCodeExpired:
+						continue
+					}
+				}
+				connections = append(connections, connection)
+			}
+			return !lastPage
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	r.cache.Put("ec2ListAllVpcPeeringConnections", connections)
+	return connections, nil
+}
+
+func (r *ec2Repository) ListAllTransitGateways() ([]*ec2.TransitGateway, error) {
+	if v := r.cache.Get("ec2ListAllTransitGateways"); v != nil {
+		return v.([]*ec2.TransitGateway), nil
+	}
+
+	var gateways []*ec2.TransitGateway
+	input := ec2.DescribeTransitGatewaysInput{}
+	err := r.client.DescribeTransitGatewaysPages(&input,
+		func(resp *ec2.DescribeTransitGatewaysOutput, lastPage bool) bool {
+			for _, gateway := range resp.TransitGateways {
+				if gateway.State != nil && *gateway.State == ec2.TransitGatewayStateDeleted {
+					continue
+				}
+				gateways = append(gateways, gateway)
+			}
+			return !lastPage
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	r.cache.Put("ec2ListAllTransitGateways", gateways)
+	return gateways, nil
+}
+
+func (r *ec2Repository) ListAllTransitGatewayVpcAttachments() ([]*ec2.TransitGatewayVpcAttachment, error) {
+	if v := r.cache.Get("ec2ListAllTransitGatewayVpcAttachments"); v != nil {
+		return v.([]*ec2.TransitGatewayVpcAttachment), nil
+	}
+
+	var attachments []*ec2.TransitGatewayVpcAttachment
+	input := ec2.DescribeTransitGatewayVpcAttachmentsInput{}
+	err := r.client.DescribeTransitGatewayVpcAttachmentsPages(&input,
+		func(resp *ec2.DescribeTransitGatewayVpcAttachmentsOutput, lastPage bool) bool {
+			for _, attachment := range resp.TransitGatewayVpcAttachments {
+				if attachment.State != nil && *attachment.State == ec2.TransitGatewayAttachmentStateDeleted {
+					continue
+				}
+				attachments = append(attachments, attachment)
+			}
+			return !lastPage
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	r.cache.Put("ec2ListAllTransitGatewayVpcAttachments", attachments)
+	return attachments, nil
+}
+
+func (r *ec2Repository) ListAllNetworkInterfaces() ([]*ec2.NetworkInterface, error) {
+	if v := r.cache.Get("ec2ListAllNetworkInterfaces"); v != nil {
+		return v.([]*ec2.NetworkInterface), nil
+	}
+
+	var interfaces []*ec2.NetworkInterface
+	input := ec2.DescribeNetworkInterfacesInput{}
+	err := r.client.DescribeNetworkInterfacesPages(&input,
+		func(resp *ec2.DescribeNetworkInterfacesOutput, lastPage bool) bool {
+			interfaces = append(interfaces, resp.NetworkInterfaces...)
+			return !lastPage
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	r.cache.Put("ec2ListAllNetworkInterfaces", interfaces)
+	return interfaces, nil
+}
+
+func (r *ec2Repository) ListAllFlowLogs() ([]*ec2.FlowLog, error) {
+	if v := r.cache.Get("ec2ListAllFlowLogs"); v != nil {
+		return v.([]*ec2.FlowLog), nil
+	}
+
+	var flowLogs []*ec2.FlowLog
+	input := ec2.DescribeFlowLogsInput{}
+	err := r.client.DescribeFlowLogsPages(&input,
+		func(resp *ec2.DescribeFlowLogsOutput, lastPage bool) bool {
+			flowLogs = append(flowLogs, resp.FlowLogs...)
+			return !lastPage
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	r.cache.Put("ec2ListAllFlowLogs", flowLogs)
+	return flowLogs, nil
+}
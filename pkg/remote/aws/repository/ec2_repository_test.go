@@ -1427,3 +1427,567 @@ func Test_ec2Repository_IsEbsEncryptionEnabledByDefault(t *testing.T) {
 		})
 	}
 }
+
+func Test_ec2Repository_ListAllVpcEndpoints(t *testing.T) {
+	testErr := errors.New("test")
+
+	tests := []struct {
+		name    string
+		mocks   func(client *awstest.MockFakeEC2)
+		want    []*ec2.VpcEndpoint
+		wantErr error
+	}{
+		{
+			name: "List only endpoints not deleted with multiple pages",
+			mocks: func(client *awstest.MockFakeEC2) {
+				client.On("DescribeVpcEndpointsPages",
+					&ec2.DescribeVpcEndpointsInput{},
+					mock.MatchedBy(func(callback func(res *ec2.DescribeVpcEndpointsOutput, lastPage bool) bool) bool {
+						callback(&ec2.DescribeVpcEndpointsOutput{
+							VpcEndpoints: []*ec2.VpcEndpoint{
+								{
+									VpcEndpointId: aws.String("vpce-0"),
+								},
+								{
+									VpcEndpointId: aws.String("vpce-1"),
+								},
+							},
+						}, false)
+						callback(&ec2.DescribeVpcEndpointsOutput{
+							VpcEndpoints: []*ec2.VpcEndpoint{
+								{
+									VpcEndpointId: aws.String("vpce-2"),
+								},
+								{
+									VpcEndpointId: aws.String("vpce-3"),
+									State:         aws.String("deleted"),
+								},
+							},
+						}, true)
+						return true
+					})).Return(nil).Once()
+			},
+			want: []*ec2.VpcEndpoint{
+				{
+					VpcEndpointId: aws.String("vpce-0"),
+				},
+				{
+					VpcEndpointId: aws.String("vpce-1"),
+				},
+				{
+					VpcEndpointId: aws.String("vpce-2"),
+				},
+			},
+		},
+		{
+			name: "Error listing VPC endpoints",
+			mocks: func(client *awstest.MockFakeEC2) {
+				client.On("DescribeVpcEndpointsPages",
+					&ec2.DescribeVpcEndpointsInput{},
+					mock.Anything).Return(testErr).Once()
+			},
+			wantErr: testErr,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := cache.New(1)
+			client := &awstest.MockFakeEC2{}
+			tt.mocks(client)
+			r := &ec2Repository{
+				client: client,
+				cache:  store,
+			}
+			got, err := r.ListAllVpcEndpoints()
+			assert.Equal(t, tt.wantErr, err)
+
+			if err == nil {
+				// Check that results were cached
+				cachedData, err := r.ListAllVpcEndpoints()
+				assert.NoError(t, err)
+				assert.Equal(t, got, cachedData)
+				assert.IsType(t, []*ec2.VpcEndpoint{}, store.Get("ec2ListAllVpcEndpoints"))
+			}
+
+			changelog, err := diff.Diff(got, tt.want)
+			assert.Nil(t, err)
+			if len(changelog) > 0 {
+				for _, change := range changelog {
+					t.Errorf("%s: %s -> %s", strings.Join(change.Path, "."), change.From, change.To)
+				}
+				t.Fail()
+			}
+		})
+	}
+}
+
+func Test_ec2Repository_ListAllVpcPeeringConnections(t *testing.T) {
+	testErr := errors.New("test")
+
+	tests := []struct {
+		name    string
+		mocks   func(client *awstest.MockFakeEC2)
+		want    []*ec2.VpcPeeringConnection
+		wantErr error
+	}{
+		{
+			name: "List only active connections with multiple pages",
+			mocks: func(client *awstest.MockFakeEC2) {
+				client.On("DescribeVpcPeeringConnectionsPages",
+					&ec2.DescribeVpcPeeringConnectionsInput{},
+					mock.MatchedBy(func(callback func(res *ec2.DescribeVpcPeeringConnectionsOutput, lastPage bool) bool) bool {
+						callback(&ec2.DescribeVpcPeeringConnectionsOutput{
+							VpcPeeringConnections: []*ec2.VpcPeeringConnection{
+								{
+									VpcPeeringConnectionId: aws.String("pcx-0"),
+								},
+								{
+									VpcPeeringConnectionId: aws.String("pcx-1"),
+								},
+							},
+						}, false)
+						callback(&ec2.DescribeVpcPeeringConnectionsOutput{
+							VpcPeeringConnections: []*ec2.VpcPeeringConnection{
+								{
+									VpcPeeringConnectionId: aws.String("pcx-2"),
+								},
+								{
+									VpcPeeringConnectionId: aws.String("pcx-3"),
+									Status: &ec2.VpcPeeringConnectionStateReason{
+										Code: aws.String(ec2.VpcPeeringConnectionStateReasonCodeRejected),
+									},
+								},
+							},
+						}, true)
+						return true
+					})).Return(nil).Once()
+			},
+			want: []*ec2.VpcPeeringConnection{
+				{
+					VpcPeeringConnectionId: aws.String("pcx-0"),
+				},
+				{
+					VpcPeeringConnectionId: aws.String("pcx-1"),
+				},
+				{
+					VpcPeeringConnectionId: aws.String("pcx-2"),
+				},
+			},
+		},
+		{
+			name: "Error listing VPC peering connections",
+			mocks: func(client *awstest.MockFakeEC2) {
+				client.On("DescribeVpcPeeringConnectionsPages",
+					&ec2.DescribeVpcPeeringConnectionsInput{},
+					mock.Anything).Return(testErr).Once()
+			},
+			wantErr: testErr,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := cache.New(1)
+			client := &awstest.MockFakeEC2{}
+			tt.mocks(client)
+			r := &ec2Repository{
+				client: client,
+				cache:  store,
+			}
+			got, err := r.ListAllVpcPeeringConnections()
+			assert.Equal(t, tt.wantErr, err)
+
+			if err == nil {
+				// Check that results were cached
+				cachedData, err := r.ListAllVpcPeeringConnections()
+				assert.NoError(t, err)
+				assert.Equal(t, got, cachedData)
+				assert.IsType(t, []*ec2.VpcPeeringConnection{}, store.Get("ec2ListAllVpcPeeringConnections"))
+			}
+
+			changelog, err := diff.Diff(got, tt.want)
+			assert.Nil(t, err)
+			if len(changelog) > 0 {
+				for _, change := range changelog {
+					t.Errorf("%s: %s -> %s", strings.Join(change.Path, "."), change.From, change.To)
+				}
+				t.Fail()
+			}
+		})
+	}
+}
+
+func Test_ec2Repository_ListAllTransitGateways(t *testing.T) {
+	testErr := errors.New("test")
+
+	tests := []struct {
+		name    string
+		mocks   func(client *awstest.MockFakeEC2)
+		want    []*ec2.TransitGateway
+		wantErr error
+	}{
+		{
+			name: "List only gateways not deleted with multiple pages",
+			mocks: func(client *awstest.MockFakeEC2) {
+				client.On("DescribeTransitGatewaysPages",
+					&ec2.DescribeTransitGatewaysInput{},
+					mock.MatchedBy(func(callback func(res *ec2.DescribeTransitGatewaysOutput, lastPage bool) bool) bool {
+						callback(&ec2.DescribeTransitGatewaysOutput{
+							TransitGateways: []*ec2.TransitGateway{
+								{
+									TransitGatewayId: aws.String("tgw-0"),
+								},
+								{
+									TransitGatewayId: aws.String("tgw-1"),
+								},
+							},
+						}, false)
+						callback(&ec2.DescribeTransitGatewaysOutput{
+							TransitGateways: []*ec2.TransitGateway{
+								{
+									TransitGatewayId: aws.String("tgw-2"),
+								},
+								{
+									TransitGatewayId: aws.String("tgw-3"),
+									State:            aws.String(ec2.TransitGatewayStateDeleted),
+								},
+							},
+						}, true)
+						return true
+					})).Return(nil).Once()
+			},
+			want: []*ec2.TransitGateway{
+				{
+					TransitGatewayId: aws.String("tgw-0"),
+				},
+				{
+					TransitGatewayId: aws.String("tgw-1"),
+				},
+				{
+					TransitGatewayId: aws.String("tgw-2"),
+				},
+			},
+		},
+		{
+			name: "Error listing transit gateways",
+			mocks: func(client *awstest.MockFakeEC2) {
+				client.On("DescribeTransitGatewaysPages",
+					&ec2.DescribeTransitGatewaysInput{},
+					mock.Anything).Return(testErr).Once()
+			},
+			wantErr: testErr,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := cache.New(1)
+			client := &awstest.MockFakeEC2{}
+			tt.mocks(client)
+			r := &ec2Repository{
+				client: client,
+				cache:  store,
+			}
+			got, err := r.ListAllTransitGateways()
+			assert.Equal(t, tt.wantErr, err)
+
+			if err == nil {
+				// Check that results were cached
+				cachedData, err := r.ListAllTransitGateways()
+				assert.NoError(t, err)
+				assert.Equal(t, got, cachedData)
+				assert.IsType(t, []*ec2.TransitGateway{}, store.Get("ec2ListAllTransitGateways"))
+			}
+
+			changelog, err := diff.Diff(got, tt.want)
+			assert.Nil(t, err)
+			if len(changelog) > 0 {
+				for _, change := range changelog {
+					t.Errorf("%s: %s -> %s", strings.Join(change.Path, "."), change.From, change.To)
+				}
+				t.Fail()
+			}
+		})
+	}
+}
+
+func Test_ec2Repository_ListAllTransitGatewayVpcAttachments(t *testing.T) {
+	testErr := errors.New("test")
+
+	tests := []struct {
+		name    string
+		mocks   func(client *awstest.MockFakeEC2)
+		want    []*ec2.TransitGatewayVpcAttachment
+		wantErr error
+	}{
+		{
+			name: "List only attachments not deleted with multiple pages",
+			mocks: func(client *awstest.MockFakeEC2) {
+				client.On("DescribeTransitGatewayVpcAttachmentsPages",
+					&ec2.DescribeTransitGatewayVpcAttachmentsInput{},
+					mock.MatchedBy(func(callback func(res *ec2.DescribeTransitGatewayVpcAttachmentsOutput, lastPage bool) bool) bool {
+						callback(&ec2.DescribeTransitGatewayVpcAttachmentsOutput{
+							TransitGatewayVpcAttachments: []*ec2.TransitGatewayVpcAttachment{
+								{
+									TransitGatewayAttachmentId: aws.String("tgw-attach-0"),
+								},
+								{
+									TransitGatewayAttachmentId: aws.String("tgw-attach-1"),
+								},
+							},
+						}, false)
+						callback(&ec2.DescribeTransitGatewayVpcAttachmentsOutput{
+							TransitGatewayVpcAttachments: []*ec2.TransitGatewayVpcAttachment{
+								{
+									TransitGatewayAttachmentId: aws.String("tgw-attach-2"),
+								},
+								{
+									TransitGatewayAttachmentId: aws.String("tgw-attach-3"),
+									State:                      aws.String(ec2.TransitGatewayAttachmentStateDeleted),
+								},
+							},
+						}, true)
+						return true
+					})).Return(nil).Once()
+			},
+			want: []*ec2.TransitGatewayVpcAttachment{
+				{
+					TransitGatewayAttachmentId: aws.String("tgw-attach-0"),
+				},
+				{
+					TransitGatewayAttachmentId: aws.String("tgw-attach-1"),
+				},
+				{
+					TransitGatewayAttachmentId: aws.String("tgw-attach-2"),
+				},
+			},
+		},
+		{
+			name: "Error listing transit gateway VPC attachments",
+			mocks: func(client *awstest.MockFakeEC2) {
+				client.On("DescribeTransitGatewayVpcAttachmentsPages",
+					&ec2.DescribeTransitGatewayVpcAttachmentsInput{},
+					mock.Anything).Return(testErr).Once()
+			},
+			wantErr: testErr,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := cache.New(1)
+			client := &awstest.MockFakeEC2{}
+			tt.mocks(client)
+			r := &ec2Repository{
+				client: client,
+				cache:  store,
+			}
+			got, err := r.ListAllTransitGatewayVpcAttachments()
+			assert.Equal(t, tt.wantErr, err)
+
+			if err == nil {
+				// Check that results were cached
+				cachedData, err := r.ListAllTransitGatewayVpcAttachments()
+				assert.NoError(t, err)
+				assert.Equal(t, got, cachedData)
+				assert.IsType(t, []*ec2.TransitGatewayVpcAttachment{}, store.Get("ec2ListAllTransitGatewayVpcAttachments"))
+			}
+
+			changelog, err := diff.Diff(got, tt.want)
+			assert.Nil(t, err)
+			if len(changelog) > 0 {
+				for _, change := range changelog {
+					t.Errorf("%s: %s -> %s", strings.Join(change.Path, "."), change.From, change.To)
+				}
+				t.Fail()
+			}
+		})
+	}
+}
+
+func Test_ec2Repository_ListAllNetworkInterfaces(t *testing.T) {
+	testErr := errors.New("test")
+
+	tests := []struct {
+		name    string
+		mocks   func(client *awstest.MockFakeEC2)
+		want    []*ec2.NetworkInterface
+		wantErr error
+	}{
+		{
+			name: "List network interfaces with multiple pages",
+			mocks: func(client *awstest.MockFakeEC2) {
+				client.On("DescribeNetworkInterfacesPages",
+					&ec2.DescribeNetworkInterfacesInput{},
+					mock.MatchedBy(func(callback func(res *ec2.DescribeNetworkInterfacesOutput, lastPage bool) bool) bool {
+						callback(&ec2.DescribeNetworkInterfacesOutput{
+							NetworkInterfaces: []*ec2.NetworkInterface{
+								{
+									NetworkInterfaceId: aws.String("eni-0"),
+								},
+								{
+									NetworkInterfaceId: aws.String("eni-1"),
+								},
+							},
+						}, false)
+						callback(&ec2.DescribeNetworkInterfacesOutput{
+							NetworkInterfaces: []*ec2.NetworkInterface{
+								{
+									NetworkInterfaceId: aws.String("eni-2"),
+								},
+								{
+									NetworkInterfaceId: aws.String("eni-3"),
+								},
+							},
+						}, true)
+						return true
+					})).Return(nil).Once()
+			},
+			want: []*ec2.NetworkInterface{
+				{
+					NetworkInterfaceId: aws.String("eni-0"),
+				},
+				{
+					NetworkInterfaceId: aws.String("eni-1"),
+				},
+				{
+					NetworkInterfaceId: aws.String("eni-2"),
+				},
+				{
+					NetworkInterfaceId: aws.String("eni-3"),
+				},
+			},
+		},
+		{
+			name: "Error listing network interfaces",
+			mocks: func(client *awstest.MockFakeEC2) {
+				client.On("DescribeNetworkInterfacesPages",
+					&ec2.DescribeNetworkInterfacesInput{},
+					mock.Anything).Return(testErr).Once()
+			},
+			wantErr: testErr,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := cache.New(1)
+			client := &awstest.MockFakeEC2{}
+			tt.mocks(client)
+			r := &ec2Repository{
+				client: client,
+				cache:  store,
+			}
+			got, err := r.ListAllNetworkInterfaces()
+			assert.Equal(t, tt.wantErr, err)
+
+			if err == nil {
+				// Check that results were cached
+				cachedData, err := r.ListAllNetworkInterfaces()
+				assert.NoError(t, err)
+				assert.Equal(t, got, cachedData)
+				assert.IsType(t, []*ec2.NetworkInterface{}, store.Get("ec2ListAllNetworkInterfaces"))
+			}
+
+			changelog, err := diff.Diff(got, tt.want)
+			assert.Nil(t, err)
+			if len(changelog) > 0 {
+				for _, change := range changelog {
+					t.Errorf("%s: %s -> %s", strings.Join(change.Path, "."), change.From, change.To)
+				}
+				t.Fail()
+			}
+		})
+	}
+}
+
+func Test_ec2Repository_ListAllFlowLogs(t *testing.T) {
+	testErr := errors.New("test")
+
+	tests := []struct {
+		name    string
+		mocks   func(client *awstest.MockFakeEC2)
+		want    []*ec2.FlowLog
+		wantErr error
+	}{
+		{
+			name: "List flow logs with multiple pages",
+			mocks: func(client *awstest.MockFakeEC2) {
+				client.On("DescribeFlowLogsPages",
+					&ec2.DescribeFlowLogsInput{},
+					mock.MatchedBy(func(callback func(res *ec2.DescribeFlowLogsOutput, lastPage bool) bool) bool {
+						callback(&ec2.DescribeFlowLogsOutput{
+							FlowLogs: []*ec2.FlowLog{
+								{
+									FlowLogId: aws.String("fl-0"),
+								},
+								{
+									FlowLogId: aws.String("fl-1"),
+								},
+							},
+						}, false)
+						callback(&ec2.DescribeFlowLogsOutput{
+							FlowLogs: []*ec2.FlowLog{
+								{
+									FlowLogId: aws.String("fl-2"),
+								},
+								{
+									FlowLogId: aws.String("fl-3"),
+								},
+							},
+						}, true)
+						return true
+					})).Return(nil).Once()
+			},
+			want: []*ec2.FlowLog{
+				{
+					FlowLogId: aws.String("fl-0"),
+				},
+				{
+					FlowLogId: aws.String("fl-1"),
+				},
+				{
+					FlowLogId: aws.String("fl-2"),
+				},
+				{
+					FlowLogId: aws.String("fl-3"),
+				},
+			},
+		},
+		{
+			name: "Error listing flow logs",
+			mocks: func(client *awstest.MockFakeEC2) {
+				client.On("DescribeFlowLogsPages",
+					&ec2.DescribeFlowLogsInput{},
+					mock.Anything).Return(testErr).Once()
+			},
+			wantErr: testErr,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := cache.New(1)
+			client := &awstest.MockFakeEC2{}
+			tt.mocks(client)
+			r := &ec2Repository{
+				client: client,
+				cache:  store,
+			}
+			got, err := r.ListAllFlowLogs()
+			assert.Equal(t, tt.wantErr, err)
+
+			if err == nil {
+				// Check that results were cached
+				cachedData, err := r.ListAllFlowLogs()
+				assert.NoError(t, err)
+				assert.Equal(t, got, cachedData)
+				assert.IsType(t, []*ec2.FlowLog{}, store.Get("ec2ListAllFlowLogs"))
+			}
+
+			changelog, err := diff.Diff(got, tt.want)
+			assert.Nil(t, err)
+			if len(changelog) > 0 {
+				for _, change := range changelog {
+					t.Errorf("%s: %s -> %s", strings.Join(change.Path, "."), change.From, change.To)
+				}
+				t.Fail()
+			}
+		})
+	}
+}
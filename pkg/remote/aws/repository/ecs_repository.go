@@ -0,0 +1,132 @@
+package repository
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/aws/aws-sdk-go/service/ecs/ecsiface"
+	"github.com/snyk/driftctl/pkg/remote/cache"
+)
+
+// DescribeClusters accepts up to 100 clusters per call
+const ecsDescribeClustersMaxItems = 100
+
+type ECSRepository interface {
+	ListAllClusters() ([]*ecs.Cluster, error)
+	ListAllServices(clusterArn string) ([]*string, error)
+	ListAllTaskDefinitions() ([]*ecs.TaskDefinition, error)
+}
+
+type ecsRepository struct {
+	client ecsiface.ECSAPI
+	cache  cache.Cache
+}
+
+func NewECSRepository(session *session.Session, c cache.Cache) *ecsRepository {
+	return &ecsRepository{
+		ecs.New(session),
+		c,
+	}
+}
+
+func (r *ecsRepository) ListAllClusters() ([]*ecs.Cluster, error) {
+	cacheKey := "ecsListAllClusters"
+	v := r.cache.GetAndLock(cacheKey)
+	defer r.cache.Unlock(cacheKey)
+	if v != nil {
+		return v.([]*ecs.Cluster), nil
+	}
+
+	var arns []*string
+	input := &ecs.ListClustersInput{}
+	err := r.client.ListClustersPages(input, func(res *ecs.ListClustersOutput, lastPage bool) bool {
+		arns = append(arns, res.ClusterArns...)
+		return !lastPage
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	clusters := make([]*ecs.Cluster, 0, len(arns))
+	for start := 0; start < len(arns); start += ecsDescribeClustersMaxItems {
+		end := start + ecsDescribeClustersMaxItems
+		if end > len(arns) {
+			end = len(arns)
+		}
+		output, err := r.client.DescribeClusters(&ecs.DescribeClustersInput{
+			Clusters: arns[start:end],
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, cluster := range output.Clusters {
+			// Deleted clusters are still described for a while
+			if aws.StringValue(cluster.Status) == "INACTIVE" {
+				continue
+			}
+			clusters = append(clusters, cluster)
+		}
+	}
+
+	r.cache.Put(cacheKey, clusters)
+	return clusters, nil
+}
+
+func (r *ecsRepository) ListAllServices(clusterArn string) ([]*string, error) {
+	cacheKey := fmt.Sprintf("ecsListAllServices_%s", clusterArn)
+	if v := r.cache.Get(cacheKey); v != nil {
+		return v.([]*string), nil
+	}
+
+	var services []*string
+	input := &ecs.ListServicesInput{
+		Cluster: aws.String(clusterArn),
+	}
+	err := r.client.ListServicesPages(input, func(res *ecs.ListServicesOutput, lastPage bool) bool {
+		services = append(services, res.ServiceArns...)
+		return !lastPage
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r.cache.Put(cacheKey, services)
+	return services, nil
+}
+
+// ListAllTaskDefinitions returns the latest active revision of each task definition family,
+// previous revisions are replaced by Terraform and are not tracked in states
+func (r *ecsRepository) ListAllTaskDefinitions() ([]*ecs.TaskDefinition, error) {
+	if v := r.cache.Get("ecsListAllTaskDefinitions"); v != nil {
+		return v.([]*ecs.TaskDefinition), nil
+	}
+
+	var families []*string
+	input := &ecs.ListTaskDefinitionFamiliesInput{
+		Status: aws.String(ecs.TaskDefinitionFamilyStatusActive),
+	}
+	err := r.client.ListTaskDefinitionFamiliesPages(input, func(res *ecs.ListTaskDefinitionFamiliesOutput, lastPage bool) bool {
+		families = append(families, res.Families...)
+		return !lastPage
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	taskDefinitions := make([]*ecs.TaskDefinition, 0, len(families))
+	for _, family := range families {
+		// Describing a family returns its latest active revision
+		output, err := r.client.DescribeTaskDefinition(&ecs.DescribeTaskDefinitionInput{
+			TaskDefinition: family,
+		})
+		if err != nil {
+			return nil, err
+		}
+		taskDefinitions = append(taskDefinitions, output.TaskDefinition)
+	}
+
+	r.cache.Put("ecsListAllTaskDefinitions", taskDefinitions)
+	return taskDefinitions, nil
+}
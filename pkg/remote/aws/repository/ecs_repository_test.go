@@ -0,0 +1,269 @@
+package repository
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/r3labs/diff/v2"
+	"github.com/snyk/driftctl/pkg/remote/cache"
+	awstest "github.com/snyk/driftctl/test/aws"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_ecsRepository_ListAllClusters(t *testing.T) {
+	tests := []struct {
+		name    string
+		mocks   func(client *awstest.MockFakeECS)
+		want    []*ecs.Cluster
+		wantErr error
+	}{
+		{
+			name: "List with 2 pages",
+			mocks: func(client *awstest.MockFakeECS) {
+				client.On("ListClustersPages",
+					&ecs.ListClustersInput{},
+					mock.MatchedBy(func(callback func(res *ecs.ListClustersOutput, lastPage bool) bool) bool {
+						callback(&ecs.ListClustersOutput{
+							ClusterArns: []*string{
+								aws.String("arn:aws:ecs:us-east-1:123456789012:cluster/foo"),
+								aws.String("arn:aws:ecs:us-east-1:123456789012:cluster/bar"),
+							},
+						}, false)
+						callback(&ecs.ListClustersOutput{
+							ClusterArns: []*string{
+								aws.String("arn:aws:ecs:us-east-1:123456789012:cluster/deleted"),
+							},
+						}, true)
+						return true
+					})).Return(nil).Once()
+				client.On("DescribeClusters", &ecs.DescribeClustersInput{
+					Clusters: []*string{
+						aws.String("arn:aws:ecs:us-east-1:123456789012:cluster/foo"),
+						aws.String("arn:aws:ecs:us-east-1:123456789012:cluster/bar"),
+						aws.String("arn:aws:ecs:us-east-1:123456789012:cluster/deleted"),
+					},
+				}).Return(&ecs.DescribeClustersOutput{
+					Clusters: []*ecs.Cluster{
+						{ClusterArn: aws.String("arn:aws:ecs:us-east-1:123456789012:cluster/foo"), ClusterName: aws.String("foo"), Status: aws.String("ACTIVE")},
+						{ClusterArn: aws.String("arn:aws:ecs:us-east-1:123456789012:cluster/bar"), ClusterName: aws.String("bar"), Status: aws.String("ACTIVE")},
+						{ClusterArn: aws.String("arn:aws:ecs:us-east-1:123456789012:cluster/deleted"), ClusterName: aws.String("deleted"), Status: aws.String("INACTIVE")},
+					},
+				}, nil).Once()
+			},
+			want: []*ecs.Cluster{
+				{ClusterArn: aws.String("arn:aws:ecs:us-east-1:123456789012:cluster/foo"), ClusterName: aws.String("foo"), Status: aws.String("ACTIVE")},
+				{ClusterArn: aws.String("arn:aws:ecs:us-east-1:123456789012:cluster/bar"), ClusterName: aws.String("bar"), Status: aws.String("ACTIVE")},
+			},
+		},
+		{
+			name: "Error describing clusters",
+			mocks: func(client *awstest.MockFakeECS) {
+				client.On("ListClustersPages",
+					&ecs.ListClustersInput{},
+					mock.MatchedBy(func(callback func(res *ecs.ListClustersOutput, lastPage bool) bool) bool {
+						callback(&ecs.ListClustersOutput{
+							ClusterArns: []*string{aws.String("arn:aws:ecs:us-east-1:123456789012:cluster/foo")},
+						}, true)
+						return true
+					})).Return(nil).Once()
+				client.On("DescribeClusters", mock.Anything).Return(nil, errors.New("remote error")).Once()
+			},
+			wantErr: errors.New("remote error"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := cache.New(1)
+			client := awstest.MockFakeECS{}
+			tt.mocks(&client)
+			r := &ecsRepository{
+				client: &client,
+				cache:  store,
+			}
+			got, err := r.ListAllClusters()
+			assert.Equal(t, tt.wantErr, err)
+
+			if err == nil {
+				// Check that results were cached
+				cachedData, err := r.ListAllClusters()
+				assert.NoError(t, err)
+				assert.Equal(t, got, cachedData)
+				assert.IsType(t, []*ecs.Cluster{}, store.Get("ecsListAllClusters"))
+			}
+
+			changelog, err := diff.Diff(got, tt.want)
+			assert.Nil(t, err)
+			if len(changelog) > 0 {
+				for _, change := range changelog {
+					t.Errorf("%s: %s -> %s", strings.Join(change.Path, "."), change.From, change.To)
+				}
+				t.Fail()
+			}
+			client.AssertExpectations(t)
+		})
+	}
+}
+
+func Test_ecsRepository_ListAllServices(t *testing.T) {
+	clusterArn := "arn:aws:ecs:us-east-1:123456789012:cluster/foo"
+
+	tests := []struct {
+		name    string
+		mocks   func(client *awstest.MockFakeECS)
+		want    []*string
+		wantErr error
+	}{
+		{
+			name: "List with 2 pages",
+			mocks: func(client *awstest.MockFakeECS) {
+				client.On("ListServicesPages",
+					&ecs.ListServicesInput{Cluster: aws.String(clusterArn)},
+					mock.MatchedBy(func(callback func(res *ecs.ListServicesOutput, lastPage bool) bool) bool {
+						callback(&ecs.ListServicesOutput{
+							ServiceArns: []*string{aws.String("arn:aws:ecs:us-east-1:123456789012:service/foo/web")},
+						}, false)
+						callback(&ecs.ListServicesOutput{
+							ServiceArns: []*string{aws.String("arn:aws:ecs:us-east-1:123456789012:service/foo/worker")},
+						}, true)
+						return true
+					})).Return(nil).Once()
+			},
+			want: []*string{
+				aws.String("arn:aws:ecs:us-east-1:123456789012:service/foo/web"),
+				aws.String("arn:aws:ecs:us-east-1:123456789012:service/foo/worker"),
+			},
+		},
+		{
+			name: "Error listing services",
+			mocks: func(client *awstest.MockFakeECS) {
+				client.On("ListServicesPages", &ecs.ListServicesInput{Cluster: aws.String(clusterArn)}, mock.Anything).Return(errors.New("remote error")).Once()
+			},
+			wantErr: errors.New("remote error"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := cache.New(1)
+			client := awstest.MockFakeECS{}
+			tt.mocks(&client)
+			r := &ecsRepository{
+				client: &client,
+				cache:  store,
+			}
+			got, err := r.ListAllServices(clusterArn)
+			assert.Equal(t, tt.wantErr, err)
+
+			if err == nil {
+				// Check that results were cached
+				cachedData, err := r.ListAllServices(clusterArn)
+				assert.NoError(t, err)
+				assert.Equal(t, got, cachedData)
+				assert.IsType(t, []*string{}, store.Get("ecsListAllServices_"+clusterArn))
+			}
+
+			changelog, err := diff.Diff(got, tt.want)
+			assert.Nil(t, err)
+			if len(changelog) > 0 {
+				for _, change := range changelog {
+					t.Errorf("%s: %s -> %s", strings.Join(change.Path, "."), change.From, change.To)
+				}
+				t.Fail()
+			}
+			client.AssertExpectations(t)
+		})
+	}
+}
+
+func Test_ecsRepository_ListAllTaskDefinitions(t *testing.T) {
+	tests := []struct {
+		name    string
+		mocks   func(client *awstest.MockFakeECS)
+		want    []*ecs.TaskDefinition
+		wantErr error
+	}{
+		{
+			name: "List latest revision of each family",
+			mocks: func(client *awstest.MockFakeECS) {
+				client.On("ListTaskDefinitionFamiliesPages",
+					&ecs.ListTaskDefinitionFamiliesInput{Status: aws.String("ACTIVE")},
+					mock.MatchedBy(func(callback func(res *ecs.ListTaskDefinitionFamiliesOutput, lastPage bool) bool) bool {
+						callback(&ecs.ListTaskDefinitionFamiliesOutput{
+							Families: []*string{aws.String("web")},
+						}, false)
+						callback(&ecs.ListTaskDefinitionFamiliesOutput{
+							Families: []*string{aws.String("worker")},
+						}, true)
+						return true
+					})).Return(nil).Once()
+				client.On("DescribeTaskDefinition", &ecs.DescribeTaskDefinitionInput{TaskDefinition: aws.String("web")}).Return(&ecs.DescribeTaskDefinitionOutput{
+					TaskDefinition: &ecs.TaskDefinition{
+						Family:            aws.String("web"),
+						Revision:          aws.Int64(3),
+						TaskDefinitionArn: aws.String("arn:aws:ecs:us-east-1:123456789012:task-definition/web:3"),
+					},
+				}, nil).Once()
+				client.On("DescribeTaskDefinition", &ecs.DescribeTaskDefinitionInput{TaskDefinition: aws.String("worker")}).Return(&ecs.DescribeTaskDefinitionOutput{
+					TaskDefinition: &ecs.TaskDefinition{
+						Family:            aws.String("worker"),
+						Revision:          aws.Int64(1),
+						TaskDefinitionArn: aws.String("arn:aws:ecs:us-east-1:123456789012:task-definition/worker:1"),
+					},
+				}, nil).Once()
+			},
+			want: []*ecs.TaskDefinition{
+				{
+					Family:            aws.String("web"),
+					Revision:          aws.Int64(3),
+					TaskDefinitionArn: aws.String("arn:aws:ecs:us-east-1:123456789012:task-definition/web:3"),
+				},
+				{
+					Family:            aws.String("worker"),
+					Revision:          aws.Int64(1),
+					TaskDefinitionArn: aws.String("arn:aws:ecs:us-east-1:123456789012:task-definition/worker:1"),
+				},
+			},
+		},
+		{
+			name: "Error listing families",
+			mocks: func(client *awstest.MockFakeECS) {
+				client.On("ListTaskDefinitionFamiliesPages", &ecs.ListTaskDefinitionFamiliesInput{Status: aws.String("ACTIVE")}, mock.Anything).Return(errors.New("remote error")).Once()
+			},
+			wantErr: errors.New("remote error"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := cache.New(1)
+			client := awstest.MockFakeECS{}
+			tt.mocks(&client)
+			r := &ecsRepository{
+				client: &client,
+				cache:  store,
+			}
+			got, err := r.ListAllTaskDefinitions()
+			assert.Equal(t, tt.wantErr, err)
+
+			if err == nil {
+				// Check that results were cached
+				cachedData, err := r.ListAllTaskDefinitions()
+				assert.NoError(t, err)
+				assert.Equal(t, got, cachedData)
+				assert.IsType(t, []*ecs.TaskDefinition{}, store.Get("ecsListAllTaskDefinitions"))
+			}
+
+			changelog, err := diff.Diff(got, tt.want)
+			assert.Nil(t, err)
+			if len(changelog) > 0 {
+				for _, change := range changelog {
+					t.Errorf("%s: %s -> %s", strings.Join(change.Path, "."), change.From, change.To)
+				}
+				t.Fail()
+			}
+			client.AssertExpectations(t)
+		})
+	}
+}
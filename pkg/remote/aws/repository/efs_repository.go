@@ -0,0 +1,78 @@
+package repository
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/efs"
+	"github.com/aws/aws-sdk-go/service/efs/efsiface"
+	"github.com/snyk/driftctl/pkg/remote/cache"
+)
+
+type EFSRepository interface {
+	ListAllFileSystems() ([]*efs.FileSystemDescription, error)
+	ListAllMountTargets(fileSystemId string) ([]*efs.MountTargetDescription, error)
+}
+
+type efsRepository struct {
+	client efsiface.EFSAPI
+	cache  cache.Cache
+}
+
+func NewEFSRepository(session *session.Session, c cache.Cache) *efsRepository {
+	return &efsRepository{
+		efs.New(session),
+		c,
+	}
+}
+
+func (r *efsRepository) ListAllFileSystems() ([]*efs.FileSystemDescription, error) {
+	cacheKey := "efsListAllFileSystems"
+	v := r.cache.GetAndLock(cacheKey)
+	defer r.cache.Unlock(cacheKey)
+	if v != nil {
+		return v.([]*efs.FileSystemDescription), nil
+	}
+
+	var fileSystems []*efs.FileSystemDescription
+	input := &efs.DescribeFileSystemsInput{}
+	err := r.client.DescribeFileSystemsPages(input, func(res *efs.DescribeFileSystemsOutput, lastPage bool) bool {
+		fileSystems = append(fileSystems, res.FileSystems...)
+		return !lastPage
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r.cache.Put(cacheKey, fileSystems)
+	return fileSystems, nil
+}
+
+// ListAllMountTargets returns the mount targets of a file system, the SDK does not provide a paginator for them
+func (r *efsRepository) ListAllMountTargets(fileSystemId string) ([]*efs.MountTargetDescription, error) {
+	cacheKey := fmt.Sprintf("efsListAllMountTargets_%s", fileSystemId)
+	if v := r.cache.Get(cacheKey); v != nil {
+		return v.([]*efs.MountTargetDescription), nil
+	}
+
+	var mountTargets []*efs.MountTargetDescription
+	var marker *string
+	for {
+		res, err := r.client.DescribeMountTargets(&efs.DescribeMountTargetsInput{
+			FileSystemId: aws.String(fileSystemId),
+			Marker:       marker,
+		})
+		if err != nil {
+			return nil, err
+		}
+		mountTargets = append(mountTargets, res.MountTargets...)
+		if res.NextMarker == nil {
+			break
+		}
+		marker = res.NextMarker
+	}
+
+	r.cache.Put(cacheKey, mountTargets)
+	return mountTargets, nil
+}
@@ -0,0 +1,164 @@
+package repository
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/efs"
+	"github.com/r3labs/diff/v2"
+	"github.com/snyk/driftctl/pkg/remote/cache"
+	awstest "github.com/snyk/driftctl/test/aws"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_efsRepository_ListAllFileSystems(t *testing.T) {
+	tests := []struct {
+		name    string
+		mocks   func(client *awstest.MockFakeEFS)
+		want    []*efs.FileSystemDescription
+		wantErr error
+	}{
+		{
+			name: "List with 2 pages",
+			mocks: func(client *awstest.MockFakeEFS) {
+				client.On("DescribeFileSystemsPages",
+					&efs.DescribeFileSystemsInput{},
+					mock.MatchedBy(func(callback func(res *efs.DescribeFileSystemsOutput, lastPage bool) bool) bool {
+						callback(&efs.DescribeFileSystemsOutput{
+							FileSystems: []*efs.FileSystemDescription{
+								{FileSystemId: aws.String("fs-0a1b2c3d")},
+							},
+						}, false)
+						callback(&efs.DescribeFileSystemsOutput{
+							FileSystems: []*efs.FileSystemDescription{
+								{FileSystemId: aws.String("fs-1b2c3d4e")},
+							},
+						}, true)
+						return true
+					})).Return(nil).Once()
+			},
+			want: []*efs.FileSystemDescription{
+				{FileSystemId: aws.String("fs-0a1b2c3d")},
+				{FileSystemId: aws.String("fs-1b2c3d4e")},
+			},
+		},
+		{
+			name: "should return remote error",
+			mocks: func(client *awstest.MockFakeEFS) {
+				client.On("DescribeFileSystemsPages",
+					&efs.DescribeFileSystemsInput{},
+					mock.Anything).Return(errors.New("remote error")).Once()
+			},
+			wantErr: errors.New("remote error"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := cache.New(1)
+			client := awstest.MockFakeEFS{}
+			tt.mocks(&client)
+			r := &efsRepository{
+				client: &client,
+				cache:  store,
+			}
+			got, err := r.ListAllFileSystems()
+			assert.Equal(t, tt.wantErr, err)
+
+			if err == nil {
+				// Check that results were cached
+				cachedData, err := r.ListAllFileSystems()
+				assert.NoError(t, err)
+				assert.Equal(t, got, cachedData)
+				assert.IsType(t, []*efs.FileSystemDescription{}, store.Get("efsListAllFileSystems"))
+			}
+
+			changelog, err := diff.Diff(got, tt.want)
+			assert.Nil(t, err)
+			if len(changelog) > 0 {
+				for _, change := range changelog {
+					t.Errorf("%s: %v -> %v", strings.Join(change.Path, "."), change.From, change.To)
+				}
+				t.Fail()
+			}
+			client.AssertExpectations(t)
+		})
+	}
+}
+
+func Test_efsRepository_ListAllMountTargets(t *testing.T) {
+	tests := []struct {
+		name    string
+		mocks   func(client *awstest.MockFakeEFS)
+		want    []*efs.MountTargetDescription
+		wantErr error
+	}{
+		{
+			name: "List with 2 pages",
+			mocks: func(client *awstest.MockFakeEFS) {
+				client.On("DescribeMountTargets", &efs.DescribeMountTargetsInput{
+					FileSystemId: aws.String("fs-0a1b2c3d"),
+				}).Return(&efs.DescribeMountTargetsOutput{
+					MountTargets: []*efs.MountTargetDescription{
+						{MountTargetId: aws.String("fsmt-0a1b2c3d"), FileSystemId: aws.String("fs-0a1b2c3d")},
+					},
+					NextMarker: aws.String("next"),
+				}, nil).Once()
+				client.On("DescribeMountTargets", &efs.DescribeMountTargetsInput{
+					FileSystemId: aws.String("fs-0a1b2c3d"),
+					Marker:       aws.String("next"),
+				}).Return(&efs.DescribeMountTargetsOutput{
+					MountTargets: []*efs.MountTargetDescription{
+						{MountTargetId: aws.String("fsmt-1b2c3d4e"), FileSystemId: aws.String("fs-0a1b2c3d")},
+					},
+				}, nil).Once()
+			},
+			want: []*efs.MountTargetDescription{
+				{MountTargetId: aws.String("fsmt-0a1b2c3d"), FileSystemId: aws.String("fs-0a1b2c3d")},
+				{MountTargetId: aws.String("fsmt-1b2c3d4e"), FileSystemId: aws.String("fs-0a1b2c3d")},
+			},
+		},
+		{
+			name: "should return remote error",
+			mocks: func(client *awstest.MockFakeEFS) {
+				client.On("DescribeMountTargets", &efs.DescribeMountTargetsInput{
+					FileSystemId: aws.String("fs-0a1b2c3d"),
+				}).Return(nil, errors.New("remote error")).Once()
+			},
+			wantErr: errors.New("remote error"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := cache.New(1)
+			client := awstest.MockFakeEFS{}
+			tt.mocks(&client)
+			r := &efsRepository{
+				client: &client,
+				cache:  store,
+			}
+			got, err := r.ListAllMountTargets("fs-0a1b2c3d")
+			assert.Equal(t, tt.wantErr, err)
+
+			if err == nil {
+				// Check that results were cached
+				cachedData, err := r.ListAllMountTargets("fs-0a1b2c3d")
+				assert.NoError(t, err)
+				assert.Equal(t, got, cachedData)
+				assert.IsType(t, []*efs.MountTargetDescription{}, store.Get("efsListAllMountTargets_fs-0a1b2c3d"))
+			}
+
+			changelog, err := diff.Diff(got, tt.want)
+			assert.Nil(t, err)
+			if len(changelog) > 0 {
+				for _, change := range changelog {
+					t.Errorf("%s: %v -> %v", strings.Join(change.Path, "."), change.From, change.To)
+				}
+				t.Fail()
+			}
+			client.AssertExpectations(t)
+		})
+	}
+}
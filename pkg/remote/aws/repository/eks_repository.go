@@ -0,0 +1,95 @@
+package repository
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/eks"
+	"github.com/aws/aws-sdk-go/service/eks/eksiface"
+	"github.com/snyk/driftctl/pkg/remote/cache"
+)
+
+type EKSRepository interface {
+	ListAllClusters() ([]*string, error)
+	ListAllNodeGroups(clusterName string) ([]*string, error)
+	ListAllAddons(clusterName string) ([]*string, error)
+}
+
+type eksRepository struct {
+	client eksiface.EKSAPI
+	cache  cache.Cache
+}
+
+func NewEKSRepository(session *session.Session, c cache.Cache) *eksRepository {
+	return &eksRepository{
+		eks.New(session),
+		c,
+	}
+}
+
+func (r *eksRepository) ListAllClusters() ([]*string, error) {
+	cacheKey := "eksListAllClusters"
+	v := r.cache.GetAndLock(cacheKey)
+	defer r.cache.Unlock(cacheKey)
+	if v != nil {
+		return v.([]*string), nil
+	}
+
+	var clusters []*string
+	input := &eks.ListClustersInput{}
+	err := r.client.ListClustersPages(input, func(res *eks.ListClustersOutput, lastPage bool) bool {
+		clusters = append(clusters, res.Clusters...)
+		return !lastPage
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r.cache.Put(cacheKey, clusters)
+	return clusters, nil
+}
+
+func (r *eksRepository) ListAllNodeGroups(clusterName string) ([]*string, error) {
+	cacheKey := fmt.Sprintf("eksListAllNodeGroups_%s", clusterName)
+	if v := r.cache.Get(cacheKey); v != nil {
+		return v.([]*string), nil
+	}
+
+	var nodeGroups []*string
+	input := &eks.ListNodegroupsInput{
+		ClusterName: aws.String(clusterName),
+	}
+	err := r.client.ListNodegroupsPages(input, func(res *eks.ListNodegroupsOutput, lastPage bool) bool {
+		nodeGroups = append(nodeGroups, res.Nodegroups...)
+		return !lastPage
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r.cache.Put(cacheKey, nodeGroups)
+	return nodeGroups, nil
+}
+
+func (r *eksRepository) ListAllAddons(clusterName string) ([]*string, error) {
+	cacheKey := fmt.Sprintf("eksListAllAddons_%s", clusterName)
+	if v := r.cache.Get(cacheKey); v != nil {
+		return v.([]*string), nil
+	}
+
+	var addons []*string
+	input := &eks.ListAddonsInput{
+		ClusterName: aws.String(clusterName),
+	}
+	err := r.client.ListAddonsPages(input, func(res *eks.ListAddonsOutput, lastPage bool) bool {
+		addons = append(addons, res.Addons...)
+		return !lastPage
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r.cache.Put(cacheKey, addons)
+	return addons, nil
+}
@@ -0,0 +1,198 @@
+package repository
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/eks"
+	"github.com/r3labs/diff/v2"
+	"github.com/snyk/driftctl/pkg/remote/cache"
+	awstest "github.com/snyk/driftctl/test/aws"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_eksRepository_ListAllClusters(t *testing.T) {
+	tests := []struct {
+		name    string
+		mocks   func(client *awstest.MockFakeEKS)
+		want    []*string
+		wantErr error
+	}{
+		{
+			name: "List with 2 pages",
+			mocks: func(client *awstest.MockFakeEKS) {
+				client.On("ListClustersPages",
+					&eks.ListClustersInput{},
+					mock.MatchedBy(func(callback func(res *eks.ListClustersOutput, lastPage bool) bool) bool {
+						callback(&eks.ListClustersOutput{Clusters: []*string{aws.String("foo")}}, false)
+						callback(&eks.ListClustersOutput{Clusters: []*string{aws.String("bar")}}, true)
+						return true
+					})).Return(nil).Once()
+			},
+			want: []*string{aws.String("foo"), aws.String("bar")},
+		},
+		{
+			name: "Error listing clusters",
+			mocks: func(client *awstest.MockFakeEKS) {
+				client.On("ListClustersPages", &eks.ListClustersInput{}, mock.Anything).Return(errors.New("remote error")).Once()
+			},
+			wantErr: errors.New("remote error"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := cache.New(1)
+			client := awstest.MockFakeEKS{}
+			tt.mocks(&client)
+			r := &eksRepository{
+				client: &client,
+				cache:  store,
+			}
+			got, err := r.ListAllClusters()
+			assert.Equal(t, tt.wantErr, err)
+
+			if err == nil {
+				// Check that results were cached
+				cachedData, err := r.ListAllClusters()
+				assert.NoError(t, err)
+				assert.Equal(t, got, cachedData)
+				assert.IsType(t, []*string{}, store.Get("eksListAllClusters"))
+			}
+
+			changelog, err := diff.Diff(got, tt.want)
+			assert.Nil(t, err)
+			if len(changelog) > 0 {
+				for _, change := range changelog {
+					t.Errorf("%s: %s -> %s", strings.Join(change.Path, "."), change.From, change.To)
+				}
+				t.Fail()
+			}
+			client.AssertExpectations(t)
+		})
+	}
+}
+
+func Test_eksRepository_ListAllNodeGroups(t *testing.T) {
+	tests := []struct {
+		name    string
+		mocks   func(client *awstest.MockFakeEKS)
+		want    []*string
+		wantErr error
+	}{
+		{
+			name: "List with 2 pages",
+			mocks: func(client *awstest.MockFakeEKS) {
+				client.On("ListNodegroupsPages",
+					&eks.ListNodegroupsInput{ClusterName: aws.String("foo")},
+					mock.MatchedBy(func(callback func(res *eks.ListNodegroupsOutput, lastPage bool) bool) bool {
+						callback(&eks.ListNodegroupsOutput{Nodegroups: []*string{aws.String("default")}}, false)
+						callback(&eks.ListNodegroupsOutput{Nodegroups: []*string{aws.String("spot")}}, true)
+						return true
+					})).Return(nil).Once()
+			},
+			want: []*string{aws.String("default"), aws.String("spot")},
+		},
+		{
+			name: "Error listing node groups",
+			mocks: func(client *awstest.MockFakeEKS) {
+				client.On("ListNodegroupsPages", &eks.ListNodegroupsInput{ClusterName: aws.String("foo")}, mock.Anything).Return(errors.New("remote error")).Once()
+			},
+			wantErr: errors.New("remote error"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := cache.New(1)
+			client := awstest.MockFakeEKS{}
+			tt.mocks(&client)
+			r := &eksRepository{
+				client: &client,
+				cache:  store,
+			}
+			got, err := r.ListAllNodeGroups("foo")
+			assert.Equal(t, tt.wantErr, err)
+
+			if err == nil {
+				// Check that results were cached
+				cachedData, err := r.ListAllNodeGroups("foo")
+				assert.NoError(t, err)
+				assert.Equal(t, got, cachedData)
+				assert.IsType(t, []*string{}, store.Get("eksListAllNodeGroups_foo"))
+			}
+
+			changelog, err := diff.Diff(got, tt.want)
+			assert.Nil(t, err)
+			if len(changelog) > 0 {
+				for _, change := range changelog {
+					t.Errorf("%s: %s -> %s", strings.Join(change.Path, "."), change.From, change.To)
+				}
+				t.Fail()
+			}
+			client.AssertExpectations(t)
+		})
+	}
+}
+
+func Test_eksRepository_ListAllAddons(t *testing.T) {
+	tests := []struct {
+		name    string
+		mocks   func(client *awstest.MockFakeEKS)
+		want    []*string
+		wantErr error
+	}{
+		{
+			name: "List with 2 pages",
+			mocks: func(client *awstest.MockFakeEKS) {
+				client.On("ListAddonsPages",
+					&eks.ListAddonsInput{ClusterName: aws.String("foo")},
+					mock.MatchedBy(func(callback func(res *eks.ListAddonsOutput, lastPage bool) bool) bool {
+						callback(&eks.ListAddonsOutput{Addons: []*string{aws.String("vpc-cni")}}, false)
+						callback(&eks.ListAddonsOutput{Addons: []*string{aws.String("coredns")}}, true)
+						return true
+					})).Return(nil).Once()
+			},
+			want: []*string{aws.String("vpc-cni"), aws.String("coredns")},
+		},
+		{
+			name: "Error listing addons",
+			mocks: func(client *awstest.MockFakeEKS) {
+				client.On("ListAddonsPages", &eks.ListAddonsInput{ClusterName: aws.String("foo")}, mock.Anything).Return(errors.New("remote error")).Once()
+			},
+			wantErr: errors.New("remote error"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := cache.New(1)
+			client := awstest.MockFakeEKS{}
+			tt.mocks(&client)
+			r := &eksRepository{
+				client: &client,
+				cache:  store,
+			}
+			got, err := r.ListAllAddons("foo")
+			assert.Equal(t, tt.wantErr, err)
+
+			if err == nil {
+				// Check that results were cached
+				cachedData, err := r.ListAllAddons("foo")
+				assert.NoError(t, err)
+				assert.Equal(t, got, cachedData)
+				assert.IsType(t, []*string{}, store.Get("eksListAllAddons_foo"))
+			}
+
+			changelog, err := diff.Diff(got, tt.want)
+			assert.Nil(t, err)
+			if len(changelog) > 0 {
+				for _, change := range changelog {
+					t.Errorf("%s: %s -> %s", strings.Join(change.Path, "."), change.From, change.To)
+				}
+				t.Fail()
+			}
+			client.AssertExpectations(t)
+		})
+	}
+}
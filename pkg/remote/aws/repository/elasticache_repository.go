@@ -0,0 +1,70 @@
+package repository
+
+import (
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/elasticache"
+	"github.com/aws/aws-sdk-go/service/elasticache/elasticacheiface"
+	"github.com/snyk/driftctl/pkg/remote/cache"
+)
+
+type ElastiCacheRepository interface {
+	ListAllCacheClusters() ([]*elasticache.CacheCluster, error)
+	ListAllReplicationGroups() ([]*elasticache.ReplicationGroup, error)
+}
+
+type elastiCacheRepository struct {
+	client elasticacheiface.ElastiCacheAPI
+	cache  cache.Cache
+}
+
+func NewElastiCacheRepository(session *session.Session, c cache.Cache) *elastiCacheRepository {
+	return &elastiCacheRepository{
+		elasticache.New(session),
+		c,
+	}
+}
+
+// ListAllCacheClusters returns standalone cache clusters,
+// the member clusters of a replication group are managed by aws_elasticache_replication_group
+func (r *elastiCacheRepository) ListAllCacheClusters() ([]*elasticache.CacheCluster, error) {
+	if v := r.cache.Get("elasticacheListAllCacheClusters"); v != nil {
+		return v.([]*elasticache.CacheCluster), nil
+	}
+
+	var clusters []*elasticache.CacheCluster
+	input := &elasticache.DescribeCacheClustersInput{}
+	err := r.client.DescribeCacheClustersPages(input, func(res *elasticache.DescribeCacheClustersOutput, lastPage bool) bool {
+		for _, cluster := range res.CacheClusters {
+			if cluster.ReplicationGroupId != nil && *cluster.ReplicationGroupId != "" {
+				continue
+			}
+			clusters = append(clusters, cluster)
+		}
+		return !lastPage
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r.cache.Put("elasticacheListAllCacheClusters", clusters)
+	return clusters, nil
+}
+
+func (r *elastiCacheRepository) ListAllReplicationGroups() ([]*elasticache.ReplicationGroup, error) {
+	if v := r.cache.Get("elasticacheListAllReplicationGroups"); v != nil {
+		return v.([]*elasticache.ReplicationGroup), nil
+	}
+
+	var groups []*elasticache.ReplicationGroup
+	input := &elasticache.DescribeReplicationGroupsInput{}
+	err := r.client.DescribeReplicationGroupsPages(input, func(res *elasticache.DescribeReplicationGroupsOutput, lastPage bool) bool {
+		groups = append(groups, res.ReplicationGroups...)
+		return !lastPage
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r.cache.Put("elasticacheListAllReplicationGroups", groups)
+	return groups, nil
+}
@@ -0,0 +1,164 @@
+package repository
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/elasticache"
+	"github.com/r3labs/diff/v2"
+	"github.com/snyk/driftctl/pkg/remote/cache"
+	awstest "github.com/snyk/driftctl/test/aws"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_elastiCacheRepository_ListAllCacheClusters(t *testing.T) {
+	tests := []struct {
+		name    string
+		mocks   func(client *awstest.MockFakeElastiCache)
+		want    []*elasticache.CacheCluster
+		wantErr error
+	}{
+		{
+			name: "List only standalone clusters with 2 pages",
+			mocks: func(client *awstest.MockFakeElastiCache) {
+				client.On("DescribeCacheClustersPages",
+					&elasticache.DescribeCacheClustersInput{},
+					mock.MatchedBy(func(callback func(res *elasticache.DescribeCacheClustersOutput, lastPage bool) bool) bool {
+						callback(&elasticache.DescribeCacheClustersOutput{
+							CacheClusters: []*elasticache.CacheCluster{
+								{CacheClusterId: aws.String("sessions")},
+								{CacheClusterId: aws.String("sessions-cache-001"), ReplicationGroupId: aws.String("sessions-cache")},
+							},
+						}, false)
+						callback(&elasticache.DescribeCacheClustersOutput{
+							CacheClusters: []*elasticache.CacheCluster{
+								{CacheClusterId: aws.String("rate-limiter")},
+							},
+						}, true)
+						return true
+					})).Return(nil).Once()
+			},
+			want: []*elasticache.CacheCluster{
+				{CacheClusterId: aws.String("sessions")},
+				{CacheClusterId: aws.String("rate-limiter")},
+			},
+		},
+		{
+			name: "should return remote error",
+			mocks: func(client *awstest.MockFakeElastiCache) {
+				client.On("DescribeCacheClustersPages",
+					&elasticache.DescribeCacheClustersInput{},
+					mock.Anything).Return(errors.New("remote error")).Once()
+			},
+			wantErr: errors.New("remote error"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := cache.New(1)
+			client := awstest.MockFakeElastiCache{}
+			tt.mocks(&client)
+			r := &elastiCacheRepository{
+				client: &client,
+				cache:  store,
+			}
+			got, err := r.ListAllCacheClusters()
+			assert.Equal(t, tt.wantErr, err)
+
+			if err == nil {
+				// Check that results were cached
+				cachedData, err := r.ListAllCacheClusters()
+				assert.NoError(t, err)
+				assert.Equal(t, got, cachedData)
+				assert.IsType(t, []*elasticache.CacheCluster{}, store.Get("elasticacheListAllCacheClusters"))
+			}
+
+			changelog, err := diff.Diff(got, tt.want)
+			assert.Nil(t, err)
+			if len(changelog) > 0 {
+				for _, change := range changelog {
+					t.Errorf("%s: %v -> %v", strings.Join(change.Path, "."), change.From, change.To)
+				}
+				t.Fail()
+			}
+			client.AssertExpectations(t)
+		})
+	}
+}
+
+func Test_elastiCacheRepository_ListAllReplicationGroups(t *testing.T) {
+	tests := []struct {
+		name    string
+		mocks   func(client *awstest.MockFakeElastiCache)
+		want    []*elasticache.ReplicationGroup
+		wantErr error
+	}{
+		{
+			name: "List with 2 pages",
+			mocks: func(client *awstest.MockFakeElastiCache) {
+				client.On("DescribeReplicationGroupsPages",
+					&elasticache.DescribeReplicationGroupsInput{},
+					mock.MatchedBy(func(callback func(res *elasticache.DescribeReplicationGroupsOutput, lastPage bool) bool) bool {
+						callback(&elasticache.DescribeReplicationGroupsOutput{
+							ReplicationGroups: []*elasticache.ReplicationGroup{
+								{ReplicationGroupId: aws.String("sessions-cache")},
+							},
+						}, false)
+						callback(&elasticache.DescribeReplicationGroupsOutput{
+							ReplicationGroups: []*elasticache.ReplicationGroup{
+								{ReplicationGroupId: aws.String("leaderboard")},
+							},
+						}, true)
+						return true
+					})).Return(nil).Once()
+			},
+			want: []*elasticache.ReplicationGroup{
+				{ReplicationGroupId: aws.String("sessions-cache")},
+				{ReplicationGroupId: aws.String("leaderboard")},
+			},
+		},
+		{
+			name: "should return remote error",
+			mocks: func(client *awstest.MockFakeElastiCache) {
+				client.On("DescribeReplicationGroupsPages",
+					&elasticache.DescribeReplicationGroupsInput{},
+					mock.Anything).Return(errors.New("remote error")).Once()
+			},
+			wantErr: errors.New("remote error"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := cache.New(1)
+			client := awstest.MockFakeElastiCache{}
+			tt.mocks(&client)
+			r := &elastiCacheRepository{
+				client: &client,
+				cache:  store,
+			}
+			got, err := r.ListAllReplicationGroups()
+			assert.Equal(t, tt.wantErr, err)
+
+			if err == nil {
+				// Check that results were cached
+				cachedData, err := r.ListAllReplicationGroups()
+				assert.NoError(t, err)
+				assert.Equal(t, got, cachedData)
+				assert.IsType(t, []*elasticache.ReplicationGroup{}, store.Get("elasticacheListAllReplicationGroups"))
+			}
+
+			changelog, err := diff.Diff(got, tt.want)
+			assert.Nil(t, err)
+			if len(changelog) > 0 {
+				for _, change := range changelog {
+					t.Errorf("%s: %v -> %v", strings.Join(change.Path, "."), change.From, change.To)
+				}
+				t.Fail()
+			}
+			client.AssertExpectations(t)
+		})
+	}
+}
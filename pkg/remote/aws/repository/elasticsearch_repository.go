@@ -0,0 +1,69 @@
+package repository
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/elasticsearchservice"
+	"github.com/aws/aws-sdk-go/service/elasticsearchservice/elasticsearchserviceiface"
+	"github.com/snyk/driftctl/pkg/remote/cache"
+)
+
+// DescribeElasticsearchDomains accepts up to 5 domains per call
+const elasticsearchDescribeDomainsMaxItems = 5
+
+type ElasticsearchRepository interface {
+	ListAllDomains() ([]*elasticsearchservice.ElasticsearchDomainStatus, error)
+}
+
+type elasticsearchRepository struct {
+	client elasticsearchserviceiface.ElasticsearchServiceAPI
+	cache  cache.Cache
+}
+
+func NewElasticsearchRepository(session *session.Session, c cache.Cache) *elasticsearchRepository {
+	return &elasticsearchRepository{
+		elasticsearchservice.New(session),
+		c,
+	}
+}
+
+// ListAllDomains describes every domain as the domain list only contains their names
+func (r *elasticsearchRepository) ListAllDomains() ([]*elasticsearchservice.ElasticsearchDomainStatus, error) {
+	if v := r.cache.Get("elasticsearchListAllDomains"); v != nil {
+		return v.([]*elasticsearchservice.ElasticsearchDomainStatus), nil
+	}
+
+	res, err := r.client.ListDomainNames(&elasticsearchservice.ListDomainNamesInput{})
+	if err != nil {
+		return nil, err
+	}
+
+	names := make([]*string, 0, len(res.DomainNames))
+	for _, domain := range res.DomainNames {
+		names = append(names, domain.DomainName)
+	}
+
+	domains := make([]*elasticsearchservice.ElasticsearchDomainStatus, 0, len(names))
+	for start := 0; start < len(names); start += elasticsearchDescribeDomainsMaxItems {
+		end := start + elasticsearchDescribeDomainsMaxItems
+		if end > len(names) {
+			end = len(names)
+		}
+		output, err := r.client.DescribeElasticsearchDomains(&elasticsearchservice.DescribeElasticsearchDomainsInput{
+			DomainNames: names[start:end],
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, domain := range output.DomainStatusList {
+			// Deleted domains are still described while their deletion is in progress
+			if aws.BoolValue(domain.Deleted) {
+				continue
+			}
+			domains = append(domains, domain)
+		}
+	}
+
+	r.cache.Put("elasticsearchListAllDomains", domains)
+	return domains, nil
+}
@@ -0,0 +1,119 @@
+package repository
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/elasticsearchservice"
+	"github.com/r3labs/diff/v2"
+	"github.com/snyk/driftctl/pkg/remote/cache"
+	awstest "github.com/snyk/driftctl/test/aws"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_elasticsearchRepository_ListAllDomains(t *testing.T) {
+	tests := []struct {
+		name    string
+		mocks   func(client *awstest.MockFakeElasticsearchService)
+		want    []*elasticsearchservice.ElasticsearchDomainStatus
+		wantErr error
+	}{
+		{
+			name: "List domains not deleted in batches of 5",
+			mocks: func(client *awstest.MockFakeElasticsearchService) {
+				client.On("ListDomainNames", &elasticsearchservice.ListDomainNamesInput{}).Return(&elasticsearchservice.ListDomainNamesOutput{
+					DomainNames: []*elasticsearchservice.DomainInfo{
+						{DomainName: aws.String("logs-0")},
+						{DomainName: aws.String("logs-1")},
+						{DomainName: aws.String("logs-2")},
+						{DomainName: aws.String("logs-3")},
+						{DomainName: aws.String("logs-4")},
+						{DomainName: aws.String("logs-5")},
+						{DomainName: aws.String("logs-6")},
+					},
+				}, nil).Once()
+				client.On("DescribeElasticsearchDomains", &elasticsearchservice.DescribeElasticsearchDomainsInput{
+					DomainNames: aws.StringSlice([]string{"logs-0", "logs-1", "logs-2", "logs-3", "logs-4"}),
+				}).Return(&elasticsearchservice.DescribeElasticsearchDomainsOutput{
+					DomainStatusList: []*elasticsearchservice.ElasticsearchDomainStatus{
+						{DomainName: aws.String("logs-0"), ARN: aws.String("arn:aws:es:us-east-1:123456789012:domain/logs-0")},
+						{DomainName: aws.String("logs-1"), ARN: aws.String("arn:aws:es:us-east-1:123456789012:domain/logs-1")},
+						{DomainName: aws.String("logs-2"), ARN: aws.String("arn:aws:es:us-east-1:123456789012:domain/logs-2")},
+						{DomainName: aws.String("logs-3"), ARN: aws.String("arn:aws:es:us-east-1:123456789012:domain/logs-3"), Deleted: aws.Bool(true)},
+						{DomainName: aws.String("logs-4"), ARN: aws.String("arn:aws:es:us-east-1:123456789012:domain/logs-4")},
+					},
+				}, nil).Once()
+				client.On("DescribeElasticsearchDomains", &elasticsearchservice.DescribeElasticsearchDomainsInput{
+					DomainNames: aws.StringSlice([]string{"logs-5", "logs-6"}),
+				}).Return(&elasticsearchservice.DescribeElasticsearchDomainsOutput{
+					DomainStatusList: []*elasticsearchservice.ElasticsearchDomainStatus{
+						{DomainName: aws.String("logs-5"), ARN: aws.String("arn:aws:es:us-east-1:123456789012:domain/logs-5")},
+						{DomainName: aws.String("logs-6"), ARN: aws.String("arn:aws:es:us-east-1:123456789012:domain/logs-6")},
+					},
+				}, nil).Once()
+			},
+			want: []*elasticsearchservice.ElasticsearchDomainStatus{
+				{DomainName: aws.String("logs-0"), ARN: aws.String("arn:aws:es:us-east-1:123456789012:domain/logs-0")},
+				{DomainName: aws.String("logs-1"), ARN: aws.String("arn:aws:es:us-east-1:123456789012:domain/logs-1")},
+				{DomainName: aws.String("logs-2"), ARN: aws.String("arn:aws:es:us-east-1:123456789012:domain/logs-2")},
+				{DomainName: aws.String("logs-4"), ARN: aws.String("arn:aws:es:us-east-1:123456789012:domain/logs-4")},
+				{DomainName: aws.String("logs-5"), ARN: aws.String("arn:aws:es:us-east-1:123456789012:domain/logs-5")},
+				{DomainName: aws.String("logs-6"), ARN: aws.String("arn:aws:es:us-east-1:123456789012:domain/logs-6")},
+			},
+		},
+		{
+			name: "should return remote error when listing domains",
+			mocks: func(client *awstest.MockFakeElasticsearchService) {
+				client.On("ListDomainNames", &elasticsearchservice.ListDomainNamesInput{}).Return(nil, errors.New("remote error")).Once()
+			},
+			wantErr: errors.New("remote error"),
+		},
+		{
+			name: "should return remote error when describing domains",
+			mocks: func(client *awstest.MockFakeElasticsearchService) {
+				client.On("ListDomainNames", &elasticsearchservice.ListDomainNamesInput{}).Return(&elasticsearchservice.ListDomainNamesOutput{
+					DomainNames: []*elasticsearchservice.DomainInfo{
+						{DomainName: aws.String("logs-0")},
+					},
+				}, nil).Once()
+				client.On("DescribeElasticsearchDomains", &elasticsearchservice.DescribeElasticsearchDomainsInput{
+					DomainNames: aws.StringSlice([]string{"logs-0"}),
+				}).Return(nil, errors.New("remote error")).Once()
+			},
+			wantErr: errors.New("remote error"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := cache.New(1)
+			client := awstest.MockFakeElasticsearchService{}
+			tt.mocks(&client)
+			r := &elasticsearchRepository{
+				client: &client,
+				cache:  store,
+			}
+			got, err := r.ListAllDomains()
+			assert.Equal(t, tt.wantErr, err)
+
+			if err == nil {
+				// Check that results were cached
+				cachedData, err := r.ListAllDomains()
+				assert.NoError(t, err)
+				assert.Equal(t, got, cachedData)
+				assert.IsType(t, []*elasticsearchservice.ElasticsearchDomainStatus{}, store.Get("elasticsearchListAllDomains"))
+			}
+
+			changelog, err := diff.Diff(got, tt.want)
+			assert.Nil(t, err)
+			if len(changelog) > 0 {
+				for _, change := range changelog {
+					t.Errorf("%s: %v -> %v", strings.Join(change.Path, "."), change.From, change.To)
+				}
+				t.Fail()
+			}
+			client.AssertExpectations(t)
+		})
+	}
+}
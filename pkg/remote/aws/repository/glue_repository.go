@@ -0,0 +1,83 @@
+package repository
+
+import (
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/glue"
+	"github.com/aws/aws-sdk-go/service/glue/glueiface"
+	"github.com/snyk/driftctl/pkg/remote/cache"
+)
+
+type GlueRepository interface {
+	ListAllJobs() ([]*glue.Job, error)
+	ListAllDatabases() ([]*glue.Database, error)
+	ListAllCrawlers() ([]*glue.Crawler, error)
+}
+
+type glueRepository struct {
+	client glueiface.GlueAPI
+	cache  cache.Cache
+}
+
+func NewGlueRepository(session *session.Session, c cache.Cache) *glueRepository {
+	return &glueRepository{
+		glue.New(session),
+		c,
+	}
+}
+
+func (r *glueRepository) ListAllJobs() ([]*glue.Job, error) {
+	if v := r.cache.Get("glueListAllJobs"); v != nil {
+		return v.([]*glue.Job), nil
+	}
+
+	var jobs []*glue.Job
+	input := &glue.GetJobsInput{}
+	err := r.client.GetJobsPages(input, func(res *glue.GetJobsOutput, lastPage bool) bool {
+		jobs = append(jobs, res.Jobs...)
+		return !lastPage
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r.cache.Put("glueListAllJobs", jobs)
+	return jobs, nil
+}
+
+func (r *glueRepository) ListAllDatabases() ([]*glue.Database, error) {
+	if v := r.cache.Get("glueListAllDatabases"); v != nil {
+		return v.([]*glue.Database), nil
+	}
+
+	var databases []*glue.Database
+	input := &glue.GetDatabasesInput{}
+	err := r.client.GetDatabasesPages(input, func(res *glue.GetDatabasesOutput, lastPage bool) bool {
+		databases = append(databases, res.DatabaseList...)
+		return !lastPage
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r.cache.Put("glueListAllDatabases", databases)
+	return databases, nil
+}
+
+func (r *glueRepository) ListAllCrawlers() ([]*glue.Crawler, error) {
+	if v := r.cache.Get("glueListAllCrawlers"); v != nil {
+		return v.([]*glue.Crawler), nil
+	}
+
+	var crawlers []*glue.Crawler
+	input := &glue.GetCrawlersInput{}
+	err := r.client.GetCrawlersPages(input, func(res *glue.GetCrawlersOutput, lastPage bool) bool {
+		crawlers = append(crawlers, res.Crawlers...)
+		return !lastPage
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r.cache.Put("glueListAllCrawlers", crawlers)
+	return crawlers, nil
+}
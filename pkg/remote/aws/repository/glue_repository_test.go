@@ -0,0 +1,237 @@
+package repository
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/glue"
+	"github.com/r3labs/diff/v2"
+	"github.com/snyk/driftctl/pkg/remote/cache"
+	awstest "github.com/snyk/driftctl/test/aws"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_glueRepository_ListAllJobs(t *testing.T) {
+	tests := []struct {
+		name    string
+		mocks   func(client *awstest.MockFakeGlue)
+		want    []*glue.Job
+		wantErr error
+	}{
+		{
+			name: "List with 2 pages",
+			mocks: func(client *awstest.MockFakeGlue) {
+				client.On("GetJobsPages",
+					&glue.GetJobsInput{},
+					mock.MatchedBy(func(callback func(res *glue.GetJobsOutput, lastPage bool) bool) bool {
+						callback(&glue.GetJobsOutput{
+							Jobs: []*glue.Job{
+								{Name: aws.String("nightly-etl")},
+							},
+						}, false)
+						callback(&glue.GetJobsOutput{
+							Jobs: []*glue.Job{
+								{Name: aws.String("hourly-aggregation")},
+							},
+						}, true)
+						return true
+					})).Return(nil).Once()
+			},
+			want: []*glue.Job{
+				{Name: aws.String("nightly-etl")},
+				{Name: aws.String("hourly-aggregation")},
+			},
+		},
+		{
+			name: "should return remote error",
+			mocks: func(client *awstest.MockFakeGlue) {
+				client.On("GetJobsPages",
+					&glue.GetJobsInput{},
+					mock.Anything).Return(errors.New("remote error")).Once()
+			},
+			wantErr: errors.New("remote error"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := cache.New(1)
+			client := awstest.MockFakeGlue{}
+			tt.mocks(&client)
+			r := &glueRepository{
+				client: &client,
+				cache:  store,
+			}
+			got, err := r.ListAllJobs()
+			assert.Equal(t, tt.wantErr, err)
+
+			if err == nil {
+				// Check that results were cached
+				cachedData, err := r.ListAllJobs()
+				assert.NoError(t, err)
+				assert.Equal(t, got, cachedData)
+				assert.IsType(t, []*glue.Job{}, store.Get("glueListAllJobs"))
+			}
+
+			changelog, err := diff.Diff(got, tt.want)
+			assert.Nil(t, err)
+			if len(changelog) > 0 {
+				for _, change := range changelog {
+					t.Errorf("%s: %v -> %v", strings.Join(change.Path, "."), change.From, change.To)
+				}
+				t.Fail()
+			}
+			client.AssertExpectations(t)
+		})
+	}
+}
+
+func Test_glueRepository_ListAllDatabases(t *testing.T) {
+	tests := []struct {
+		name    string
+		mocks   func(client *awstest.MockFakeGlue)
+		want    []*glue.Database
+		wantErr error
+	}{
+		{
+			name: "List with 2 pages",
+			mocks: func(client *awstest.MockFakeGlue) {
+				client.On("GetDatabasesPages",
+					&glue.GetDatabasesInput{},
+					mock.MatchedBy(func(callback func(res *glue.GetDatabasesOutput, lastPage bool) bool) bool {
+						callback(&glue.GetDatabasesOutput{
+							DatabaseList: []*glue.Database{
+								{Name: aws.String("sales"), CatalogId: aws.String("123456789012")},
+							},
+						}, false)
+						callback(&glue.GetDatabasesOutput{
+							DatabaseList: []*glue.Database{
+								{Name: aws.String("marketing"), CatalogId: aws.String("123456789012")},
+							},
+						}, true)
+						return true
+					})).Return(nil).Once()
+			},
+			want: []*glue.Database{
+				{Name: aws.String("sales"), CatalogId: aws.String("123456789012")},
+				{Name: aws.String("marketing"), CatalogId: aws.String("123456789012")},
+			},
+		},
+		{
+			name: "should return remote error",
+			mocks: func(client *awstest.MockFakeGlue) {
+				client.On("GetDatabasesPages",
+					&glue.GetDatabasesInput{},
+					mock.Anything).Return(errors.New("remote error")).Once()
+			},
+			wantErr: errors.New("remote error"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := cache.New(1)
+			client := awstest.MockFakeGlue{}
+			tt.mocks(&client)
+			r := &glueRepository{
+				client: &client,
+				cache:  store,
+			}
+			got, err := r.ListAllDatabases()
+			assert.Equal(t, tt.wantErr, err)
+
+			if err == nil {
+				// Check that results were cached
+				cachedData, err := r.ListAllDatabases()
+				assert.NoError(t, err)
+				assert.Equal(t, got, cachedData)
+				assert.IsType(t, []*glue.Database{}, store.Get("glueListAllDatabases"))
+			}
+
+			changelog, err := diff.Diff(got, tt.want)
+			assert.Nil(t, err)
+			if len(changelog) > 0 {
+				for _, change := range changelog {
+					t.Errorf("%s: %v -> %v", strings.Join(change.Path, "."), change.From, change.To)
+				}
+				t.Fail()
+			}
+			client.AssertExpectations(t)
+		})
+	}
+}
+
+func Test_glueRepository_ListAllCrawlers(t *testing.T) {
+	tests := []struct {
+		name    string
+		mocks   func(client *awstest.MockFakeGlue)
+		want    []*glue.Crawler
+		wantErr error
+	}{
+		{
+			name: "List with 2 pages",
+			mocks: func(client *awstest.MockFakeGlue) {
+				client.On("GetCrawlersPages",
+					&glue.GetCrawlersInput{},
+					mock.MatchedBy(func(callback func(res *glue.GetCrawlersOutput, lastPage bool) bool) bool {
+						callback(&glue.GetCrawlersOutput{
+							Crawlers: []*glue.Crawler{
+								{Name: aws.String("sales-crawler")},
+							},
+						}, false)
+						callback(&glue.GetCrawlersOutput{
+							Crawlers: []*glue.Crawler{
+								{Name: aws.String("marketing-crawler")},
+							},
+						}, true)
+						return true
+					})).Return(nil).Once()
+			},
+			want: []*glue.Crawler{
+				{Name: aws.String("sales-crawler")},
+				{Name: aws.String("marketing-crawler")},
+			},
+		},
+		{
+			name: "should return remote error",
+			mocks: func(client *awstest.MockFakeGlue) {
+				client.On("GetCrawlersPages",
+					&glue.GetCrawlersInput{},
+					mock.Anything).Return(errors.New("remote error")).Once()
+			},
+			wantErr: errors.New("remote error"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := cache.New(1)
+			client := awstest.MockFakeGlue{}
+			tt.mocks(&client)
+			r := &glueRepository{
+				client: &client,
+				cache:  store,
+			}
+			got, err := r.ListAllCrawlers()
+			assert.Equal(t, tt.wantErr, err)
+
+			if err == nil {
+				// Check that results were cached
+				cachedData, err := r.ListAllCrawlers()
+				assert.NoError(t, err)
+				assert.Equal(t, got, cachedData)
+				assert.IsType(t, []*glue.Crawler{}, store.Get("glueListAllCrawlers"))
+			}
+
+			changelog, err := diff.Diff(got, tt.want)
+			assert.Nil(t, err)
+			if len(changelog) > 0 {
+				for _, change := range changelog {
+					t.Errorf("%s: %v -> %v", strings.Join(change.Path, "."), change.From, change.To)
+				}
+				t.Fail()
+			}
+			client.AssertExpectations(t)
+		})
+	}
+}
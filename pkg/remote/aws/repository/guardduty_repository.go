@@ -0,0 +1,43 @@
+package repository
+
+import (
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/guardduty"
+	"github.com/aws/aws-sdk-go/service/guardduty/guarddutyiface"
+	"github.com/snyk/driftctl/pkg/remote/cache"
+)
+
+type GuardDutyRepository interface {
+	ListAllDetectors() ([]*string, error)
+}
+
+type guardDutyRepository struct {
+	client guarddutyiface.GuardDutyAPI
+	cache  cache.Cache
+}
+
+func NewGuardDutyRepository(session *session.Session, c cache.Cache) *guardDutyRepository {
+	return &guardDutyRepository{
+		guardduty.New(session),
+		c,
+	}
+}
+
+func (r *guardDutyRepository) ListAllDetectors() ([]*string, error) {
+	if v := r.cache.Get("guarddutyListAllDetectors"); v != nil {
+		return v.([]*string), nil
+	}
+
+	var detectorIds []*string
+	input := &guardduty.ListDetectorsInput{}
+	err := r.client.ListDetectorsPages(input, func(res *guardduty.ListDetectorsOutput, lastPage bool) bool {
+		detectorIds = append(detectorIds, res.DetectorIds...)
+		return !lastPage
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r.cache.Put("guarddutyListAllDetectors", detectorIds)
+	return detectorIds, nil
+}
@@ -0,0 +1,85 @@
+package repository
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/guardduty"
+	"github.com/r3labs/diff/v2"
+	"github.com/snyk/driftctl/pkg/remote/cache"
+	awstest "github.com/snyk/driftctl/test/aws"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_guardDutyRepository_ListAllDetectors(t *testing.T) {
+	tests := []struct {
+		name    string
+		mocks   func(client *awstest.MockFakeGuardDuty)
+		want    []*string
+		wantErr error
+	}{
+		{
+			name: "List with 2 pages",
+			mocks: func(client *awstest.MockFakeGuardDuty) {
+				client.On("ListDetectorsPages",
+					&guardduty.ListDetectorsInput{},
+					mock.MatchedBy(func(callback func(res *guardduty.ListDetectorsOutput, lastPage bool) bool) bool {
+						callback(&guardduty.ListDetectorsOutput{
+							DetectorIds: aws.StringSlice([]string{"12abc34d567e8fa901bc2d34e56789f0"}),
+						}, false)
+						callback(&guardduty.ListDetectorsOutput{
+							DetectorIds: aws.StringSlice([]string{"98fed76c543b2a109fe8d76c54321b0a"}),
+						}, true)
+						return true
+					})).Return(nil).Once()
+			},
+			want: []*string{
+				aws.String("12abc34d567e8fa901bc2d34e56789f0"),
+				aws.String("98fed76c543b2a109fe8d76c54321b0a"),
+			},
+		},
+		{
+			name: "should return remote error",
+			mocks: func(client *awstest.MockFakeGuardDuty) {
+				client.On("ListDetectorsPages",
+					&guardduty.ListDetectorsInput{},
+					mock.Anything).Return(errors.New("remote error")).Once()
+			},
+			wantErr: errors.New("remote error"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := cache.New(1)
+			client := awstest.MockFakeGuardDuty{}
+			tt.mocks(&client)
+			r := &guardDutyRepository{
+				client: &client,
+				cache:  store,
+			}
+			got, err := r.ListAllDetectors()
+			assert.Equal(t, tt.wantErr, err)
+
+			if err == nil {
+				// Check that results were cached
+				cachedData, err := r.ListAllDetectors()
+				assert.NoError(t, err)
+				assert.Equal(t, got, cachedData)
+				assert.IsType(t, []*string{}, store.Get("guarddutyListAllDetectors"))
+			}
+
+			changelog, err := diff.Diff(got, tt.want)
+			assert.Nil(t, err)
+			if len(changelog) > 0 {
+				for _, change := range changelog {
+					t.Errorf("%s: %v -> %v", strings.Join(change.Path, "."), change.From, change.To)
+				}
+				t.Fail()
+			}
+			client.AssertExpectations(t)
+		})
+	}
+}
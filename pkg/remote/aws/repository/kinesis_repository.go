@@ -0,0 +1,58 @@
+package repository
+
+import (
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/kinesis"
+	"github.com/aws/aws-sdk-go/service/kinesis/kinesisiface"
+	"github.com/snyk/driftctl/pkg/remote/cache"
+)
+
+type KinesisRepository interface {
+	ListAllStreams() ([]*kinesis.StreamDescriptionSummary, error)
+}
+
+type kinesisRepository struct {
+	client kinesisiface.KinesisAPI
+	cache  cache.Cache
+}
+
+func NewKinesisRepository(session *session.Session, c cache.Cache) *kinesisRepository {
+	return &kinesisRepository{
+		kinesis.New(session),
+		c,
+	}
+}
+
+// ListAllStreams describes every stream as the stream list only contains their names
+func (r *kinesisRepository) ListAllStreams() ([]*kinesis.StreamDescriptionSummary, error) {
+	if v := r.cache.Get("kinesisListAllStreams"); v != nil {
+		return v.([]*kinesis.StreamDescriptionSummary), nil
+	}
+
+	var names []*string
+	input := &kinesis.ListStreamsInput{}
+	err := r.client.ListStreamsPages(input, func(res *kinesis.ListStreamsOutput, lastPage bool) bool {
+		names = append(names, res.StreamNames...)
+		return !lastPage
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	streams := make([]*kinesis.StreamDescriptionSummary, 0, len(names))
+	for _, name := range names {
+		output, err := r.client.DescribeStreamSummary(&kinesis.DescribeStreamSummaryInput{
+			StreamName: name,
+		})
+		if err != nil {
+			return nil, err
+		}
+		if output.StreamDescriptionSummary.StreamStatus != nil && *output.StreamDescriptionSummary.StreamStatus == kinesis.StreamStatusDeleting {
+			continue
+		}
+		streams = append(streams, output.StreamDescriptionSummary)
+	}
+
+	r.cache.Put("kinesisListAllStreams", streams)
+	return streams, nil
+}
@@ -0,0 +1,117 @@
+package repository
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/kinesis"
+	"github.com/r3labs/diff/v2"
+	"github.com/snyk/driftctl/pkg/remote/cache"
+	awstest "github.com/snyk/driftctl/test/aws"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_kinesisRepository_ListAllStreams(t *testing.T) {
+	tests := []struct {
+		name    string
+		mocks   func(client *awstest.MockFakeKinesis)
+		want    []*kinesis.StreamDescriptionSummary
+		wantErr error
+	}{
+		{
+			name: "List streams not being deleted with 2 pages",
+			mocks: func(client *awstest.MockFakeKinesis) {
+				client.On("ListStreamsPages",
+					&kinesis.ListStreamsInput{},
+					mock.MatchedBy(func(callback func(res *kinesis.ListStreamsOutput, lastPage bool) bool) bool {
+						callback(&kinesis.ListStreamsOutput{
+							StreamNames: aws.StringSlice([]string{"clicks", "orders"}),
+						}, false)
+						callback(&kinesis.ListStreamsOutput{
+							StreamNames: aws.StringSlice([]string{"legacy"}),
+						}, true)
+						return true
+					})).Return(nil).Once()
+				client.On("DescribeStreamSummary", &kinesis.DescribeStreamSummaryInput{
+					StreamName: aws.String("clicks"),
+				}).Return(&kinesis.DescribeStreamSummaryOutput{
+					StreamDescriptionSummary: &kinesis.StreamDescriptionSummary{StreamName: aws.String("clicks"), StreamARN: aws.String("arn:aws:kinesis:us-east-1:123456789012:stream/clicks"), StreamStatus: aws.String("ACTIVE")},
+				}, nil).Once()
+				client.On("DescribeStreamSummary", &kinesis.DescribeStreamSummaryInput{
+					StreamName: aws.String("orders"),
+				}).Return(&kinesis.DescribeStreamSummaryOutput{
+					StreamDescriptionSummary: &kinesis.StreamDescriptionSummary{StreamName: aws.String("orders"), StreamARN: aws.String("arn:aws:kinesis:us-east-1:123456789012:stream/orders"), StreamStatus: aws.String("UPDATING")},
+				}, nil).Once()
+				client.On("DescribeStreamSummary", &kinesis.DescribeStreamSummaryInput{
+					StreamName: aws.String("legacy"),
+				}).Return(&kinesis.DescribeStreamSummaryOutput{
+					StreamDescriptionSummary: &kinesis.StreamDescriptionSummary{StreamName: aws.String("legacy"), StreamARN: aws.String("arn:aws:kinesis:us-east-1:123456789012:stream/legacy"), StreamStatus: aws.String("DELETING")},
+				}, nil).Once()
+			},
+			want: []*kinesis.StreamDescriptionSummary{
+				{StreamName: aws.String("clicks"), StreamARN: aws.String("arn:aws:kinesis:us-east-1:123456789012:stream/clicks"), StreamStatus: aws.String("ACTIVE")},
+				{StreamName: aws.String("orders"), StreamARN: aws.String("arn:aws:kinesis:us-east-1:123456789012:stream/orders"), StreamStatus: aws.String("UPDATING")},
+			},
+		},
+		{
+			name: "should return remote error when listing streams",
+			mocks: func(client *awstest.MockFakeKinesis) {
+				client.On("ListStreamsPages",
+					&kinesis.ListStreamsInput{},
+					mock.Anything).Return(errors.New("remote error")).Once()
+			},
+			wantErr: errors.New("remote error"),
+		},
+		{
+			name: "should return remote error when describing streams",
+			mocks: func(client *awstest.MockFakeKinesis) {
+				client.On("ListStreamsPages",
+					&kinesis.ListStreamsInput{},
+					mock.MatchedBy(func(callback func(res *kinesis.ListStreamsOutput, lastPage bool) bool) bool {
+						callback(&kinesis.ListStreamsOutput{
+							StreamNames: aws.StringSlice([]string{"clicks"}),
+						}, true)
+						return true
+					})).Return(nil).Once()
+				client.On("DescribeStreamSummary", &kinesis.DescribeStreamSummaryInput{
+					StreamName: aws.String("clicks"),
+				}).Return(nil, errors.New("remote error")).Once()
+			},
+			wantErr: errors.New("remote error"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := cache.New(1)
+			client := awstest.MockFakeKinesis{}
+			tt.mocks(&client)
+			r := &kinesisRepository{
+				client: &client,
+				cache:  store,
+			}
+			got, err := r.ListAllStreams()
+			assert.Equal(t, tt.wantErr, err)
+
+			if err == nil {
+				// Check that results were cached
+				cachedData, err := r.ListAllStreams()
+				assert.NoError(t, err)
+				assert.Equal(t, got, cachedData)
+				assert.IsType(t, []*kinesis.StreamDescriptionSummary{}, store.Get("kinesisListAllStreams"))
+			}
+
+			changelog, err := diff.Diff(got, tt.want)
+			assert.Nil(t, err)
+			if len(changelog) > 0 {
+				for _, change := range changelog {
+					t.Errorf("%s: %v -> %v", strings.Join(change.Path, "."), change.From, change.To)
+				}
+				t.Fail()
+			}
+			client.AssertExpectations(t)
+		})
+	}
+}
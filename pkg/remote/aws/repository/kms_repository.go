@@ -16,6 +16,7 @@ import (
 type KMSRepository interface {
 	ListAllKeys() ([]*kms.KeyListEntry, error)
 	ListAllAliases() ([]*kms.AliasListEntry, error)
+	ListAllGrants(keyId string) ([]*kms.GrantListEntry, error)
 }
 
 type kmsRepository struct {
@@ -82,6 +83,28 @@ func (r *kmsRepository) ListAllAliases() ([]*kms.AliasListEntry, error) {
 	return result, nil
 }
 
+func (r *kmsRepository) ListAllGrants(keyId string) ([]*kms.GrantListEntry, error) {
+	cacheKey := fmt.Sprintf("kmsListAllGrants_%s", keyId)
+	if v := r.cache.Get(cacheKey); v != nil {
+		return v.([]*kms.GrantListEntry), nil
+	}
+
+	var grants []*kms.GrantListEntry
+	input := kms.ListGrantsInput{KeyId: &keyId}
+	err := r.client.ListGrantsPages(&input,
+		func(resp *kms.ListGrantsResponse, lastPage bool) bool {
+			grants = append(grants, resp.Grants...)
+			return !lastPage
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	r.cache.Put(cacheKey, grants)
+	return grants, nil
+}
+
 func (r *kmsRepository) describeKey(keyId *string) (*kms.DescribeKeyOutput, error) {
 	var results interface{}
 	// Since this method can be call in parallel, we should lock and unlock if we want to be sure to hit the cache
@@ -1,6 +1,7 @@
 package repository
 
 import (
+	"errors"
 	"strings"
 	"sync"
 	"testing"
@@ -247,3 +248,77 @@ func Test_KMSRepository_ListAllAliases(t *testing.T) {
 		})
 	}
 }
+
+func Test_KMSRepository_ListAllGrants(t *testing.T) {
+	tests := []struct {
+		name    string
+		mocks   func(client *awstest.MockFakeKMS)
+		want    []*kms.GrantListEntry
+		wantErr error
+	}{
+		{
+			name: "List with 2 pages",
+			mocks: func(client *awstest.MockFakeKMS) {
+				client.On("ListGrantsPages",
+					&kms.ListGrantsInput{KeyId: aws.String("key-id-1")},
+					mock.MatchedBy(func(callback func(res *kms.ListGrantsResponse, lastPage bool) bool) bool {
+						callback(&kms.ListGrantsResponse{
+							Grants: []*kms.GrantListEntry{
+								{GrantId: aws.String("grant-1"), KeyId: aws.String("key-id-1")},
+							},
+						}, false)
+						callback(&kms.ListGrantsResponse{
+							Grants: []*kms.GrantListEntry{
+								{GrantId: aws.String("grant-2"), KeyId: aws.String("key-id-1")},
+							},
+						}, true)
+						return true
+					})).Return(nil).Once()
+			},
+			want: []*kms.GrantListEntry{
+				{GrantId: aws.String("grant-1"), KeyId: aws.String("key-id-1")},
+				{GrantId: aws.String("grant-2"), KeyId: aws.String("key-id-1")},
+			},
+		},
+		{
+			name: "should return remote error",
+			mocks: func(client *awstest.MockFakeKMS) {
+				client.On("ListGrantsPages",
+					&kms.ListGrantsInput{KeyId: aws.String("key-id-1")},
+					mock.AnythingOfType("func(*kms.ListGrantsResponse, bool) bool")).Return(errors.New("remote error")).Once()
+			},
+			wantErr: errors.New("remote error"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := cache.New(1)
+			client := awstest.MockFakeKMS{}
+			tt.mocks(&client)
+			r := &kmsRepository{
+				client:          &client,
+				cache:           store,
+				describeKeyLock: &sync.Mutex{},
+			}
+			got, err := r.ListAllGrants("key-id-1")
+			assert.Equal(t, tt.wantErr, err)
+
+			if err == nil {
+				// Check that results were cached
+				cachedData, err := r.ListAllGrants("key-id-1")
+				assert.NoError(t, err)
+				assert.Equal(t, got, cachedData)
+				assert.IsType(t, []*kms.GrantListEntry{}, store.Get("kmsListAllGrants_key-id-1"))
+			}
+
+			changelog, err := diff.Diff(got, tt.want)
+			assert.Nil(t, err)
+			if len(changelog) > 0 {
+				for _, change := range changelog {
+					t.Errorf("%s: %v -> %v", strings.Join(change.Path, "."), change.From, change.To)
+				}
+				t.Fail()
+			}
+		})
+	}
+}
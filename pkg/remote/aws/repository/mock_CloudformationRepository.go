@@ -12,6 +12,29 @@ type MockCloudformationRepository struct {
 	mock.Mock
 }
 
+// ListAllStackResources provides a mock function with given fields: stackName
+func (_m *MockCloudformationRepository) ListAllStackResources(stackName string) ([]*cloudformation.StackResourceSummary, error) {
+	ret := _m.Called(stackName)
+
+	var r0 []*cloudformation.StackResourceSummary
+	if rf, ok := ret.Get(0).(func(string) []*cloudformation.StackResourceSummary); ok {
+		r0 = rf(stackName)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*cloudformation.StackResourceSummary)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(stackName)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ListAllStacks provides a mock function with given fields:
 func (_m *MockCloudformationRepository) ListAllStacks() ([]*cloudformation.Stack, error) {
 	ret := _m.Called()
@@ -102,6 +102,29 @@ func (_m *MockEC2Repository) ListAllAddressesAssociation() ([]*ec2.Address, erro
 	return r0, r1
 }
 
+// ListAllFlowLogs provides a mock function with given fields:
+func (_m *MockEC2Repository) ListAllFlowLogs() ([]*ec2.FlowLog, error) {
+	ret := _m.Called()
+
+	var r0 []*ec2.FlowLog
+	if rf, ok := ret.Get(0).(func() []*ec2.FlowLog); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*ec2.FlowLog)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ListAllImages provides a mock function with given fields:
 func (_m *MockEC2Repository) ListAllImages() ([]*ec2.Image, error) {
 	ret := _m.Called()
@@ -240,6 +263,29 @@ func (_m *MockEC2Repository) ListAllNetworkACLs() ([]*ec2.NetworkAcl, error) {
 	return r0, r1
 }
 
+// ListAllNetworkInterfaces provides a mock function with given fields:
+func (_m *MockEC2Repository) ListAllNetworkInterfaces() ([]*ec2.NetworkInterface, error) {
+	ret := _m.Called()
+
+	var r0 []*ec2.NetworkInterface
+	if rf, ok := ret.Get(0).(func() []*ec2.NetworkInterface); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*ec2.NetworkInterface)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ListAllRouteTables provides a mock function with given fields:
 func (_m *MockEC2Repository) ListAllRouteTables() ([]*ec2.RouteTable, error) {
 	ret := _m.Called()
@@ -350,6 +396,52 @@ func (_m *MockEC2Repository) ListAllSubnets() ([]*ec2.Subnet, []*ec2.Subnet, err
 	return r0, r1, r2
 }
 
+// ListAllTransitGatewayVpcAttachments provides a mock function with given fields:
+func (_m *MockEC2Repository) ListAllTransitGatewayVpcAttachments() ([]*ec2.TransitGatewayVpcAttachment, error) {
+	ret := _m.Called()
+
+	var r0 []*ec2.TransitGatewayVpcAttachment
+	if rf, ok := ret.Get(0).(func() []*ec2.TransitGatewayVpcAttachment); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*ec2.TransitGatewayVpcAttachment)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListAllTransitGateways provides a mock function with given fields:
+func (_m *MockEC2Repository) ListAllTransitGateways() ([]*ec2.TransitGateway, error) {
+	ret := _m.Called()
+
+	var r0 []*ec2.TransitGateway
+	if rf, ok := ret.Get(0).(func() []*ec2.TransitGateway); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*ec2.TransitGateway)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ListAllVPCs provides a mock function with given fields:
 func (_m *MockEC2Repository) ListAllVPCs() ([]*ec2.Vpc, []*ec2.Vpc, error) {
 	ret := _m.Called()
@@ -404,3 +496,49 @@ func (_m *MockEC2Repository) ListAllVolumes() ([]*ec2.Volume, error) {
 
 	return r0, r1
 }
+
+// ListAllVpcEndpoints provides a mock function with given fields:
+func (_m *MockEC2Repository) ListAllVpcEndpoints() ([]*ec2.VpcEndpoint, error) {
+	ret := _m.Called()
+
+	var r0 []*ec2.VpcEndpoint
+	if rf, ok := ret.Get(0).(func() []*ec2.VpcEndpoint); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*ec2.VpcEndpoint)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListAllVpcPeeringConnections provides a mock function with given fields:
+func (_m *MockEC2Repository) ListAllVpcPeeringConnections() ([]*ec2.VpcPeeringConnection, error) {
+	ret := _m.Called()
+
+	var r0 []*ec2.VpcPeeringConnection
+	if rf, ok := ret.Get(0).(func() []*ec2.VpcPeeringConnection); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*ec2.VpcPeeringConnection)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
@@ -35,6 +35,29 @@ func (_m *MockKMSRepository) ListAllAliases() ([]*kms.AliasListEntry, error) {
 	return r0, r1
 }
 
+// ListAllGrants provides a mock function with given fields: keyId
+func (_m *MockKMSRepository) ListAllGrants(keyId string) ([]*kms.GrantListEntry, error) {
+	ret := _m.Called(keyId)
+
+	var r0 []*kms.GrantListEntry
+	if rf, ok := ret.Get(0).(func(string) []*kms.GrantListEntry); ok {
+		r0 = rf(keyId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*kms.GrantListEntry)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(keyId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ListAllKeys provides a mock function with given fields:
 func (_m *MockKMSRepository) ListAllKeys() ([]*kms.KeyListEntry, error) {
 	ret := _m.Called()
@@ -79,6 +79,29 @@ func (_m *MockS3Repository) GetBucketPolicy(bucketName string, region string) (*
 	return r0, r1
 }
 
+// GetBucketPublicAccessBlock provides a mock function with given fields: bucketName, region
+func (_m *MockS3Repository) GetBucketPublicAccessBlock(bucketName string, region string) (*s3.PublicAccessBlockConfiguration, error) {
+	ret := _m.Called(bucketName, region)
+
+	var r0 *s3.PublicAccessBlockConfiguration
+	if rf, ok := ret.Get(0).(func(string, string) *s3.PublicAccessBlockConfiguration); ok {
+		r0 = rf(bucketName, region)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*s3.PublicAccessBlockConfiguration)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(bucketName, region)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ListAllBuckets provides a mock function with given fields:
 func (_m *MockS3Repository) ListAllBuckets() ([]*s3.Bucket, error) {
 	ret := _m.Called()
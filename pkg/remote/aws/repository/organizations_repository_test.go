@@ -0,0 +1,90 @@
+package repository
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/organizations"
+	"github.com/r3labs/diff/v2"
+	"github.com/snyk/driftctl/pkg/remote/cache"
+	awstest "github.com/snyk/driftctl/test/aws"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_organizationsRepository_ListAllAccounts(t *testing.T) {
+	tests := []struct {
+		name    string
+		mocks   func(client *awstest.MockFakeOrganizations)
+		want    []*organizations.Account
+		wantErr error
+	}{
+		{
+			name: "List with 2 pages",
+			mocks: func(client *awstest.MockFakeOrganizations) {
+				client.On("ListAccountsPages",
+					&organizations.ListAccountsInput{},
+					mock.MatchedBy(func(callback func(res *organizations.ListAccountsOutput, lastPage bool) bool) bool {
+						callback(&organizations.ListAccountsOutput{
+							Accounts: []*organizations.Account{
+								{Id: aws.String("111111111111"), Status: aws.String(organizations.AccountStatusActive)},
+								{Id: aws.String("222222222222"), Status: aws.String(organizations.AccountStatusSuspended)},
+							},
+						}, false)
+						callback(&organizations.ListAccountsOutput{
+							Accounts: []*organizations.Account{
+								{Id: aws.String("333333333333"), Status: aws.String(organizations.AccountStatusActive)},
+							},
+						}, true)
+						return true
+					})).Return(nil).Once()
+			},
+			want: []*organizations.Account{
+				{Id: aws.String("111111111111"), Status: aws.String(organizations.AccountStatusActive)},
+				{Id: aws.String("222222222222"), Status: aws.String(organizations.AccountStatusSuspended)},
+				{Id: aws.String("333333333333"), Status: aws.String(organizations.AccountStatusActive)},
+			},
+		},
+		{
+			name: "List outside of an organization",
+			mocks: func(client *awstest.MockFakeOrganizations) {
+				client.On("ListAccountsPages", &organizations.ListAccountsInput{}, mock.Anything).
+					Return(errors.New("AWSOrganizationsNotInUseException")).Once()
+			},
+			wantErr: errors.New("AWSOrganizationsNotInUseException"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := cache.New(1)
+			client := awstest.MockFakeOrganizations{}
+			tt.mocks(&client)
+			r := &organizationsRepository{
+				client: &client,
+				cache:  store,
+			}
+			got, err := r.ListAllAccounts()
+			assert.Equal(t, tt.wantErr, err)
+
+			if err == nil {
+				// Check that results were cached
+				cachedData, err := r.ListAllAccounts()
+				assert.NoError(t, err)
+				assert.Equal(t, got, cachedData)
+				assert.IsType(t, []*organizations.Account{}, store.Get("organizationsListAllAccounts"))
+			}
+
+			changelog, err := diff.Diff(got, tt.want)
+			assert.Nil(t, err)
+			if len(changelog) > 0 {
+				for _, change := range changelog {
+					t.Errorf("%s: %s -> %s", strings.Join(change.Path, "."), change.From, change.To)
+				}
+				t.Fail()
+			}
+			client.AssertExpectations(t)
+		})
+	}
+}
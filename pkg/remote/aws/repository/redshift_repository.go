@@ -0,0 +1,43 @@
+package repository
+
+import (
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/redshift"
+	"github.com/aws/aws-sdk-go/service/redshift/redshiftiface"
+	"github.com/snyk/driftctl/pkg/remote/cache"
+)
+
+type RedshiftRepository interface {
+	ListAllClusters() ([]*redshift.Cluster, error)
+}
+
+type redshiftRepository struct {
+	client redshiftiface.RedshiftAPI
+	cache  cache.Cache
+}
+
+func NewRedshiftRepository(session *session.Session, c cache.Cache) *redshiftRepository {
+	return &redshiftRepository{
+		redshift.New(session),
+		c,
+	}
+}
+
+func (r *redshiftRepository) ListAllClusters() ([]*redshift.Cluster, error) {
+	if v := r.cache.Get("redshiftListAllClusters"); v != nil {
+		return v.([]*redshift.Cluster), nil
+	}
+
+	var clusters []*redshift.Cluster
+	input := &redshift.DescribeClustersInput{}
+	err := r.client.DescribeClustersPages(input, func(res *redshift.DescribeClustersOutput, lastPage bool) bool {
+		clusters = append(clusters, res.Clusters...)
+		return !lastPage
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r.cache.Put("redshiftListAllClusters", clusters)
+	return clusters, nil
+}
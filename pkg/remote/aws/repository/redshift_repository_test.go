@@ -0,0 +1,89 @@
+package repository
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/redshift"
+	"github.com/r3labs/diff/v2"
+	"github.com/snyk/driftctl/pkg/remote/cache"
+	awstest "github.com/snyk/driftctl/test/aws"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_redshiftRepository_ListAllClusters(t *testing.T) {
+	tests := []struct {
+		name    string
+		mocks   func(client *awstest.MockFakeRedshift)
+		want    []*redshift.Cluster
+		wantErr error
+	}{
+		{
+			name: "List with 2 pages",
+			mocks: func(client *awstest.MockFakeRedshift) {
+				client.On("DescribeClustersPages",
+					&redshift.DescribeClustersInput{},
+					mock.MatchedBy(func(callback func(res *redshift.DescribeClustersOutput, lastPage bool) bool) bool {
+						callback(&redshift.DescribeClustersOutput{
+							Clusters: []*redshift.Cluster{
+								{ClusterIdentifier: aws.String("analytics")},
+							},
+						}, false)
+						callback(&redshift.DescribeClustersOutput{
+							Clusters: []*redshift.Cluster{
+								{ClusterIdentifier: aws.String("reporting")},
+							},
+						}, true)
+						return true
+					})).Return(nil).Once()
+			},
+			want: []*redshift.Cluster{
+				{ClusterIdentifier: aws.String("analytics")},
+				{ClusterIdentifier: aws.String("reporting")},
+			},
+		},
+		{
+			name: "should return remote error",
+			mocks: func(client *awstest.MockFakeRedshift) {
+				client.On("DescribeClustersPages",
+					&redshift.DescribeClustersInput{},
+					mock.Anything).Return(errors.New("remote error")).Once()
+			},
+			wantErr: errors.New("remote error"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := cache.New(1)
+			client := awstest.MockFakeRedshift{}
+			tt.mocks(&client)
+			r := &redshiftRepository{
+				client: &client,
+				cache:  store,
+			}
+			got, err := r.ListAllClusters()
+			assert.Equal(t, tt.wantErr, err)
+
+			if err == nil {
+				// Check that results were cached
+				cachedData, err := r.ListAllClusters()
+				assert.NoError(t, err)
+				assert.Equal(t, got, cachedData)
+				assert.IsType(t, []*redshift.Cluster{}, store.Get("redshiftListAllClusters"))
+			}
+
+			changelog, err := diff.Diff(got, tt.want)
+			assert.Nil(t, err)
+			if len(changelog) > 0 {
+				for _, change := range changelog {
+					t.Errorf("%s: %v -> %v", strings.Join(change.Path, "."), change.From, change.To)
+				}
+				t.Fail()
+			}
+			client.AssertExpectations(t)
+		})
+	}
+}
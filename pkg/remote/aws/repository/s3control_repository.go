@@ -0,0 +1,49 @@
+package repository
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3control"
+	"github.com/aws/aws-sdk-go/service/s3control/s3controliface"
+	"github.com/snyk/driftctl/pkg/remote/cache"
+)
+
+type S3ControlRepository interface {
+	GetAccountPublicAccessBlock(accountId string) (*s3control.PublicAccessBlockConfiguration, error)
+}
+
+type s3ControlRepository struct {
+	client s3controliface.S3ControlAPI
+	cache  cache.Cache
+}
+
+func NewS3ControlRepository(session *session.Session, c cache.Cache) *s3ControlRepository {
+	return &s3ControlRepository{
+		s3control.New(session),
+		c,
+	}
+}
+
+// GetAccountPublicAccessBlock returns nil when no public access block is configured for the account
+func (r *s3ControlRepository) GetAccountPublicAccessBlock(accountId string) (*s3control.PublicAccessBlockConfiguration, error) {
+	cacheKey := fmt.Sprintf("s3controlGetAccountPublicAccessBlock_%s", accountId)
+	if v := r.cache.Get(cacheKey); v != nil {
+		return v.(*s3control.PublicAccessBlockConfiguration), nil
+	}
+
+	res, err := r.client.GetPublicAccessBlock(&s3control.GetPublicAccessBlockInput{
+		AccountId: aws.String(accountId),
+	})
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == s3control.ErrCodeNoSuchPublicAccessBlockConfiguration {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	r.cache.Put(cacheKey, res.PublicAccessBlockConfiguration)
+	return res.PublicAccessBlockConfiguration, nil
+}
@@ -0,0 +1,100 @@
+package repository
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/s3control"
+	"github.com/snyk/driftctl/pkg/remote/cache"
+	awstest "github.com/snyk/driftctl/test/aws"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_s3ControlRepository_GetAccountPublicAccessBlock(t *testing.T) {
+	tests := []struct {
+		name    string
+		mocks   func(client *awstest.MockFakeS3Control, store *cache.MockCache)
+		want    *s3control.PublicAccessBlockConfiguration
+		wantErr error
+	}{
+		{
+			name: "get account public access block",
+			mocks: func(client *awstest.MockFakeS3Control, store *cache.MockCache) {
+				store.On("Get", "s3controlGetAccountPublicAccessBlock_123456789012").Return(nil).Once()
+				client.On("GetPublicAccessBlock", &s3control.GetPublicAccessBlockInput{
+					AccountId: aws.String("123456789012"),
+				}).Return(&s3control.GetPublicAccessBlockOutput{
+					PublicAccessBlockConfiguration: &s3control.PublicAccessBlockConfiguration{
+						BlockPublicAcls:       aws.Bool(true),
+						BlockPublicPolicy:     aws.Bool(true),
+						IgnorePublicAcls:      aws.Bool(true),
+						RestrictPublicBuckets: aws.Bool(true),
+					},
+				}, nil).Once()
+				store.On("Put", "s3controlGetAccountPublicAccessBlock_123456789012", &s3control.PublicAccessBlockConfiguration{
+					BlockPublicAcls:       aws.Bool(true),
+					BlockPublicPolicy:     aws.Bool(true),
+					IgnorePublicAcls:      aws.Bool(true),
+					RestrictPublicBuckets: aws.Bool(true),
+				}).Return(false).Once()
+			},
+			want: &s3control.PublicAccessBlockConfiguration{
+				BlockPublicAcls:       aws.Bool(true),
+				BlockPublicPolicy:     aws.Bool(true),
+				IgnorePublicAcls:      aws.Bool(true),
+				RestrictPublicBuckets: aws.Bool(true),
+			},
+		},
+		{
+			name: "get account public access block (cached)",
+			mocks: func(client *awstest.MockFakeS3Control, store *cache.MockCache) {
+				store.On("Get", "s3controlGetAccountPublicAccessBlock_123456789012").Return(&s3control.PublicAccessBlockConfiguration{
+					BlockPublicAcls: aws.Bool(true),
+				}).Once()
+			},
+			want: &s3control.PublicAccessBlockConfiguration{
+				BlockPublicAcls: aws.Bool(true),
+			},
+		},
+		{
+			name: "no public access block configured for the account",
+			mocks: func(client *awstest.MockFakeS3Control, store *cache.MockCache) {
+				store.On("Get", "s3controlGetAccountPublicAccessBlock_123456789012").Return(nil).Once()
+				client.On("GetPublicAccessBlock", &s3control.GetPublicAccessBlockInput{
+					AccountId: aws.String("123456789012"),
+				}).Return(nil, awserr.New(s3control.ErrCodeNoSuchPublicAccessBlockConfiguration, "", nil)).Once()
+			},
+			want: nil,
+		},
+		{
+			name: "should return remote error",
+			mocks: func(client *awstest.MockFakeS3Control, store *cache.MockCache) {
+				store.On("Get", "s3controlGetAccountPublicAccessBlock_123456789012").Return(nil).Once()
+				client.On("GetPublicAccessBlock", &s3control.GetPublicAccessBlockInput{
+					AccountId: aws.String("123456789012"),
+				}).Return(nil, errors.New("remote error")).Once()
+			},
+			wantErr: errors.New("remote error"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := &cache.MockCache{}
+			client := &awstest.MockFakeS3Control{}
+			tt.mocks(client, store)
+			r := &s3ControlRepository{
+				client: client,
+				cache:  store,
+			}
+			got, err := r.GetAccountPublicAccessBlock("123456789012")
+
+			assert.Equal(t, tt.wantErr, err)
+			assert.Equal(t, tt.want, got)
+
+			client.AssertExpectations(t)
+			store.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,81 @@
+package repository
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/secretsmanager"
+	"github.com/aws/aws-sdk-go/service/secretsmanager/secretsmanageriface"
+	"github.com/sirupsen/logrus"
+	"github.com/snyk/driftctl/pkg/remote/cache"
+)
+
+type SecretsManagerRepository interface {
+	ListAllSecrets() ([]*secretsmanager.SecretListEntry, error)
+	GetSecretPolicy(secretArn string) (string, error)
+}
+
+type secretsManagerRepository struct {
+	client secretsmanageriface.SecretsManagerAPI
+	cache  cache.Cache
+}
+
+func NewSecretsManagerRepository(session *session.Session, c cache.Cache) *secretsManagerRepository {
+	return &secretsManagerRepository{
+		secretsmanager.New(session),
+		c,
+	}
+}
+
+// ListAllSecrets only returns the metadata of the secrets, their values are never retrieved
+func (r *secretsManagerRepository) ListAllSecrets() ([]*secretsmanager.SecretListEntry, error) {
+	cacheKey := "secretsmanagerListAllSecrets"
+	v := r.cache.GetAndLock(cacheKey)
+	defer r.cache.Unlock(cacheKey)
+	if v != nil {
+		return v.([]*secretsmanager.SecretListEntry), nil
+	}
+
+	var secrets []*secretsmanager.SecretListEntry
+	input := &secretsmanager.ListSecretsInput{}
+	err := r.client.ListSecretsPages(input, func(res *secretsmanager.ListSecretsOutput, lastPage bool) bool {
+		for _, secret := range res.SecretList {
+			// Secrets created by another service (e.g. RDS) are managed by this service
+			if aws.StringValue(secret.OwningService) != "" {
+				logrus.WithFields(logrus.Fields{
+					"arn":   aws.StringValue(secret.ARN),
+					"owner": aws.StringValue(secret.OwningService),
+				}).Debug("Ignoring secret owned by another service")
+				continue
+			}
+			secrets = append(secrets, secret)
+		}
+		return !lastPage
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r.cache.Put(cacheKey, secrets)
+	return secrets, nil
+}
+
+// GetSecretPolicy returns the resource policy of a secret or an empty string when it has none
+func (r *secretsManagerRepository) GetSecretPolicy(secretArn string) (string, error) {
+	cacheKey := fmt.Sprintf("secretsmanagerGetSecretPolicy_%s", secretArn)
+	if v := r.cache.Get(cacheKey); v != nil {
+		return v.(string), nil
+	}
+
+	output, err := r.client.GetResourcePolicy(&secretsmanager.GetResourcePolicyInput{
+		SecretId: &secretArn,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	policy := aws.StringValue(output.ResourcePolicy)
+	r.cache.Put(cacheKey, policy)
+	return policy, nil
+}
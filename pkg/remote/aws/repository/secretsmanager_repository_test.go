@@ -0,0 +1,157 @@
+package repository
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/secretsmanager"
+	"github.com/r3labs/diff/v2"
+	"github.com/snyk/driftctl/pkg/remote/cache"
+	awstest "github.com/snyk/driftctl/test/aws"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_secretsManagerRepository_ListAllSecrets(t *testing.T) {
+	tests := []struct {
+		name    string
+		mocks   func(client *awstest.MockFakeSecretsManager)
+		want    []*secretsmanager.SecretListEntry
+		wantErr error
+	}{
+		{
+			name: "List with 2 pages ignoring secrets owned by other services",
+			mocks: func(client *awstest.MockFakeSecretsManager) {
+				client.On("ListSecretsPages",
+					&secretsmanager.ListSecretsInput{},
+					mock.MatchedBy(func(callback func(res *secretsmanager.ListSecretsOutput, lastPage bool) bool) bool {
+						callback(&secretsmanager.ListSecretsOutput{
+							SecretList: []*secretsmanager.SecretListEntry{
+								{ARN: aws.String("arn:aws:secretsmanager:us-east-1:929327065333:secret:foo-Y6pRnY"), Name: aws.String("foo")},
+								{ARN: aws.String("arn:aws:secretsmanager:us-east-1:929327065333:secret:rds!db-42-iwtbOc"), Name: aws.String("rds!db-42"), OwningService: aws.String("rds")},
+							},
+						}, false)
+						callback(&secretsmanager.ListSecretsOutput{
+							SecretList: []*secretsmanager.SecretListEntry{
+								{ARN: aws.String("arn:aws:secretsmanager:us-east-1:929327065333:secret:bar-Wh5sMv"), Name: aws.String("bar")},
+							},
+						}, true)
+						return true
+					})).Return(nil).Once()
+			},
+			want: []*secretsmanager.SecretListEntry{
+				{ARN: aws.String("arn:aws:secretsmanager:us-east-1:929327065333:secret:foo-Y6pRnY"), Name: aws.String("foo")},
+				{ARN: aws.String("arn:aws:secretsmanager:us-east-1:929327065333:secret:bar-Wh5sMv"), Name: aws.String("bar")},
+			},
+		},
+		{
+			name: "should return remote error",
+			mocks: func(client *awstest.MockFakeSecretsManager) {
+				client.On("ListSecretsPages",
+					&secretsmanager.ListSecretsInput{},
+					mock.AnythingOfType("func(*secretsmanager.ListSecretsOutput, bool) bool")).Return(errors.New("remote error")).Once()
+			},
+			wantErr: errors.New("remote error"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := cache.New(1)
+			client := awstest.MockFakeSecretsManager{}
+			tt.mocks(&client)
+			r := &secretsManagerRepository{
+				client: &client,
+				cache:  store,
+			}
+			got, err := r.ListAllSecrets()
+			assert.Equal(t, tt.wantErr, err)
+
+			if err == nil {
+				// Check that results were cached
+				cachedData, err := r.ListAllSecrets()
+				assert.NoError(t, err)
+				assert.Equal(t, got, cachedData)
+				assert.IsType(t, []*secretsmanager.SecretListEntry{}, store.Get("secretsmanagerListAllSecrets"))
+			}
+
+			changelog, err := diff.Diff(got, tt.want)
+			assert.Nil(t, err)
+			if len(changelog) > 0 {
+				for _, change := range changelog {
+					t.Errorf("%s: %v -> %v", strings.Join(change.Path, "."), change.From, change.To)
+				}
+				t.Fail()
+			}
+		})
+	}
+}
+
+func Test_secretsManagerRepository_GetSecretPolicy(t *testing.T) {
+	secretArn := "arn:aws:secretsmanager:us-east-1:929327065333:secret:foo-Y6pRnY"
+
+	tests := []struct {
+		name    string
+		mocks   func(client *awstest.MockFakeSecretsManager)
+		want    string
+		wantErr error
+	}{
+		{
+			name: "secret with a policy",
+			mocks: func(client *awstest.MockFakeSecretsManager) {
+				client.On("GetResourcePolicy", &secretsmanager.GetResourcePolicyInput{
+					SecretId: aws.String(secretArn),
+				}).Return(&secretsmanager.GetResourcePolicyOutput{
+					ARN:            aws.String(secretArn),
+					ResourcePolicy: aws.String("{\"Version\":\"2012-10-17\"}"),
+				}, nil).Once()
+			},
+			want: "{\"Version\":\"2012-10-17\"}",
+		},
+		{
+			name: "secret without policy",
+			mocks: func(client *awstest.MockFakeSecretsManager) {
+				client.On("GetResourcePolicy", &secretsmanager.GetResourcePolicyInput{
+					SecretId: aws.String(secretArn),
+				}).Return(&secretsmanager.GetResourcePolicyOutput{
+					ARN: aws.String(secretArn),
+				}, nil).Once()
+			},
+			want: "",
+		},
+		{
+			name: "should return remote error",
+			mocks: func(client *awstest.MockFakeSecretsManager) {
+				client.On("GetResourcePolicy", &secretsmanager.GetResourcePolicyInput{
+					SecretId: aws.String(secretArn),
+				}).Return(nil, errors.New("remote error")).Once()
+			},
+			wantErr: errors.New("remote error"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := cache.New(1)
+			client := awstest.MockFakeSecretsManager{}
+			tt.mocks(&client)
+			r := &secretsManagerRepository{
+				client: &client,
+				cache:  store,
+			}
+			got, err := r.GetSecretPolicy(secretArn)
+			assert.Equal(t, tt.wantErr, err)
+
+			if err == nil {
+				// Check that results were cached
+				cachedData, err := r.GetSecretPolicy(secretArn)
+				assert.NoError(t, err)
+				assert.Equal(t, got, cachedData)
+				assert.IsType(t, "", store.Get("secretsmanagerGetSecretPolicy_"+secretArn))
+			}
+
+			assert.Equal(t, tt.want, got)
+			client.AssertExpectations(t)
+		})
+	}
+}
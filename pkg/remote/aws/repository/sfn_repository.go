@@ -0,0 +1,43 @@
+package repository
+
+import (
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/sfn"
+	"github.com/aws/aws-sdk-go/service/sfn/sfniface"
+	"github.com/snyk/driftctl/pkg/remote/cache"
+)
+
+type SFNRepository interface {
+	ListAllStateMachines() ([]*sfn.StateMachineListItem, error)
+}
+
+type sfnRepository struct {
+	client sfniface.SFNAPI
+	cache  cache.Cache
+}
+
+func NewSFNRepository(session *session.Session, c cache.Cache) *sfnRepository {
+	return &sfnRepository{
+		sfn.New(session),
+		c,
+	}
+}
+
+func (r *sfnRepository) ListAllStateMachines() ([]*sfn.StateMachineListItem, error) {
+	if v := r.cache.Get("sfnListAllStateMachines"); v != nil {
+		return v.([]*sfn.StateMachineListItem), nil
+	}
+
+	var stateMachines []*sfn.StateMachineListItem
+	input := &sfn.ListStateMachinesInput{}
+	err := r.client.ListStateMachinesPages(input, func(res *sfn.ListStateMachinesOutput, lastPage bool) bool {
+		stateMachines = append(stateMachines, res.StateMachines...)
+		return !lastPage
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r.cache.Put("sfnListAllStateMachines", stateMachines)
+	return stateMachines, nil
+}
@@ -0,0 +1,89 @@
+package repository
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sfn"
+	"github.com/r3labs/diff/v2"
+	"github.com/snyk/driftctl/pkg/remote/cache"
+	awstest "github.com/snyk/driftctl/test/aws"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_sfnRepository_ListAllStateMachines(t *testing.T) {
+	tests := []struct {
+		name    string
+		mocks   func(client *awstest.MockFakeSFN)
+		want    []*sfn.StateMachineListItem
+		wantErr error
+	}{
+		{
+			name: "List with 2 pages",
+			mocks: func(client *awstest.MockFakeSFN) {
+				client.On("ListStateMachinesPages",
+					&sfn.ListStateMachinesInput{},
+					mock.MatchedBy(func(callback func(res *sfn.ListStateMachinesOutput, lastPage bool) bool) bool {
+						callback(&sfn.ListStateMachinesOutput{
+							StateMachines: []*sfn.StateMachineListItem{
+								{StateMachineArn: aws.String("arn:aws:states:us-east-1:123456789012:stateMachine:order-processing"), Name: aws.String("order-processing")},
+							},
+						}, false)
+						callback(&sfn.ListStateMachinesOutput{
+							StateMachines: []*sfn.StateMachineListItem{
+								{StateMachineArn: aws.String("arn:aws:states:us-east-1:123456789012:stateMachine:invoice-generation"), Name: aws.String("invoice-generation")},
+							},
+						}, true)
+						return true
+					})).Return(nil).Once()
+			},
+			want: []*sfn.StateMachineListItem{
+				{StateMachineArn: aws.String("arn:aws:states:us-east-1:123456789012:stateMachine:order-processing"), Name: aws.String("order-processing")},
+				{StateMachineArn: aws.String("arn:aws:states:us-east-1:123456789012:stateMachine:invoice-generation"), Name: aws.String("invoice-generation")},
+			},
+		},
+		{
+			name: "should return remote error",
+			mocks: func(client *awstest.MockFakeSFN) {
+				client.On("ListStateMachinesPages",
+					&sfn.ListStateMachinesInput{},
+					mock.Anything).Return(errors.New("remote error")).Once()
+			},
+			wantErr: errors.New("remote error"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := cache.New(1)
+			client := awstest.MockFakeSFN{}
+			tt.mocks(&client)
+			r := &sfnRepository{
+				client: &client,
+				cache:  store,
+			}
+			got, err := r.ListAllStateMachines()
+			assert.Equal(t, tt.wantErr, err)
+
+			if err == nil {
+				// Check that results were cached
+				cachedData, err := r.ListAllStateMachines()
+				assert.NoError(t, err)
+				assert.Equal(t, got, cachedData)
+				assert.IsType(t, []*sfn.StateMachineListItem{}, store.Get("sfnListAllStateMachines"))
+			}
+
+			changelog, err := diff.Diff(got, tt.want)
+			assert.Nil(t, err)
+			if len(changelog) > 0 {
+				for _, change := range changelog {
+					t.Errorf("%s: %v -> %v", strings.Join(change.Path, "."), change.From, change.To)
+				}
+				t.Fail()
+			}
+			client.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,44 @@
+package repository
+
+import (
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/aws-sdk-go/service/ssm/ssmiface"
+	"github.com/snyk/driftctl/pkg/remote/cache"
+)
+
+type SSMRepository interface {
+	ListAllParameters() ([]*ssm.ParameterMetadata, error)
+}
+
+type ssmRepository struct {
+	client ssmiface.SSMAPI
+	cache  cache.Cache
+}
+
+func NewSSMRepository(session *session.Session, c cache.Cache) *ssmRepository {
+	return &ssmRepository{
+		ssm.New(session),
+		c,
+	}
+}
+
+// ListAllParameters describes the parameters without ever getting their values
+func (r *ssmRepository) ListAllParameters() ([]*ssm.ParameterMetadata, error) {
+	if v := r.cache.Get("ssmListAllParameters"); v != nil {
+		return v.([]*ssm.ParameterMetadata), nil
+	}
+
+	var parameters []*ssm.ParameterMetadata
+	input := &ssm.DescribeParametersInput{}
+	err := r.client.DescribeParametersPages(input, func(res *ssm.DescribeParametersOutput, lastPage bool) bool {
+		parameters = append(parameters, res.Parameters...)
+		return !lastPage
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r.cache.Put("ssmListAllParameters", parameters)
+	return parameters, nil
+}
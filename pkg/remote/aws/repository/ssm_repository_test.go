@@ -0,0 +1,90 @@
+package repository
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/r3labs/diff/v2"
+	"github.com/snyk/driftctl/pkg/remote/cache"
+	awstest "github.com/snyk/driftctl/test/aws"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_ssmRepository_ListAllParameters(t *testing.T) {
+	tests := []struct {
+		name    string
+		mocks   func(client *awstest.MockFakeSSM)
+		want    []*ssm.ParameterMetadata
+		wantErr error
+	}{
+		{
+			name: "List with 2 pages",
+			mocks: func(client *awstest.MockFakeSSM) {
+				client.On("DescribeParametersPages",
+					&ssm.DescribeParametersInput{},
+					mock.MatchedBy(func(callback func(res *ssm.DescribeParametersOutput, lastPage bool) bool) bool {
+						callback(&ssm.DescribeParametersOutput{
+							Parameters: []*ssm.ParameterMetadata{
+								{Name: aws.String("/app/foo"), Type: aws.String(ssm.ParameterTypeString)},
+								{Name: aws.String("/app/bar"), Type: aws.String(ssm.ParameterTypeSecureString)},
+							},
+						}, false)
+						callback(&ssm.DescribeParametersOutput{
+							Parameters: []*ssm.ParameterMetadata{
+								{Name: aws.String("/app/baz"), Type: aws.String(ssm.ParameterTypeStringList)},
+							},
+						}, true)
+						return true
+					})).Return(nil).Once()
+			},
+			want: []*ssm.ParameterMetadata{
+				{Name: aws.String("/app/foo"), Type: aws.String(ssm.ParameterTypeString)},
+				{Name: aws.String("/app/bar"), Type: aws.String(ssm.ParameterTypeSecureString)},
+				{Name: aws.String("/app/baz"), Type: aws.String(ssm.ParameterTypeStringList)},
+			},
+		},
+		{
+			name: "should return remote error",
+			mocks: func(client *awstest.MockFakeSSM) {
+				client.On("DescribeParametersPages",
+					&ssm.DescribeParametersInput{},
+					mock.AnythingOfType("func(*ssm.DescribeParametersOutput, bool) bool")).Return(errors.New("remote error")).Once()
+			},
+			wantErr: errors.New("remote error"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := cache.New(1)
+			client := awstest.MockFakeSSM{}
+			tt.mocks(&client)
+			r := &ssmRepository{
+				client: &client,
+				cache:  store,
+			}
+			got, err := r.ListAllParameters()
+			assert.Equal(t, tt.wantErr, err)
+
+			if err == nil {
+				// Check that results were cached
+				cachedData, err := r.ListAllParameters()
+				assert.NoError(t, err)
+				assert.Equal(t, got, cachedData)
+				assert.IsType(t, []*ssm.ParameterMetadata{}, store.Get("ssmListAllParameters"))
+			}
+
+			changelog, err := diff.Diff(got, tt.want)
+			assert.Nil(t, err)
+			if len(changelog) > 0 {
+				for _, change := range changelog {
+					t.Errorf("%s: %v -> %v", strings.Join(change.Path, "."), change.From, change.To)
+				}
+				t.Fail()
+			}
+		})
+	}
+}
@@ -0,0 +1,38 @@
+package repository
+
+import (
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/sts"
+	"github.com/aws/aws-sdk-go/service/sts/stsiface"
+	"github.com/snyk/driftctl/pkg/remote/cache"
+)
+
+type STSRepository interface {
+	GetCallerIdentity() (*sts.GetCallerIdentityOutput, error)
+}
+
+type stsRepository struct {
+	client stsiface.STSAPI
+	cache  cache.Cache
+}
+
+func NewSTSRepository(session *session.Session, c cache.Cache) *stsRepository {
+	return &stsRepository{
+		sts.New(session),
+		c,
+	}
+}
+
+func (r *stsRepository) GetCallerIdentity() (*sts.GetCallerIdentityOutput, error) {
+	if v := r.cache.Get("stsGetCallerIdentity"); v != nil {
+		return v.(*sts.GetCallerIdentityOutput), nil
+	}
+
+	identity, err := r.client.GetCallerIdentity(&sts.GetCallerIdentityInput{})
+	if err != nil {
+		return nil, err
+	}
+
+	r.cache.Put("stsGetCallerIdentity", identity)
+	return identity, nil
+}
@@ -0,0 +1,64 @@
+package repository
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sts"
+	"github.com/snyk/driftctl/pkg/remote/cache"
+	awstest "github.com/snyk/driftctl/test/aws"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_stsRepository_GetCallerIdentity(t *testing.T) {
+	tests := []struct {
+		name    string
+		mocks   func(client *awstest.MockFakeSTS)
+		want    *sts.GetCallerIdentityOutput
+		wantErr error
+	}{
+		{
+			name: "Get caller identity",
+			mocks: func(client *awstest.MockFakeSTS) {
+				client.On("GetCallerIdentity", &sts.GetCallerIdentityInput{}).Return(&sts.GetCallerIdentityOutput{
+					Account: aws.String("111111111111"),
+					Arn:     aws.String("arn:aws:iam::111111111111:user/driftctl"),
+				}, nil).Once()
+			},
+			want: &sts.GetCallerIdentityOutput{
+				Account: aws.String("111111111111"),
+				Arn:     aws.String("arn:aws:iam::111111111111:user/driftctl"),
+			},
+		},
+		{
+			name: "Get caller identity without credentials",
+			mocks: func(client *awstest.MockFakeSTS) {
+				client.On("GetCallerIdentity", &sts.GetCallerIdentityInput{}).Return(nil, errors.New("NoCredentialProviders")).Once()
+			},
+			wantErr: errors.New("NoCredentialProviders"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := cache.New(1)
+			client := awstest.MockFakeSTS{}
+			tt.mocks(&client)
+			r := &stsRepository{
+				client: &client,
+				cache:  store,
+			}
+			got, err := r.GetCallerIdentity()
+			assert.Equal(t, tt.wantErr, err)
+			assert.Equal(t, tt.want, got)
+
+			if err == nil {
+				// Check that results were cached
+				cachedData, err := r.GetCallerIdentity()
+				assert.NoError(t, err)
+				assert.Equal(t, got, cachedData)
+			}
+			client.AssertExpectations(t)
+		})
+	}
+}
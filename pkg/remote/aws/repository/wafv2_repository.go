@@ -0,0 +1,56 @@
+package repository
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/wafv2"
+	"github.com/aws/aws-sdk-go/service/wafv2/wafv2iface"
+	"github.com/snyk/driftctl/pkg/remote/cache"
+)
+
+type WAFV2Repository interface {
+	ListAllWebACLs(scope string) ([]*wafv2.WebACLSummary, error)
+}
+
+type wafv2Repository struct {
+	client wafv2iface.WAFV2API
+	cache  cache.Cache
+}
+
+func NewWAFV2Repository(session *session.Session, c cache.Cache) *wafv2Repository {
+	return &wafv2Repository{
+		wafv2.New(session),
+		c,
+	}
+}
+
+// ListAllWebACLs returns the web ACLs of a scope, the SDK does not provide paginators for WAFv2 APIs
+func (r *wafv2Repository) ListAllWebACLs(scope string) ([]*wafv2.WebACLSummary, error) {
+	cacheKey := fmt.Sprintf("wafv2ListAllWebACLs_%s", scope)
+	if v := r.cache.Get(cacheKey); v != nil {
+		return v.([]*wafv2.WebACLSummary), nil
+	}
+
+	var webACLs []*wafv2.WebACLSummary
+	var nextMarker *string
+	for {
+		res, err := r.client.ListWebACLs(&wafv2.ListWebACLsInput{
+			Scope:      aws.String(scope),
+			NextMarker: nextMarker,
+		})
+		if err != nil {
+			return nil, err
+		}
+		webACLs = append(webACLs, res.WebACLs...)
+		// The last page may still return a marker along with an empty list
+		if res.NextMarker == nil || len(res.WebACLs) == 0 {
+			break
+		}
+		nextMarker = res.NextMarker
+	}
+
+	r.cache.Put(cacheKey, webACLs)
+	return webACLs, nil
+}
@@ -0,0 +1,97 @@
+package repository
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/wafv2"
+	"github.com/r3labs/diff/v2"
+	"github.com/snyk/driftctl/pkg/remote/cache"
+	awstest "github.com/snyk/driftctl/test/aws"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_wafv2Repository_ListAllWebACLs(t *testing.T) {
+	tests := []struct {
+		name    string
+		mocks   func(client *awstest.MockFakeWAFV2)
+		want    []*wafv2.WebACLSummary
+		wantErr error
+	}{
+		{
+			name: "List with 2 pages",
+			mocks: func(client *awstest.MockFakeWAFV2) {
+				client.On("ListWebACLs", &wafv2.ListWebACLsInput{
+					Scope: aws.String("REGIONAL"),
+				}).Return(&wafv2.ListWebACLsOutput{
+					WebACLs: []*wafv2.WebACLSummary{
+						{Name: aws.String("api"), Id: aws.String("a1b2c3d4-5678-90ab-cdef-EXAMPLE11111")},
+					},
+					NextMarker: aws.String("next"),
+				}, nil).Once()
+				client.On("ListWebACLs", &wafv2.ListWebACLsInput{
+					Scope:      aws.String("REGIONAL"),
+					NextMarker: aws.String("next"),
+				}).Return(&wafv2.ListWebACLsOutput{
+					WebACLs: []*wafv2.WebACLSummary{
+						{Name: aws.String("admin"), Id: aws.String("a1b2c3d4-5678-90ab-cdef-EXAMPLE22222")},
+					},
+					NextMarker: aws.String("last"),
+				}, nil).Once()
+				client.On("ListWebACLs", &wafv2.ListWebACLsInput{
+					Scope:      aws.String("REGIONAL"),
+					NextMarker: aws.String("last"),
+				}).Return(&wafv2.ListWebACLsOutput{
+					WebACLs:    []*wafv2.WebACLSummary{},
+					NextMarker: aws.String("last"),
+				}, nil).Once()
+			},
+			want: []*wafv2.WebACLSummary{
+				{Name: aws.String("api"), Id: aws.String("a1b2c3d4-5678-90ab-cdef-EXAMPLE11111")},
+				{Name: aws.String("admin"), Id: aws.String("a1b2c3d4-5678-90ab-cdef-EXAMPLE22222")},
+			},
+		},
+		{
+			name: "should return remote error",
+			mocks: func(client *awstest.MockFakeWAFV2) {
+				client.On("ListWebACLs", &wafv2.ListWebACLsInput{
+					Scope: aws.String("REGIONAL"),
+				}).Return(nil, errors.New("remote error")).Once()
+			},
+			wantErr: errors.New("remote error"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := cache.New(1)
+			client := awstest.MockFakeWAFV2{}
+			tt.mocks(&client)
+			r := &wafv2Repository{
+				client: &client,
+				cache:  store,
+			}
+			got, err := r.ListAllWebACLs("REGIONAL")
+			assert.Equal(t, tt.wantErr, err)
+
+			if err == nil {
+				// Check that results were cached
+				cachedData, err := r.ListAllWebACLs("REGIONAL")
+				assert.NoError(t, err)
+				assert.Equal(t, got, cachedData)
+				assert.IsType(t, []*wafv2.WebACLSummary{}, store.Get("wafv2ListAllWebACLs_REGIONAL"))
+			}
+
+			changelog, err := diff.Diff(got, tt.want)
+			assert.Nil(t, err)
+			if len(changelog) > 0 {
+				for _, change := range changelog {
+					t.Errorf("%s: %v -> %v", strings.Join(change.Path, "."), change.From, change.To)
+				}
+				t.Fail()
+			}
+			client.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,63 @@
+package aws
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/snyk/driftctl/pkg/remote/aws/repository"
+	remoteerror "github.com/snyk/driftctl/pkg/remote/error"
+	"github.com/snyk/driftctl/pkg/resource"
+	resourceaws "github.com/snyk/driftctl/pkg/resource/aws"
+)
+
+type S3AccountPublicAccessBlockEnumerator struct {
+	repository repository.S3ControlRepository
+	factory    resource.ResourceFactory
+	accountId  string
+}
+
+func NewS3AccountPublicAccessBlockEnumerator(repo repository.S3ControlRepository, factory resource.ResourceFactory, accountId string) *S3AccountPublicAccessBlockEnumerator {
+	return &S3AccountPublicAccessBlockEnumerator{
+		repository: repo,
+		factory:    factory,
+		accountId:  accountId,
+	}
+}
+
+func (e *S3AccountPublicAccessBlockEnumerator) SupportedType() resource.ResourceType {
+	return resourceaws.AwsS3AccountPublicAccessBlockResourceType
+}
+
+// Enumerate always returns the public access block of the account, an account without configuration
+// behaves like a block with every setting disabled
+func (e *S3AccountPublicAccessBlockEnumerator) Enumerate() ([]*resource.Resource, error) {
+	accessBlock, err := e.repository.GetAccountPublicAccessBlock(e.accountId)
+	if err != nil {
+		return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
+	}
+
+	results := make([]*resource.Resource, 0, 1)
+
+	attrs := map[string]interface{}{
+		"account_id":              e.accountId,
+		"block_public_acls":       false,
+		"block_public_policy":     false,
+		"ignore_public_acls":      false,
+		"restrict_public_buckets": false,
+	}
+	if accessBlock != nil {
+		attrs["block_public_acls"] = aws.BoolValue(accessBlock.BlockPublicAcls)
+		attrs["block_public_policy"] = aws.BoolValue(accessBlock.BlockPublicPolicy)
+		attrs["ignore_public_acls"] = aws.BoolValue(accessBlock.IgnorePublicAcls)
+		attrs["restrict_public_buckets"] = aws.BoolValue(accessBlock.RestrictPublicBuckets)
+	}
+
+	results = append(
+		results,
+		e.factory.CreateAbstractResource(
+			string(e.SupportedType()),
+			e.accountId,
+			attrs,
+		),
+	)
+
+	return results, err
+}
@@ -0,0 +1,48 @@
+package aws
+
+import (
+	"github.com/snyk/driftctl/pkg/remote/aws/repository"
+	remoteerror "github.com/snyk/driftctl/pkg/remote/error"
+	"github.com/snyk/driftctl/pkg/resource"
+	"github.com/snyk/driftctl/pkg/resource/aws"
+)
+
+type SecretsManagerSecretEnumerator struct {
+	repository repository.SecretsManagerRepository
+	factory    resource.ResourceFactory
+}
+
+func NewSecretsManagerSecretEnumerator(repo repository.SecretsManagerRepository, factory resource.ResourceFactory) *SecretsManagerSecretEnumerator {
+	return &SecretsManagerSecretEnumerator{
+		repository: repo,
+		factory:    factory,
+	}
+}
+
+func (e *SecretsManagerSecretEnumerator) SupportedType() resource.ResourceType {
+	return aws.AwsSecretsManagerSecretResourceType
+}
+
+func (e *SecretsManagerSecretEnumerator) Enumerate() ([]*resource.Resource, error) {
+	secrets, err := e.repository.ListAllSecrets()
+	if err != nil {
+		return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
+	}
+
+	results := make([]*resource.Resource, 0, len(secrets))
+
+	for _, secret := range secrets {
+		results = append(
+			results,
+			e.factory.CreateAbstractResource(
+				string(e.SupportedType()),
+				*secret.ARN,
+				map[string]interface{}{
+					"name": *secret.Name,
+				},
+			),
+		)
+	}
+
+	return results, err
+}
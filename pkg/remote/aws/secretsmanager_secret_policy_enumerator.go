@@ -0,0 +1,57 @@
+package aws
+
+import (
+	"github.com/snyk/driftctl/pkg/remote/aws/repository"
+	remoteerror "github.com/snyk/driftctl/pkg/remote/error"
+	"github.com/snyk/driftctl/pkg/resource"
+	"github.com/snyk/driftctl/pkg/resource/aws"
+)
+
+type SecretsManagerSecretPolicyEnumerator struct {
+	repository repository.SecretsManagerRepository
+	factory    resource.ResourceFactory
+}
+
+func NewSecretsManagerSecretPolicyEnumerator(repo repository.SecretsManagerRepository, factory resource.ResourceFactory) *SecretsManagerSecretPolicyEnumerator {
+	return &SecretsManagerSecretPolicyEnumerator{
+		repository: repo,
+		factory:    factory,
+	}
+}
+
+func (e *SecretsManagerSecretPolicyEnumerator) SupportedType() resource.ResourceType {
+	return aws.AwsSecretsManagerSecretPolicyResourceType
+}
+
+func (e *SecretsManagerSecretPolicyEnumerator) Enumerate() ([]*resource.Resource, error) {
+	secrets, err := e.repository.ListAllSecrets()
+	if err != nil {
+		return nil, remoteerror.NewResourceListingErrorWithType(err, string(e.SupportedType()), aws.AwsSecretsManagerSecretResourceType)
+	}
+
+	results := make([]*resource.Resource, 0, len(secrets))
+
+	for _, secret := range secrets {
+		policy, err := e.repository.GetSecretPolicy(*secret.ARN)
+		if err != nil {
+			return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
+		}
+		// Secrets without resource policy do not have any aws_secretsmanager_secret_policy
+		if policy == "" {
+			continue
+		}
+		results = append(
+			results,
+			e.factory.CreateAbstractResource(
+				string(e.SupportedType()),
+				*secret.ARN,
+				map[string]interface{}{
+					"secret_arn": *secret.ARN,
+					"policy":     policy,
+				},
+			),
+		)
+	}
+
+	return results, err
+}
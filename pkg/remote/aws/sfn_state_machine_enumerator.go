@@ -0,0 +1,48 @@
+package aws
+
+import (
+	"github.com/snyk/driftctl/pkg/remote/aws/repository"
+	remoteerror "github.com/snyk/driftctl/pkg/remote/error"
+	"github.com/snyk/driftctl/pkg/resource"
+	"github.com/snyk/driftctl/pkg/resource/aws"
+)
+
+type SFNStateMachineEnumerator struct {
+	repository repository.SFNRepository
+	factory    resource.ResourceFactory
+}
+
+func NewSFNStateMachineEnumerator(repo repository.SFNRepository, factory resource.ResourceFactory) *SFNStateMachineEnumerator {
+	return &SFNStateMachineEnumerator{
+		repository: repo,
+		factory:    factory,
+	}
+}
+
+func (e *SFNStateMachineEnumerator) SupportedType() resource.ResourceType {
+	return aws.AwsSfnStateMachineResourceType
+}
+
+func (e *SFNStateMachineEnumerator) Enumerate() ([]*resource.Resource, error) {
+	stateMachines, err := e.repository.ListAllStateMachines()
+	if err != nil {
+		return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
+	}
+
+	results := make([]*resource.Resource, 0, len(stateMachines))
+
+	for _, stateMachine := range stateMachines {
+		results = append(
+			results,
+			e.factory.CreateAbstractResource(
+				string(e.SupportedType()),
+				*stateMachine.StateMachineArn,
+				map[string]interface{}{
+					"name": *stateMachine.Name,
+				},
+			),
+		)
+	}
+
+	return results, err
+}
@@ -4,6 +4,7 @@ import (
 	"strings"
 
 	"github.com/sirupsen/logrus"
+	"github.com/snyk/driftctl/pkg/remote/common"
 	remoteerror "github.com/snyk/driftctl/pkg/remote/error"
 	"github.com/snyk/driftctl/pkg/resource"
 	"github.com/snyk/driftctl/pkg/resource/aws"
@@ -23,9 +24,14 @@ func NewSQSQueueDetailsFetcher(provider terraform.ResourceReader, deserializer *
 }
 
 func (r *SQSQueueDetailsFetcher) ReadDetails(res *resource.Resource) (*resource.Resource, error) {
+	attributes := map[string]string{}
+	if alias := common.ReadAlias(res, ""); alias != "" {
+		attributes["alias"] = alias
+	}
 	ctyVal, err := r.reader.ReadResource(terraform.ReadResourceArgs{
-		ID: res.ResourceId(),
-		Ty: aws.AwsSqsQueueResourceType,
+		ID:         res.ResourceId(),
+		Ty:         aws.AwsSqsQueueResourceType,
+		Attributes: attributes,
 	})
 	if err != nil {
 		if strings.Contains(err.Error(), "NonExistentQueue") {
@@ -0,0 +1,58 @@
+package aws
+
+import (
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/snyk/driftctl/pkg/remote/aws/repository"
+	remoteerror "github.com/snyk/driftctl/pkg/remote/error"
+	"github.com/snyk/driftctl/pkg/resource"
+	"github.com/snyk/driftctl/pkg/resource/aws"
+)
+
+type SSMParameterEnumerator struct {
+	repository repository.SSMRepository
+	factory    resource.ResourceFactory
+}
+
+func NewSSMParameterEnumerator(repo repository.SSMRepository, factory resource.ResourceFactory) *SSMParameterEnumerator {
+	return &SSMParameterEnumerator{
+		repository: repo,
+		factory:    factory,
+	}
+}
+
+func (e *SSMParameterEnumerator) SupportedType() resource.ResourceType {
+	return aws.AwsSsmParameterResourceType
+}
+
+func (e *SSMParameterEnumerator) Enumerate() ([]*resource.Resource, error) {
+	parameters, err := e.repository.ListAllParameters()
+	if err != nil {
+		return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
+	}
+
+	results := make([]*resource.Resource, 0, len(parameters))
+
+	for _, parameter := range parameters {
+		// Parameters have no details fetcher, the described metadata is what is compared in deep mode
+		results = append(
+			results,
+			e.factory.CreateAbstractResource(
+				string(e.SupportedType()),
+				*parameter.Name,
+				map[string]interface{}{
+					"id":              *parameter.Name,
+					"name":            *parameter.Name,
+					"type":            awssdk.StringValue(parameter.Type),
+					"description":     awssdk.StringValue(parameter.Description),
+					"key_id":          awssdk.StringValue(parameter.KeyId),
+					"tier":            awssdk.StringValue(parameter.Tier),
+					"allowed_pattern": awssdk.StringValue(parameter.AllowedPattern),
+					"data_type":       awssdk.StringValue(parameter.DataType),
+					"version":         float64(awssdk.Int64Value(parameter.Version)),
+				},
+			),
+		)
+	}
+
+	return results, err
+}
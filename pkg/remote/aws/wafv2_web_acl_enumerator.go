@@ -0,0 +1,53 @@
+package aws
+
+import (
+	"github.com/snyk/driftctl/pkg/remote/aws/repository"
+	remoteerror "github.com/snyk/driftctl/pkg/remote/error"
+	"github.com/snyk/driftctl/pkg/resource"
+	"github.com/snyk/driftctl/pkg/resource/aws"
+)
+
+// WAFV2WebACLEnumerator lists the web ACLs of a scope, REGIONAL ones are listed in every region
+// while CLOUDFRONT ones are global and can only be listed from us-east-1
+type WAFV2WebACLEnumerator struct {
+	repository repository.WAFV2Repository
+	factory    resource.ResourceFactory
+	scope      string
+}
+
+func NewWAFV2WebACLEnumerator(repo repository.WAFV2Repository, factory resource.ResourceFactory, scope string) *WAFV2WebACLEnumerator {
+	return &WAFV2WebACLEnumerator{
+		repository: repo,
+		factory:    factory,
+		scope:      scope,
+	}
+}
+
+func (e *WAFV2WebACLEnumerator) SupportedType() resource.ResourceType {
+	return aws.AwsWafv2WebAclResourceType
+}
+
+func (e *WAFV2WebACLEnumerator) Enumerate() ([]*resource.Resource, error) {
+	webACLs, err := e.repository.ListAllWebACLs(e.scope)
+	if err != nil {
+		return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
+	}
+
+	results := make([]*resource.Resource, 0, len(webACLs))
+
+	for _, webACL := range webACLs {
+		results = append(
+			results,
+			e.factory.CreateAbstractResource(
+				string(e.SupportedType()),
+				*webACL.Id,
+				map[string]interface{}{
+					"name":  *webACL.Name,
+					"scope": e.scope,
+				},
+			),
+		)
+	}
+
+	return results, nil
+}
@@ -0,0 +1,115 @@
+package remote
+
+import (
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/acm"
+	"github.com/pkg/errors"
+	"github.com/snyk/driftctl/mocks"
+	"github.com/snyk/driftctl/pkg/filter"
+	"github.com/snyk/driftctl/pkg/remote/alerts"
+	"github.com/snyk/driftctl/pkg/remote/aws"
+	"github.com/snyk/driftctl/pkg/remote/aws/repository"
+	"github.com/snyk/driftctl/pkg/remote/common"
+	remoteerr "github.com/snyk/driftctl/pkg/remote/error"
+	"github.com/snyk/driftctl/pkg/resource"
+	resourceaws "github.com/snyk/driftctl/pkg/resource/aws"
+	"github.com/snyk/driftctl/pkg/terraform"
+	testresource "github.com/snyk/driftctl/test/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestACMCertificate(t *testing.T) {
+	tests := []struct {
+		test           string
+		mocks          func(*repository.MockACMRepository, *mocks.AlerterInterface)
+		assertExpected func(*testing.T, []*resource.Resource)
+		wantErr        error
+	}{
+		{
+			test: "no certificates",
+			mocks: func(repository *repository.MockACMRepository, alerter *mocks.AlerterInterface) {
+				repository.On("ListAllCertificates").Return([]*acm.CertificateSummary{}, nil)
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+		},
+		{
+			test: "multiple certificates",
+			mocks: func(repository *repository.MockACMRepository, alerter *mocks.AlerterInterface) {
+				repository.On("ListAllCertificates").Return([]*acm.CertificateSummary{
+					{
+						CertificateArn: awssdk.String("arn:aws:acm:us-east-1:123456789012:certificate/0a5b8f2e-4c1d-4d5a-9b7e-2f3c4d5e6f70"),
+						DomainName:     awssdk.String("example.com"),
+					},
+					{
+						CertificateArn: awssdk.String("arn:aws:acm:us-east-1:123456789012:certificate/1b6c9a3f-5d2e-4e6b-8c8f-3a4d5e6f7081"),
+						DomainName:     awssdk.String("api.example.com"),
+					},
+				}, nil)
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 2)
+
+				assert.Equal(t, "arn:aws:acm:us-east-1:123456789012:certificate/0a5b8f2e-4c1d-4d5a-9b7e-2f3c4d5e6f70", got[0].ResourceId())
+				assert.Equal(t, resourceaws.AwsAcmCertificateResourceType, got[0].ResourceType())
+
+				assert.Equal(t, "arn:aws:acm:us-east-1:123456789012:certificate/1b6c9a3f-5d2e-4e6b-8c8f-3a4d5e6f7081", got[1].ResourceId())
+				assert.Equal(t, resourceaws.AwsAcmCertificateResourceType, got[1].ResourceType())
+
+				assert.Equal(t, "api.example.com", *got[1].Attributes().GetString("domain_name"))
+			},
+		},
+		{
+			test: "cannot list certificates",
+			mocks: func(repository *repository.MockACMRepository, alerter *mocks.AlerterInterface) {
+				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
+				repository.On("ListAllCertificates").Return(nil, awsError)
+
+				alerter.On("SendAlert", resourceaws.AwsAcmCertificateResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsAcmCertificateResourceType, resourceaws.AwsAcmCertificateResourceType), alerts.EnumerationPhase)).Return()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+		},
+	}
+
+	schemaRepository := testresource.InitFakeSchemaRepository("aws", "3.19.0")
+	resourceaws.InitResourcesMetadata(schemaRepository)
+	factory := terraform.NewTerraformResourceFactory(schemaRepository)
+
+	for _, c := range tests {
+		t.Run(c.test, func(tt *testing.T) {
+
+			scanOptions := ScannerOptions{}
+			remoteLibrary := common.NewRemoteLibrary()
+
+			// Initialize mocks
+			alerter := &mocks.AlerterInterface{}
+			fakeRepo := &repository.MockACMRepository{}
+			c.mocks(fakeRepo, alerter)
+
+			var repo repository.ACMRepository = fakeRepo
+
+			remoteLibrary.AddEnumerator(aws.NewACMCertificateEnumerator(repo, factory))
+
+			testFilter := &filter.MockFilter{}
+			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
+
+			s := NewScanner(remoteLibrary, alerter, scanOptions, testFilter)
+			got, err := s.Resources()
+			assert.Equal(tt, c.wantErr, err)
+			if err != nil {
+				return
+			}
+
+			c.assertExpected(tt, got)
+			alerter.AssertExpectations(tt)
+			fakeRepo.AssertExpectations(tt)
+		})
+	}
+}
@@ -0,0 +1,107 @@
+package remote
+
+import (
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/cloudtrail"
+	"github.com/pkg/errors"
+	"github.com/snyk/driftctl/mocks"
+	"github.com/snyk/driftctl/pkg/filter"
+	"github.com/snyk/driftctl/pkg/remote/alerts"
+	"github.com/snyk/driftctl/pkg/remote/aws"
+	"github.com/snyk/driftctl/pkg/remote/aws/repository"
+	"github.com/snyk/driftctl/pkg/remote/common"
+	remoteerr "github.com/snyk/driftctl/pkg/remote/error"
+	"github.com/snyk/driftctl/pkg/resource"
+	resourceaws "github.com/snyk/driftctl/pkg/resource/aws"
+	"github.com/snyk/driftctl/pkg/terraform"
+	testresource "github.com/snyk/driftctl/test/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestCloudTrail(t *testing.T) {
+	tests := []struct {
+		test           string
+		mocks          func(*repository.MockCloudTrailRepository, *mocks.AlerterInterface)
+		assertExpected func(*testing.T, []*resource.Resource)
+		wantErr        error
+	}{
+		{
+			test: "no trails",
+			mocks: func(repository *repository.MockCloudTrailRepository, alerter *mocks.AlerterInterface) {
+				repository.On("ListAllTrails").Return([]*cloudtrail.Trail{}, nil)
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+		},
+		{
+			test: "multiple trails",
+			mocks: func(repository *repository.MockCloudTrailRepository, alerter *mocks.AlerterInterface) {
+				repository.On("ListAllTrails").Return([]*cloudtrail.Trail{
+					{Name: awssdk.String("management-events"), TrailARN: awssdk.String("arn:aws:cloudtrail:us-east-1:123456789012:trail/management-events")},
+					{Name: awssdk.String("data-events"), TrailARN: awssdk.String("arn:aws:cloudtrail:us-east-1:123456789012:trail/data-events")},
+				}, nil)
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 2)
+
+				assert.Equal(t, "management-events", got[0].ResourceId())
+				assert.Equal(t, resourceaws.AwsCloudtrailResourceType, got[0].ResourceType())
+
+				assert.Equal(t, "data-events", got[1].ResourceId())
+				assert.Equal(t, resourceaws.AwsCloudtrailResourceType, got[1].ResourceType())
+			},
+		},
+		{
+			test: "cannot list trails",
+			mocks: func(repository *repository.MockCloudTrailRepository, alerter *mocks.AlerterInterface) {
+				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
+				repository.On("ListAllTrails").Return(nil, awsError)
+
+				alerter.On("SendAlert", resourceaws.AwsCloudtrailResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsCloudtrailResourceType, resourceaws.AwsCloudtrailResourceType), alerts.EnumerationPhase)).Return()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+		},
+	}
+
+	schemaRepository := testresource.InitFakeSchemaRepository("aws", "3.19.0")
+	resourceaws.InitResourcesMetadata(schemaRepository)
+	factory := terraform.NewTerraformResourceFactory(schemaRepository)
+
+	for _, c := range tests {
+		t.Run(c.test, func(tt *testing.T) {
+
+			scanOptions := ScannerOptions{}
+			remoteLibrary := common.NewRemoteLibrary()
+
+			// Initialize mocks
+			alerter := &mocks.AlerterInterface{}
+			fakeRepo := &repository.MockCloudTrailRepository{}
+			c.mocks(fakeRepo, alerter)
+
+			var repo repository.CloudTrailRepository = fakeRepo
+
+			remoteLibrary.AddEnumerator(aws.NewCloudTrailEnumerator(repo, factory))
+
+			testFilter := &filter.MockFilter{}
+			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
+
+			s := NewScanner(remoteLibrary, alerter, scanOptions, testFilter)
+			got, err := s.Resources()
+			assert.Equal(tt, c.wantErr, err)
+			if err != nil {
+				return
+			}
+
+			c.assertExpected(tt, got)
+			alerter.AssertExpectations(tt)
+			fakeRepo.AssertExpectations(tt)
+		})
+	}
+}
@@ -0,0 +1,107 @@
+package remote
+
+import (
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/pkg/errors"
+	"github.com/snyk/driftctl/mocks"
+	"github.com/snyk/driftctl/pkg/filter"
+	"github.com/snyk/driftctl/pkg/remote/alerts"
+	"github.com/snyk/driftctl/pkg/remote/aws"
+	"github.com/snyk/driftctl/pkg/remote/aws/repository"
+	"github.com/snyk/driftctl/pkg/remote/common"
+	remoteerr "github.com/snyk/driftctl/pkg/remote/error"
+	"github.com/snyk/driftctl/pkg/resource"
+	resourceaws "github.com/snyk/driftctl/pkg/resource/aws"
+	"github.com/snyk/driftctl/pkg/terraform"
+	testresource "github.com/snyk/driftctl/test/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestCloudWatchMetricAlarm(t *testing.T) {
+	tests := []struct {
+		test           string
+		mocks          func(*repository.MockCloudWatchRepository, *mocks.AlerterInterface)
+		assertExpected func(*testing.T, []*resource.Resource)
+		wantErr        error
+	}{
+		{
+			test: "no alarm",
+			mocks: func(repository *repository.MockCloudWatchRepository, alerter *mocks.AlerterInterface) {
+				repository.On("ListAllMetricAlarms").Return([]*cloudwatch.MetricAlarm{}, nil)
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+		},
+		{
+			test: "multiple alarms",
+			mocks: func(repository *repository.MockCloudWatchRepository, alerter *mocks.AlerterInterface) {
+				repository.On("ListAllMetricAlarms").Return([]*cloudwatch.MetricAlarm{
+					{AlarmName: awssdk.String("cpu-high")},
+					{AlarmName: awssdk.String("disk-full")},
+				}, nil)
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 2)
+
+				assert.Equal(t, "cpu-high", got[0].ResourceId())
+				assert.Equal(t, resourceaws.AwsCloudwatchMetricAlarmResourceType, got[0].ResourceType())
+
+				assert.Equal(t, "disk-full", got[1].ResourceId())
+				assert.Equal(t, resourceaws.AwsCloudwatchMetricAlarmResourceType, got[1].ResourceType())
+			},
+		},
+		{
+			test: "cannot list alarms",
+			mocks: func(repository *repository.MockCloudWatchRepository, alerter *mocks.AlerterInterface) {
+				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
+				repository.On("ListAllMetricAlarms").Return(nil, awsError)
+
+				alerter.On("SendAlert", resourceaws.AwsCloudwatchMetricAlarmResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsCloudwatchMetricAlarmResourceType, resourceaws.AwsCloudwatchMetricAlarmResourceType), alerts.EnumerationPhase)).Return()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+		},
+	}
+
+	schemaRepository := testresource.InitFakeSchemaRepository("aws", "3.19.0")
+	resourceaws.InitResourcesMetadata(schemaRepository)
+	factory := terraform.NewTerraformResourceFactory(schemaRepository)
+
+	for _, c := range tests {
+		t.Run(c.test, func(tt *testing.T) {
+
+			scanOptions := ScannerOptions{}
+			remoteLibrary := common.NewRemoteLibrary()
+
+			// Initialize mocks
+			alerter := &mocks.AlerterInterface{}
+			fakeRepo := &repository.MockCloudWatchRepository{}
+			c.mocks(fakeRepo, alerter)
+
+			var repo repository.CloudWatchRepository = fakeRepo
+
+			remoteLibrary.AddEnumerator(aws.NewCloudWatchMetricAlarmEnumerator(repo, factory))
+
+			testFilter := &filter.MockFilter{}
+			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
+
+			s := NewScanner(remoteLibrary, alerter, scanOptions, testFilter)
+			got, err := s.Resources()
+			assert.Equal(tt, c.wantErr, err)
+			if err != nil {
+				return
+			}
+
+			c.assertExpected(tt, got)
+			alerter.AssertExpectations(tt)
+			fakeRepo.AssertExpectations(tt)
+		})
+	}
+}
@@ -0,0 +1,224 @@
+package remote
+
+import (
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/cloudwatchevents"
+	"github.com/pkg/errors"
+	"github.com/snyk/driftctl/mocks"
+	"github.com/snyk/driftctl/pkg/filter"
+	"github.com/snyk/driftctl/pkg/remote/alerts"
+	"github.com/snyk/driftctl/pkg/remote/aws"
+	"github.com/snyk/driftctl/pkg/remote/aws/repository"
+	"github.com/snyk/driftctl/pkg/remote/common"
+	remoteerr "github.com/snyk/driftctl/pkg/remote/error"
+	"github.com/snyk/driftctl/pkg/resource"
+	resourceaws "github.com/snyk/driftctl/pkg/resource/aws"
+	"github.com/snyk/driftctl/pkg/terraform"
+	testresource "github.com/snyk/driftctl/test/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestCloudWatchEventRule(t *testing.T) {
+	tests := []struct {
+		test           string
+		mocks          func(*repository.MockCloudWatchEventsRepository, *mocks.AlerterInterface)
+		assertExpected func(*testing.T, []*resource.Resource)
+		wantErr        error
+	}{
+		{
+			test: "no rule",
+			mocks: func(repository *repository.MockCloudWatchEventsRepository, alerter *mocks.AlerterInterface) {
+				repository.On("ListAllRules").Return([]*cloudwatchevents.Rule{}, nil)
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+		},
+		{
+			test: "multiple rules",
+			mocks: func(repository *repository.MockCloudWatchEventsRepository, alerter *mocks.AlerterInterface) {
+				repository.On("ListAllRules").Return([]*cloudwatchevents.Rule{
+					{Name: awssdk.String("nightly")},
+					{Name: awssdk.String("on-push")},
+				}, nil)
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 2)
+
+				assert.Equal(t, "nightly", got[0].ResourceId())
+				assert.Equal(t, resourceaws.AwsCloudwatchEventRuleResourceType, got[0].ResourceType())
+
+				assert.Equal(t, "on-push", got[1].ResourceId())
+				assert.Equal(t, resourceaws.AwsCloudwatchEventRuleResourceType, got[1].ResourceType())
+			},
+		},
+		{
+			test: "cannot list rules",
+			mocks: func(repository *repository.MockCloudWatchEventsRepository, alerter *mocks.AlerterInterface) {
+				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
+				repository.On("ListAllRules").Return(nil, awsError)
+
+				alerter.On("SendAlert", resourceaws.AwsCloudwatchEventRuleResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsCloudwatchEventRuleResourceType, resourceaws.AwsCloudwatchEventRuleResourceType), alerts.EnumerationPhase)).Return()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+		},
+	}
+
+	schemaRepository := testresource.InitFakeSchemaRepository("aws", "3.19.0")
+	resourceaws.InitResourcesMetadata(schemaRepository)
+	factory := terraform.NewTerraformResourceFactory(schemaRepository)
+
+	for _, c := range tests {
+		t.Run(c.test, func(tt *testing.T) {
+
+			scanOptions := ScannerOptions{}
+			remoteLibrary := common.NewRemoteLibrary()
+
+			// Initialize mocks
+			alerter := &mocks.AlerterInterface{}
+			fakeRepo := &repository.MockCloudWatchEventsRepository{}
+			c.mocks(fakeRepo, alerter)
+
+			var repo repository.CloudWatchEventsRepository = fakeRepo
+
+			remoteLibrary.AddEnumerator(aws.NewCloudWatchEventRuleEnumerator(repo, factory))
+
+			testFilter := &filter.MockFilter{}
+			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
+
+			s := NewScanner(remoteLibrary, alerter, scanOptions, testFilter)
+			got, err := s.Resources()
+			assert.Equal(tt, c.wantErr, err)
+			if err != nil {
+				return
+			}
+
+			c.assertExpected(tt, got)
+			alerter.AssertExpectations(tt)
+			fakeRepo.AssertExpectations(tt)
+		})
+	}
+}
+
+func TestCloudWatchEventTarget(t *testing.T) {
+	tests := []struct {
+		test           string
+		mocks          func(*repository.MockCloudWatchEventsRepository, *mocks.AlerterInterface)
+		assertExpected func(*testing.T, []*resource.Resource)
+		wantErr        error
+	}{
+		{
+			test: "no target",
+			mocks: func(repository *repository.MockCloudWatchEventsRepository, alerter *mocks.AlerterInterface) {
+				repository.On("ListAllRules").Return([]*cloudwatchevents.Rule{
+					{Name: awssdk.String("nightly")},
+					{Name: awssdk.String("on-push")},
+				}, nil)
+				repository.On("ListAllTargets", "nightly").Return([]*cloudwatchevents.Target{}, nil)
+				repository.On("ListAllTargets", "on-push").Return([]*cloudwatchevents.Target{}, nil)
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+		},
+		{
+			test: "multiple targets",
+			mocks: func(repository *repository.MockCloudWatchEventsRepository, alerter *mocks.AlerterInterface) {
+				repository.On("ListAllRules").Return([]*cloudwatchevents.Rule{
+					{Name: awssdk.String("nightly")},
+					{Name: awssdk.String("on-push")},
+				}, nil)
+				repository.On("ListAllTargets", "nightly").Return([]*cloudwatchevents.Target{
+					{Id: awssdk.String("cleanup"), Arn: awssdk.String("arn:aws:lambda:us-east-1:929327065333:function:cleanup")},
+					{Id: awssdk.String("alerts"), Arn: awssdk.String("arn:aws:sns:us-east-1:929327065333:alerts")},
+				}, nil)
+				repository.On("ListAllTargets", "on-push").Return([]*cloudwatchevents.Target{
+					{Id: awssdk.String("build"), Arn: awssdk.String("arn:aws:codebuild:us-east-1:929327065333:project/build")},
+				}, nil)
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 3)
+
+				assert.Equal(t, "nightly-cleanup", got[0].ResourceId())
+				assert.Equal(t, resourceaws.AwsCloudwatchEventTargetResourceType, got[0].ResourceType())
+
+				assert.Equal(t, "nightly-alerts", got[1].ResourceId())
+				assert.Equal(t, resourceaws.AwsCloudwatchEventTargetResourceType, got[1].ResourceType())
+
+				assert.Equal(t, "on-push-build", got[2].ResourceId())
+				assert.Equal(t, resourceaws.AwsCloudwatchEventTargetResourceType, got[2].ResourceType())
+
+				assert.Equal(t, "nightly", *got[0].Attributes().GetString("rule"))
+				assert.Equal(t, "cleanup", *got[0].Attributes().GetString("target_id"))
+			},
+		},
+		{
+			test: "cannot list rules",
+			mocks: func(repository *repository.MockCloudWatchEventsRepository, alerter *mocks.AlerterInterface) {
+				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
+				repository.On("ListAllRules").Return(nil, awsError)
+
+				alerter.On("SendAlert", resourceaws.AwsCloudwatchEventTargetResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsCloudwatchEventTargetResourceType, resourceaws.AwsCloudwatchEventRuleResourceType), alerts.EnumerationPhase)).Return()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+		},
+		{
+			test: "cannot list targets",
+			mocks: func(repository *repository.MockCloudWatchEventsRepository, alerter *mocks.AlerterInterface) {
+				repository.On("ListAllRules").Return([]*cloudwatchevents.Rule{
+					{Name: awssdk.String("nightly")},
+				}, nil)
+				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
+				repository.On("ListAllTargets", "nightly").Return(nil, awsError)
+
+				alerter.On("SendAlert", resourceaws.AwsCloudwatchEventTargetResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsCloudwatchEventTargetResourceType, resourceaws.AwsCloudwatchEventTargetResourceType), alerts.EnumerationPhase)).Return()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+		},
+	}
+
+	schemaRepository := testresource.InitFakeSchemaRepository("aws", "3.19.0")
+	resourceaws.InitResourcesMetadata(schemaRepository)
+	factory := terraform.NewTerraformResourceFactory(schemaRepository)
+
+	for _, c := range tests {
+		t.Run(c.test, func(tt *testing.T) {
+
+			scanOptions := ScannerOptions{}
+			remoteLibrary := common.NewRemoteLibrary()
+
+			// Initialize mocks
+			alerter := &mocks.AlerterInterface{}
+			fakeRepo := &repository.MockCloudWatchEventsRepository{}
+			c.mocks(fakeRepo, alerter)
+
+			var repo repository.CloudWatchEventsRepository = fakeRepo
+
+			remoteLibrary.AddEnumerator(aws.NewCloudWatchEventTargetEnumerator(repo, factory))
+
+			testFilter := &filter.MockFilter{}
+			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
+
+			s := NewScanner(remoteLibrary, alerter, scanOptions, testFilter)
+			got, err := s.Resources()
+			assert.Equal(tt, c.wantErr, err)
+			if err != nil {
+				return
+			}
+
+			c.assertExpected(tt, got)
+			alerter.AssertExpectations(tt)
+			fakeRepo.AssertExpectations(tt)
+		})
+	}
+}
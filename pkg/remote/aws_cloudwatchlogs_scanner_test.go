@@ -0,0 +1,194 @@
+package remote
+
+import (
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
+	"github.com/pkg/errors"
+	"github.com/snyk/driftctl/mocks"
+	"github.com/snyk/driftctl/pkg/filter"
+	"github.com/snyk/driftctl/pkg/remote/alerts"
+	"github.com/snyk/driftctl/pkg/remote/aws"
+	"github.com/snyk/driftctl/pkg/remote/aws/repository"
+	"github.com/snyk/driftctl/pkg/remote/common"
+	remoteerr "github.com/snyk/driftctl/pkg/remote/error"
+	"github.com/snyk/driftctl/pkg/resource"
+	resourceaws "github.com/snyk/driftctl/pkg/resource/aws"
+	"github.com/snyk/driftctl/pkg/terraform"
+	testresource "github.com/snyk/driftctl/test/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestCloudWatchLogGroup(t *testing.T) {
+	tests := []struct {
+		test           string
+		mocks          func(*repository.MockCloudWatchLogsRepository, *mocks.AlerterInterface)
+		assertExpected func(*testing.T, []*resource.Resource)
+		wantErr        error
+	}{
+		{
+			test: "no log group",
+			mocks: func(repository *repository.MockCloudWatchLogsRepository, alerter *mocks.AlerterInterface) {
+				repository.On("ListAllLogGroups").Return([]*cloudwatchlogs.LogGroup{}, nil)
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+		},
+		{
+			test: "multiple log groups",
+			mocks: func(repository *repository.MockCloudWatchLogsRepository, alerter *mocks.AlerterInterface) {
+				repository.On("ListAllLogGroups").Return([]*cloudwatchlogs.LogGroup{
+					{LogGroupName: awssdk.String("/app/api")},
+					{LogGroupName: awssdk.String("/aws/lambda/foo")},
+				}, nil)
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 2)
+
+				assert.Equal(t, "/app/api", got[0].ResourceId())
+				assert.Equal(t, resourceaws.AwsCloudwatchLogGroupResourceType, got[0].ResourceType())
+
+				assert.Equal(t, "/aws/lambda/foo", got[1].ResourceId())
+				assert.Equal(t, resourceaws.AwsCloudwatchLogGroupResourceType, got[1].ResourceType())
+			},
+		},
+		{
+			test: "cannot list log groups",
+			mocks: func(repository *repository.MockCloudWatchLogsRepository, alerter *mocks.AlerterInterface) {
+				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
+				repository.On("ListAllLogGroups").Return(nil, awsError)
+
+				alerter.On("SendAlert", resourceaws.AwsCloudwatchLogGroupResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsCloudwatchLogGroupResourceType, resourceaws.AwsCloudwatchLogGroupResourceType), alerts.EnumerationPhase)).Return()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+		},
+	}
+
+	schemaRepository := testresource.InitFakeSchemaRepository("aws", "3.19.0")
+	resourceaws.InitResourcesMetadata(schemaRepository)
+	factory := terraform.NewTerraformResourceFactory(schemaRepository)
+
+	for _, c := range tests {
+		t.Run(c.test, func(tt *testing.T) {
+
+			scanOptions := ScannerOptions{}
+			remoteLibrary := common.NewRemoteLibrary()
+
+			// Initialize mocks
+			alerter := &mocks.AlerterInterface{}
+			fakeRepo := &repository.MockCloudWatchLogsRepository{}
+			c.mocks(fakeRepo, alerter)
+
+			var repo repository.CloudWatchLogsRepository = fakeRepo
+
+			remoteLibrary.AddEnumerator(aws.NewCloudWatchLogGroupEnumerator(repo, factory))
+
+			testFilter := &filter.MockFilter{}
+			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
+
+			s := NewScanner(remoteLibrary, alerter, scanOptions, testFilter)
+			got, err := s.Resources()
+			assert.Equal(tt, c.wantErr, err)
+			if err != nil {
+				return
+			}
+
+			c.assertExpected(tt, got)
+			alerter.AssertExpectations(tt)
+			fakeRepo.AssertExpectations(tt)
+		})
+	}
+}
+
+func TestCloudWatchLogMetricFilter(t *testing.T) {
+	tests := []struct {
+		test           string
+		mocks          func(*repository.MockCloudWatchLogsRepository, *mocks.AlerterInterface)
+		assertExpected func(*testing.T, []*resource.Resource)
+		wantErr        error
+	}{
+		{
+			test: "no metric filter",
+			mocks: func(repository *repository.MockCloudWatchLogsRepository, alerter *mocks.AlerterInterface) {
+				repository.On("ListAllMetricFilters").Return([]*cloudwatchlogs.MetricFilter{}, nil)
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+		},
+		{
+			test: "multiple metric filters",
+			mocks: func(repository *repository.MockCloudWatchLogsRepository, alerter *mocks.AlerterInterface) {
+				repository.On("ListAllMetricFilters").Return([]*cloudwatchlogs.MetricFilter{
+					{FilterName: awssdk.String("errors"), LogGroupName: awssdk.String("/app/api")},
+					{FilterName: awssdk.String("warnings"), LogGroupName: awssdk.String("/app/worker")},
+				}, nil)
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 2)
+
+				assert.Equal(t, "errors", got[0].ResourceId())
+				assert.Equal(t, resourceaws.AwsCloudwatchLogMetricFilterResourceType, got[0].ResourceType())
+
+				assert.Equal(t, "warnings", got[1].ResourceId())
+				assert.Equal(t, resourceaws.AwsCloudwatchLogMetricFilterResourceType, got[1].ResourceType())
+
+				assert.Equal(t, "/app/api", *got[0].Attributes().GetString("log_group_name"))
+				assert.Equal(t, "/app/worker", *got[1].Attributes().GetString("log_group_name"))
+			},
+		},
+		{
+			test: "cannot list metric filters",
+			mocks: func(repository *repository.MockCloudWatchLogsRepository, alerter *mocks.AlerterInterface) {
+				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
+				repository.On("ListAllMetricFilters").Return(nil, awsError)
+
+				alerter.On("SendAlert", resourceaws.AwsCloudwatchLogMetricFilterResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsCloudwatchLogMetricFilterResourceType, resourceaws.AwsCloudwatchLogMetricFilterResourceType), alerts.EnumerationPhase)).Return()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+		},
+	}
+
+	schemaRepository := testresource.InitFakeSchemaRepository("aws", "3.19.0")
+	resourceaws.InitResourcesMetadata(schemaRepository)
+	factory := terraform.NewTerraformResourceFactory(schemaRepository)
+
+	for _, c := range tests {
+		t.Run(c.test, func(tt *testing.T) {
+
+			scanOptions := ScannerOptions{}
+			remoteLibrary := common.NewRemoteLibrary()
+
+			// Initialize mocks
+			alerter := &mocks.AlerterInterface{}
+			fakeRepo := &repository.MockCloudWatchLogsRepository{}
+			c.mocks(fakeRepo, alerter)
+
+			var repo repository.CloudWatchLogsRepository = fakeRepo
+
+			remoteLibrary.AddEnumerator(aws.NewCloudWatchLogMetricFilterEnumerator(repo, factory))
+
+			testFilter := &filter.MockFilter{}
+			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
+
+			s := NewScanner(remoteLibrary, alerter, scanOptions, testFilter)
+			got, err := s.Resources()
+			assert.Equal(tt, c.wantErr, err)
+			if err != nil {
+				return
+			}
+
+			c.assertExpected(tt, got)
+			alerter.AssertExpectations(tt)
+			fakeRepo.AssertExpectations(tt)
+		})
+	}
+}
@@ -0,0 +1,221 @@
+package remote
+
+import (
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/cognitoidentityprovider"
+	"github.com/pkg/errors"
+	"github.com/snyk/driftctl/mocks"
+	"github.com/snyk/driftctl/pkg/filter"
+	"github.com/snyk/driftctl/pkg/remote/alerts"
+	"github.com/snyk/driftctl/pkg/remote/aws"
+	"github.com/snyk/driftctl/pkg/remote/aws/repository"
+	"github.com/snyk/driftctl/pkg/remote/common"
+	remoteerr "github.com/snyk/driftctl/pkg/remote/error"
+	"github.com/snyk/driftctl/pkg/resource"
+	resourceaws "github.com/snyk/driftctl/pkg/resource/aws"
+	"github.com/snyk/driftctl/pkg/terraform"
+	testresource "github.com/snyk/driftctl/test/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestCognitoUserPool(t *testing.T) {
+	tests := []struct {
+		test           string
+		mocks          func(*repository.MockCognitoRepository, *mocks.AlerterInterface)
+		assertExpected func(*testing.T, []*resource.Resource)
+		wantErr        error
+	}{
+		{
+			test: "no user pools",
+			mocks: func(repository *repository.MockCognitoRepository, alerter *mocks.AlerterInterface) {
+				repository.On("ListAllUserPools").Return([]*cognitoidentityprovider.UserPoolDescriptionType{}, nil)
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+		},
+		{
+			test: "multiple user pools",
+			mocks: func(repository *repository.MockCognitoRepository, alerter *mocks.AlerterInterface) {
+				repository.On("ListAllUserPools").Return([]*cognitoidentityprovider.UserPoolDescriptionType{
+					{Id: awssdk.String("us-east-1_AbCdEfGhI"), Name: awssdk.String("customers")},
+					{Id: awssdk.String("us-east-1_JkLmNoPqR"), Name: awssdk.String("employees")},
+				}, nil)
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 2)
+
+				assert.Equal(t, "us-east-1_AbCdEfGhI", got[0].ResourceId())
+				assert.Equal(t, resourceaws.AwsCognitoUserPoolResourceType, got[0].ResourceType())
+
+				assert.Equal(t, "us-east-1_JkLmNoPqR", got[1].ResourceId())
+				assert.Equal(t, resourceaws.AwsCognitoUserPoolResourceType, got[1].ResourceType())
+			},
+		},
+		{
+			test: "cannot list user pools",
+			mocks: func(repository *repository.MockCognitoRepository, alerter *mocks.AlerterInterface) {
+				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
+				repository.On("ListAllUserPools").Return(nil, awsError)
+
+				alerter.On("SendAlert", resourceaws.AwsCognitoUserPoolResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsCognitoUserPoolResourceType, resourceaws.AwsCognitoUserPoolResourceType), alerts.EnumerationPhase)).Return()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+		},
+	}
+
+	schemaRepository := testresource.InitFakeSchemaRepository("aws", "3.19.0")
+	resourceaws.InitResourcesMetadata(schemaRepository)
+	factory := terraform.NewTerraformResourceFactory(schemaRepository)
+
+	for _, c := range tests {
+		t.Run(c.test, func(tt *testing.T) {
+
+			scanOptions := ScannerOptions{}
+			remoteLibrary := common.NewRemoteLibrary()
+
+			// Initialize mocks
+			alerter := &mocks.AlerterInterface{}
+			fakeRepo := &repository.MockCognitoRepository{}
+			c.mocks(fakeRepo, alerter)
+
+			var repo repository.CognitoRepository = fakeRepo
+
+			remoteLibrary.AddEnumerator(aws.NewCognitoUserPoolEnumerator(repo, factory))
+
+			testFilter := &filter.MockFilter{}
+			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
+
+			s := NewScanner(remoteLibrary, alerter, scanOptions, testFilter)
+			got, err := s.Resources()
+			assert.Equal(tt, c.wantErr, err)
+			if err != nil {
+				return
+			}
+
+			c.assertExpected(tt, got)
+			alerter.AssertExpectations(tt)
+			fakeRepo.AssertExpectations(tt)
+		})
+	}
+}
+
+func TestCognitoUserPoolClient(t *testing.T) {
+	tests := []struct {
+		test           string
+		mocks          func(*repository.MockCognitoRepository, *mocks.AlerterInterface)
+		assertExpected func(*testing.T, []*resource.Resource)
+		wantErr        error
+	}{
+		{
+			test: "no user pool clients",
+			mocks: func(repository *repository.MockCognitoRepository, alerter *mocks.AlerterInterface) {
+				repository.On("ListAllUserPools").Return([]*cognitoidentityprovider.UserPoolDescriptionType{
+					{Id: awssdk.String("us-east-1_AbCdEfGhI"), Name: awssdk.String("customers")},
+				}, nil)
+				repository.On("ListAllUserPoolClients", "us-east-1_AbCdEfGhI").Return([]*cognitoidentityprovider.UserPoolClientDescription{}, nil)
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+		},
+		{
+			test: "multiple user pool clients",
+			mocks: func(repository *repository.MockCognitoRepository, alerter *mocks.AlerterInterface) {
+				repository.On("ListAllUserPools").Return([]*cognitoidentityprovider.UserPoolDescriptionType{
+					{Id: awssdk.String("us-east-1_AbCdEfGhI"), Name: awssdk.String("customers")},
+					{Id: awssdk.String("us-east-1_JkLmNoPqR"), Name: awssdk.String("employees")},
+				}, nil)
+				repository.On("ListAllUserPoolClients", "us-east-1_AbCdEfGhI").Return([]*cognitoidentityprovider.UserPoolClientDescription{
+					{ClientId: awssdk.String("1example23456789"), ClientName: awssdk.String("web"), UserPoolId: awssdk.String("us-east-1_AbCdEfGhI")},
+					{ClientId: awssdk.String("2example23456789"), ClientName: awssdk.String("mobile"), UserPoolId: awssdk.String("us-east-1_AbCdEfGhI")},
+				}, nil)
+				repository.On("ListAllUserPoolClients", "us-east-1_JkLmNoPqR").Return([]*cognitoidentityprovider.UserPoolClientDescription{
+					{ClientId: awssdk.String("3example23456789"), ClientName: awssdk.String("intranet"), UserPoolId: awssdk.String("us-east-1_JkLmNoPqR")},
+				}, nil)
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 3)
+
+				assert.Equal(t, "1example23456789", got[0].ResourceId())
+				assert.Equal(t, resourceaws.AwsCognitoUserPoolClientResourceType, got[0].ResourceType())
+
+				assert.Equal(t, "2example23456789", got[1].ResourceId())
+				assert.Equal(t, resourceaws.AwsCognitoUserPoolClientResourceType, got[1].ResourceType())
+
+				assert.Equal(t, "3example23456789", got[2].ResourceId())
+				assert.Equal(t, resourceaws.AwsCognitoUserPoolClientResourceType, got[2].ResourceType())
+
+				assert.Equal(t, "us-east-1_JkLmNoPqR", *got[2].Attributes().GetString("user_pool_id"))
+			},
+		},
+		{
+			test: "cannot list user pools",
+			mocks: func(repository *repository.MockCognitoRepository, alerter *mocks.AlerterInterface) {
+				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
+				repository.On("ListAllUserPools").Return(nil, awsError)
+
+				alerter.On("SendAlert", resourceaws.AwsCognitoUserPoolClientResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsCognitoUserPoolClientResourceType, resourceaws.AwsCognitoUserPoolResourceType), alerts.EnumerationPhase)).Return()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+		},
+		{
+			test: "cannot list user pool clients",
+			mocks: func(repository *repository.MockCognitoRepository, alerter *mocks.AlerterInterface) {
+				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
+				repository.On("ListAllUserPools").Return([]*cognitoidentityprovider.UserPoolDescriptionType{
+					{Id: awssdk.String("us-east-1_AbCdEfGhI"), Name: awssdk.String("customers")},
+				}, nil)
+				repository.On("ListAllUserPoolClients", "us-east-1_AbCdEfGhI").Return(nil, awsError)
+
+				alerter.On("SendAlert", resourceaws.AwsCognitoUserPoolClientResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsCognitoUserPoolClientResourceType, resourceaws.AwsCognitoUserPoolClientResourceType), alerts.EnumerationPhase)).Return()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+		},
+	}
+
+	schemaRepository := testresource.InitFakeSchemaRepository("aws", "3.19.0")
+	resourceaws.InitResourcesMetadata(schemaRepository)
+	factory := terraform.NewTerraformResourceFactory(schemaRepository)
+
+	for _, c := range tests {
+		t.Run(c.test, func(tt *testing.T) {
+
+			scanOptions := ScannerOptions{}
+			remoteLibrary := common.NewRemoteLibrary()
+
+			// Initialize mocks
+			alerter := &mocks.AlerterInterface{}
+			fakeRepo := &repository.MockCognitoRepository{}
+			c.mocks(fakeRepo, alerter)
+
+			var repo repository.CognitoRepository = fakeRepo
+
+			remoteLibrary.AddEnumerator(aws.NewCognitoUserPoolClientEnumerator(repo, factory))
+
+			testFilter := &filter.MockFilter{}
+			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
+
+			s := NewScanner(remoteLibrary, alerter, scanOptions, testFilter)
+			got, err := s.Resources()
+			assert.Equal(tt, c.wantErr, err)
+			if err != nil {
+				return
+			}
+
+			c.assertExpected(tt, got)
+			alerter.AssertExpectations(tt)
+			fakeRepo.AssertExpectations(tt)
+		})
+	}
+}
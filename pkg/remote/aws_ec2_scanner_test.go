@@ -2901,3 +2901,551 @@ func TestEC2EbsEncryptionByDefault(t *testing.T) {
 		})
 	}
 }
+
+func TestEC2VpcEndpoint(t *testing.T) {
+	tests := []struct {
+		test           string
+		mocks          func(*repository.MockEC2Repository, *mocks.AlerterInterface)
+		assertExpected func(*testing.T, []*resource.Resource)
+		wantErr        error
+	}{
+		{
+			test: "no vpc endpoints",
+			mocks: func(repository *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
+				repository.On("ListAllVpcEndpoints").Return([]*ec2.VpcEndpoint{}, nil)
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+		},
+		{
+			test: "multiple vpc endpoints",
+			mocks: func(repository *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
+				repository.On("ListAllVpcEndpoints").Return([]*ec2.VpcEndpoint{
+					{VpcEndpointId: awssdk.String("vpce-0a1b2c3d4e5f6a7b8")},
+					{VpcEndpointId: awssdk.String("vpce-0b2c3d4e5f6a7b8c9")},
+				}, nil)
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 2)
+
+				assert.Equal(t, "vpce-0a1b2c3d4e5f6a7b8", got[0].ResourceId())
+				assert.Equal(t, resourceaws.AwsVpcEndpointResourceType, got[0].ResourceType())
+
+				assert.Equal(t, "vpce-0b2c3d4e5f6a7b8c9", got[1].ResourceId())
+				assert.Equal(t, resourceaws.AwsVpcEndpointResourceType, got[1].ResourceType())
+			},
+		},
+		{
+			test: "cannot list vpc endpoints",
+			mocks: func(repository *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
+				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
+				repository.On("ListAllVpcEndpoints").Return(nil, awsError)
+
+				alerter.On("SendAlert", resourceaws.AwsVpcEndpointResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsVpcEndpointResourceType, resourceaws.AwsVpcEndpointResourceType), alerts.EnumerationPhase)).Return()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+		},
+	}
+
+	schemaRepository := testresource.InitFakeSchemaRepository("aws", "3.19.0")
+	resourceaws.InitResourcesMetadata(schemaRepository)
+	factory := terraform.NewTerraformResourceFactory(schemaRepository)
+
+	for _, c := range tests {
+		t.Run(c.test, func(tt *testing.T) {
+
+			scanOptions := ScannerOptions{}
+			remoteLibrary := common.NewRemoteLibrary()
+
+			// Initialize mocks
+			alerter := &mocks.AlerterInterface{}
+			fakeRepo := &repository.MockEC2Repository{}
+			c.mocks(fakeRepo, alerter)
+
+			var repo repository.EC2Repository = fakeRepo
+
+			remoteLibrary.AddEnumerator(aws.NewEC2VpcEndpointEnumerator(repo, factory))
+
+			testFilter := &filter.MockFilter{}
+			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
+
+			s := NewScanner(remoteLibrary, alerter, scanOptions, testFilter)
+			got, err := s.Resources()
+			assert.Equal(tt, c.wantErr, err)
+			if err != nil {
+				return
+			}
+
+			c.assertExpected(tt, got)
+			alerter.AssertExpectations(tt)
+			fakeRepo.AssertExpectations(tt)
+		})
+	}
+}
+
+func TestEC2VpcPeeringConnection(t *testing.T) {
+	tests := []struct {
+		test           string
+		mocks          func(*repository.MockEC2Repository, *mocks.AlerterInterface)
+		assertExpected func(*testing.T, []*resource.Resource)
+		wantErr        error
+	}{
+		{
+			test: "no vpc peering connections",
+			mocks: func(repository *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
+				repository.On("ListAllVpcPeeringConnections").Return([]*ec2.VpcPeeringConnection{}, nil)
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+		},
+		{
+			test: "multiple vpc peering connections",
+			mocks: func(repository *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
+				repository.On("ListAllVpcPeeringConnections").Return([]*ec2.VpcPeeringConnection{
+					{VpcPeeringConnectionId: awssdk.String("pcx-0a1b2c3d4e5f6a7b8")},
+					{VpcPeeringConnectionId: awssdk.String("pcx-0b2c3d4e5f6a7b8c9")},
+				}, nil)
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 2)
+
+				assert.Equal(t, "pcx-0a1b2c3d4e5f6a7b8", got[0].ResourceId())
+				assert.Equal(t, resourceaws.AwsVpcPeeringConnectionResourceType, got[0].ResourceType())
+
+				assert.Equal(t, "pcx-0b2c3d4e5f6a7b8c9", got[1].ResourceId())
+				assert.Equal(t, resourceaws.AwsVpcPeeringConnectionResourceType, got[1].ResourceType())
+			},
+		},
+		{
+			test: "cannot list vpc peering connections",
+			mocks: func(repository *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
+				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
+				repository.On("ListAllVpcPeeringConnections").Return(nil, awsError)
+
+				alerter.On("SendAlert", resourceaws.AwsVpcPeeringConnectionResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsVpcPeeringConnectionResourceType, resourceaws.AwsVpcPeeringConnectionResourceType), alerts.EnumerationPhase)).Return()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+		},
+	}
+
+	schemaRepository := testresource.InitFakeSchemaRepository("aws", "3.19.0")
+	resourceaws.InitResourcesMetadata(schemaRepository)
+	factory := terraform.NewTerraformResourceFactory(schemaRepository)
+
+	for _, c := range tests {
+		t.Run(c.test, func(tt *testing.T) {
+
+			scanOptions := ScannerOptions{}
+			remoteLibrary := common.NewRemoteLibrary()
+
+			// Initialize mocks
+			alerter := &mocks.AlerterInterface{}
+			fakeRepo := &repository.MockEC2Repository{}
+			c.mocks(fakeRepo, alerter)
+
+			var repo repository.EC2Repository = fakeRepo
+
+			remoteLibrary.AddEnumerator(aws.NewEC2VpcPeeringConnectionEnumerator(repo, factory))
+
+			testFilter := &filter.MockFilter{}
+			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
+
+			s := NewScanner(remoteLibrary, alerter, scanOptions, testFilter)
+			got, err := s.Resources()
+			assert.Equal(tt, c.wantErr, err)
+			if err != nil {
+				return
+			}
+
+			c.assertExpected(tt, got)
+			alerter.AssertExpectations(tt)
+			fakeRepo.AssertExpectations(tt)
+		})
+	}
+}
+
+func TestEC2TransitGateway(t *testing.T) {
+	tests := []struct {
+		test           string
+		mocks          func(*repository.MockEC2Repository, *mocks.AlerterInterface)
+		assertExpected func(*testing.T, []*resource.Resource)
+		wantErr        error
+	}{
+		{
+			test: "no transit gateways",
+			mocks: func(repository *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
+				repository.On("ListAllTransitGateways").Return([]*ec2.TransitGateway{}, nil)
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+		},
+		{
+			test: "multiple transit gateways",
+			mocks: func(repository *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
+				repository.On("ListAllTransitGateways").Return([]*ec2.TransitGateway{
+					{TransitGatewayId: awssdk.String("tgw-0a1b2c3d4e5f6a7b8")},
+					{TransitGatewayId: awssdk.String("tgw-0b2c3d4e5f6a7b8c9")},
+				}, nil)
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 2)
+
+				assert.Equal(t, "tgw-0a1b2c3d4e5f6a7b8", got[0].ResourceId())
+				assert.Equal(t, resourceaws.AwsEc2TransitGatewayResourceType, got[0].ResourceType())
+
+				assert.Equal(t, "tgw-0b2c3d4e5f6a7b8c9", got[1].ResourceId())
+				assert.Equal(t, resourceaws.AwsEc2TransitGatewayResourceType, got[1].ResourceType())
+			},
+		},
+		{
+			test: "cannot list transit gateways",
+			mocks: func(repository *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
+				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
+				repository.On("ListAllTransitGateways").Return(nil, awsError)
+
+				alerter.On("SendAlert", resourceaws.AwsEc2TransitGatewayResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsEc2TransitGatewayResourceType, resourceaws.AwsEc2TransitGatewayResourceType), alerts.EnumerationPhase)).Return()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+		},
+	}
+
+	schemaRepository := testresource.InitFakeSchemaRepository("aws", "3.19.0")
+	resourceaws.InitResourcesMetadata(schemaRepository)
+	factory := terraform.NewTerraformResourceFactory(schemaRepository)
+
+	for _, c := range tests {
+		t.Run(c.test, func(tt *testing.T) {
+
+			scanOptions := ScannerOptions{}
+			remoteLibrary := common.NewRemoteLibrary()
+
+			// Initialize mocks
+			alerter := &mocks.AlerterInterface{}
+			fakeRepo := &repository.MockEC2Repository{}
+			c.mocks(fakeRepo, alerter)
+
+			var repo repository.EC2Repository = fakeRepo
+
+			remoteLibrary.AddEnumerator(aws.NewEC2TransitGatewayEnumerator(repo, factory))
+
+			testFilter := &filter.MockFilter{}
+			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
+
+			s := NewScanner(remoteLibrary, alerter, scanOptions, testFilter)
+			got, err := s.Resources()
+			assert.Equal(tt, c.wantErr, err)
+			if err != nil {
+				return
+			}
+
+			c.assertExpected(tt, got)
+			alerter.AssertExpectations(tt)
+			fakeRepo.AssertExpectations(tt)
+		})
+	}
+}
+
+func TestEC2TransitGatewayVpcAttachment(t *testing.T) {
+	tests := []struct {
+		test           string
+		mocks          func(*repository.MockEC2Repository, *mocks.AlerterInterface)
+		assertExpected func(*testing.T, []*resource.Resource)
+		wantErr        error
+	}{
+		{
+			test: "no transit gateway vpc attachments",
+			mocks: func(repository *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
+				repository.On("ListAllTransitGatewayVpcAttachments").Return([]*ec2.TransitGatewayVpcAttachment{}, nil)
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+		},
+		{
+			test: "multiple transit gateway vpc attachments",
+			mocks: func(repository *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
+				repository.On("ListAllTransitGatewayVpcAttachments").Return([]*ec2.TransitGatewayVpcAttachment{
+					{TransitGatewayAttachmentId: awssdk.String("tgw-attach-0a1b2c3d4e5f6a7b8")},
+					{TransitGatewayAttachmentId: awssdk.String("tgw-attach-0b2c3d4e5f6a7b8c9")},
+				}, nil)
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 2)
+
+				assert.Equal(t, "tgw-attach-0a1b2c3d4e5f6a7b8", got[0].ResourceId())
+				assert.Equal(t, resourceaws.AwsEc2TransitGatewayVpcAttachmentResourceType, got[0].ResourceType())
+
+				assert.Equal(t, "tgw-attach-0b2c3d4e5f6a7b8c9", got[1].ResourceId())
+				assert.Equal(t, resourceaws.AwsEc2TransitGatewayVpcAttachmentResourceType, got[1].ResourceType())
+			},
+		},
+		{
+			test: "cannot list transit gateway vpc attachments",
+			mocks: func(repository *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
+				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
+				repository.On("ListAllTransitGatewayVpcAttachments").Return(nil, awsError)
+
+				alerter.On("SendAlert", resourceaws.AwsEc2TransitGatewayVpcAttachmentResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsEc2TransitGatewayVpcAttachmentResourceType, resourceaws.AwsEc2TransitGatewayVpcAttachmentResourceType), alerts.EnumerationPhase)).Return()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+		},
+	}
+
+	schemaRepository := testresource.InitFakeSchemaRepository("aws", "3.19.0")
+	resourceaws.InitResourcesMetadata(schemaRepository)
+	factory := terraform.NewTerraformResourceFactory(schemaRepository)
+
+	for _, c := range tests {
+		t.Run(c.test, func(tt *testing.T) {
+
+			scanOptions := ScannerOptions{}
+			remoteLibrary := common.NewRemoteLibrary()
+
+			// Initialize mocks
+			alerter := &mocks.AlerterInterface{}
+			fakeRepo := &repository.MockEC2Repository{}
+			c.mocks(fakeRepo, alerter)
+
+			var repo repository.EC2Repository = fakeRepo
+
+			remoteLibrary.AddEnumerator(aws.NewEC2TransitGatewayVpcAttachmentEnumerator(repo, factory))
+
+			testFilter := &filter.MockFilter{}
+			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
+
+			s := NewScanner(remoteLibrary, alerter, scanOptions, testFilter)
+			got, err := s.Resources()
+			assert.Equal(tt, c.wantErr, err)
+			if err != nil {
+				return
+			}
+
+			c.assertExpected(tt, got)
+			alerter.AssertExpectations(tt)
+			fakeRepo.AssertExpectations(tt)
+		})
+	}
+}
+
+func TestEC2NetworkInterface(t *testing.T) {
+	tests := []struct {
+		test           string
+		mocks          func(*repository.MockEC2Repository, *mocks.AlerterInterface)
+		assertExpected func(*testing.T, []*resource.Resource)
+		wantErr        error
+	}{
+		{
+			test: "no network interfaces",
+			mocks: func(repository *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
+				repository.On("ListAllNetworkInterfaces").Return([]*ec2.NetworkInterface{}, nil)
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+		},
+		{
+			test: "multiple network interfaces",
+			mocks: func(repository *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
+				repository.On("ListAllNetworkInterfaces").Return([]*ec2.NetworkInterface{
+					{
+						NetworkInterfaceId: awssdk.String("eni-0a1b2c3d4e5f6a7b8"),
+						Description:        awssdk.String("my interface"),
+						InterfaceType:      awssdk.String("interface"),
+						RequesterManaged:   awssdk.Bool(false),
+					},
+					{
+						NetworkInterfaceId: awssdk.String("eni-0c3d4e5f6a7b8c9d0"),
+						Description:        awssdk.String("ELB app/my-alb/50dc6c495c0c9188"),
+						InterfaceType:      awssdk.String("interface"),
+					},
+				}, nil)
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 2)
+
+				assert.Equal(t, "eni-0a1b2c3d4e5f6a7b8", got[0].ResourceId())
+				assert.Equal(t, resourceaws.AwsNetworkInterfaceResourceType, got[0].ResourceType())
+
+				assert.Equal(t, "eni-0c3d4e5f6a7b8c9d0", got[1].ResourceId())
+				assert.Equal(t, resourceaws.AwsNetworkInterfaceResourceType, got[1].ResourceType())
+				assert.Equal(t, "ELB app/my-alb/50dc6c495c0c9188", *got[1].Attributes().GetString("description"))
+			},
+		},
+		{
+			test: "network interfaces owned by other services are ignored",
+			mocks: func(repository *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
+				repository.On("ListAllNetworkInterfaces").Return([]*ec2.NetworkInterface{
+					{
+						NetworkInterfaceId: awssdk.String("eni-0a1b2c3d4e5f6a7b8"),
+						Description:        awssdk.String("my interface"),
+						InterfaceType:      awssdk.String("interface"),
+						RequesterManaged:   awssdk.Bool(false),
+					},
+					{
+						NetworkInterfaceId: awssdk.String("eni-0b2c3d4e5f6a7b8c9"),
+						Description:        awssdk.String("Interface for NAT Gateway nat-0a1b2c3d4e5f6a7b8"),
+						InterfaceType:      awssdk.String("natGateway"),
+						RequesterManaged:   awssdk.Bool(true),
+					},
+					{
+						NetworkInterfaceId: awssdk.String("eni-0d4e5f6a7b8c9d0e1"),
+						Description:        awssdk.String("RDSNetworkInterface"),
+						InterfaceType:      awssdk.String("interface"),
+						RequesterManaged:   awssdk.Bool(true),
+					},
+					{
+						NetworkInterfaceId: awssdk.String("eni-0e5f6a7b8c9d0e1f2"),
+						Description:        awssdk.String("VPC Endpoint Interface vpce-0a1b2c3d4e5f6a7b8"),
+						InterfaceType:      awssdk.String("vpc_endpoint"),
+					},
+				}, nil)
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 1)
+				assert.Equal(t, "eni-0a1b2c3d4e5f6a7b8", got[0].ResourceId())
+			},
+		},
+		{
+			test: "cannot list network interfaces",
+			mocks: func(repository *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
+				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
+				repository.On("ListAllNetworkInterfaces").Return(nil, awsError)
+
+				alerter.On("SendAlert", resourceaws.AwsNetworkInterfaceResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsNetworkInterfaceResourceType, resourceaws.AwsNetworkInterfaceResourceType), alerts.EnumerationPhase)).Return()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+		},
+	}
+
+	schemaRepository := testresource.InitFakeSchemaRepository("aws", "3.19.0")
+	resourceaws.InitResourcesMetadata(schemaRepository)
+	factory := terraform.NewTerraformResourceFactory(schemaRepository)
+
+	for _, c := range tests {
+		t.Run(c.test, func(tt *testing.T) {
+
+			scanOptions := ScannerOptions{}
+			remoteLibrary := common.NewRemoteLibrary()
+
+			// Initialize mocks
+			alerter := &mocks.AlerterInterface{}
+			fakeRepo := &repository.MockEC2Repository{}
+			c.mocks(fakeRepo, alerter)
+
+			var repo repository.EC2Repository = fakeRepo
+
+			remoteLibrary.AddEnumerator(aws.NewEC2NetworkInterfaceEnumerator(repo, factory))
+
+			testFilter := &filter.MockFilter{}
+			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
+
+			s := NewScanner(remoteLibrary, alerter, scanOptions, testFilter)
+			got, err := s.Resources()
+			assert.Equal(tt, c.wantErr, err)
+			if err != nil {
+				return
+			}
+
+			c.assertExpected(tt, got)
+			alerter.AssertExpectations(tt)
+			fakeRepo.AssertExpectations(tt)
+		})
+	}
+}
+
+func TestEC2FlowLog(t *testing.T) {
+	tests := []struct {
+		test           string
+		mocks          func(*repository.MockEC2Repository, *mocks.AlerterInterface)
+		assertExpected func(*testing.T, []*resource.Resource)
+		wantErr        error
+	}{
+		{
+			test: "no flow logs",
+			mocks: func(repository *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
+				repository.On("ListAllFlowLogs").Return([]*ec2.FlowLog{}, nil)
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+		},
+		{
+			test: "multiple flow logs",
+			mocks: func(repository *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
+				repository.On("ListAllFlowLogs").Return([]*ec2.FlowLog{
+					{FlowLogId: awssdk.String("fl-0a1b2c3d4e5f6a7b8")},
+					{FlowLogId: awssdk.String("fl-0b2c3d4e5f6a7b8c9")},
+				}, nil)
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 2)
+
+				assert.Equal(t, "fl-0a1b2c3d4e5f6a7b8", got[0].ResourceId())
+				assert.Equal(t, resourceaws.AwsFlowLogResourceType, got[0].ResourceType())
+
+				assert.Equal(t, "fl-0b2c3d4e5f6a7b8c9", got[1].ResourceId())
+				assert.Equal(t, resourceaws.AwsFlowLogResourceType, got[1].ResourceType())
+			},
+		},
+		{
+			test: "cannot list flow logs",
+			mocks: func(repository *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
+				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
+				repository.On("ListAllFlowLogs").Return(nil, awsError)
+
+				alerter.On("SendAlert", resourceaws.AwsFlowLogResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsFlowLogResourceType, resourceaws.AwsFlowLogResourceType), alerts.EnumerationPhase)).Return()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+		},
+	}
+
+	schemaRepository := testresource.InitFakeSchemaRepository("aws", "3.19.0")
+	resourceaws.InitResourcesMetadata(schemaRepository)
+	factory := terraform.NewTerraformResourceFactory(schemaRepository)
+
+	for _, c := range tests {
+		t.Run(c.test, func(tt *testing.T) {
+
+			scanOptions := ScannerOptions{}
+			remoteLibrary := common.NewRemoteLibrary()
+
+			// Initialize mocks
+			alerter := &mocks.AlerterInterface{}
+			fakeRepo := &repository.MockEC2Repository{}
+			c.mocks(fakeRepo, alerter)
+
+			var repo repository.EC2Repository = fakeRepo
+
+			remoteLibrary.AddEnumerator(aws.NewEC2FlowLogEnumerator(repo, factory))
+
+			testFilter := &filter.MockFilter{}
+			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
+
+			s := NewScanner(remoteLibrary, alerter, scanOptions, testFilter)
+			got, err := s.Resources()
+			assert.Equal(tt, c.wantErr, err)
+			if err != nil {
+				return
+			}
+
+			c.assertExpected(tt, got)
+			alerter.AssertExpectations(tt)
+			fakeRepo.AssertExpectations(tt)
+		})
+	}
+}
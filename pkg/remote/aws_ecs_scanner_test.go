@@ -0,0 +1,349 @@
+package remote
+
+import (
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/pkg/errors"
+	"github.com/snyk/driftctl/mocks"
+	"github.com/snyk/driftctl/pkg/filter"
+	"github.com/snyk/driftctl/pkg/remote/alerts"
+	"github.com/snyk/driftctl/pkg/remote/aws"
+	"github.com/snyk/driftctl/pkg/remote/aws/repository"
+	"github.com/snyk/driftctl/pkg/remote/cache"
+	"github.com/snyk/driftctl/pkg/remote/common"
+	remoteerr "github.com/snyk/driftctl/pkg/remote/error"
+	"github.com/snyk/driftctl/pkg/resource"
+	resourceaws "github.com/snyk/driftctl/pkg/resource/aws"
+	"github.com/snyk/driftctl/pkg/terraform"
+	"github.com/snyk/driftctl/test"
+	"github.com/snyk/driftctl/test/goldenfile"
+	testresource "github.com/snyk/driftctl/test/resource"
+	terraform2 "github.com/snyk/driftctl/test/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestECSCluster(t *testing.T) {
+	tests := []struct {
+		test    string
+		dirName string
+		mocks   func(*repository.MockECSRepository, *mocks.AlerterInterface)
+		wantErr error
+	}{
+		{
+			test:    "no cluster",
+			dirName: "aws_ecs_cluster_empty",
+			mocks: func(repository *repository.MockECSRepository, alerter *mocks.AlerterInterface) {
+				repository.On("ListAllClusters").Return([]*ecs.Cluster{}, nil)
+			},
+		},
+		{
+			test:    "multiple clusters",
+			dirName: "aws_ecs_cluster_multiple",
+			mocks: func(repository *repository.MockECSRepository, alerter *mocks.AlerterInterface) {
+				repository.On("ListAllClusters").Return([]*ecs.Cluster{
+					{ClusterArn: awssdk.String("arn:aws:ecs:us-east-1:929327065333:cluster/foo"), ClusterName: awssdk.String("foo")},
+					{ClusterArn: awssdk.String("arn:aws:ecs:us-east-1:929327065333:cluster/bar"), ClusterName: awssdk.String("bar")},
+				}, nil)
+			},
+		},
+		{
+			test:    "cannot list clusters",
+			dirName: "aws_ecs_cluster_list",
+			mocks: func(repository *repository.MockECSRepository, alerter *mocks.AlerterInterface) {
+				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
+				repository.On("ListAllClusters").Return(nil, awsError)
+
+				alerter.On("SendAlert", resourceaws.AwsEcsClusterResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsEcsClusterResourceType, resourceaws.AwsEcsClusterResourceType), alerts.EnumerationPhase)).Return()
+			},
+		},
+	}
+
+	schemaRepository := testresource.InitFakeSchemaRepository("aws", "3.19.0")
+	resourceaws.InitResourcesMetadata(schemaRepository)
+	factory := terraform.NewTerraformResourceFactory(schemaRepository)
+	deserializer := resource.NewDeserializer(factory)
+
+	for _, c := range tests {
+		t.Run(c.test, func(tt *testing.T) {
+			shouldUpdate := c.dirName == *goldenfile.Update
+
+			sess := session.Must(session.NewSessionWithOptions(session.Options{
+				SharedConfigState: session.SharedConfigEnable,
+			}))
+
+			scanOptions := ScannerOptions{Deep: true}
+			providerLibrary := terraform.NewProviderLibrary()
+			remoteLibrary := common.NewRemoteLibrary()
+
+			// Initialize mocks
+			alerter := &mocks.AlerterInterface{}
+			fakeRepo := &repository.MockECSRepository{}
+			c.mocks(fakeRepo, alerter)
+
+			var repo repository.ECSRepository = fakeRepo
+			providerVersion := "3.19.0"
+			realProvider, err := terraform2.InitTestAwsProvider(providerLibrary, providerVersion)
+			if err != nil {
+				t.Fatal(err)
+			}
+			provider := terraform2.NewFakeTerraformProvider(realProvider)
+			provider.WithResponse(c.dirName)
+
+			// Replace mock by real resources if we are in update mode
+			if shouldUpdate {
+				err := realProvider.Init()
+				if err != nil {
+					t.Fatal(err)
+				}
+				provider.ShouldUpdate()
+				repo = repository.NewECSRepository(sess, cache.New(0))
+			}
+
+			remoteLibrary.AddEnumerator(aws.NewECSClusterEnumerator(repo, factory))
+			remoteLibrary.AddDetailsFetcher(resourceaws.AwsEcsClusterResourceType, common.NewGenericDetailsFetcher(resourceaws.AwsEcsClusterResourceType, provider, deserializer))
+
+			testFilter := &filter.MockFilter{}
+			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
+
+			s := NewScanner(remoteLibrary, alerter, scanOptions, testFilter)
+			got, err := s.Resources()
+			assert.Equal(tt, c.wantErr, err)
+			if err != nil {
+				return
+			}
+			test.TestAgainstGoldenFile(got, resourceaws.AwsEcsClusterResourceType, c.dirName, provider, deserializer, shouldUpdate, tt)
+			alerter.AssertExpectations(tt)
+			fakeRepo.AssertExpectations(tt)
+		})
+	}
+}
+
+func TestECSService(t *testing.T) {
+	tests := []struct {
+		test    string
+		dirName string
+		mocks   func(*repository.MockECSRepository, *mocks.AlerterInterface)
+		wantErr error
+	}{
+		{
+			test:    "no service",
+			dirName: "aws_ecs_service_empty",
+			mocks: func(repository *repository.MockECSRepository, alerter *mocks.AlerterInterface) {
+				repository.On("ListAllClusters").Return([]*ecs.Cluster{
+					{ClusterArn: awssdk.String("arn:aws:ecs:us-east-1:929327065333:cluster/foo"), ClusterName: awssdk.String("foo")},
+					{ClusterArn: awssdk.String("arn:aws:ecs:us-east-1:929327065333:cluster/bar"), ClusterName: awssdk.String("bar")},
+				}, nil)
+				repository.On("ListAllServices", "arn:aws:ecs:us-east-1:929327065333:cluster/foo").Return([]*string{}, nil)
+				repository.On("ListAllServices", "arn:aws:ecs:us-east-1:929327065333:cluster/bar").Return([]*string{}, nil)
+			},
+		},
+		{
+			test:    "multiple services",
+			dirName: "aws_ecs_service_multiple",
+			mocks: func(repository *repository.MockECSRepository, alerter *mocks.AlerterInterface) {
+				repository.On("ListAllClusters").Return([]*ecs.Cluster{
+					{ClusterArn: awssdk.String("arn:aws:ecs:us-east-1:929327065333:cluster/foo"), ClusterName: awssdk.String("foo")},
+					{ClusterArn: awssdk.String("arn:aws:ecs:us-east-1:929327065333:cluster/bar"), ClusterName: awssdk.String("bar")},
+				}, nil)
+				repository.On("ListAllServices", "arn:aws:ecs:us-east-1:929327065333:cluster/foo").Return([]*string{
+					awssdk.String("arn:aws:ecs:us-east-1:929327065333:service/foo/web"),
+					awssdk.String("arn:aws:ecs:us-east-1:929327065333:service/worker"),
+				}, nil)
+				repository.On("ListAllServices", "arn:aws:ecs:us-east-1:929327065333:cluster/bar").Return([]*string{
+					awssdk.String("arn:aws:ecs:us-east-1:929327065333:service/bar/web"),
+				}, nil)
+			},
+		},
+		{
+			test:    "cannot list clusters",
+			dirName: "aws_ecs_service_list_clusters",
+			mocks: func(repository *repository.MockECSRepository, alerter *mocks.AlerterInterface) {
+				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
+				repository.On("ListAllClusters").Return(nil, awsError)
+
+				alerter.On("SendAlert", resourceaws.AwsEcsServiceResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsEcsServiceResourceType, resourceaws.AwsEcsClusterResourceType), alerts.EnumerationPhase)).Return()
+			},
+		},
+		{
+			test:    "cannot list services",
+			dirName: "aws_ecs_service_list",
+			mocks: func(repository *repository.MockECSRepository, alerter *mocks.AlerterInterface) {
+				repository.On("ListAllClusters").Return([]*ecs.Cluster{
+					{ClusterArn: awssdk.String("arn:aws:ecs:us-east-1:929327065333:cluster/foo"), ClusterName: awssdk.String("foo")},
+				}, nil)
+				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
+				repository.On("ListAllServices", "arn:aws:ecs:us-east-1:929327065333:cluster/foo").Return(nil, awsError)
+
+				alerter.On("SendAlert", resourceaws.AwsEcsServiceResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsEcsServiceResourceType, resourceaws.AwsEcsServiceResourceType), alerts.EnumerationPhase)).Return()
+			},
+		},
+	}
+
+	schemaRepository := testresource.InitFakeSchemaRepository("aws", "3.19.0")
+	resourceaws.InitResourcesMetadata(schemaRepository)
+	factory := terraform.NewTerraformResourceFactory(schemaRepository)
+	deserializer := resource.NewDeserializer(factory)
+
+	for _, c := range tests {
+		t.Run(c.test, func(tt *testing.T) {
+			shouldUpdate := c.dirName == *goldenfile.Update
+
+			sess := session.Must(session.NewSessionWithOptions(session.Options{
+				SharedConfigState: session.SharedConfigEnable,
+			}))
+
+			scanOptions := ScannerOptions{Deep: true}
+			providerLibrary := terraform.NewProviderLibrary()
+			remoteLibrary := common.NewRemoteLibrary()
+
+			// Initialize mocks
+			alerter := &mocks.AlerterInterface{}
+			fakeRepo := &repository.MockECSRepository{}
+			c.mocks(fakeRepo, alerter)
+
+			var repo repository.ECSRepository = fakeRepo
+			providerVersion := "3.19.0"
+			realProvider, err := terraform2.InitTestAwsProvider(providerLibrary, providerVersion)
+			if err != nil {
+				t.Fatal(err)
+			}
+			provider := terraform2.NewFakeTerraformProvider(realProvider)
+			provider.WithResponse(c.dirName)
+
+			// Replace mock by real resources if we are in update mode
+			if shouldUpdate {
+				err := realProvider.Init()
+				if err != nil {
+					t.Fatal(err)
+				}
+				provider.ShouldUpdate()
+				repo = repository.NewECSRepository(sess, cache.New(0))
+			}
+
+			remoteLibrary.AddEnumerator(aws.NewECSServiceEnumerator(repo, factory))
+			remoteLibrary.AddDetailsFetcher(resourceaws.AwsEcsServiceResourceType, common.NewGenericDetailsFetcher(resourceaws.AwsEcsServiceResourceType, provider, deserializer))
+
+			testFilter := &filter.MockFilter{}
+			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
+
+			s := NewScanner(remoteLibrary, alerter, scanOptions, testFilter)
+			got, err := s.Resources()
+			assert.Equal(tt, c.wantErr, err)
+			if err != nil {
+				return
+			}
+			test.TestAgainstGoldenFile(got, resourceaws.AwsEcsServiceResourceType, c.dirName, provider, deserializer, shouldUpdate, tt)
+			alerter.AssertExpectations(tt)
+			fakeRepo.AssertExpectations(tt)
+		})
+	}
+}
+
+func TestECSTaskDefinition(t *testing.T) {
+	tests := []struct {
+		test    string
+		dirName string
+		mocks   func(*repository.MockECSRepository, *mocks.AlerterInterface)
+		wantErr error
+	}{
+		{
+			test:    "no task definition",
+			dirName: "aws_ecs_task_definition_empty",
+			mocks: func(repository *repository.MockECSRepository, alerter *mocks.AlerterInterface) {
+				repository.On("ListAllTaskDefinitions").Return([]*ecs.TaskDefinition{}, nil)
+			},
+		},
+		{
+			test:    "multiple task definitions",
+			dirName: "aws_ecs_task_definition_multiple",
+			mocks: func(repository *repository.MockECSRepository, alerter *mocks.AlerterInterface) {
+				repository.On("ListAllTaskDefinitions").Return([]*ecs.TaskDefinition{
+					{
+						Family:            awssdk.String("service"),
+						Revision:          awssdk.Int64(2),
+						TaskDefinitionArn: awssdk.String("arn:aws:ecs:us-east-1:929327065333:task-definition/service:2"),
+					},
+					{
+						Family:            awssdk.String("worker"),
+						Revision:          awssdk.Int64(7),
+						TaskDefinitionArn: awssdk.String("arn:aws:ecs:us-east-1:929327065333:task-definition/worker:7"),
+					},
+				}, nil)
+			},
+		},
+		{
+			test:    "cannot list task definitions",
+			dirName: "aws_ecs_task_definition_list",
+			mocks: func(repository *repository.MockECSRepository, alerter *mocks.AlerterInterface) {
+				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
+				repository.On("ListAllTaskDefinitions").Return(nil, awsError)
+
+				alerter.On("SendAlert", resourceaws.AwsEcsTaskDefinitionResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsEcsTaskDefinitionResourceType, resourceaws.AwsEcsTaskDefinitionResourceType), alerts.EnumerationPhase)).Return()
+			},
+		},
+	}
+
+	schemaRepository := testresource.InitFakeSchemaRepository("aws", "3.19.0")
+	resourceaws.InitResourcesMetadata(schemaRepository)
+	factory := terraform.NewTerraformResourceFactory(schemaRepository)
+	deserializer := resource.NewDeserializer(factory)
+
+	for _, c := range tests {
+		t.Run(c.test, func(tt *testing.T) {
+			shouldUpdate := c.dirName == *goldenfile.Update
+
+			sess := session.Must(session.NewSessionWithOptions(session.Options{
+				SharedConfigState: session.SharedConfigEnable,
+			}))
+
+			scanOptions := ScannerOptions{Deep: true}
+			providerLibrary := terraform.NewProviderLibrary()
+			remoteLibrary := common.NewRemoteLibrary()
+
+			// Initialize mocks
+			alerter := &mocks.AlerterInterface{}
+			fakeRepo := &repository.MockECSRepository{}
+			c.mocks(fakeRepo, alerter)
+
+			var repo repository.ECSRepository = fakeRepo
+			providerVersion := "3.19.0"
+			realProvider, err := terraform2.InitTestAwsProvider(providerLibrary, providerVersion)
+			if err != nil {
+				t.Fatal(err)
+			}
+			provider := terraform2.NewFakeTerraformProvider(realProvider)
+			provider.WithResponse(c.dirName)
+
+			// Replace mock by real resources if we are in update mode
+			if shouldUpdate {
+				err := realProvider.Init()
+				if err != nil {
+					t.Fatal(err)
+				}
+				provider.ShouldUpdate()
+				repo = repository.NewECSRepository(sess, cache.New(0))
+			}
+
+			remoteLibrary.AddEnumerator(aws.NewECSTaskDefinitionEnumerator(repo, factory))
+			remoteLibrary.AddDetailsFetcher(resourceaws.AwsEcsTaskDefinitionResourceType, common.NewGenericDetailsFetcher(resourceaws.AwsEcsTaskDefinitionResourceType, provider, deserializer))
+
+			testFilter := &filter.MockFilter{}
+			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
+
+			s := NewScanner(remoteLibrary, alerter, scanOptions, testFilter)
+			got, err := s.Resources()
+			assert.Equal(tt, c.wantErr, err)
+			if err != nil {
+				return
+			}
+			test.TestAgainstGoldenFile(got, resourceaws.AwsEcsTaskDefinitionResourceType, c.dirName, provider, deserializer, shouldUpdate, tt)
+			alerter.AssertExpectations(tt)
+			fakeRepo.AssertExpectations(tt)
+		})
+	}
+}
@@ -0,0 +1,243 @@
+package remote
+
+import (
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/efs"
+	"github.com/pkg/errors"
+	"github.com/snyk/driftctl/mocks"
+	"github.com/snyk/driftctl/pkg/filter"
+	"github.com/snyk/driftctl/pkg/remote/alerts"
+	"github.com/snyk/driftctl/pkg/remote/aws"
+	"github.com/snyk/driftctl/pkg/remote/aws/repository"
+	"github.com/snyk/driftctl/pkg/remote/cache"
+	"github.com/snyk/driftctl/pkg/remote/common"
+	remoteerr "github.com/snyk/driftctl/pkg/remote/error"
+	"github.com/snyk/driftctl/pkg/resource"
+	resourceaws "github.com/snyk/driftctl/pkg/resource/aws"
+	"github.com/snyk/driftctl/pkg/terraform"
+	"github.com/snyk/driftctl/test"
+	"github.com/snyk/driftctl/test/goldenfile"
+	testresource "github.com/snyk/driftctl/test/resource"
+	terraform2 "github.com/snyk/driftctl/test/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestEFSFileSystem(t *testing.T) {
+	tests := []struct {
+		test    string
+		dirName string
+		mocks   func(*repository.MockEFSRepository, *mocks.AlerterInterface)
+		wantErr error
+	}{
+		{
+			test:    "no file systems",
+			dirName: "aws_efs_file_system_empty",
+			mocks: func(repository *repository.MockEFSRepository, alerter *mocks.AlerterInterface) {
+				repository.On("ListAllFileSystems").Return([]*efs.FileSystemDescription{}, nil)
+			},
+		},
+		{
+			test:    "multiple file systems",
+			dirName: "aws_efs_file_system_multiple",
+			mocks: func(repository *repository.MockEFSRepository, alerter *mocks.AlerterInterface) {
+				repository.On("ListAllFileSystems").Return([]*efs.FileSystemDescription{
+					{FileSystemId: awssdk.String("fs-0a1b2c3d")},
+					{FileSystemId: awssdk.String("fs-1b2c3d4e")},
+				}, nil)
+			},
+		},
+		{
+			test:    "cannot list file systems",
+			dirName: "aws_efs_file_system_list",
+			mocks: func(repository *repository.MockEFSRepository, alerter *mocks.AlerterInterface) {
+				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
+				repository.On("ListAllFileSystems").Return(nil, awsError)
+
+				alerter.On("SendAlert", resourceaws.AwsEfsFileSystemResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsEfsFileSystemResourceType, resourceaws.AwsEfsFileSystemResourceType), alerts.EnumerationPhase)).Return()
+			},
+		},
+	}
+
+	schemaRepository := testresource.InitFakeSchemaRepository("aws", "3.19.0")
+	resourceaws.InitResourcesMetadata(schemaRepository)
+	factory := terraform.NewTerraformResourceFactory(schemaRepository)
+	deserializer := resource.NewDeserializer(factory)
+
+	for _, c := range tests {
+		t.Run(c.test, func(tt *testing.T) {
+			shouldUpdate := c.dirName == *goldenfile.Update
+
+			sess := session.Must(session.NewSessionWithOptions(session.Options{
+				SharedConfigState: session.SharedConfigEnable,
+			}))
+
+			scanOptions := ScannerOptions{Deep: true}
+			providerLibrary := terraform.NewProviderLibrary()
+			remoteLibrary := common.NewRemoteLibrary()
+
+			// Initialize mocks
+			alerter := &mocks.AlerterInterface{}
+			fakeRepo := &repository.MockEFSRepository{}
+			c.mocks(fakeRepo, alerter)
+
+			var repo repository.EFSRepository = fakeRepo
+			providerVersion := "3.19.0"
+			realProvider, err := terraform2.InitTestAwsProvider(providerLibrary, providerVersion)
+			if err != nil {
+				t.Fatal(err)
+			}
+			provider := terraform2.NewFakeTerraformProvider(realProvider)
+			provider.WithResponse(c.dirName)
+
+			// Replace mock by real resources if we are in update mode
+			if shouldUpdate {
+				err := realProvider.Init()
+				if err != nil {
+					t.Fatal(err)
+				}
+				provider.ShouldUpdate()
+				repo = repository.NewEFSRepository(sess, cache.New(0))
+			}
+
+			remoteLibrary.AddEnumerator(aws.NewEFSFileSystemEnumerator(repo, factory))
+			remoteLibrary.AddDetailsFetcher(resourceaws.AwsEfsFileSystemResourceType, common.NewGenericDetailsFetcher(resourceaws.AwsEfsFileSystemResourceType, provider, deserializer))
+
+			testFilter := &filter.MockFilter{}
+			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
+
+			s := NewScanner(remoteLibrary, alerter, scanOptions, testFilter)
+			got, err := s.Resources()
+			assert.Equal(tt, c.wantErr, err)
+			if err != nil {
+				return
+			}
+			test.TestAgainstGoldenFile(got, resourceaws.AwsEfsFileSystemResourceType, c.dirName, provider, deserializer, shouldUpdate, tt)
+			alerter.AssertExpectations(tt)
+			fakeRepo.AssertExpectations(tt)
+		})
+	}
+}
+
+func TestEFSMountTarget(t *testing.T) {
+	tests := []struct {
+		test    string
+		dirName string
+		mocks   func(*repository.MockEFSRepository, *mocks.AlerterInterface)
+		wantErr error
+	}{
+		{
+			test:    "no mount targets",
+			dirName: "aws_efs_mount_target_empty",
+			mocks: func(repository *repository.MockEFSRepository, alerter *mocks.AlerterInterface) {
+				repository.On("ListAllFileSystems").Return([]*efs.FileSystemDescription{
+					{FileSystemId: awssdk.String("fs-0a1b2c3d")},
+				}, nil)
+				repository.On("ListAllMountTargets", "fs-0a1b2c3d").Return([]*efs.MountTargetDescription{}, nil)
+			},
+		},
+		{
+			test:    "multiple mount targets",
+			dirName: "aws_efs_mount_target_multiple",
+			mocks: func(repository *repository.MockEFSRepository, alerter *mocks.AlerterInterface) {
+				repository.On("ListAllFileSystems").Return([]*efs.FileSystemDescription{
+					{FileSystemId: awssdk.String("fs-0a1b2c3d")},
+					{FileSystemId: awssdk.String("fs-1b2c3d4e")},
+				}, nil)
+				repository.On("ListAllMountTargets", "fs-0a1b2c3d").Return([]*efs.MountTargetDescription{
+					{MountTargetId: awssdk.String("fsmt-0a1b2c3d"), SubnetId: awssdk.String("subnet-0a1b2c3d")},
+					{MountTargetId: awssdk.String("fsmt-1b2c3d4e"), SubnetId: awssdk.String("subnet-1b2c3d4e")},
+				}, nil)
+				repository.On("ListAllMountTargets", "fs-1b2c3d4e").Return([]*efs.MountTargetDescription{
+					{MountTargetId: awssdk.String("fsmt-2c3d4e5f"), SubnetId: awssdk.String("subnet-0a1b2c3d")},
+				}, nil)
+			},
+		},
+		{
+			test:    "cannot list file systems",
+			dirName: "aws_efs_mount_target_list_file_systems",
+			mocks: func(repository *repository.MockEFSRepository, alerter *mocks.AlerterInterface) {
+				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
+				repository.On("ListAllFileSystems").Return(nil, awsError)
+
+				alerter.On("SendAlert", resourceaws.AwsEfsMountTargetResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsEfsMountTargetResourceType, resourceaws.AwsEfsFileSystemResourceType), alerts.EnumerationPhase)).Return()
+			},
+		},
+		{
+			test:    "cannot list mount targets",
+			dirName: "aws_efs_mount_target_list",
+			mocks: func(repository *repository.MockEFSRepository, alerter *mocks.AlerterInterface) {
+				repository.On("ListAllFileSystems").Return([]*efs.FileSystemDescription{
+					{FileSystemId: awssdk.String("fs-0a1b2c3d")},
+				}, nil)
+				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
+				repository.On("ListAllMountTargets", "fs-0a1b2c3d").Return(nil, awsError)
+
+				alerter.On("SendAlert", resourceaws.AwsEfsMountTargetResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsEfsMountTargetResourceType, resourceaws.AwsEfsMountTargetResourceType), alerts.EnumerationPhase)).Return()
+			},
+		},
+	}
+
+	schemaRepository := testresource.InitFakeSchemaRepository("aws", "3.19.0")
+	resourceaws.InitResourcesMetadata(schemaRepository)
+	factory := terraform.NewTerraformResourceFactory(schemaRepository)
+	deserializer := resource.NewDeserializer(factory)
+
+	for _, c := range tests {
+		t.Run(c.test, func(tt *testing.T) {
+			shouldUpdate := c.dirName == *goldenfile.Update
+
+			sess := session.Must(session.NewSessionWithOptions(session.Options{
+				SharedConfigState: session.SharedConfigEnable,
+			}))
+
+			scanOptions := ScannerOptions{Deep: true}
+			providerLibrary := terraform.NewProviderLibrary()
+			remoteLibrary := common.NewRemoteLibrary()
+
+			// Initialize mocks
+			alerter := &mocks.AlerterInterface{}
+			fakeRepo := &repository.MockEFSRepository{}
+			c.mocks(fakeRepo, alerter)
+
+			var repo repository.EFSRepository = fakeRepo
+			providerVersion := "3.19.0"
+			realProvider, err := terraform2.InitTestAwsProvider(providerLibrary, providerVersion)
+			if err != nil {
+				t.Fatal(err)
+			}
+			provider := terraform2.NewFakeTerraformProvider(realProvider)
+			provider.WithResponse(c.dirName)
+
+			// Replace mock by real resources if we are in update mode
+			if shouldUpdate {
+				err := realProvider.Init()
+				if err != nil {
+					t.Fatal(err)
+				}
+				provider.ShouldUpdate()
+				repo = repository.NewEFSRepository(sess, cache.New(0))
+			}
+
+			remoteLibrary.AddEnumerator(aws.NewEFSMountTargetEnumerator(repo, factory))
+			remoteLibrary.AddDetailsFetcher(resourceaws.AwsEfsMountTargetResourceType, common.NewGenericDetailsFetcher(resourceaws.AwsEfsMountTargetResourceType, provider, deserializer))
+
+			testFilter := &filter.MockFilter{}
+			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
+
+			s := NewScanner(remoteLibrary, alerter, scanOptions, testFilter)
+			got, err := s.Resources()
+			assert.Equal(tt, c.wantErr, err)
+			if err != nil {
+				return
+			}
+			test.TestAgainstGoldenFile(got, resourceaws.AwsEfsMountTargetResourceType, c.dirName, provider, deserializer, shouldUpdate, tt)
+			alerter.AssertExpectations(tt)
+			fakeRepo.AssertExpectations(tt)
+		})
+	}
+}
@@ -0,0 +1,404 @@
+package remote
+
+import (
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/pkg/errors"
+	"github.com/snyk/driftctl/mocks"
+	"github.com/snyk/driftctl/pkg/filter"
+	"github.com/snyk/driftctl/pkg/remote/alerts"
+	"github.com/snyk/driftctl/pkg/remote/aws"
+	"github.com/snyk/driftctl/pkg/remote/aws/repository"
+	"github.com/snyk/driftctl/pkg/remote/cache"
+	"github.com/snyk/driftctl/pkg/remote/common"
+	remoteerr "github.com/snyk/driftctl/pkg/remote/error"
+	"github.com/snyk/driftctl/pkg/resource"
+	resourceaws "github.com/snyk/driftctl/pkg/resource/aws"
+	"github.com/snyk/driftctl/pkg/terraform"
+	"github.com/snyk/driftctl/test"
+	"github.com/snyk/driftctl/test/goldenfile"
+	testresource "github.com/snyk/driftctl/test/resource"
+	terraform2 "github.com/snyk/driftctl/test/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestEKSCluster(t *testing.T) {
+	tests := []struct {
+		test    string
+		dirName string
+		mocks   func(*repository.MockEKSRepository, *mocks.AlerterInterface)
+		wantErr error
+	}{
+		{
+			test:    "no cluster",
+			dirName: "aws_eks_cluster_empty",
+			mocks: func(repository *repository.MockEKSRepository, alerter *mocks.AlerterInterface) {
+				repository.On("ListAllClusters").Return([]*string{}, nil)
+			},
+		},
+		{
+			test:    "multiple clusters",
+			dirName: "aws_eks_cluster_multiple",
+			mocks: func(repository *repository.MockEKSRepository, alerter *mocks.AlerterInterface) {
+				repository.On("ListAllClusters").Return([]*string{
+					awssdk.String("foo"),
+					awssdk.String("bar"),
+				}, nil)
+			},
+		},
+		{
+			test:    "cannot list clusters",
+			dirName: "aws_eks_cluster_list",
+			mocks: func(repository *repository.MockEKSRepository, alerter *mocks.AlerterInterface) {
+				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
+				repository.On("ListAllClusters").Return(nil, awsError)
+
+				alerter.On("SendAlert", resourceaws.AwsEksClusterResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsEksClusterResourceType, resourceaws.AwsEksClusterResourceType), alerts.EnumerationPhase)).Return()
+			},
+		},
+	}
+
+	schemaRepository := testresource.InitFakeSchemaRepository("aws", "3.19.0")
+	resourceaws.InitResourcesMetadata(schemaRepository)
+	factory := terraform.NewTerraformResourceFactory(schemaRepository)
+	deserializer := resource.NewDeserializer(factory)
+
+	for _, c := range tests {
+		t.Run(c.test, func(tt *testing.T) {
+			shouldUpdate := c.dirName == *goldenfile.Update
+
+			sess := session.Must(session.NewSessionWithOptions(session.Options{
+				SharedConfigState: session.SharedConfigEnable,
+			}))
+
+			scanOptions := ScannerOptions{Deep: true}
+			providerLibrary := terraform.NewProviderLibrary()
+			remoteLibrary := common.NewRemoteLibrary()
+
+			// Initialize mocks
+			alerter := &mocks.AlerterInterface{}
+			fakeRepo := &repository.MockEKSRepository{}
+			c.mocks(fakeRepo, alerter)
+
+			var repo repository.EKSRepository = fakeRepo
+			providerVersion := "3.19.0"
+			realProvider, err := terraform2.InitTestAwsProvider(providerLibrary, providerVersion)
+			if err != nil {
+				t.Fatal(err)
+			}
+			provider := terraform2.NewFakeTerraformProvider(realProvider)
+			provider.WithResponse(c.dirName)
+
+			// Replace mock by real resources if we are in update mode
+			if shouldUpdate {
+				err := realProvider.Init()
+				if err != nil {
+					t.Fatal(err)
+				}
+				provider.ShouldUpdate()
+				repo = repository.NewEKSRepository(sess, cache.New(0))
+			}
+
+			remoteLibrary.AddEnumerator(aws.NewEKSClusterEnumerator(repo, factory))
+			remoteLibrary.AddDetailsFetcher(resourceaws.AwsEksClusterResourceType, common.NewGenericDetailsFetcher(resourceaws.AwsEksClusterResourceType, provider, deserializer))
+
+			testFilter := &filter.MockFilter{}
+			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
+
+			s := NewScanner(remoteLibrary, alerter, scanOptions, testFilter)
+			got, err := s.Resources()
+			assert.Equal(tt, c.wantErr, err)
+			if err != nil {
+				return
+			}
+			test.TestAgainstGoldenFile(got, resourceaws.AwsEksClusterResourceType, c.dirName, provider, deserializer, shouldUpdate, tt)
+			alerter.AssertExpectations(tt)
+			fakeRepo.AssertExpectations(tt)
+		})
+	}
+}
+
+func TestEKSNodeGroup(t *testing.T) {
+	tests := []struct {
+		test    string
+		dirName string
+		mocks   func(*repository.MockEKSRepository, *mocks.AlerterInterface)
+		wantErr error
+	}{
+		{
+			test:    "no node group",
+			dirName: "aws_eks_node_group_empty",
+			mocks: func(repository *repository.MockEKSRepository, alerter *mocks.AlerterInterface) {
+				repository.On("ListAllClusters").Return([]*string{
+					awssdk.String("foo"),
+					awssdk.String("bar"),
+				}, nil)
+				repository.On("ListAllNodeGroups", "foo").Return([]*string{}, nil)
+				repository.On("ListAllNodeGroups", "bar").Return([]*string{}, nil)
+			},
+		},
+		{
+			test:    "multiple node groups",
+			dirName: "aws_eks_node_group_multiple",
+			mocks: func(repository *repository.MockEKSRepository, alerter *mocks.AlerterInterface) {
+				repository.On("ListAllClusters").Return([]*string{
+					awssdk.String("foo"),
+					awssdk.String("bar"),
+				}, nil)
+				repository.On("ListAllNodeGroups", "foo").Return([]*string{
+					awssdk.String("default"),
+					awssdk.String("spot"),
+				}, nil)
+				repository.On("ListAllNodeGroups", "bar").Return([]*string{
+					awssdk.String("default"),
+				}, nil)
+			},
+		},
+		{
+			test:    "cannot list clusters",
+			dirName: "aws_eks_node_group_list_clusters",
+			mocks: func(repository *repository.MockEKSRepository, alerter *mocks.AlerterInterface) {
+				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
+				repository.On("ListAllClusters").Return(nil, awsError)
+
+				alerter.On("SendAlert", resourceaws.AwsEksNodeGroupResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsEksNodeGroupResourceType, resourceaws.AwsEksClusterResourceType), alerts.EnumerationPhase)).Return()
+			},
+		},
+		{
+			test:    "cannot list node groups",
+			dirName: "aws_eks_node_group_list",
+			mocks: func(repository *repository.MockEKSRepository, alerter *mocks.AlerterInterface) {
+				repository.On("ListAllClusters").Return([]*string{awssdk.String("foo")}, nil)
+				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
+				repository.On("ListAllNodeGroups", "foo").Return(nil, awsError)
+
+				alerter.On("SendAlert", resourceaws.AwsEksNodeGroupResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsEksNodeGroupResourceType, resourceaws.AwsEksNodeGroupResourceType), alerts.EnumerationPhase)).Return()
+			},
+		},
+	}
+
+	schemaRepository := testresource.InitFakeSchemaRepository("aws", "3.19.0")
+	resourceaws.InitResourcesMetadata(schemaRepository)
+	factory := terraform.NewTerraformResourceFactory(schemaRepository)
+	deserializer := resource.NewDeserializer(factory)
+
+	for _, c := range tests {
+		t.Run(c.test, func(tt *testing.T) {
+			shouldUpdate := c.dirName == *goldenfile.Update
+
+			sess := session.Must(session.NewSessionWithOptions(session.Options{
+				SharedConfigState: session.SharedConfigEnable,
+			}))
+
+			scanOptions := ScannerOptions{Deep: true}
+			providerLibrary := terraform.NewProviderLibrary()
+			remoteLibrary := common.NewRemoteLibrary()
+
+			// Initialize mocks
+			alerter := &mocks.AlerterInterface{}
+			fakeRepo := &repository.MockEKSRepository{}
+			c.mocks(fakeRepo, alerter)
+
+			var repo repository.EKSRepository = fakeRepo
+			providerVersion := "3.19.0"
+			realProvider, err := terraform2.InitTestAwsProvider(providerLibrary, providerVersion)
+			if err != nil {
+				t.Fatal(err)
+			}
+			provider := terraform2.NewFakeTerraformProvider(realProvider)
+			provider.WithResponse(c.dirName)
+
+			// Replace mock by real resources if we are in update mode
+			if shouldUpdate {
+				err := realProvider.Init()
+				if err != nil {
+					t.Fatal(err)
+				}
+				provider.ShouldUpdate()
+				repo = repository.NewEKSRepository(sess, cache.New(0))
+			}
+
+			remoteLibrary.AddEnumerator(aws.NewEKSNodeGroupEnumerator(repo, factory))
+			remoteLibrary.AddDetailsFetcher(resourceaws.AwsEksNodeGroupResourceType, common.NewGenericDetailsFetcher(resourceaws.AwsEksNodeGroupResourceType, provider, deserializer))
+
+			testFilter := &filter.MockFilter{}
+			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
+
+			s := NewScanner(remoteLibrary, alerter, scanOptions, testFilter)
+			got, err := s.Resources()
+			assert.Equal(tt, c.wantErr, err)
+			if err != nil {
+				return
+			}
+			test.TestAgainstGoldenFile(got, resourceaws.AwsEksNodeGroupResourceType, c.dirName, provider, deserializer, shouldUpdate, tt)
+			alerter.AssertExpectations(tt)
+			fakeRepo.AssertExpectations(tt)
+		})
+	}
+}
+
+func TestEKSAddon(t *testing.T) {
+	tests := []struct {
+		test           string
+		mocks          func(*repository.MockEKSRepository, *mocks.AlerterInterface)
+		assertExpected func(*testing.T, []*resource.Resource)
+		wantErr        error
+	}{
+		{
+			test: "no addon",
+			mocks: func(repository *repository.MockEKSRepository, alerter *mocks.AlerterInterface) {
+				repository.On("ListAllClusters").Return([]*string{
+					awssdk.String("foo"),
+					awssdk.String("bar"),
+				}, nil)
+				repository.On("ListAllAddons", "foo").Return([]*string{}, nil)
+				repository.On("ListAllAddons", "bar").Return([]*string{}, nil)
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+		},
+		{
+			test: "multiple addons",
+			mocks: func(repository *repository.MockEKSRepository, alerter *mocks.AlerterInterface) {
+				repository.On("ListAllClusters").Return([]*string{
+					awssdk.String("foo"),
+					awssdk.String("bar"),
+				}, nil)
+				repository.On("ListAllAddons", "foo").Return([]*string{
+					awssdk.String("vpc-cni"),
+					awssdk.String("coredns"),
+				}, nil)
+				repository.On("ListAllAddons", "bar").Return([]*string{
+					awssdk.String("vpc-cni"),
+				}, nil)
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 3)
+
+				assert.Equal(t, "foo:vpc-cni", got[0].ResourceId())
+				assert.Equal(t, resourceaws.AwsEksAddonResourceType, got[0].ResourceType())
+				assert.Equal(t, "foo", *got[0].Attributes().GetString("cluster_name"))
+				assert.Equal(t, "vpc-cni", *got[0].Attributes().GetString("addon_name"))
+
+				assert.Equal(t, "foo:coredns", got[1].ResourceId())
+				assert.Equal(t, resourceaws.AwsEksAddonResourceType, got[1].ResourceType())
+
+				assert.Equal(t, "bar:vpc-cni", got[2].ResourceId())
+				assert.Equal(t, resourceaws.AwsEksAddonResourceType, got[2].ResourceType())
+			},
+		},
+		{
+			test: "cannot list clusters",
+			mocks: func(repository *repository.MockEKSRepository, alerter *mocks.AlerterInterface) {
+				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
+				repository.On("ListAllClusters").Return(nil, awsError)
+
+				alerter.On("SendAlert", resourceaws.AwsEksAddonResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsEksAddonResourceType, resourceaws.AwsEksClusterResourceType), alerts.EnumerationPhase)).Return()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+		},
+		{
+			test: "cannot list addons",
+			mocks: func(repository *repository.MockEKSRepository, alerter *mocks.AlerterInterface) {
+				repository.On("ListAllClusters").Return([]*string{awssdk.String("foo")}, nil)
+				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
+				repository.On("ListAllAddons", "foo").Return(nil, awsError)
+
+				alerter.On("SendAlert", resourceaws.AwsEksAddonResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsEksAddonResourceType, resourceaws.AwsEksAddonResourceType), alerts.EnumerationPhase)).Return()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+		},
+	}
+
+	schemaRepository := testresource.InitFakeSchemaRepository("aws", "3.19.0")
+	resourceaws.InitResourcesMetadata(schemaRepository)
+	factory := terraform.NewTerraformResourceFactory(schemaRepository)
+
+	for _, c := range tests {
+		t.Run(c.test, func(tt *testing.T) {
+
+			scanOptions := ScannerOptions{}
+			remoteLibrary := common.NewRemoteLibrary()
+
+			// Initialize mocks
+			alerter := &mocks.AlerterInterface{}
+			fakeRepo := &repository.MockEKSRepository{}
+			c.mocks(fakeRepo, alerter)
+
+			var repo repository.EKSRepository = fakeRepo
+
+			remoteLibrary.AddEnumerator(aws.NewEKSAddonEnumerator(repo, factory))
+
+			testFilter := &filter.MockFilter{}
+			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
+
+			s := NewScanner(remoteLibrary, alerter, scanOptions, testFilter)
+			got, err := s.Resources()
+			assert.Equal(tt, c.wantErr, err)
+			if err != nil {
+				return
+			}
+
+			c.assertExpected(tt, got)
+			alerter.AssertExpectations(tt)
+			fakeRepo.AssertExpectations(tt)
+		})
+	}
+}
+
+// aws_eks_addon is unknown to the 3.19.0 provider, addons are thus kept as enumerated in deep mode
+func TestEKSAddon_DeepMode(t *testing.T) {
+	schemaRepository := testresource.InitFakeSchemaRepository("aws", "3.19.0")
+	resourceaws.InitResourcesMetadata(schemaRepository)
+	factory := terraform.NewTerraformResourceFactory(schemaRepository)
+	deserializer := resource.NewDeserializer(factory)
+
+	providerLibrary := terraform.NewProviderLibrary()
+	remoteLibrary := common.NewRemoteLibrary()
+
+	alerter := &mocks.AlerterInterface{}
+	fakeRepo := &repository.MockEKSRepository{}
+	fakeRepo.On("ListAllClusters").Return([]*string{
+		awssdk.String("foo"),
+	}, nil)
+	fakeRepo.On("ListAllAddons", "foo").Return([]*string{
+		awssdk.String("vpc-cni"),
+		awssdk.String("coredns"),
+	}, nil)
+
+	realProvider, err := terraform2.InitTestAwsProvider(providerLibrary, "3.19.0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// No golden file exists for this directory, reading an addon would panic
+	provider := terraform2.NewFakeTerraformProvider(realProvider)
+	provider.WithResponse("aws_eks_addon_unknown")
+
+	remoteLibrary.AddEnumerator(aws.NewEKSAddonEnumerator(fakeRepo, factory))
+	remoteLibrary.AddDetailsFetcher(resourceaws.AwsEksAddonResourceType, common.NewGenericDetailsFetcher(resourceaws.AwsEksAddonResourceType, provider, deserializer))
+
+	testFilter := &filter.MockFilter{}
+	testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
+
+	s := NewScanner(remoteLibrary, alerter, ScannerOptions{Deep: true}, testFilter)
+	got, err := s.Resources()
+	assert.NoError(t, err)
+
+	got = resource.Sort(got)
+	assert.Len(t, got, 2)
+	assert.Equal(t, "foo:coredns", got[0].ResourceId())
+	assert.Equal(t, "coredns", *got[0].Attributes().GetString("addon_name"))
+	assert.Equal(t, "foo:vpc-cni", got[1].ResourceId())
+	assert.Equal(t, "foo", *got[1].Attributes().GetString("cluster_name"))
+	alerter.AssertExpectations(t)
+	fakeRepo.AssertExpectations(t)
+}
@@ -0,0 +1,220 @@
+package remote
+
+import (
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/elasticache"
+	"github.com/pkg/errors"
+	"github.com/snyk/driftctl/mocks"
+	"github.com/snyk/driftctl/pkg/filter"
+	"github.com/snyk/driftctl/pkg/remote/alerts"
+	"github.com/snyk/driftctl/pkg/remote/aws"
+	"github.com/snyk/driftctl/pkg/remote/aws/repository"
+	"github.com/snyk/driftctl/pkg/remote/cache"
+	"github.com/snyk/driftctl/pkg/remote/common"
+	remoteerr "github.com/snyk/driftctl/pkg/remote/error"
+	"github.com/snyk/driftctl/pkg/resource"
+	resourceaws "github.com/snyk/driftctl/pkg/resource/aws"
+	"github.com/snyk/driftctl/pkg/terraform"
+	"github.com/snyk/driftctl/test"
+	"github.com/snyk/driftctl/test/goldenfile"
+	testresource "github.com/snyk/driftctl/test/resource"
+	terraform2 "github.com/snyk/driftctl/test/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestElastiCacheCluster(t *testing.T) {
+	tests := []struct {
+		test    string
+		dirName string
+		mocks   func(*repository.MockElastiCacheRepository, *mocks.AlerterInterface)
+		wantErr error
+	}{
+		{
+			test:    "no clusters",
+			dirName: "aws_elasticache_cluster_empty",
+			mocks: func(repository *repository.MockElastiCacheRepository, alerter *mocks.AlerterInterface) {
+				repository.On("ListAllCacheClusters").Return([]*elasticache.CacheCluster{}, nil)
+			},
+		},
+		{
+			test:    "multiple clusters",
+			dirName: "aws_elasticache_cluster_multiple",
+			mocks: func(repository *repository.MockElastiCacheRepository, alerter *mocks.AlerterInterface) {
+				repository.On("ListAllCacheClusters").Return([]*elasticache.CacheCluster{
+					{CacheClusterId: awssdk.String("sessions")},
+					{CacheClusterId: awssdk.String("rate-limiter")},
+				}, nil)
+			},
+		},
+		{
+			test:    "cannot list clusters",
+			dirName: "aws_elasticache_cluster_list",
+			mocks: func(repository *repository.MockElastiCacheRepository, alerter *mocks.AlerterInterface) {
+				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
+				repository.On("ListAllCacheClusters").Return(nil, awsError)
+
+				alerter.On("SendAlert", resourceaws.AwsElasticacheClusterResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsElasticacheClusterResourceType, resourceaws.AwsElasticacheClusterResourceType), alerts.EnumerationPhase)).Return()
+			},
+		},
+	}
+
+	schemaRepository := testresource.InitFakeSchemaRepository("aws", "3.19.0")
+	resourceaws.InitResourcesMetadata(schemaRepository)
+	factory := terraform.NewTerraformResourceFactory(schemaRepository)
+	deserializer := resource.NewDeserializer(factory)
+
+	for _, c := range tests {
+		t.Run(c.test, func(tt *testing.T) {
+			shouldUpdate := c.dirName == *goldenfile.Update
+
+			sess := session.Must(session.NewSessionWithOptions(session.Options{
+				SharedConfigState: session.SharedConfigEnable,
+			}))
+
+			scanOptions := ScannerOptions{Deep: true}
+			providerLibrary := terraform.NewProviderLibrary()
+			remoteLibrary := common.NewRemoteLibrary()
+
+			// Initialize mocks
+			alerter := &mocks.AlerterInterface{}
+			fakeRepo := &repository.MockElastiCacheRepository{}
+			c.mocks(fakeRepo, alerter)
+
+			var repo repository.ElastiCacheRepository = fakeRepo
+			providerVersion := "3.19.0"
+			realProvider, err := terraform2.InitTestAwsProvider(providerLibrary, providerVersion)
+			if err != nil {
+				t.Fatal(err)
+			}
+			provider := terraform2.NewFakeTerraformProvider(realProvider)
+			provider.WithResponse(c.dirName)
+
+			// Replace mock by real resources if we are in update mode
+			if shouldUpdate {
+				err := realProvider.Init()
+				if err != nil {
+					t.Fatal(err)
+				}
+				provider.ShouldUpdate()
+				repo = repository.NewElastiCacheRepository(sess, cache.New(0))
+			}
+
+			remoteLibrary.AddEnumerator(aws.NewElastiCacheClusterEnumerator(repo, factory))
+			remoteLibrary.AddDetailsFetcher(resourceaws.AwsElasticacheClusterResourceType, common.NewGenericDetailsFetcher(resourceaws.AwsElasticacheClusterResourceType, provider, deserializer))
+
+			testFilter := &filter.MockFilter{}
+			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
+
+			s := NewScanner(remoteLibrary, alerter, scanOptions, testFilter)
+			got, err := s.Resources()
+			assert.Equal(tt, c.wantErr, err)
+			if err != nil {
+				return
+			}
+			test.TestAgainstGoldenFile(got, resourceaws.AwsElasticacheClusterResourceType, c.dirName, provider, deserializer, shouldUpdate, tt)
+			alerter.AssertExpectations(tt)
+			fakeRepo.AssertExpectations(tt)
+		})
+	}
+}
+
+func TestElastiCacheReplicationGroup(t *testing.T) {
+	tests := []struct {
+		test    string
+		dirName string
+		mocks   func(*repository.MockElastiCacheRepository, *mocks.AlerterInterface)
+		wantErr error
+	}{
+		{
+			test:    "no replication groups",
+			dirName: "aws_elasticache_replication_group_empty",
+			mocks: func(repository *repository.MockElastiCacheRepository, alerter *mocks.AlerterInterface) {
+				repository.On("ListAllReplicationGroups").Return([]*elasticache.ReplicationGroup{}, nil)
+			},
+		},
+		{
+			test:    "multiple replication groups",
+			dirName: "aws_elasticache_replication_group_multiple",
+			mocks: func(repository *repository.MockElastiCacheRepository, alerter *mocks.AlerterInterface) {
+				repository.On("ListAllReplicationGroups").Return([]*elasticache.ReplicationGroup{
+					{ReplicationGroupId: awssdk.String("sessions-cache")},
+					{ReplicationGroupId: awssdk.String("leaderboard")},
+				}, nil)
+			},
+		},
+		{
+			test:    "cannot list replication groups",
+			dirName: "aws_elasticache_replication_group_list",
+			mocks: func(repository *repository.MockElastiCacheRepository, alerter *mocks.AlerterInterface) {
+				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
+				repository.On("ListAllReplicationGroups").Return(nil, awsError)
+
+				alerter.On("SendAlert", resourceaws.AwsElasticacheReplicationGroupResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsElasticacheReplicationGroupResourceType, resourceaws.AwsElasticacheReplicationGroupResourceType), alerts.EnumerationPhase)).Return()
+			},
+		},
+	}
+
+	schemaRepository := testresource.InitFakeSchemaRepository("aws", "3.19.0")
+	resourceaws.InitResourcesMetadata(schemaRepository)
+	factory := terraform.NewTerraformResourceFactory(schemaRepository)
+	deserializer := resource.NewDeserializer(factory)
+
+	for _, c := range tests {
+		t.Run(c.test, func(tt *testing.T) {
+			shouldUpdate := c.dirName == *goldenfile.Update
+
+			sess := session.Must(session.NewSessionWithOptions(session.Options{
+				SharedConfigState: session.SharedConfigEnable,
+			}))
+
+			scanOptions := ScannerOptions{Deep: true}
+			providerLibrary := terraform.NewProviderLibrary()
+			remoteLibrary := common.NewRemoteLibrary()
+
+			// Initialize mocks
+			alerter := &mocks.AlerterInterface{}
+			fakeRepo := &repository.MockElastiCacheRepository{}
+			c.mocks(fakeRepo, alerter)
+
+			var repo repository.ElastiCacheRepository = fakeRepo
+			providerVersion := "3.19.0"
+			realProvider, err := terraform2.InitTestAwsProvider(providerLibrary, providerVersion)
+			if err != nil {
+				t.Fatal(err)
+			}
+			provider := terraform2.NewFakeTerraformProvider(realProvider)
+			provider.WithResponse(c.dirName)
+
+			// Replace mock by real resources if we are in update mode
+			if shouldUpdate {
+				err := realProvider.Init()
+				if err != nil {
+					t.Fatal(err)
+				}
+				provider.ShouldUpdate()
+				repo = repository.NewElastiCacheRepository(sess, cache.New(0))
+			}
+
+			remoteLibrary.AddEnumerator(aws.NewElastiCacheReplicationGroupEnumerator(repo, factory))
+			remoteLibrary.AddDetailsFetcher(resourceaws.AwsElasticacheReplicationGroupResourceType, common.NewGenericDetailsFetcher(resourceaws.AwsElasticacheReplicationGroupResourceType, provider, deserializer))
+
+			testFilter := &filter.MockFilter{}
+			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
+
+			s := NewScanner(remoteLibrary, alerter, scanOptions, testFilter)
+			got, err := s.Resources()
+			assert.Equal(tt, c.wantErr, err)
+			if err != nil {
+				return
+			}
+			test.TestAgainstGoldenFile(got, resourceaws.AwsElasticacheReplicationGroupResourceType, c.dirName, provider, deserializer, shouldUpdate, tt)
+			alerter.AssertExpectations(tt)
+			fakeRepo.AssertExpectations(tt)
+		})
+	}
+}
@@ -0,0 +1,124 @@
+package remote
+
+import (
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/elasticsearchservice"
+	"github.com/pkg/errors"
+	"github.com/snyk/driftctl/mocks"
+	"github.com/snyk/driftctl/pkg/filter"
+	"github.com/snyk/driftctl/pkg/remote/alerts"
+	"github.com/snyk/driftctl/pkg/remote/aws"
+	"github.com/snyk/driftctl/pkg/remote/aws/repository"
+	"github.com/snyk/driftctl/pkg/remote/cache"
+	"github.com/snyk/driftctl/pkg/remote/common"
+	remoteerr "github.com/snyk/driftctl/pkg/remote/error"
+	"github.com/snyk/driftctl/pkg/resource"
+	resourceaws "github.com/snyk/driftctl/pkg/resource/aws"
+	"github.com/snyk/driftctl/pkg/terraform"
+	"github.com/snyk/driftctl/test"
+	"github.com/snyk/driftctl/test/goldenfile"
+	testresource "github.com/snyk/driftctl/test/resource"
+	terraform2 "github.com/snyk/driftctl/test/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestElasticsearchDomain(t *testing.T) {
+	tests := []struct {
+		test    string
+		dirName string
+		mocks   func(*repository.MockElasticsearchRepository, *mocks.AlerterInterface)
+		wantErr error
+	}{
+		{
+			test:    "no domains",
+			dirName: "aws_elasticsearch_domain_empty",
+			mocks: func(repository *repository.MockElasticsearchRepository, alerter *mocks.AlerterInterface) {
+				repository.On("ListAllDomains").Return([]*elasticsearchservice.ElasticsearchDomainStatus{}, nil)
+			},
+		},
+		{
+			test:    "multiple domains",
+			dirName: "aws_elasticsearch_domain_multiple",
+			mocks: func(repository *repository.MockElasticsearchRepository, alerter *mocks.AlerterInterface) {
+				repository.On("ListAllDomains").Return([]*elasticsearchservice.ElasticsearchDomainStatus{
+					{ARN: awssdk.String("arn:aws:es:us-east-1:123456789012:domain/logs"), DomainName: awssdk.String("logs")},
+					{ARN: awssdk.String("arn:aws:es:us-east-1:123456789012:domain/search"), DomainName: awssdk.String("search")},
+				}, nil)
+			},
+		},
+		{
+			test:    "cannot list domains",
+			dirName: "aws_elasticsearch_domain_list",
+			mocks: func(repository *repository.MockElasticsearchRepository, alerter *mocks.AlerterInterface) {
+				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
+				repository.On("ListAllDomains").Return(nil, awsError)
+
+				alerter.On("SendAlert", resourceaws.AwsElasticsearchDomainResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsElasticsearchDomainResourceType, resourceaws.AwsElasticsearchDomainResourceType), alerts.EnumerationPhase)).Return()
+			},
+		},
+	}
+
+	schemaRepository := testresource.InitFakeSchemaRepository("aws", "3.19.0")
+	resourceaws.InitResourcesMetadata(schemaRepository)
+	factory := terraform.NewTerraformResourceFactory(schemaRepository)
+	deserializer := resource.NewDeserializer(factory)
+
+	for _, c := range tests {
+		t.Run(c.test, func(tt *testing.T) {
+			shouldUpdate := c.dirName == *goldenfile.Update
+
+			sess := session.Must(session.NewSessionWithOptions(session.Options{
+				SharedConfigState: session.SharedConfigEnable,
+			}))
+
+			scanOptions := ScannerOptions{Deep: true}
+			providerLibrary := terraform.NewProviderLibrary()
+			remoteLibrary := common.NewRemoteLibrary()
+
+			// Initialize mocks
+			alerter := &mocks.AlerterInterface{}
+			fakeRepo := &repository.MockElasticsearchRepository{}
+			c.mocks(fakeRepo, alerter)
+
+			var repo repository.ElasticsearchRepository = fakeRepo
+			providerVersion := "3.19.0"
+			realProvider, err := terraform2.InitTestAwsProvider(providerLibrary, providerVersion)
+			if err != nil {
+				t.Fatal(err)
+			}
+			provider := terraform2.NewFakeTerraformProvider(realProvider)
+			provider.WithResponse(c.dirName)
+
+			// Replace mock by real resources if we are in update mode
+			if shouldUpdate {
+				err := realProvider.Init()
+				if err != nil {
+					t.Fatal(err)
+				}
+				provider.ShouldUpdate()
+				repo = repository.NewElasticsearchRepository(sess, cache.New(0))
+			}
+
+			remoteLibrary.AddEnumerator(aws.NewElasticsearchDomainEnumerator(repo, factory))
+			remoteLibrary.AddDetailsFetcher(resourceaws.AwsElasticsearchDomainResourceType, common.NewGenericDetailsFetcher(resourceaws.AwsElasticsearchDomainResourceType, provider, deserializer))
+
+			testFilter := &filter.MockFilter{}
+			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
+
+			s := NewScanner(remoteLibrary, alerter, scanOptions, testFilter)
+			got, err := s.Resources()
+			assert.Equal(tt, c.wantErr, err)
+			if err != nil {
+				return
+			}
+			test.TestAgainstGoldenFile(got, resourceaws.AwsElasticsearchDomainResourceType, c.dirName, provider, deserializer, shouldUpdate, tt)
+			alerter.AssertExpectations(tt)
+			fakeRepo.AssertExpectations(tt)
+		})
+	}
+}
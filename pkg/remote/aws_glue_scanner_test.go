@@ -0,0 +1,277 @@
+package remote
+
+import (
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/glue"
+	"github.com/pkg/errors"
+	"github.com/snyk/driftctl/mocks"
+	"github.com/snyk/driftctl/pkg/filter"
+	"github.com/snyk/driftctl/pkg/remote/alerts"
+	"github.com/snyk/driftctl/pkg/remote/aws"
+	"github.com/snyk/driftctl/pkg/remote/aws/repository"
+	"github.com/snyk/driftctl/pkg/remote/common"
+	remoteerr "github.com/snyk/driftctl/pkg/remote/error"
+	"github.com/snyk/driftctl/pkg/resource"
+	resourceaws "github.com/snyk/driftctl/pkg/resource/aws"
+	"github.com/snyk/driftctl/pkg/terraform"
+	testresource "github.com/snyk/driftctl/test/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestGlueJob(t *testing.T) {
+	tests := []struct {
+		test           string
+		mocks          func(*repository.MockGlueRepository, *mocks.AlerterInterface)
+		assertExpected func(*testing.T, []*resource.Resource)
+		wantErr        error
+	}{
+		{
+			test: "no jobs",
+			mocks: func(repository *repository.MockGlueRepository, alerter *mocks.AlerterInterface) {
+				repository.On("ListAllJobs").Return([]*glue.Job{}, nil)
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+		},
+		{
+			test: "multiple jobs",
+			mocks: func(repository *repository.MockGlueRepository, alerter *mocks.AlerterInterface) {
+				repository.On("ListAllJobs").Return([]*glue.Job{
+					{Name: awssdk.String("nightly-etl")},
+					{Name: awssdk.String("hourly-aggregation")},
+				}, nil)
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 2)
+
+				assert.Equal(t, "nightly-etl", got[0].ResourceId())
+				assert.Equal(t, resourceaws.AwsGlueJobResourceType, got[0].ResourceType())
+
+				assert.Equal(t, "hourly-aggregation", got[1].ResourceId())
+				assert.Equal(t, resourceaws.AwsGlueJobResourceType, got[1].ResourceType())
+			},
+		},
+		{
+			test: "cannot list jobs",
+			mocks: func(repository *repository.MockGlueRepository, alerter *mocks.AlerterInterface) {
+				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
+				repository.On("ListAllJobs").Return(nil, awsError)
+
+				alerter.On("SendAlert", resourceaws.AwsGlueJobResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsGlueJobResourceType, resourceaws.AwsGlueJobResourceType), alerts.EnumerationPhase)).Return()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+		},
+	}
+
+	schemaRepository := testresource.InitFakeSchemaRepository("aws", "3.19.0")
+	resourceaws.InitResourcesMetadata(schemaRepository)
+	factory := terraform.NewTerraformResourceFactory(schemaRepository)
+
+	for _, c := range tests {
+		t.Run(c.test, func(tt *testing.T) {
+
+			scanOptions := ScannerOptions{}
+			remoteLibrary := common.NewRemoteLibrary()
+
+			// Initialize mocks
+			alerter := &mocks.AlerterInterface{}
+			fakeRepo := &repository.MockGlueRepository{}
+			c.mocks(fakeRepo, alerter)
+
+			var repo repository.GlueRepository = fakeRepo
+
+			remoteLibrary.AddEnumerator(aws.NewGlueJobEnumerator(repo, factory))
+
+			testFilter := &filter.MockFilter{}
+			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
+
+			s := NewScanner(remoteLibrary, alerter, scanOptions, testFilter)
+			got, err := s.Resources()
+			assert.Equal(tt, c.wantErr, err)
+			if err != nil {
+				return
+			}
+
+			c.assertExpected(tt, got)
+			alerter.AssertExpectations(tt)
+			fakeRepo.AssertExpectations(tt)
+		})
+	}
+}
+
+func TestGlueCatalogDatabase(t *testing.T) {
+	tests := []struct {
+		test           string
+		mocks          func(*repository.MockGlueRepository, *mocks.AlerterInterface)
+		assertExpected func(*testing.T, []*resource.Resource)
+		wantErr        error
+	}{
+		{
+			test: "no databases",
+			mocks: func(repository *repository.MockGlueRepository, alerter *mocks.AlerterInterface) {
+				repository.On("ListAllDatabases").Return([]*glue.Database{}, nil)
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+		},
+		{
+			test: "multiple databases",
+			mocks: func(repository *repository.MockGlueRepository, alerter *mocks.AlerterInterface) {
+				repository.On("ListAllDatabases").Return([]*glue.Database{
+					{Name: awssdk.String("sales"), CatalogId: awssdk.String("123456789012")},
+					{Name: awssdk.String("marketing"), CatalogId: awssdk.String("123456789012")},
+				}, nil)
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 2)
+
+				assert.Equal(t, "123456789012:sales", got[0].ResourceId())
+				assert.Equal(t, resourceaws.AwsGlueCatalogDatabaseResourceType, got[0].ResourceType())
+
+				assert.Equal(t, "123456789012:marketing", got[1].ResourceId())
+				assert.Equal(t, resourceaws.AwsGlueCatalogDatabaseResourceType, got[1].ResourceType())
+
+				assert.Equal(t, "marketing", *got[1].Attributes().GetString("name"))
+			},
+		},
+		{
+			test: "cannot list databases",
+			mocks: func(repository *repository.MockGlueRepository, alerter *mocks.AlerterInterface) {
+				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
+				repository.On("ListAllDatabases").Return(nil, awsError)
+
+				alerter.On("SendAlert", resourceaws.AwsGlueCatalogDatabaseResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsGlueCatalogDatabaseResourceType, resourceaws.AwsGlueCatalogDatabaseResourceType), alerts.EnumerationPhase)).Return()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+		},
+	}
+
+	schemaRepository := testresource.InitFakeSchemaRepository("aws", "3.19.0")
+	resourceaws.InitResourcesMetadata(schemaRepository)
+	factory := terraform.NewTerraformResourceFactory(schemaRepository)
+
+	for _, c := range tests {
+		t.Run(c.test, func(tt *testing.T) {
+
+			scanOptions := ScannerOptions{}
+			remoteLibrary := common.NewRemoteLibrary()
+
+			// Initialize mocks
+			alerter := &mocks.AlerterInterface{}
+			fakeRepo := &repository.MockGlueRepository{}
+			c.mocks(fakeRepo, alerter)
+
+			var repo repository.GlueRepository = fakeRepo
+
+			remoteLibrary.AddEnumerator(aws.NewGlueCatalogDatabaseEnumerator(repo, factory))
+
+			testFilter := &filter.MockFilter{}
+			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
+
+			s := NewScanner(remoteLibrary, alerter, scanOptions, testFilter)
+			got, err := s.Resources()
+			assert.Equal(tt, c.wantErr, err)
+			if err != nil {
+				return
+			}
+
+			c.assertExpected(tt, got)
+			alerter.AssertExpectations(tt)
+			fakeRepo.AssertExpectations(tt)
+		})
+	}
+}
+
+func TestGlueCrawler(t *testing.T) {
+	tests := []struct {
+		test           string
+		mocks          func(*repository.MockGlueRepository, *mocks.AlerterInterface)
+		assertExpected func(*testing.T, []*resource.Resource)
+		wantErr        error
+	}{
+		{
+			test: "no crawlers",
+			mocks: func(repository *repository.MockGlueRepository, alerter *mocks.AlerterInterface) {
+				repository.On("ListAllCrawlers").Return([]*glue.Crawler{}, nil)
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+		},
+		{
+			test: "multiple crawlers",
+			mocks: func(repository *repository.MockGlueRepository, alerter *mocks.AlerterInterface) {
+				repository.On("ListAllCrawlers").Return([]*glue.Crawler{
+					{Name: awssdk.String("sales-crawler")},
+					{Name: awssdk.String("marketing-crawler")},
+				}, nil)
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 2)
+
+				assert.Equal(t, "sales-crawler", got[0].ResourceId())
+				assert.Equal(t, resourceaws.AwsGlueCrawlerResourceType, got[0].ResourceType())
+
+				assert.Equal(t, "marketing-crawler", got[1].ResourceId())
+				assert.Equal(t, resourceaws.AwsGlueCrawlerResourceType, got[1].ResourceType())
+			},
+		},
+		{
+			test: "cannot list crawlers",
+			mocks: func(repository *repository.MockGlueRepository, alerter *mocks.AlerterInterface) {
+				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
+				repository.On("ListAllCrawlers").Return(nil, awsError)
+
+				alerter.On("SendAlert", resourceaws.AwsGlueCrawlerResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsGlueCrawlerResourceType, resourceaws.AwsGlueCrawlerResourceType), alerts.EnumerationPhase)).Return()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+		},
+	}
+
+	schemaRepository := testresource.InitFakeSchemaRepository("aws", "3.19.0")
+	resourceaws.InitResourcesMetadata(schemaRepository)
+	factory := terraform.NewTerraformResourceFactory(schemaRepository)
+
+	for _, c := range tests {
+		t.Run(c.test, func(tt *testing.T) {
+
+			scanOptions := ScannerOptions{}
+			remoteLibrary := common.NewRemoteLibrary()
+
+			// Initialize mocks
+			alerter := &mocks.AlerterInterface{}
+			fakeRepo := &repository.MockGlueRepository{}
+			c.mocks(fakeRepo, alerter)
+
+			var repo repository.GlueRepository = fakeRepo
+
+			remoteLibrary.AddEnumerator(aws.NewGlueCrawlerEnumerator(repo, factory))
+
+			testFilter := &filter.MockFilter{}
+			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
+
+			s := NewScanner(remoteLibrary, alerter, scanOptions, testFilter)
+			got, err := s.Resources()
+			assert.Equal(tt, c.wantErr, err)
+			if err != nil {
+				return
+			}
+
+			c.assertExpected(tt, got)
+			alerter.AssertExpectations(tt)
+			fakeRepo.AssertExpectations(tt)
+		})
+	}
+}
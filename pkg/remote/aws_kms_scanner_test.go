@@ -222,3 +222,118 @@ func TestKMSAlias(t *testing.T) {
 		})
 	}
 }
+
+func TestKMSGrant(t *testing.T) {
+	tests := []struct {
+		test           string
+		mocks          func(*repository.MockKMSRepository, *mocks.AlerterInterface)
+		assertExpected func(*testing.T, []*resource.Resource)
+		wantErr        error
+	}{
+		{
+			test: "no grants",
+			mocks: func(repository *repository.MockKMSRepository, alerter *mocks.AlerterInterface) {
+				repository.On("ListAllKeys").Return([]*kms.KeyListEntry{
+					{KeyId: awssdk.String("8ee21d91-c000-428c-8032-235aac55da36")},
+					{KeyId: awssdk.String("5d765f32-bfdc-4610-b6ab-f82db5d0601b")},
+				}, nil)
+				repository.On("ListAllGrants", "8ee21d91-c000-428c-8032-235aac55da36").Return([]*kms.GrantListEntry{}, nil)
+				repository.On("ListAllGrants", "5d765f32-bfdc-4610-b6ab-f82db5d0601b").Return([]*kms.GrantListEntry{}, nil)
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+		},
+		{
+			test: "multiple grants",
+			mocks: func(repository *repository.MockKMSRepository, alerter *mocks.AlerterInterface) {
+				repository.On("ListAllKeys").Return([]*kms.KeyListEntry{
+					{KeyId: awssdk.String("8ee21d91-c000-428c-8032-235aac55da36")},
+					{KeyId: awssdk.String("5d765f32-bfdc-4610-b6ab-f82db5d0601b")},
+				}, nil)
+				repository.On("ListAllGrants", "8ee21d91-c000-428c-8032-235aac55da36").Return([]*kms.GrantListEntry{
+					{GrantId: awssdk.String("0c237476b39f8bc44e45212e08498fbe3151305030726c0590dd8d3e9f3d6a60"), Name: awssdk.String("my-grant")},
+					{GrantId: awssdk.String("f6d1b9ea3b9d0a87c5bc28cc3f5b33e1a5f3cf4f0b4a25c1d2e1f0b5a3c7d9e2")},
+				}, nil)
+				repository.On("ListAllGrants", "5d765f32-bfdc-4610-b6ab-f82db5d0601b").Return([]*kms.GrantListEntry{
+					{GrantId: awssdk.String("a5e1b0ab1e45a3b1c9d0bd2f0b7c4c3e8f2d9a6b5c4d3e2f1a0b9c8d7e6f5a4b")},
+				}, nil)
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 3)
+
+				assert.Equal(t, "8ee21d91-c000-428c-8032-235aac55da36:0c237476b39f8bc44e45212e08498fbe3151305030726c0590dd8d3e9f3d6a60", got[0].ResourceId())
+				assert.Equal(t, resourceaws.AwsKmsGrantResourceType, got[0].ResourceType())
+
+				assert.Equal(t, "8ee21d91-c000-428c-8032-235aac55da36:f6d1b9ea3b9d0a87c5bc28cc3f5b33e1a5f3cf4f0b4a25c1d2e1f0b5a3c7d9e2", got[1].ResourceId())
+				assert.Equal(t, resourceaws.AwsKmsGrantResourceType, got[1].ResourceType())
+
+				assert.Equal(t, "5d765f32-bfdc-4610-b6ab-f82db5d0601b:a5e1b0ab1e45a3b1c9d0bd2f0b7c4c3e8f2d9a6b5c4d3e2f1a0b9c8d7e6f5a4b", got[2].ResourceId())
+				assert.Equal(t, resourceaws.AwsKmsGrantResourceType, got[2].ResourceType())
+				assert.Equal(t, "my-grant", *got[0].Attributes().GetString("name"))
+			},
+		},
+		{
+			test: "cannot list keys",
+			mocks: func(repository *repository.MockKMSRepository, alerter *mocks.AlerterInterface) {
+				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
+				repository.On("ListAllKeys").Return(nil, awsError)
+
+				alerter.On("SendAlert", resourceaws.AwsKmsGrantResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsKmsGrantResourceType, resourceaws.AwsKmsKeyResourceType), alerts.EnumerationPhase)).Return()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+		},
+		{
+			test: "cannot list grants",
+			mocks: func(repository *repository.MockKMSRepository, alerter *mocks.AlerterInterface) {
+				repository.On("ListAllKeys").Return([]*kms.KeyListEntry{
+					{KeyId: awssdk.String("8ee21d91-c000-428c-8032-235aac55da36")},
+				}, nil)
+				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
+				repository.On("ListAllGrants", "8ee21d91-c000-428c-8032-235aac55da36").Return(nil, awsError)
+
+				alerter.On("SendAlert", resourceaws.AwsKmsGrantResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsKmsGrantResourceType, resourceaws.AwsKmsGrantResourceType), alerts.EnumerationPhase)).Return()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+		},
+	}
+
+	schemaRepository := testresource.InitFakeSchemaRepository("aws", "3.19.0")
+	resourceaws.InitResourcesMetadata(schemaRepository)
+	factory := terraform.NewTerraformResourceFactory(schemaRepository)
+
+	for _, c := range tests {
+		t.Run(c.test, func(tt *testing.T) {
+
+			scanOptions := ScannerOptions{}
+			remoteLibrary := common.NewRemoteLibrary()
+
+			// Initialize mocks
+			alerter := &mocks.AlerterInterface{}
+			fakeRepo := &repository.MockKMSRepository{}
+			c.mocks(fakeRepo, alerter)
+
+			var repo repository.KMSRepository = fakeRepo
+
+			remoteLibrary.AddEnumerator(aws.NewKMSGrantEnumerator(repo, factory))
+
+			testFilter := &filter.MockFilter{}
+			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
+
+			s := NewScanner(remoteLibrary, alerter, scanOptions, testFilter)
+			got, err := s.Resources()
+			assert.Equal(tt, c.wantErr, err)
+			if err != nil {
+				return
+			}
+
+			c.assertExpected(tt, got)
+			alerter.AssertExpectations(tt)
+			fakeRepo.AssertExpectations(tt)
+		})
+	}
+}
@@ -975,3 +975,125 @@ func TestS3BucketAnalytic(t *testing.T) {
 		})
 	}
 }
+
+func TestS3BucketPublicAccessBlock(t *testing.T) {
+	tests := []struct {
+		test           string
+		mocks          func(*repository.MockS3Repository, *mocks.AlerterInterface)
+		assertExpected func(*testing.T, []*resource.Resource)
+		wantErr        error
+	}{
+		{
+			test: "bucket without public access block",
+			mocks: func(repository *repository.MockS3Repository, alerter *mocks.AlerterInterface) {
+				repository.On("ListAllBuckets").Return([]*s3.Bucket{
+					{Name: awssdk.String("driftctl-test-no-block")},
+				}, nil)
+				repository.On("GetBucketLocation", "driftctl-test-no-block").Return("eu-west-3", nil)
+				repository.On("GetBucketPublicAccessBlock", "driftctl-test-no-block", "eu-west-3").Return(nil, nil)
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+		},
+		{
+			test: "multiple buckets with public access block",
+			mocks: func(repository *repository.MockS3Repository, alerter *mocks.AlerterInterface) {
+				repository.On("ListAllBuckets").Return([]*s3.Bucket{
+					{Name: awssdk.String("driftctl-test-block")},
+					{Name: awssdk.String("driftctl-test-block2")},
+					{Name: awssdk.String("driftctl-test-block3")},
+				}, nil)
+				repository.On("GetBucketLocation", "driftctl-test-block").Return("eu-west-3", nil)
+				repository.On("GetBucketLocation", "driftctl-test-block2").Return("eu-west-1", nil)
+				repository.On("GetBucketLocation", "driftctl-test-block3").Return("eu-west-3", nil)
+				repository.On("GetBucketPublicAccessBlock", "driftctl-test-block", "eu-west-3").Return(&s3.PublicAccessBlockConfiguration{
+					BlockPublicAcls:       awssdk.Bool(true),
+					BlockPublicPolicy:     awssdk.Bool(true),
+					IgnorePublicAcls:      awssdk.Bool(true),
+					RestrictPublicBuckets: awssdk.Bool(true),
+				}, nil)
+				repository.On("GetBucketPublicAccessBlock", "driftctl-test-block3", "eu-west-3").Return(&s3.PublicAccessBlockConfiguration{
+					BlockPublicAcls: awssdk.Bool(true),
+				}, nil)
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 2)
+
+				assert.Equal(t, "driftctl-test-block", got[0].ResourceId())
+				assert.Equal(t, resourceaws.AwsS3BucketPublicAccessBlockResourceType, got[0].ResourceType())
+
+				assert.Equal(t, "driftctl-test-block3", got[1].ResourceId())
+				assert.Equal(t, resourceaws.AwsS3BucketPublicAccessBlockResourceType, got[1].ResourceType())
+
+				assert.Equal(t, "eu-west-3", *got[1].Attributes().GetString("region"))
+			},
+		},
+		{
+			test: "cannot get public access block",
+			mocks: func(repository *repository.MockS3Repository, alerter *mocks.AlerterInterface) {
+				awsError := awserr.NewRequestFailure(awserr.New("AccessDenied", "", errors.New("")), 403, "")
+				repository.On("ListAllBuckets").Return([]*s3.Bucket{
+					{Name: awssdk.String("driftctl-test-block")},
+				}, nil)
+				repository.On("GetBucketLocation", "driftctl-test-block").Return("eu-west-3", nil)
+				repository.On("GetBucketPublicAccessBlock", "driftctl-test-block", "eu-west-3").Return(nil, awsError)
+
+				alerter.On("SendAlert", "aws_s3_bucket_public_access_block.driftctl-test-block", alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceScanningError(awsError, resourceaws.AwsS3BucketPublicAccessBlockResourceType, "driftctl-test-block"), alerts.EnumerationPhase)).Return()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+		},
+		{
+			test: "cannot list bucket",
+			mocks: func(repository *repository.MockS3Repository, alerter *mocks.AlerterInterface) {
+				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
+				repository.On("ListAllBuckets").Return(nil, awsError)
+
+				alerter.On("SendAlert", resourceaws.AwsS3BucketPublicAccessBlockResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsS3BucketPublicAccessBlockResourceType, resourceaws.AwsS3BucketResourceType), alerts.EnumerationPhase)).Return()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+		},
+	}
+
+	schemaRepository := testresource.InitFakeSchemaRepository("aws", "3.19.0")
+	resourceaws.InitResourcesMetadata(schemaRepository)
+	factory := terraform.NewTerraformResourceFactory(schemaRepository)
+
+	for _, c := range tests {
+		t.Run(c.test, func(tt *testing.T) {
+
+			scanOptions := ScannerOptions{}
+			remoteLibrary := common.NewRemoteLibrary()
+
+			// Initialize mocks
+			alerter := &mocks.AlerterInterface{}
+			fakeRepo := &repository.MockS3Repository{}
+			c.mocks(fakeRepo, alerter)
+
+			var repo repository.S3Repository = fakeRepo
+
+			remoteLibrary.AddEnumerator(aws.NewS3BucketPublicAccessBlockEnumerator(repo, factory, tf.TerraformProviderConfig{
+				Name:         "test",
+				DefaultAlias: "eu-west-3",
+			}, alerter))
+
+			testFilter := &filter.MockFilter{}
+			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
+
+			s := NewScanner(remoteLibrary, alerter, scanOptions, testFilter)
+			got, err := s.Resources()
+			assert.Equal(tt, c.wantErr, err)
+			if err != nil {
+				return
+			}
+
+			c.assertExpected(tt, got)
+			alerter.AssertExpectations(tt)
+			fakeRepo.AssertExpectations(tt)
+		})
+	}
+}
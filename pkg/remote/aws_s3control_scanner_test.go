@@ -0,0 +1,116 @@
+package remote
+
+import (
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/s3control"
+	"github.com/pkg/errors"
+	"github.com/snyk/driftctl/mocks"
+	"github.com/snyk/driftctl/pkg/filter"
+	"github.com/snyk/driftctl/pkg/remote/alerts"
+	"github.com/snyk/driftctl/pkg/remote/aws"
+	"github.com/snyk/driftctl/pkg/remote/aws/repository"
+	"github.com/snyk/driftctl/pkg/remote/common"
+	remoteerr "github.com/snyk/driftctl/pkg/remote/error"
+	"github.com/snyk/driftctl/pkg/resource"
+	resourceaws "github.com/snyk/driftctl/pkg/resource/aws"
+	"github.com/snyk/driftctl/pkg/terraform"
+	testresource "github.com/snyk/driftctl/test/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestS3AccountPublicAccessBlock(t *testing.T) {
+	tests := []struct {
+		test           string
+		mocks          func(*repository.MockS3ControlRepository, *mocks.AlerterInterface)
+		assertExpected func(*testing.T, []*resource.Resource)
+		wantErr        error
+	}{
+		{
+			test: "account without public access block",
+			mocks: func(repository *repository.MockS3ControlRepository, alerter *mocks.AlerterInterface) {
+				repository.On("GetAccountPublicAccessBlock", "123456789012").Return(nil, nil)
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 1)
+
+				assert.Equal(t, "123456789012", got[0].ResourceId())
+				assert.Equal(t, resourceaws.AwsS3AccountPublicAccessBlockResourceType, got[0].ResourceType())
+
+				assert.Equal(t, false, *got[0].Attributes().GetBool("block_public_acls"))
+				assert.Equal(t, false, *got[0].Attributes().GetBool("restrict_public_buckets"))
+			},
+		},
+		{
+			test: "account with public access block",
+			mocks: func(repository *repository.MockS3ControlRepository, alerter *mocks.AlerterInterface) {
+				repository.On("GetAccountPublicAccessBlock", "123456789012").Return(&s3control.PublicAccessBlockConfiguration{
+					BlockPublicAcls:   awssdk.Bool(true),
+					BlockPublicPolicy: awssdk.Bool(true),
+				}, nil)
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 1)
+
+				assert.Equal(t, "123456789012", got[0].ResourceId())
+				assert.Equal(t, resourceaws.AwsS3AccountPublicAccessBlockResourceType, got[0].ResourceType())
+
+				assert.Equal(t, "123456789012", *got[0].Attributes().GetString("account_id"))
+				assert.Equal(t, true, *got[0].Attributes().GetBool("block_public_acls"))
+				assert.Equal(t, true, *got[0].Attributes().GetBool("block_public_policy"))
+				assert.Equal(t, false, *got[0].Attributes().GetBool("ignore_public_acls"))
+				assert.Equal(t, false, *got[0].Attributes().GetBool("restrict_public_buckets"))
+			},
+		},
+		{
+			test: "cannot get public access block",
+			mocks: func(repository *repository.MockS3ControlRepository, alerter *mocks.AlerterInterface) {
+				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
+				repository.On("GetAccountPublicAccessBlock", "123456789012").Return(nil, awsError)
+
+				alerter.On("SendAlert", resourceaws.AwsS3AccountPublicAccessBlockResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsS3AccountPublicAccessBlockResourceType, resourceaws.AwsS3AccountPublicAccessBlockResourceType), alerts.EnumerationPhase)).Return()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+		},
+	}
+
+	schemaRepository := testresource.InitFakeSchemaRepository("aws", "3.19.0")
+	resourceaws.InitResourcesMetadata(schemaRepository)
+	factory := terraform.NewTerraformResourceFactory(schemaRepository)
+
+	for _, c := range tests {
+		t.Run(c.test, func(tt *testing.T) {
+
+			scanOptions := ScannerOptions{}
+			remoteLibrary := common.NewRemoteLibrary()
+
+			// Initialize mocks
+			alerter := &mocks.AlerterInterface{}
+			fakeRepo := &repository.MockS3ControlRepository{}
+			c.mocks(fakeRepo, alerter)
+
+			var repo repository.S3ControlRepository = fakeRepo
+
+			remoteLibrary.AddEnumerator(aws.NewS3AccountPublicAccessBlockEnumerator(repo, factory, "123456789012"))
+
+			testFilter := &filter.MockFilter{}
+			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
+
+			s := NewScanner(remoteLibrary, alerter, scanOptions, testFilter)
+			got, err := s.Resources()
+			assert.Equal(tt, c.wantErr, err)
+			if err != nil {
+				return
+			}
+
+			c.assertExpected(tt, got)
+			alerter.AssertExpectations(tt)
+			fakeRepo.AssertExpectations(tt)
+		})
+	}
+}
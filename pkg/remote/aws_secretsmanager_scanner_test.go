@@ -0,0 +1,212 @@
+package remote
+
+import (
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/secretsmanager"
+	"github.com/pkg/errors"
+	"github.com/snyk/driftctl/mocks"
+	"github.com/snyk/driftctl/pkg/filter"
+	"github.com/snyk/driftctl/pkg/remote/alerts"
+	"github.com/snyk/driftctl/pkg/remote/aws"
+	"github.com/snyk/driftctl/pkg/remote/aws/repository"
+	"github.com/snyk/driftctl/pkg/remote/common"
+	remoteerr "github.com/snyk/driftctl/pkg/remote/error"
+	"github.com/snyk/driftctl/pkg/resource"
+	resourceaws "github.com/snyk/driftctl/pkg/resource/aws"
+	"github.com/snyk/driftctl/pkg/terraform"
+	testresource "github.com/snyk/driftctl/test/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestSecretsManagerSecret(t *testing.T) {
+	tests := []struct {
+		test           string
+		mocks          func(*repository.MockSecretsManagerRepository, *mocks.AlerterInterface)
+		assertExpected func(*testing.T, []*resource.Resource)
+		wantErr        error
+	}{
+		{
+			test: "no secret",
+			mocks: func(repository *repository.MockSecretsManagerRepository, alerter *mocks.AlerterInterface) {
+				repository.On("ListAllSecrets").Return([]*secretsmanager.SecretListEntry{}, nil)
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+		},
+		{
+			test: "multiple secrets",
+			mocks: func(repository *repository.MockSecretsManagerRepository, alerter *mocks.AlerterInterface) {
+				repository.On("ListAllSecrets").Return([]*secretsmanager.SecretListEntry{
+					{ARN: awssdk.String("arn:aws:secretsmanager:us-east-1:929327065333:secret:foo-Y6pRnY"), Name: awssdk.String("foo")},
+					{ARN: awssdk.String("arn:aws:secretsmanager:us-east-1:929327065333:secret:bar-Wh5sMv"), Name: awssdk.String("bar")},
+				}, nil)
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 2)
+
+				assert.Equal(t, "arn:aws:secretsmanager:us-east-1:929327065333:secret:foo-Y6pRnY", got[0].ResourceId())
+				assert.Equal(t, resourceaws.AwsSecretsManagerSecretResourceType, got[0].ResourceType())
+
+				assert.Equal(t, "arn:aws:secretsmanager:us-east-1:929327065333:secret:bar-Wh5sMv", got[1].ResourceId())
+				assert.Equal(t, resourceaws.AwsSecretsManagerSecretResourceType, got[1].ResourceType())
+				assert.Equal(t, "foo", *got[0].Attributes().GetString("name"))
+			},
+		},
+		{
+			test: "cannot list secrets",
+			mocks: func(repository *repository.MockSecretsManagerRepository, alerter *mocks.AlerterInterface) {
+				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
+				repository.On("ListAllSecrets").Return(nil, awsError)
+
+				alerter.On("SendAlert", resourceaws.AwsSecretsManagerSecretResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsSecretsManagerSecretResourceType, resourceaws.AwsSecretsManagerSecretResourceType), alerts.EnumerationPhase)).Return()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+		},
+	}
+
+	schemaRepository := testresource.InitFakeSchemaRepository("aws", "3.19.0")
+	resourceaws.InitResourcesMetadata(schemaRepository)
+	factory := terraform.NewTerraformResourceFactory(schemaRepository)
+
+	for _, c := range tests {
+		t.Run(c.test, func(tt *testing.T) {
+
+			scanOptions := ScannerOptions{}
+			remoteLibrary := common.NewRemoteLibrary()
+
+			// Initialize mocks
+			alerter := &mocks.AlerterInterface{}
+			fakeRepo := &repository.MockSecretsManagerRepository{}
+			c.mocks(fakeRepo, alerter)
+
+			var repo repository.SecretsManagerRepository = fakeRepo
+
+			remoteLibrary.AddEnumerator(aws.NewSecretsManagerSecretEnumerator(repo, factory))
+
+			testFilter := &filter.MockFilter{}
+			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
+
+			s := NewScanner(remoteLibrary, alerter, scanOptions, testFilter)
+			got, err := s.Resources()
+			assert.Equal(tt, c.wantErr, err)
+			if err != nil {
+				return
+			}
+
+			c.assertExpected(tt, got)
+			alerter.AssertExpectations(tt)
+			fakeRepo.AssertExpectations(tt)
+		})
+	}
+}
+
+func TestSecretsManagerSecretPolicy(t *testing.T) {
+	tests := []struct {
+		test           string
+		mocks          func(*repository.MockSecretsManagerRepository, *mocks.AlerterInterface)
+		assertExpected func(*testing.T, []*resource.Resource)
+		wantErr        error
+	}{
+		{
+			test: "no secret policy",
+			mocks: func(repository *repository.MockSecretsManagerRepository, alerter *mocks.AlerterInterface) {
+				repository.On("ListAllSecrets").Return([]*secretsmanager.SecretListEntry{
+					{ARN: awssdk.String("arn:aws:secretsmanager:us-east-1:929327065333:secret:foo-Y6pRnY"), Name: awssdk.String("foo")},
+					{ARN: awssdk.String("arn:aws:secretsmanager:us-east-1:929327065333:secret:bar-Wh5sMv"), Name: awssdk.String("bar")},
+				}, nil)
+				repository.On("GetSecretPolicy", "arn:aws:secretsmanager:us-east-1:929327065333:secret:foo-Y6pRnY").Return("", nil)
+				repository.On("GetSecretPolicy", "arn:aws:secretsmanager:us-east-1:929327065333:secret:bar-Wh5sMv").Return("", nil)
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+		},
+		{
+			test: "one secret with a policy",
+			mocks: func(repository *repository.MockSecretsManagerRepository, alerter *mocks.AlerterInterface) {
+				repository.On("ListAllSecrets").Return([]*secretsmanager.SecretListEntry{
+					{ARN: awssdk.String("arn:aws:secretsmanager:us-east-1:929327065333:secret:foo-Y6pRnY"), Name: awssdk.String("foo")},
+					{ARN: awssdk.String("arn:aws:secretsmanager:us-east-1:929327065333:secret:bar-Wh5sMv"), Name: awssdk.String("bar")},
+				}, nil)
+				repository.On("GetSecretPolicy", "arn:aws:secretsmanager:us-east-1:929327065333:secret:foo-Y6pRnY").Return("{\"Version\":\"2012-10-17\"}", nil)
+				repository.On("GetSecretPolicy", "arn:aws:secretsmanager:us-east-1:929327065333:secret:bar-Wh5sMv").Return("", nil)
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 1)
+
+				assert.Equal(t, "arn:aws:secretsmanager:us-east-1:929327065333:secret:foo-Y6pRnY", got[0].ResourceId())
+				assert.Equal(t, resourceaws.AwsSecretsManagerSecretPolicyResourceType, got[0].ResourceType())
+				assert.Equal(t, "arn:aws:secretsmanager:us-east-1:929327065333:secret:foo-Y6pRnY", *got[0].Attributes().GetString("secret_arn"))
+			},
+		},
+		{
+			test: "cannot list secrets",
+			mocks: func(repository *repository.MockSecretsManagerRepository, alerter *mocks.AlerterInterface) {
+				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
+				repository.On("ListAllSecrets").Return(nil, awsError)
+
+				alerter.On("SendAlert", resourceaws.AwsSecretsManagerSecretPolicyResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsSecretsManagerSecretPolicyResourceType, resourceaws.AwsSecretsManagerSecretResourceType), alerts.EnumerationPhase)).Return()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+		},
+		{
+			test: "cannot get secret policy",
+			mocks: func(repository *repository.MockSecretsManagerRepository, alerter *mocks.AlerterInterface) {
+				repository.On("ListAllSecrets").Return([]*secretsmanager.SecretListEntry{
+					{ARN: awssdk.String("arn:aws:secretsmanager:us-east-1:929327065333:secret:foo-Y6pRnY"), Name: awssdk.String("foo")},
+				}, nil)
+				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
+				repository.On("GetSecretPolicy", "arn:aws:secretsmanager:us-east-1:929327065333:secret:foo-Y6pRnY").Return("", awsError)
+
+				alerter.On("SendAlert", resourceaws.AwsSecretsManagerSecretPolicyResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsSecretsManagerSecretPolicyResourceType, resourceaws.AwsSecretsManagerSecretPolicyResourceType), alerts.EnumerationPhase)).Return()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+		},
+	}
+
+	schemaRepository := testresource.InitFakeSchemaRepository("aws", "3.19.0")
+	resourceaws.InitResourcesMetadata(schemaRepository)
+	factory := terraform.NewTerraformResourceFactory(schemaRepository)
+
+	for _, c := range tests {
+		t.Run(c.test, func(tt *testing.T) {
+
+			scanOptions := ScannerOptions{}
+			remoteLibrary := common.NewRemoteLibrary()
+
+			// Initialize mocks
+			alerter := &mocks.AlerterInterface{}
+			fakeRepo := &repository.MockSecretsManagerRepository{}
+			c.mocks(fakeRepo, alerter)
+
+			var repo repository.SecretsManagerRepository = fakeRepo
+
+			remoteLibrary.AddEnumerator(aws.NewSecretsManagerSecretPolicyEnumerator(repo, factory))
+
+			testFilter := &filter.MockFilter{}
+			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
+
+			s := NewScanner(remoteLibrary, alerter, scanOptions, testFilter)
+			got, err := s.Resources()
+			assert.Equal(tt, c.wantErr, err)
+			if err != nil {
+				return
+			}
+
+			c.assertExpected(tt, got)
+			alerter.AssertExpectations(tt)
+			fakeRepo.AssertExpectations(tt)
+		})
+	}
+}
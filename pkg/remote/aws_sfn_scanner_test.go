@@ -0,0 +1,109 @@
+package remote
+
+import (
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/sfn"
+	"github.com/pkg/errors"
+	"github.com/snyk/driftctl/mocks"
+	"github.com/snyk/driftctl/pkg/filter"
+	"github.com/snyk/driftctl/pkg/remote/alerts"
+	"github.com/snyk/driftctl/pkg/remote/aws"
+	"github.com/snyk/driftctl/pkg/remote/aws/repository"
+	"github.com/snyk/driftctl/pkg/remote/common"
+	remoteerr "github.com/snyk/driftctl/pkg/remote/error"
+	"github.com/snyk/driftctl/pkg/resource"
+	resourceaws "github.com/snyk/driftctl/pkg/resource/aws"
+	"github.com/snyk/driftctl/pkg/terraform"
+	testresource "github.com/snyk/driftctl/test/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestSFNStateMachine(t *testing.T) {
+	tests := []struct {
+		test           string
+		mocks          func(*repository.MockSFNRepository, *mocks.AlerterInterface)
+		assertExpected func(*testing.T, []*resource.Resource)
+		wantErr        error
+	}{
+		{
+			test: "no state machines",
+			mocks: func(repository *repository.MockSFNRepository, alerter *mocks.AlerterInterface) {
+				repository.On("ListAllStateMachines").Return([]*sfn.StateMachineListItem{}, nil)
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+		},
+		{
+			test: "multiple state machines",
+			mocks: func(repository *repository.MockSFNRepository, alerter *mocks.AlerterInterface) {
+				repository.On("ListAllStateMachines").Return([]*sfn.StateMachineListItem{
+					{StateMachineArn: awssdk.String("arn:aws:states:us-east-1:123456789012:stateMachine:order-processing"), Name: awssdk.String("order-processing")},
+					{StateMachineArn: awssdk.String("arn:aws:states:us-east-1:123456789012:stateMachine:invoice-generation"), Name: awssdk.String("invoice-generation")},
+				}, nil)
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 2)
+
+				assert.Equal(t, "arn:aws:states:us-east-1:123456789012:stateMachine:order-processing", got[0].ResourceId())
+				assert.Equal(t, resourceaws.AwsSfnStateMachineResourceType, got[0].ResourceType())
+
+				assert.Equal(t, "arn:aws:states:us-east-1:123456789012:stateMachine:invoice-generation", got[1].ResourceId())
+				assert.Equal(t, resourceaws.AwsSfnStateMachineResourceType, got[1].ResourceType())
+
+				assert.Equal(t, "invoice-generation", *got[1].Attributes().GetString("name"))
+			},
+		},
+		{
+			test: "cannot list state machines",
+			mocks: func(repository *repository.MockSFNRepository, alerter *mocks.AlerterInterface) {
+				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
+				repository.On("ListAllStateMachines").Return(nil, awsError)
+
+				alerter.On("SendAlert", resourceaws.AwsSfnStateMachineResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsSfnStateMachineResourceType, resourceaws.AwsSfnStateMachineResourceType), alerts.EnumerationPhase)).Return()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+		},
+	}
+
+	schemaRepository := testresource.InitFakeSchemaRepository("aws", "3.19.0")
+	resourceaws.InitResourcesMetadata(schemaRepository)
+	factory := terraform.NewTerraformResourceFactory(schemaRepository)
+
+	for _, c := range tests {
+		t.Run(c.test, func(tt *testing.T) {
+
+			scanOptions := ScannerOptions{}
+			remoteLibrary := common.NewRemoteLibrary()
+
+			// Initialize mocks
+			alerter := &mocks.AlerterInterface{}
+			fakeRepo := &repository.MockSFNRepository{}
+			c.mocks(fakeRepo, alerter)
+
+			var repo repository.SFNRepository = fakeRepo
+
+			remoteLibrary.AddEnumerator(aws.NewSFNStateMachineEnumerator(repo, factory))
+
+			testFilter := &filter.MockFilter{}
+			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
+
+			s := NewScanner(remoteLibrary, alerter, scanOptions, testFilter)
+			got, err := s.Resources()
+			assert.Equal(tt, c.wantErr, err)
+			if err != nil {
+				return
+			}
+
+			c.assertExpected(tt, got)
+			alerter.AssertExpectations(tt)
+			fakeRepo.AssertExpectations(tt)
+		})
+	}
+}
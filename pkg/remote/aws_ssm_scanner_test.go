@@ -0,0 +1,126 @@
+package remote
+
+import (
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/pkg/errors"
+	"github.com/snyk/driftctl/mocks"
+	"github.com/snyk/driftctl/pkg/filter"
+	"github.com/snyk/driftctl/pkg/remote/alerts"
+	"github.com/snyk/driftctl/pkg/remote/aws"
+	"github.com/snyk/driftctl/pkg/remote/aws/repository"
+	"github.com/snyk/driftctl/pkg/remote/common"
+	remoteerr "github.com/snyk/driftctl/pkg/remote/error"
+	"github.com/snyk/driftctl/pkg/resource"
+	resourceaws "github.com/snyk/driftctl/pkg/resource/aws"
+	"github.com/snyk/driftctl/pkg/terraform"
+	testresource "github.com/snyk/driftctl/test/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestSSMParameter(t *testing.T) {
+	tests := []struct {
+		test           string
+		mocks          func(*repository.MockSSMRepository, *mocks.AlerterInterface)
+		assertExpected func(*testing.T, []*resource.Resource)
+		wantErr        error
+	}{
+		{
+			test: "no parameter",
+			mocks: func(repository *repository.MockSSMRepository, alerter *mocks.AlerterInterface) {
+				repository.On("ListAllParameters").Return([]*ssm.ParameterMetadata{}, nil)
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+		},
+		{
+			test: "multiple parameters",
+			mocks: func(repository *repository.MockSSMRepository, alerter *mocks.AlerterInterface) {
+				repository.On("ListAllParameters").Return([]*ssm.ParameterMetadata{
+					{
+						Name:     awssdk.String("/app/foo"),
+						Type:     awssdk.String(ssm.ParameterTypeString),
+						Tier:     awssdk.String(ssm.ParameterTierStandard),
+						DataType: awssdk.String("text"),
+						Version:  awssdk.Int64(1),
+					},
+					{
+						Name:     awssdk.String("/app/bar"),
+						Type:     awssdk.String(ssm.ParameterTypeSecureString),
+						KeyId:    awssdk.String("alias/aws/ssm"),
+						Tier:     awssdk.String(ssm.ParameterTierStandard),
+						DataType: awssdk.String("text"),
+						Version:  awssdk.Int64(3),
+					},
+				}, nil)
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 2)
+
+				assert.Equal(t, "/app/foo", got[0].ResourceId())
+				assert.Equal(t, resourceaws.AwsSsmParameterResourceType, got[0].ResourceType())
+
+				assert.Equal(t, "/app/bar", got[1].ResourceId())
+				assert.Equal(t, resourceaws.AwsSsmParameterResourceType, got[1].ResourceType())
+
+				assert.Equal(t, "SecureString", *got[1].Attributes().GetString("type"))
+				assert.Equal(t, "alias/aws/ssm", *got[1].Attributes().GetString("key_id"))
+				assert.Equal(t, float64(3), *got[1].Attributes().GetFloat64("version"))
+				_, exist := got[1].Attributes().Get("value")
+				assert.False(t, exist)
+			},
+		},
+		{
+			test: "cannot list parameters",
+			mocks: func(repository *repository.MockSSMRepository, alerter *mocks.AlerterInterface) {
+				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
+				repository.On("ListAllParameters").Return(nil, awsError)
+
+				alerter.On("SendAlert", resourceaws.AwsSsmParameterResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsSsmParameterResourceType, resourceaws.AwsSsmParameterResourceType), alerts.EnumerationPhase)).Return()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+		},
+	}
+
+	schemaRepository := testresource.InitFakeSchemaRepository("aws", "3.19.0")
+	resourceaws.InitResourcesMetadata(schemaRepository)
+	factory := terraform.NewTerraformResourceFactory(schemaRepository)
+
+	for _, c := range tests {
+		t.Run(c.test, func(tt *testing.T) {
+
+			scanOptions := ScannerOptions{}
+			remoteLibrary := common.NewRemoteLibrary()
+
+			// Initialize mocks
+			alerter := &mocks.AlerterInterface{}
+			fakeRepo := &repository.MockSSMRepository{}
+			c.mocks(fakeRepo, alerter)
+
+			var repo repository.SSMRepository = fakeRepo
+
+			remoteLibrary.AddEnumerator(aws.NewSSMParameterEnumerator(repo, factory))
+
+			testFilter := &filter.MockFilter{}
+			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
+
+			s := NewScanner(remoteLibrary, alerter, scanOptions, testFilter)
+			got, err := s.Resources()
+			assert.Equal(tt, c.wantErr, err)
+			if err != nil {
+				return
+			}
+
+			c.assertExpected(tt, got)
+			alerter.AssertExpectations(tt)
+			fakeRepo.AssertExpectations(tt)
+		})
+	}
+}
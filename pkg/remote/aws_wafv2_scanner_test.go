@@ -0,0 +1,122 @@
+package remote
+
+import (
+	"sort"
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/wafv2"
+	"github.com/pkg/errors"
+	"github.com/snyk/driftctl/mocks"
+	"github.com/snyk/driftctl/pkg/filter"
+	"github.com/snyk/driftctl/pkg/remote/alerts"
+	"github.com/snyk/driftctl/pkg/remote/aws"
+	"github.com/snyk/driftctl/pkg/remote/aws/repository"
+	"github.com/snyk/driftctl/pkg/remote/common"
+	remoteerr "github.com/snyk/driftctl/pkg/remote/error"
+	"github.com/snyk/driftctl/pkg/resource"
+	resourceaws "github.com/snyk/driftctl/pkg/resource/aws"
+	"github.com/snyk/driftctl/pkg/terraform"
+	testresource "github.com/snyk/driftctl/test/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestWAFV2WebACL(t *testing.T) {
+	tests := []struct {
+		test           string
+		mocks          func(*repository.MockWAFV2Repository, *mocks.AlerterInterface)
+		assertExpected func(*testing.T, []*resource.Resource)
+		wantErr        error
+	}{
+		{
+			test: "no web acls",
+			mocks: func(repository *repository.MockWAFV2Repository, alerter *mocks.AlerterInterface) {
+				repository.On("ListAllWebACLs", "REGIONAL").Return([]*wafv2.WebACLSummary{}, nil)
+				repository.On("ListAllWebACLs", "CLOUDFRONT").Return([]*wafv2.WebACLSummary{}, nil)
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+		},
+		{
+			test: "regional and cloudfront web acls",
+			mocks: func(repository *repository.MockWAFV2Repository, alerter *mocks.AlerterInterface) {
+				repository.On("ListAllWebACLs", "REGIONAL").Return([]*wafv2.WebACLSummary{
+					{Id: awssdk.String("a1b2c3d4-5678-90ab-cdef-EXAMPLE11111"), Name: awssdk.String("alb-acl")},
+				}, nil)
+				repository.On("ListAllWebACLs", "CLOUDFRONT").Return([]*wafv2.WebACLSummary{
+					{Id: awssdk.String("a1b2c3d4-5678-90ab-cdef-EXAMPLE22222"), Name: awssdk.String("cdn-acl")},
+				}, nil)
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 2)
+				sort.Slice(got, func(i, j int) bool {
+					return got[i].ResourceId() < got[j].ResourceId()
+				})
+
+				assert.Equal(t, "a1b2c3d4-5678-90ab-cdef-EXAMPLE11111", got[0].ResourceId())
+				assert.Equal(t, resourceaws.AwsWafv2WebAclResourceType, got[0].ResourceType())
+				assert.Equal(t, "REGIONAL", *got[0].Attributes().GetString("scope"))
+				assert.Equal(t, "eu-west-1", got[0].Region)
+
+				// CloudFront web ACLs are listed from us-east-1 even when it is not a scanned region
+				assert.Equal(t, "a1b2c3d4-5678-90ab-cdef-EXAMPLE22222", got[1].ResourceId())
+				assert.Equal(t, resourceaws.AwsWafv2WebAclResourceType, got[1].ResourceType())
+				assert.Equal(t, "cdn-acl", *got[1].Attributes().GetString("name"))
+				assert.Equal(t, "CLOUDFRONT", *got[1].Attributes().GetString("scope"))
+				assert.Equal(t, "us-east-1", got[1].Region)
+			},
+		},
+		{
+			test: "cannot list web acls",
+			mocks: func(repository *repository.MockWAFV2Repository, alerter *mocks.AlerterInterface) {
+				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
+				repository.On("ListAllWebACLs", "REGIONAL").Return(nil, awsError)
+				repository.On("ListAllWebACLs", "CLOUDFRONT").Return([]*wafv2.WebACLSummary{}, nil)
+
+				alerter.On("SendAlert", resourceaws.AwsWafv2WebAclResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsWafv2WebAclResourceType, resourceaws.AwsWafv2WebAclResourceType), alerts.EnumerationPhase)).Return()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+		},
+	}
+
+	schemaRepository := testresource.InitFakeSchemaRepository("aws", "3.19.0")
+	resourceaws.InitResourcesMetadata(schemaRepository)
+	factory := terraform.NewTerraformResourceFactory(schemaRepository)
+
+	for _, c := range tests {
+		t.Run(c.test, func(tt *testing.T) {
+
+			scanOptions := ScannerOptions{}
+			remoteLibrary := common.NewRemoteLibrary()
+
+			// Initialize mocks
+			alerter := &mocks.AlerterInterface{}
+			fakeRepo := &repository.MockWAFV2Repository{}
+			c.mocks(fakeRepo, alerter)
+
+			var repo repository.WAFV2Repository = fakeRepo
+
+			common.NewScopedLibrary(remoteLibrary, "", "eu-west-1").AddEnumerator(aws.NewWAFV2WebACLEnumerator(repo, factory, wafv2.ScopeRegional))
+			common.NewScopedLibrary(remoteLibrary, "", "us-east-1").AddEnumerator(aws.NewWAFV2WebACLEnumerator(repo, factory, wafv2.ScopeCloudfront))
+
+			testFilter := &filter.MockFilter{}
+			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
+
+			s := NewScanner(remoteLibrary, alerter, scanOptions, testFilter)
+			got, err := s.Resources()
+			assert.Equal(tt, c.wantErr, err)
+			if err != nil {
+				return
+			}
+
+			c.assertExpected(tt, got)
+			alerter.AssertExpectations(tt)
+			fakeRepo.AssertExpectations(tt)
+		})
+	}
+}
@@ -21,7 +21,8 @@ func Init(
 	progress output.Progress,
 	resourceSchemaRepository *resource.SchemaRepository,
 	factory resource.ResourceFactory,
-	configDir string) error {
+	configDir string,
+	opts common.RemoteOptions) error {
 
 	provider, err := NewAzureTerraformProvider(version, progress, configDir)
 	if err != nil {
@@ -39,57 +40,70 @@ func Init(
 	}
 	clientOptions := &arm.ClientOptions{}
 
-	c := cache.New(100)
-
-	storageAccountRepo := repository.NewStorageRepository(cred, clientOptions, providerConfig, c)
-	networkRepo := repository.NewNetworkRepository(cred, clientOptions, providerConfig, c)
-	resourcesRepo := repository.NewResourcesRepository(cred, clientOptions, providerConfig, c)
-	containerRegistryRepo := repository.NewContainerRegistryRepository(cred, clientOptions, providerConfig, c)
-	postgresqlRepo := repository.NewPostgresqlRepository(cred, clientOptions, providerConfig, c)
-	privateDNSRepo := repository.NewPrivateDNSRepository(cred, clientOptions, providerConfig, c)
-	computeRepo := repository.NewComputeRepository(cred, clientOptions, providerConfig, c)
-
 	providerLibrary.AddProvider(terraform.AZURE, provider)
 	deserializer := resource.NewDeserializer(factory)
 
-	remoteLibrary.AddEnumerator(NewAzurermStorageAccountEnumerator(storageAccountRepo, factory))
-	remoteLibrary.AddEnumerator(NewAzurermStorageContainerEnumerator(storageAccountRepo, factory))
-	remoteLibrary.AddEnumerator(NewAzurermVirtualNetworkEnumerator(networkRepo, factory))
-	remoteLibrary.AddEnumerator(NewAzurermRouteTableEnumerator(networkRepo, factory))
-	remoteLibrary.AddEnumerator(NewAzurermRouteEnumerator(networkRepo, factory))
-	remoteLibrary.AddEnumerator(NewAzurermResourceGroupEnumerator(resourcesRepo, factory))
-	remoteLibrary.AddEnumerator(NewAzurermSubnetEnumerator(networkRepo, factory))
-	remoteLibrary.AddEnumerator(NewAzurermContainerRegistryEnumerator(containerRegistryRepo, factory))
-	remoteLibrary.AddEnumerator(NewAzurermFirewallsEnumerator(networkRepo, factory))
-	remoteLibrary.AddEnumerator(NewAzurermPostgresqlServerEnumerator(postgresqlRepo, factory))
-	remoteLibrary.AddEnumerator(NewAzurermPublicIPEnumerator(networkRepo, factory))
-	remoteLibrary.AddEnumerator(NewAzurermPostgresqlDatabaseEnumerator(postgresqlRepo, factory))
-	remoteLibrary.AddEnumerator(NewAzurermNetworkSecurityGroupEnumerator(networkRepo, factory))
-	remoteLibrary.AddDetailsFetcher(azurerm.AzureNetworkSecurityGroupResourceType, common.NewGenericDetailsFetcher(azurerm.AzureNetworkSecurityGroupResourceType, provider, deserializer))
-	remoteLibrary.AddEnumerator(NewAzurermLoadBalancerEnumerator(networkRepo, factory))
-	remoteLibrary.AddEnumerator(NewAzurermLoadBalancerRuleEnumerator(networkRepo, factory))
-	remoteLibrary.AddDetailsFetcher(azurerm.AzureLoadBalancerRuleResourceType, common.NewGenericDetailsFetcher(azurerm.AzureLoadBalancerRuleResourceType, provider, deserializer))
+	subscriptions, err := listSubscriptions(repository.NewSubscriptionsRepository(cred, clientOptions, cache.New(2)), opts)
+	if err != nil {
+		return err
+	}
 
-	remoteLibrary.AddEnumerator(NewAzurermPrivateDNSZoneEnumerator(privateDNSRepo, factory))
-	remoteLibrary.AddDetailsFetcher(azurerm.AzurePrivateDNSZoneResourceType, common.NewGenericDetailsFetcher(azurerm.AzurePrivateDNSZoneResourceType, provider, deserializer))
-	remoteLibrary.AddEnumerator(NewAzurermPrivateDNSARecordEnumerator(privateDNSRepo, factory))
-	remoteLibrary.AddDetailsFetcher(azurerm.AzurePrivateDNSARecordResourceType, common.NewGenericDetailsFetcher(azurerm.AzurePrivateDNSARecordResourceType, provider, deserializer))
-	remoteLibrary.AddEnumerator(NewAzurermPrivateDNSAAAARecordEnumerator(privateDNSRepo, factory))
-	remoteLibrary.AddDetailsFetcher(azurerm.AzurePrivateDNSAAAARecordResourceType, common.NewGenericDetailsFetcher(azurerm.AzurePrivateDNSAAAARecordResourceType, provider, deserializer))
-	remoteLibrary.AddEnumerator(NewAzurermPrivateDNSMXRecordEnumerator(privateDNSRepo, factory))
-	remoteLibrary.AddDetailsFetcher(azurerm.AzurePrivateDNSMXRecordResourceType, common.NewGenericDetailsFetcher(azurerm.AzurePrivateDNSMXRecordResourceType, provider, deserializer))
-	remoteLibrary.AddEnumerator(NewAzurermPrivateDNSCNameRecordEnumerator(privateDNSRepo, factory))
-	remoteLibrary.AddDetailsFetcher(azurerm.AzurePrivateDNSCNameRecordResourceType, common.NewGenericDetailsFetcher(azurerm.AzurePrivateDNSCNameRecordResourceType, provider, deserializer))
-	remoteLibrary.AddEnumerator(NewAzurermPrivateDNSPTRRecordEnumerator(privateDNSRepo, factory))
-	remoteLibrary.AddDetailsFetcher(azurerm.AzurePrivateDNSPTRRecordResourceType, common.NewGenericDetailsFetcher(azurerm.AzurePrivateDNSPTRRecordResourceType, provider, deserializer))
-	remoteLibrary.AddEnumerator(NewAzurermPrivateDNSSRVRecordEnumerator(privateDNSRepo, factory))
-	remoteLibrary.AddDetailsFetcher(azurerm.AzurePrivateDNSSRVRecordResourceType, common.NewGenericDetailsFetcher(azurerm.AzurePrivateDNSSRVRecordResourceType, provider, deserializer))
-	remoteLibrary.AddEnumerator(NewAzurermPrivateDNSTXTRecordEnumerator(privateDNSRepo, factory))
-	remoteLibrary.AddDetailsFetcher(azurerm.AzurePrivateDNSTXTRecordResourceType, common.NewGenericDetailsFetcher(azurerm.AzurePrivateDNSTXTRecordResourceType, provider, deserializer))
+	for _, subscription := range subscriptions {
+		subscriptionConfig := providerConfig
+		if subscription != "" {
+			subscriptionConfig.SubscriptionID = subscription
+		}
+		// Each subscription gets its own repositories and cache as resources are cached without their subscription
+		c := cache.New(100)
+		subscriptionLibrary := common.NewScopedLibrary(remoteLibrary, subscription, "")
 
-	remoteLibrary.AddEnumerator(NewAzurermImageEnumerator(computeRepo, factory))
-	remoteLibrary.AddEnumerator(NewAzurermSSHPublicKeyEnumerator(computeRepo, factory))
-	remoteLibrary.AddDetailsFetcher(azurerm.AzureSSHPublicKeyResourceType, common.NewGenericDetailsFetcher(azurerm.AzureSSHPublicKeyResourceType, provider, deserializer))
+		storageAccountRepo := repository.NewStorageRepository(cred, clientOptions, subscriptionConfig, c)
+		networkRepo := repository.NewNetworkRepository(cred, clientOptions, subscriptionConfig, c)
+		resourcesRepo := repository.NewResourcesRepository(cred, clientOptions, subscriptionConfig, c)
+		containerRegistryRepo := repository.NewContainerRegistryRepository(cred, clientOptions, subscriptionConfig, c)
+		postgresqlRepo := repository.NewPostgresqlRepository(cred, clientOptions, subscriptionConfig, c)
+		privateDNSRepo := repository.NewPrivateDNSRepository(cred, clientOptions, subscriptionConfig, c)
+		computeRepo := repository.NewComputeRepository(cred, clientOptions, subscriptionConfig, c)
+
+		subscriptionLibrary.AddEnumerator(NewAzurermStorageAccountEnumerator(storageAccountRepo, factory))
+		subscriptionLibrary.AddEnumerator(NewAzurermStorageContainerEnumerator(storageAccountRepo, factory))
+		subscriptionLibrary.AddEnumerator(NewAzurermVirtualNetworkEnumerator(networkRepo, factory))
+		subscriptionLibrary.AddEnumerator(NewAzurermRouteTableEnumerator(networkRepo, factory))
+		subscriptionLibrary.AddEnumerator(NewAzurermRouteEnumerator(networkRepo, factory))
+		subscriptionLibrary.AddEnumerator(NewAzurermResourceGroupEnumerator(resourcesRepo, factory))
+		subscriptionLibrary.AddEnumerator(NewAzurermSubnetEnumerator(networkRepo, factory))
+		subscriptionLibrary.AddEnumerator(NewAzurermContainerRegistryEnumerator(containerRegistryRepo, factory))
+		subscriptionLibrary.AddEnumerator(NewAzurermFirewallsEnumerator(networkRepo, factory))
+		subscriptionLibrary.AddEnumerator(NewAzurermPostgresqlServerEnumerator(postgresqlRepo, factory))
+		subscriptionLibrary.AddEnumerator(NewAzurermPublicIPEnumerator(networkRepo, factory))
+		subscriptionLibrary.AddEnumerator(NewAzurermPostgresqlDatabaseEnumerator(postgresqlRepo, factory))
+		subscriptionLibrary.AddEnumerator(NewAzurermNetworkSecurityGroupEnumerator(networkRepo, factory))
+		subscriptionLibrary.AddDetailsFetcher(azurerm.AzureNetworkSecurityGroupResourceType, common.NewGenericDetailsFetcher(azurerm.AzureNetworkSecurityGroupResourceType, provider, deserializer))
+		subscriptionLibrary.AddEnumerator(NewAzurermLoadBalancerEnumerator(networkRepo, factory))
+		subscriptionLibrary.AddEnumerator(NewAzurermLoadBalancerRuleEnumerator(networkRepo, factory))
+		subscriptionLibrary.AddDetailsFetcher(azurerm.AzureLoadBalancerRuleResourceType, common.NewGenericDetailsFetcher(azurerm.AzureLoadBalancerRuleResourceType, provider, deserializer))
+
+		subscriptionLibrary.AddEnumerator(NewAzurermPrivateDNSZoneEnumerator(privateDNSRepo, factory))
+		subscriptionLibrary.AddDetailsFetcher(azurerm.AzurePrivateDNSZoneResourceType, common.NewGenericDetailsFetcher(azurerm.AzurePrivateDNSZoneResourceType, provider, deserializer))
+		subscriptionLibrary.AddEnumerator(NewAzurermPrivateDNSARecordEnumerator(privateDNSRepo, factory))
+		subscriptionLibrary.AddDetailsFetcher(azurerm.AzurePrivateDNSARecordResourceType, common.NewGenericDetailsFetcher(azurerm.AzurePrivateDNSARecordResourceType, provider, deserializer))
+		subscriptionLibrary.AddEnumerator(NewAzurermPrivateDNSAAAARecordEnumerator(privateDNSRepo, factory))
+		subscriptionLibrary.AddDetailsFetcher(azurerm.AzurePrivateDNSAAAARecordResourceType, common.NewGenericDetailsFetcher(azurerm.AzurePrivateDNSAAAARecordResourceType, provider, deserializer))
+		subscriptionLibrary.AddEnumerator(NewAzurermPrivateDNSMXRecordEnumerator(privateDNSRepo, factory))
+		subscriptionLibrary.AddDetailsFetcher(azurerm.AzurePrivateDNSMXRecordResourceType, common.NewGenericDetailsFetcher(azurerm.AzurePrivateDNSMXRecordResourceType, provider, deserializer))
+		subscriptionLibrary.AddEnumerator(NewAzurermPrivateDNSCNameRecordEnumerator(privateDNSRepo, factory))
+		subscriptionLibrary.AddDetailsFetcher(azurerm.AzurePrivateDNSCNameRecordResourceType, common.NewGenericDetailsFetcher(azurerm.AzurePrivateDNSCNameRecordResourceType, provider, deserializer))
+		subscriptionLibrary.AddEnumerator(NewAzurermPrivateDNSPTRRecordEnumerator(privateDNSRepo, factory))
+		subscriptionLibrary.AddDetailsFetcher(azurerm.AzurePrivateDNSPTRRecordResourceType, common.NewGenericDetailsFetcher(azurerm.AzurePrivateDNSPTRRecordResourceType, provider, deserializer))
+		subscriptionLibrary.AddEnumerator(NewAzurermPrivateDNSSRVRecordEnumerator(privateDNSRepo, factory))
+		subscriptionLibrary.AddDetailsFetcher(azurerm.AzurePrivateDNSSRVRecordResourceType, common.NewGenericDetailsFetcher(azurerm.AzurePrivateDNSSRVRecordResourceType, provider, deserializer))
+		subscriptionLibrary.AddEnumerator(NewAzurermPrivateDNSTXTRecordEnumerator(privateDNSRepo, factory))
+		subscriptionLibrary.AddDetailsFetcher(azurerm.AzurePrivateDNSTXTRecordResourceType, common.NewGenericDetailsFetcher(azurerm.AzurePrivateDNSTXTRecordResourceType, provider, deserializer))
+
+		subscriptionLibrary.AddEnumerator(NewAzurermImageEnumerator(computeRepo, factory))
+		subscriptionLibrary.AddEnumerator(NewAzurermSSHPublicKeyEnumerator(computeRepo, factory))
+		subscriptionLibrary.AddDetailsFetcher(azurerm.AzureSSHPublicKeyResourceType, common.NewGenericDetailsFetcher(azurerm.AzureSSHPublicKeyResourceType, provider, deserializer))
+	}
 
 	err = resourceSchemaRepository.Init(terraform.AZURE, provider.Version(), provider.Schema())
 	if err != nil {
@@ -2,9 +2,11 @@ package azurerm
 
 import (
 	"os"
+	"strings"
 
 	"github.com/snyk/driftctl/pkg/output"
 	"github.com/snyk/driftctl/pkg/remote/azurerm/common"
+	remotecommon "github.com/snyk/driftctl/pkg/remote/common"
 	"github.com/snyk/driftctl/pkg/remote/terraform"
 	tf "github.com/snyk/driftctl/pkg/terraform"
 )
@@ -36,8 +38,8 @@ func NewAzureTerraformProvider(version string, progress output.Progress, configD
 
 	tfProvider, err := terraform.NewTerraformProvider(installer, terraform.TerraformProviderConfig{
 		Name: p.name,
-		GetProviderConfig: func(_ string) interface{} {
-			c := p.GetConfig()
+		GetProviderConfig: func(alias string) interface{} {
+			c := p.subscriptionConfig(alias)
 			return map[string]interface{}{
 				"subscription_id":            c.SubscriptionID,
 				"tenant_id":                  c.TenantID,
@@ -63,6 +65,16 @@ func (p *AzureTerraformProvider) GetConfig() common.AzureProviderConfig {
 	}
 }
 
+// subscriptionConfig returns the configuration of the provider reading resources of the subscription given in the alias,
+// e.g. 008b5f48-1b66-4d92-a6b6-d215b4c9b473/, resources without subscription in their alias are read in the one of the environment
+func (p *AzureTerraformProvider) subscriptionConfig(alias string) common.AzureProviderConfig {
+	c := p.GetConfig()
+	if i := strings.Index(alias, remotecommon.AliasAccountSeparator); i > 0 {
+		c.SubscriptionID = alias[:i]
+	}
+	return c
+}
+
 func (p *AzureTerraformProvider) Name() string {
 	return p.name
 }
@@ -0,0 +1,39 @@
+package azurerm
+
+import (
+	"testing"
+
+	"github.com/snyk/driftctl/pkg/remote/azurerm/common"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAzureTerraformProvider_subscriptionConfig(t *testing.T) {
+	t.Setenv("AZURE_SUBSCRIPTION_ID", "008b5f48-1b66-4d92-a6b6-d215b4c9b473")
+	t.Setenv("AZURE_TENANT_ID", "tenant")
+	t.Setenv("AZURE_CLIENT_ID", "")
+	t.Setenv("AZURE_CLIENT_SECRET", "")
+
+	p := &AzureTerraformProvider{}
+
+	tests := []struct {
+		name  string
+		alias string
+		want  common.AzureProviderConfig
+	}{
+		{
+			name:  "subscription of the environment",
+			alias: "",
+			want:  common.AzureProviderConfig{SubscriptionID: "008b5f48-1b66-4d92-a6b6-d215b4c9b473", TenantID: "tenant"},
+		},
+		{
+			name:  "scanned subscription",
+			alias: "7bfb2c5c-7308-46ed-8ae4-fffa356eb406/",
+			want:  common.AzureProviderConfig{SubscriptionID: "7bfb2c5c-7308-46ed-8ae4-fffa356eb406", TenantID: "tenant"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, p.subscriptionConfig(tt.alias))
+		})
+	}
+}
@@ -0,0 +1,180 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	armruntime "github.com/Azure/azure-sdk-for-go/sdk/azcore/arm/runtime"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/runtime"
+	"github.com/snyk/driftctl/pkg/remote/cache"
+)
+
+const (
+	subscriptionsModule      = "subscriptions"
+	subscriptionsVersion     = "v0.1.0"
+	subscriptionsAPI         = "2020-01-01"
+	managementGroupsAPI      = "2020-05-01"
+	SubscriptionStateEnabled = "Enabled"
+	// managementGroupSubscriptionType is the type of the subscriptions found among the descendants of a management group
+	managementGroupSubscriptionType = "Microsoft.Management/managementGroups/subscriptions"
+)
+
+// Subscription is a subscription the credentials have access to
+type Subscription struct {
+	SubscriptionID string `json:"subscriptionId"`
+	DisplayName    string `json:"displayName"`
+	State          string `json:"state"`
+}
+
+// ManagementGroupDescendant is a management group or a subscription nested in a management group,
+// the name of a subscription is its ID
+type ManagementGroupDescendant struct {
+	ID   string `json:"id"`
+	Type string `json:"type"`
+	Name string `json:"name"`
+}
+
+type SubscriptionsRepository interface {
+	ListAllSubscriptions() ([]*Subscription, error)
+	ListManagementGroupSubscriptions(managementGroup string) ([]string, error)
+}
+
+// The subscriptions and management groups APIs are not covered by the SDK modules we depend on,
+// the client lists them through the ARM pipeline shared with the other clients
+type subscriptionsClient interface {
+	ListSubscriptions() ([]*Subscription, error)
+	ListManagementGroupDescendants(managementGroup string) ([]*ManagementGroupDescendant, error)
+}
+
+type subscriptionsClientImpl struct {
+	ep string
+	pl runtime.Pipeline
+}
+
+func (c subscriptionsClientImpl) ListSubscriptions() ([]*Subscription, error) {
+	results := make([]*Subscription, 0)
+	err := c.list("/subscriptions", subscriptionsAPI, func(resp *http.Response) (string, error) {
+		page := struct {
+			Value    []*Subscription `json:"value"`
+			NextLink string          `json:"nextLink"`
+		}{}
+		if err := runtime.UnmarshalAsJSON(resp, &page); err != nil {
+			return "", err
+		}
+		results = append(results, page.Value...)
+		return page.NextLink, nil
+	})
+	return results, err
+}
+
+func (c subscriptionsClientImpl) ListManagementGroupDescendants(managementGroup string) ([]*ManagementGroupDescendant, error) {
+	urlPath := fmt.Sprintf("/providers/Microsoft.Management/managementGroups/%s/descendants", url.PathEscape(managementGroup))
+	results := make([]*ManagementGroupDescendant, 0)
+	err := c.list(urlPath, managementGroupsAPI, func(resp *http.Response) (string, error) {
+		page := struct {
+			Value    []*ManagementGroupDescendant `json:"value"`
+			NextLink string                       `json:"nextLink"`
+		}{}
+		if err := runtime.UnmarshalAsJSON(resp, &page); err != nil {
+			return "", err
+		}
+		results = append(results, page.Value...)
+		return page.NextLink, nil
+	})
+	return results, err
+}
+
+// list requests every page of an ARM list operation, handlePage returns the link to the next page
+func (c subscriptionsClientImpl) list(urlPath, apiVersion string, handlePage func(*http.Response) (string, error)) error {
+	next := fmt.Sprintf("%s?api-version=%s", runtime.JoinPaths(c.ep, urlPath), apiVersion)
+	for next != "" {
+		req, err := runtime.NewRequest(context.Background(), http.MethodGet, next)
+		if err != nil {
+			return err
+		}
+		req.Raw().Header.Set("Accept", "application/json")
+		resp, err := c.pl.Do(req)
+		if err != nil {
+			return err
+		}
+		if !runtime.HasStatusCode(resp, http.StatusOK) {
+			body, err := runtime.Payload(resp)
+			if err != nil {
+				return runtime.NewResponseError(err, resp)
+			}
+			return runtime.NewResponseError(fmt.Errorf("%s", strings.TrimSpace(string(body))), resp)
+		}
+		next, err = handlePage(resp)
+		if err != nil {
+			return runtime.NewResponseError(err, resp)
+		}
+	}
+	return nil
+}
+
+type subscriptionsRepository struct {
+	client subscriptionsClient
+	cache  cache.Cache
+}
+
+func NewSubscriptionsRepository(cred azcore.TokenCredential, options *arm.ClientOptions, cache cache.Cache) *subscriptionsRepository {
+	cp := arm.ClientOptions{}
+	if options != nil {
+		cp = *options
+	}
+	if len(cp.Host) == 0 {
+		cp.Host = arm.AzurePublicCloud
+	}
+	return &subscriptionsRepository{
+		&subscriptionsClientImpl{
+			ep: string(cp.Host),
+			pl: armruntime.NewPipeline(subscriptionsModule, subscriptionsVersion, cred, &cp),
+		},
+		cache,
+	}
+}
+
+func (s *subscriptionsRepository) ListAllSubscriptions() ([]*Subscription, error) {
+	cacheKey := "subscriptionsListAllSubscriptions"
+	if v := s.cache.Get(cacheKey); v != nil {
+		return v.([]*Subscription), nil
+	}
+
+	results, err := s.client.ListSubscriptions()
+	if err != nil {
+		return nil, err
+	}
+
+	s.cache.Put(cacheKey, results)
+
+	return results, nil
+}
+
+// ListManagementGroupSubscriptions returns the IDs of the subscriptions nested at any depth in a management group
+func (s *subscriptionsRepository) ListManagementGroupSubscriptions(managementGroup string) ([]string, error) {
+	cacheKey := fmt.Sprintf("subscriptionsListManagementGroupSubscriptions_%s", managementGroup)
+	if v := s.cache.Get(cacheKey); v != nil {
+		return v.([]string), nil
+	}
+
+	descendants, err := s.client.ListManagementGroupDescendants(managementGroup)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]string, 0, len(descendants))
+	for _, descendant := range descendants {
+		if descendant.Type == managementGroupSubscriptionType {
+			results = append(results, descendant.Name)
+		}
+	}
+
+	s.cache.Put(cacheKey, results)
+
+	return results, nil
+}
@@ -0,0 +1,139 @@
+package repository
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/snyk/driftctl/pkg/remote/cache"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Subscriptions_ListAllSubscriptions(t *testing.T) {
+	expectedResults := []*Subscription{
+		{SubscriptionID: "008b5f48-1b66-4d92-a6b6-d215b4c9b473", DisplayName: "dev", State: "Enabled"},
+		{SubscriptionID: "7bfb2c5c-7308-46ed-8ae4-fffa356eb406", DisplayName: "prod", State: "Disabled"},
+	}
+
+	testcases := []struct {
+		name     string
+		mocks    func(*mockSubscriptionsClient, *cache.MockCache)
+		expected []*Subscription
+		wantErr  string
+	}{
+		{
+			name: "should return subscriptions",
+			mocks: func(client *mockSubscriptionsClient, mockCache *cache.MockCache) {
+				client.On("ListSubscriptions").Return(expectedResults, nil).Times(1)
+				mockCache.On("Get", "subscriptionsListAllSubscriptions").Return(nil).Times(1)
+				mockCache.On("Put", "subscriptionsListAllSubscriptions", expectedResults).Return(true).Times(1)
+			},
+			expected: expectedResults,
+		},
+		{
+			name: "should hit cache and return subscriptions",
+			mocks: func(client *mockSubscriptionsClient, mockCache *cache.MockCache) {
+				mockCache.On("Get", "subscriptionsListAllSubscriptions").Return(expectedResults).Times(1)
+			},
+			expected: expectedResults,
+		},
+		{
+			name: "should return remote error",
+			mocks: func(client *mockSubscriptionsClient, mockCache *cache.MockCache) {
+				client.On("ListSubscriptions").Return(nil, errors.New("remote error")).Times(1)
+				mockCache.On("Get", "subscriptionsListAllSubscriptions").Return(nil).Times(1)
+			},
+			wantErr: "remote error",
+		},
+	}
+
+	for _, tt := range testcases {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeClient := &mockSubscriptionsClient{}
+			mockCache := &cache.MockCache{}
+			tt.mocks(fakeClient, mockCache)
+
+			s := &subscriptionsRepository{
+				client: fakeClient,
+				cache:  mockCache,
+			}
+			got, err := s.ListAllSubscriptions()
+			fakeClient.AssertExpectations(t)
+			mockCache.AssertExpectations(t)
+
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
+
+func Test_Subscriptions_ListManagementGroupSubscriptions(t *testing.T) {
+	testcases := []struct {
+		name     string
+		mocks    func(*mockSubscriptionsClient, *cache.MockCache)
+		expected []string
+		wantErr  string
+	}{
+		{
+			name: "should return subscriptions nested in the management group",
+			mocks: func(client *mockSubscriptionsClient, mockCache *cache.MockCache) {
+				client.On("ListManagementGroupDescendants", "platform").Return([]*ManagementGroupDescendant{
+					{
+						ID:   "/providers/Microsoft.Management/managementGroups/platform-dev",
+						Type: "Microsoft.Management/managementGroups",
+						Name: "platform-dev",
+					},
+					{
+						ID:   "/subscriptions/008b5f48-1b66-4d92-a6b6-d215b4c9b473",
+						Type: "Microsoft.Management/managementGroups/subscriptions",
+						Name: "008b5f48-1b66-4d92-a6b6-d215b4c9b473",
+					},
+				}, nil).Times(1)
+				mockCache.On("Get", "subscriptionsListManagementGroupSubscriptions_platform").Return(nil).Times(1)
+				mockCache.On("Put", "subscriptionsListManagementGroupSubscriptions_platform", []string{"008b5f48-1b66-4d92-a6b6-d215b4c9b473"}).Return(true).Times(1)
+			},
+			expected: []string{"008b5f48-1b66-4d92-a6b6-d215b4c9b473"},
+		},
+		{
+			name: "should hit cache and return subscriptions",
+			mocks: func(client *mockSubscriptionsClient, mockCache *cache.MockCache) {
+				mockCache.On("Get", "subscriptionsListManagementGroupSubscriptions_platform").Return([]string{"008b5f48-1b66-4d92-a6b6-d215b4c9b473"}).Times(1)
+			},
+			expected: []string{"008b5f48-1b66-4d92-a6b6-d215b4c9b473"},
+		},
+		{
+			name: "should return remote error",
+			mocks: func(client *mockSubscriptionsClient, mockCache *cache.MockCache) {
+				client.On("ListManagementGroupDescendants", "platform").Return(nil, errors.New("remote error")).Times(1)
+				mockCache.On("Get", "subscriptionsListManagementGroupSubscriptions_platform").Return(nil).Times(1)
+			},
+			wantErr: "remote error",
+		},
+	}
+
+	for _, tt := range testcases {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeClient := &mockSubscriptionsClient{}
+			mockCache := &cache.MockCache{}
+			tt.mocks(fakeClient, mockCache)
+
+			s := &subscriptionsRepository{
+				client: fakeClient,
+				cache:  mockCache,
+			}
+			got, err := s.ListManagementGroupSubscriptions("platform")
+			fakeClient.AssertExpectations(t)
+			mockCache.AssertExpectations(t)
+
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
@@ -0,0 +1,63 @@
+package azurerm
+
+import (
+	"sort"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/snyk/driftctl/pkg/remote/azurerm/repository"
+	"github.com/snyk/driftctl/pkg/remote/common"
+)
+
+// listSubscriptions returns the IDs of the subscriptions to scan,
+// an empty ID stands for the subscription of the environment when none is given
+func listSubscriptions(repo repository.SubscriptionsRepository, opts common.RemoteOptions) ([]string, error) {
+	if len(opts.AzureSubscriptions) == 0 && opts.AzureManagementGroup == "" {
+		return []string{""}, nil
+	}
+
+	subscriptions := make([]string, 0, len(opts.AzureSubscriptions))
+	seen := make(map[string]struct{})
+	for _, id := range opts.AzureSubscriptions {
+		if _, exists := seen[id]; exists {
+			continue
+		}
+		seen[id] = struct{}{}
+		subscriptions = append(subscriptions, id)
+	}
+
+	if opts.AzureManagementGroup != "" {
+		groupSubscriptions, err := repo.ListManagementGroupSubscriptions(opts.AzureManagementGroup)
+		if err != nil {
+			return nil, errors.Errorf("unable to list subscriptions of management group %s: %s", opts.AzureManagementGroup, err)
+		}
+		accessible, err := repo.ListAllSubscriptions()
+		if err != nil {
+			return nil, errors.Errorf("unable to list Azure subscriptions: %s", err)
+		}
+		enabled := make(map[string]struct{}, len(accessible))
+		for _, subscription := range accessible {
+			if subscription.State == repository.SubscriptionStateEnabled {
+				enabled[subscription.SubscriptionID] = struct{}{}
+			}
+		}
+		for _, id := range groupSubscriptions {
+			if _, exists := seen[id]; exists {
+				continue
+			}
+			if _, isEnabled := enabled[id]; !isEnabled {
+				logrus.WithFields(logrus.Fields{
+					"subscription":     id,
+					"management_group": opts.AzureManagementGroup,
+				}).Debug("Skipping subscription that is disabled or not accessible with the current credentials")
+				continue
+			}
+			seen[id] = struct{}{}
+			subscriptions = append(subscriptions, id)
+		}
+	}
+
+	sort.Strings(subscriptions)
+
+	return subscriptions, nil
+}
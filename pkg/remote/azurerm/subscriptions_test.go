@@ -0,0 +1,68 @@
+package azurerm
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/snyk/driftctl/pkg/remote/azurerm/repository"
+	"github.com/snyk/driftctl/pkg/remote/common"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListSubscriptions(t *testing.T) {
+	tests := []struct {
+		name    string
+		opts    common.RemoteOptions
+		mocks   func(*repository.MockSubscriptionsRepository)
+		want    []string
+		wantErr string
+	}{
+		{
+			name: "subscription of the environment only",
+			opts: common.RemoteOptions{},
+			want: []string{""},
+		},
+		{
+			name: "given subscriptions",
+			opts: common.RemoteOptions{AzureSubscriptions: []string{"bbbb", "aaaa", "bbbb"}},
+			want: []string{"aaaa", "bbbb"},
+		},
+		{
+			name: "enabled subscriptions of a management group",
+			opts: common.RemoteOptions{AzureSubscriptions: []string{"aaaa"}, AzureManagementGroup: "platform"},
+			mocks: func(repo *repository.MockSubscriptionsRepository) {
+				repo.On("ListManagementGroupSubscriptions", "platform").Return([]string{"cccc", "aaaa", "dddd", "eeee"}, nil).Once()
+				repo.On("ListAllSubscriptions").Return([]*repository.Subscription{
+					{SubscriptionID: "aaaa", State: "Enabled"},
+					{SubscriptionID: "cccc", State: "Enabled"},
+					{SubscriptionID: "dddd", State: "Disabled"},
+				}, nil).Once()
+			},
+			want: []string{"aaaa", "cccc"},
+		},
+		{
+			name: "unknown management group",
+			opts: common.RemoteOptions{AzureManagementGroup: "platform"},
+			mocks: func(repo *repository.MockSubscriptionsRepository) {
+				repo.On("ListManagementGroupSubscriptions", "platform").Return(nil, errors.New("AuthorizationFailed")).Once()
+			},
+			wantErr: "unable to list subscriptions of management group platform: AuthorizationFailed",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &repository.MockSubscriptionsRepository{}
+			if tt.mocks != nil {
+				tt.mocks(repo)
+			}
+			got, err := listSubscriptions(repo, tt.opts)
+			repo.AssertExpectations(t)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
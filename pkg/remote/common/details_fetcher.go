@@ -7,6 +7,21 @@ import (
 	"github.com/snyk/driftctl/pkg/terraform"
 )
 
+// AliasAccountSeparator separates the account from the region in provider aliases, e.g. 123456789012/eu-west-1
+const AliasAccountSeparator = "/"
+
+// ReadAlias returns the alias of the provider configuration able to read a resource,
+// resources found in another account or region are read by a provider configured for them
+func ReadAlias(res *resource.Resource, alias string) string {
+	if alias == "" {
+		alias = res.Region
+	}
+	if res.Account != "" {
+		alias = res.Account + AliasAccountSeparator + alias
+	}
+	return alias
+}
+
 type DetailsFetcher interface {
 	ReadDetails(*resource.Resource) (*resource.Resource, error)
 }
@@ -26,10 +41,22 @@ func NewGenericDetailsFetcher(resType resource.ResourceType, provider terraform.
 }
 
 func (f *GenericDetailsFetcher) ReadDetails(res *resource.Resource) (*resource.Resource, error) {
+	// The provider cannot read types it does not know, e.g. ones added by a later version of it
+	if res.Schema() == nil {
+		logrus.WithFields(logrus.Fields{
+			"type": f.resType,
+			"id":   res.ResourceId(),
+		}).Debug("Resource type unknown to the provider, skipping details fetching")
+		return res, nil
+	}
+
 	attributes := map[string]string{}
 	if res.Schema().ResolveReadAttributesFunc != nil {
 		attributes = res.Schema().ResolveReadAttributesFunc(res)
 	}
+	if alias := ReadAlias(res, attributes["alias"]); alias != "" {
+		attributes["alias"] = alias
+	}
 	ctyVal, err := f.reader.ReadResource(terraform.ReadResourceArgs{
 		Ty:         f.resType,
 		ID:         res.ResourceId(),
@@ -0,0 +1,60 @@
+package common
+
+import (
+	"testing"
+
+	"github.com/snyk/driftctl/pkg/resource"
+	"github.com/snyk/driftctl/pkg/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/zclconf/go-cty/cty"
+)
+
+func TestGenericDetailsFetcher_ReadDetails(t *testing.T) {
+	factory := &terraform.MockResourceFactory{}
+	factory.On("CreateAbstractResource", "aws_eks_cluster", "foo", map[string]interface{}{"id": "foo", "version": "1.21"}).Return(&resource.Resource{
+		Id:    "foo",
+		Type:  "aws_eks_cluster",
+		Attrs: &resource.Attributes{"id": "foo", "version": "1.21"},
+	}).Once()
+
+	val := cty.ObjectVal(map[string]cty.Value{
+		"id":      cty.StringVal("foo"),
+		"version": cty.StringVal("1.21"),
+	})
+	reader := &terraform.MockResourceReader{}
+	reader.On("ReadResource", terraform.ReadResourceArgs{
+		Ty:         "aws_eks_cluster",
+		ID:         "foo",
+		Attributes: map[string]string{"alias": "111111111111/eu-west-1"},
+	}).Return(&val, nil).Once()
+
+	fetcher := NewGenericDetailsFetcher("aws_eks_cluster", reader, resource.NewDeserializer(factory))
+	got, err := fetcher.ReadDetails(&resource.Resource{
+		Id:      "foo",
+		Type:    "aws_eks_cluster",
+		Attrs:   &resource.Attributes{},
+		Sch:     &resource.Schema{},
+		Account: "111111111111",
+		Region:  "eu-west-1",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "1.21", *got.Attributes().GetString("version"))
+	factory.AssertExpectations(t)
+	reader.AssertExpectations(t)
+}
+
+func TestGenericDetailsFetcher_ReadDetails_UnknownType(t *testing.T) {
+	// No expectation, the provider must not be called
+	reader := &terraform.MockResourceReader{}
+
+	res := &resource.Resource{
+		Id:    "foo:vpc-cni",
+		Type:  "aws_eks_addon",
+		Attrs: &resource.Attributes{"cluster_name": "foo", "addon_name": "vpc-cni"},
+	}
+	fetcher := NewGenericDetailsFetcher("aws_eks_addon", reader, resource.NewDeserializer(&terraform.MockResourceFactory{}))
+	got, err := fetcher.ReadDetails(res)
+	assert.NoError(t, err)
+	assert.Same(t, res, got)
+	reader.AssertExpectations(t)
+}
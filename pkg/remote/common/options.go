@@ -0,0 +1,18 @@
+package common
+
+// RemoteOptions narrow or widen the scope scanned by a remote
+type RemoteOptions struct {
+	// AWSRegions are the regions scanned by aws+tf, only the region of the session is scanned when empty
+	AWSRegions []string
+	// AWSAssumeRoles are the ARNs of roles assumed to scan other AWS accounts
+	AWSAssumeRoles []string
+	// AWSOrganizationRole is assumed in every active account of the AWS organization
+	AWSOrganizationRole string
+	// GCPScope is the project, folder or organization scanned by gcp+tf, e.g. organizations/123,
+	// only the project of the environment is scanned when empty
+	GCPScope string
+	// AzureSubscriptions are the IDs of the subscriptions scanned by azure+tf
+	AzureSubscriptions []string
+	// AzureManagementGroup has its subscriptions scanned, whatever their depth in the group hierarchy
+	AzureManagementGroup string
+}
@@ -0,0 +1,39 @@
+package common
+
+import (
+	"github.com/snyk/driftctl/pkg/resource"
+)
+
+// scopedEnumerator tells resources the account and region they were found in
+type scopedEnumerator struct {
+	Enumerator
+	account string
+	region  string
+}
+
+func (e *scopedEnumerator) Enumerate() ([]*resource.Resource, error) {
+	resources, err := e.Enumerator.Enumerate()
+	for _, res := range resources {
+		if res != nil {
+			res.Account = e.account
+			res.Region = e.region
+		}
+	}
+	return resources, err
+}
+
+// ScopedLibrary adds the enumerators of an account or of one of its regions to the remote library,
+// the region is empty for global services
+type ScopedLibrary struct {
+	*RemoteLibrary
+	account string
+	region  string
+}
+
+func NewScopedLibrary(library *RemoteLibrary, account, region string) *ScopedLibrary {
+	return &ScopedLibrary{library, account, region}
+}
+
+func (l *ScopedLibrary) AddEnumerator(enumerator Enumerator) {
+	l.RemoteLibrary.AddEnumerator(&scopedEnumerator{enumerator, l.account, l.region})
+}
@@ -0,0 +1,28 @@
+package common
+
+import (
+	"testing"
+
+	"github.com/snyk/driftctl/pkg/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScopedLibrary_AddEnumerator(t *testing.T) {
+	enumerator := &MockEnumerator{}
+	enumerator.On("Enumerate").Return([]*resource.Resource{
+		{Id: "i-0123456789", Type: "aws_instance"},
+		nil,
+	}, nil).Once()
+
+	library := NewRemoteLibrary()
+	NewScopedLibrary(library, "111111111111", "eu-west-1").AddEnumerator(enumerator)
+
+	assert.Len(t, library.Enumerators(), 1)
+	got, err := library.Enumerators()[0].Enumerate()
+	assert.NoError(t, err)
+	assert.Equal(t, []*resource.Resource{
+		{Id: "i-0123456789", Type: "aws_instance", Account: "111111111111", Region: "eu-west-1"},
+		nil,
+	}, got)
+	enumerator.AssertExpectations(t)
+}
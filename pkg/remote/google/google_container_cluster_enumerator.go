@@ -0,0 +1,60 @@
+package google
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+	remoteerror "github.com/snyk/driftctl/pkg/remote/error"
+	"github.com/snyk/driftctl/pkg/remote/google/repository"
+	"github.com/snyk/driftctl/pkg/resource"
+	"github.com/snyk/driftctl/pkg/resource/google"
+)
+
+type GoogleContainerClusterEnumerator struct {
+	repository repository.AssetRepository
+	factory    resource.ResourceFactory
+}
+
+func NewGoogleContainerClusterEnumerator(repo repository.AssetRepository, factory resource.ResourceFactory) *GoogleContainerClusterEnumerator {
+	return &GoogleContainerClusterEnumerator{
+		repository: repo,
+		factory:    factory,
+	}
+}
+
+func (e *GoogleContainerClusterEnumerator) SupportedType() resource.ResourceType {
+	return google.GoogleContainerClusterResourceType
+}
+
+func (e *GoogleContainerClusterEnumerator) Enumerate() ([]*resource.Resource, error) {
+	clusters, err := e.repository.SearchAllContainerClusters()
+	if err != nil {
+		return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
+	}
+
+	results := make([]*resource.Resource, 0, len(clusters))
+	for _, res := range clusters {
+		// Zonal clusters are named after their zone while Terraform always identifies clusters by location,
+		// e.g. //container.googleapis.com/projects/my-project/zones/us-central1-a/clusters/my-cluster
+		splittedName := strings.Split(res.GetName(), "/")
+		if len(splittedName) != 9 {
+			logrus.WithField("name", res.GetName()).Error("Unable to decode project from cluster name")
+			continue
+		}
+		project, location, name := splittedName[4], splittedName[6], splittedName[8]
+		results = append(
+			results,
+			e.factory.CreateAbstractResource(
+				string(e.SupportedType()),
+				fmt.Sprintf("projects/%s/locations/%s/clusters/%s", project, location, name),
+				map[string]interface{}{
+					"name":     name,
+					"location": location,
+				},
+			),
+		)
+	}
+
+	return results, err
+}
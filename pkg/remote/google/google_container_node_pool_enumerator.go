@@ -0,0 +1,60 @@
+package google
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+	remoteerror "github.com/snyk/driftctl/pkg/remote/error"
+	"github.com/snyk/driftctl/pkg/remote/google/repository"
+	"github.com/snyk/driftctl/pkg/resource"
+	"github.com/snyk/driftctl/pkg/resource/google"
+)
+
+type GoogleContainerNodePoolEnumerator struct {
+	repository repository.AssetRepository
+	factory    resource.ResourceFactory
+}
+
+func NewGoogleContainerNodePoolEnumerator(repo repository.AssetRepository, factory resource.ResourceFactory) *GoogleContainerNodePoolEnumerator {
+	return &GoogleContainerNodePoolEnumerator{
+		repository: repo,
+		factory:    factory,
+	}
+}
+
+func (e *GoogleContainerNodePoolEnumerator) SupportedType() resource.ResourceType {
+	return google.GoogleContainerNodePoolResourceType
+}
+
+func (e *GoogleContainerNodePoolEnumerator) Enumerate() ([]*resource.Resource, error) {
+	nodePools, err := e.repository.SearchAllContainerNodePools()
+	if err != nil {
+		return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
+	}
+
+	results := make([]*resource.Resource, 0, len(nodePools))
+	for _, res := range nodePools {
+		// e.g. //container.googleapis.com/projects/my-project/locations/us-central1/clusters/my-cluster/nodePools/my-pool
+		splittedName := strings.Split(res.GetName(), "/")
+		if len(splittedName) != 11 {
+			logrus.WithField("name", res.GetName()).Error("Unable to decode project from node pool name")
+			continue
+		}
+		project, location, cluster, name := splittedName[4], splittedName[6], splittedName[8], splittedName[10]
+		results = append(
+			results,
+			e.factory.CreateAbstractResource(
+				string(e.SupportedType()),
+				fmt.Sprintf("projects/%s/locations/%s/clusters/%s/nodePools/%s", project, location, cluster, name),
+				map[string]interface{}{
+					"name":     name,
+					"cluster":  cluster,
+					"location": location,
+				},
+			),
+		)
+	}
+
+	return results, err
+}
@@ -0,0 +1,47 @@
+package google
+
+import (
+	remoteerror "github.com/snyk/driftctl/pkg/remote/error"
+	"github.com/snyk/driftctl/pkg/remote/google/repository"
+	"github.com/snyk/driftctl/pkg/resource"
+	"github.com/snyk/driftctl/pkg/resource/google"
+)
+
+type GoogleKMSCryptoKeyEnumerator struct {
+	repository repository.AssetRepository
+	factory    resource.ResourceFactory
+}
+
+func NewGoogleKMSCryptoKeyEnumerator(repo repository.AssetRepository, factory resource.ResourceFactory) *GoogleKMSCryptoKeyEnumerator {
+	return &GoogleKMSCryptoKeyEnumerator{
+		repository: repo,
+		factory:    factory,
+	}
+}
+
+func (e *GoogleKMSCryptoKeyEnumerator) SupportedType() resource.ResourceType {
+	return google.GoogleKMSCryptoKeyResourceType
+}
+
+func (e *GoogleKMSCryptoKeyEnumerator) Enumerate() ([]*resource.Resource, error) {
+	cryptoKeys, err := e.repository.SearchAllKMSCryptoKeys()
+	if err != nil {
+		return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
+	}
+
+	results := make([]*resource.Resource, 0, len(cryptoKeys))
+	for _, res := range cryptoKeys {
+		results = append(
+			results,
+			e.factory.CreateAbstractResource(
+				string(e.SupportedType()),
+				trimResourceName(res.GetName()),
+				map[string]interface{}{
+					"name": res.GetDisplayName(),
+				},
+			),
+		)
+	}
+
+	return results, err
+}
@@ -0,0 +1,47 @@
+package google
+
+import (
+	remoteerror "github.com/snyk/driftctl/pkg/remote/error"
+	"github.com/snyk/driftctl/pkg/remote/google/repository"
+	"github.com/snyk/driftctl/pkg/resource"
+	"github.com/snyk/driftctl/pkg/resource/google"
+)
+
+type GoogleKMSKeyRingEnumerator struct {
+	repository repository.AssetRepository
+	factory    resource.ResourceFactory
+}
+
+func NewGoogleKMSKeyRingEnumerator(repo repository.AssetRepository, factory resource.ResourceFactory) *GoogleKMSKeyRingEnumerator {
+	return &GoogleKMSKeyRingEnumerator{
+		repository: repo,
+		factory:    factory,
+	}
+}
+
+func (e *GoogleKMSKeyRingEnumerator) SupportedType() resource.ResourceType {
+	return google.GoogleKMSKeyRingResourceType
+}
+
+func (e *GoogleKMSKeyRingEnumerator) Enumerate() ([]*resource.Resource, error) {
+	keyRings, err := e.repository.SearchAllKMSKeyRings()
+	if err != nil {
+		return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
+	}
+
+	results := make([]*resource.Resource, 0, len(keyRings))
+	for _, res := range keyRings {
+		results = append(
+			results,
+			e.factory.CreateAbstractResource(
+				string(e.SupportedType()),
+				trimResourceName(res.GetName()),
+				map[string]interface{}{
+					"name": res.GetDisplayName(),
+				},
+			),
+		)
+	}
+
+	return results, err
+}
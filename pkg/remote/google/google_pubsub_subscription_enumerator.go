@@ -0,0 +1,47 @@
+package google
+
+import (
+	remoteerror "github.com/snyk/driftctl/pkg/remote/error"
+	"github.com/snyk/driftctl/pkg/remote/google/repository"
+	"github.com/snyk/driftctl/pkg/resource"
+	"github.com/snyk/driftctl/pkg/resource/google"
+)
+
+type GooglePubsubSubscriptionEnumerator struct {
+	repository repository.AssetRepository
+	factory    resource.ResourceFactory
+}
+
+func NewGooglePubsubSubscriptionEnumerator(repo repository.AssetRepository, factory resource.ResourceFactory) *GooglePubsubSubscriptionEnumerator {
+	return &GooglePubsubSubscriptionEnumerator{
+		repository: repo,
+		factory:    factory,
+	}
+}
+
+func (e *GooglePubsubSubscriptionEnumerator) SupportedType() resource.ResourceType {
+	return google.GooglePubsubSubscriptionResourceType
+}
+
+func (e *GooglePubsubSubscriptionEnumerator) Enumerate() ([]*resource.Resource, error) {
+	subscriptions, err := e.repository.SearchAllPubsubSubscriptions()
+	if err != nil {
+		return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
+	}
+
+	results := make([]*resource.Resource, 0, len(subscriptions))
+	for _, res := range subscriptions {
+		results = append(
+			results,
+			e.factory.CreateAbstractResource(
+				string(e.SupportedType()),
+				trimResourceName(res.GetName()),
+				map[string]interface{}{
+					"name": res.GetDisplayName(),
+				},
+			),
+		)
+	}
+
+	return results, err
+}
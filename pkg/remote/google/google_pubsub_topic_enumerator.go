@@ -0,0 +1,47 @@
+package google
+
+import (
+	remoteerror "github.com/snyk/driftctl/pkg/remote/error"
+	"github.com/snyk/driftctl/pkg/remote/google/repository"
+	"github.com/snyk/driftctl/pkg/resource"
+	"github.com/snyk/driftctl/pkg/resource/google"
+)
+
+type GooglePubsubTopicEnumerator struct {
+	repository repository.AssetRepository
+	factory    resource.ResourceFactory
+}
+
+func NewGooglePubsubTopicEnumerator(repo repository.AssetRepository, factory resource.ResourceFactory) *GooglePubsubTopicEnumerator {
+	return &GooglePubsubTopicEnumerator{
+		repository: repo,
+		factory:    factory,
+	}
+}
+
+func (e *GooglePubsubTopicEnumerator) SupportedType() resource.ResourceType {
+	return google.GooglePubsubTopicResourceType
+}
+
+func (e *GooglePubsubTopicEnumerator) Enumerate() ([]*resource.Resource, error) {
+	topics, err := e.repository.SearchAllPubsubTopics()
+	if err != nil {
+		return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
+	}
+
+	results := make([]*resource.Resource, 0, len(topics))
+	for _, res := range topics {
+		results = append(
+			results,
+			e.factory.CreateAbstractResource(
+				string(e.SupportedType()),
+				trimResourceName(res.GetName()),
+				map[string]interface{}{
+					"name": res.GetDisplayName(),
+				},
+			),
+		)
+	}
+
+	return results, err
+}
@@ -22,7 +22,8 @@ func Init(version string, alerter *alerter.Alerter,
 	progress output.Progress,
 	resourceSchemaRepository *resource.SchemaRepository,
 	factory resource.ResourceFactory,
-	configDir string) error {
+	configDir string,
+	opts common.RemoteOptions) error {
 
 	provider, err := NewGCPTerraformProvider(version, progress, configDir)
 	if err != nil {
@@ -51,57 +52,82 @@ func Init(version string, alerter *alerter.Alerter,
 		return err
 	}
 
-	assetRepository := repository.NewAssetRepository(assetClient, provider.GetConfig(), repositoryCache)
+	scopeRepository := repository.NewAssetRepository(assetClient, provider.GetConfig(), repositoryCache)
+	if opts.GCPScope != "" {
+		scopeRepository = repository.NewScopedAssetRepository(assetClient, opts.GCPScope, repositoryCache)
+	}
 	storageRepository := repository.NewStorageRepository(storageClient, repositoryCache)
-	iamRepository := repository.NewCloudResourceManagerRepository(crmService, provider.GetConfig(), repositoryCache)
 
 	providerLibrary.AddProvider(terraform.GOOGLE, provider)
 	deserializer := resource.NewDeserializer(factory)
 
-	remoteLibrary.AddEnumerator(NewGoogleStorageBucketEnumerator(assetRepository, factory))
-	remoteLibrary.AddDetailsFetcher(google.GoogleStorageBucketResourceType, common.NewGenericDetailsFetcher(google.GoogleStorageBucketResourceType, provider, deserializer))
-
-	remoteLibrary.AddEnumerator(NewGoogleComputeFirewallEnumerator(assetRepository, factory))
-	remoteLibrary.AddDetailsFetcher(google.GoogleComputeFirewallResourceType, common.NewGenericDetailsFetcher(google.GoogleComputeFirewallResourceType, provider, deserializer))
-
-	remoteLibrary.AddEnumerator(NewGoogleComputeRouterEnumerator(assetRepository, factory))
-
-	remoteLibrary.AddEnumerator(NewGoogleComputeInstanceEnumerator(assetRepository, factory))
-
-	remoteLibrary.AddEnumerator(NewGoogleProjectIamMemberEnumerator(iamRepository, factory))
-	remoteLibrary.AddDetailsFetcher(google.GoogleProjectIamMemberResourceType, common.NewGenericDetailsFetcher(google.GoogleProjectIamMemberResourceType, provider, deserializer))
-
-	remoteLibrary.AddEnumerator(NewGoogleStorageBucketIamMemberEnumerator(assetRepository, storageRepository, factory))
-	remoteLibrary.AddDetailsFetcher(google.GoogleStorageBucketIamMemberResourceType, common.NewGenericDetailsFetcher(google.GoogleStorageBucketIamMemberResourceType, provider, deserializer))
-
-	remoteLibrary.AddEnumerator(NewGoogleComputeNetworkEnumerator(assetRepository, factory))
-	remoteLibrary.AddDetailsFetcher(google.GoogleComputeNetworkResourceType, common.NewGenericDetailsFetcher(google.GoogleComputeNetworkResourceType, provider, deserializer))
-
-	remoteLibrary.AddEnumerator(NewGoogleComputeSubnetworkEnumerator(assetRepository, factory))
-	remoteLibrary.AddDetailsFetcher(google.GoogleComputeSubnetworkResourceType, common.NewGenericDetailsFetcher(google.GoogleComputeSubnetworkResourceType, provider, deserializer))
-
-	remoteLibrary.AddEnumerator(NewGoogleDNSManagedZoneEnumerator(assetRepository, factory))
-
-	remoteLibrary.AddEnumerator(NewGoogleComputeInstanceGroupEnumerator(assetRepository, factory))
-	remoteLibrary.AddDetailsFetcher(google.GoogleComputeInstanceGroupResourceType, common.NewGenericDetailsFetcher(google.GoogleComputeInstanceGroupResourceType, provider, deserializer))
-
-	remoteLibrary.AddEnumerator(NewGoogleBigqueryDatasetEnumerator(assetRepository, factory))
-	remoteLibrary.AddEnumerator(NewGoogleBigqueryTableEnumerator(assetRepository, factory))
+	projects, err := listProjects(scopeRepository, opts)
+	if err != nil {
+		return err
+	}
 
-	remoteLibrary.AddEnumerator(NewGoogleComputeAddressEnumerator(assetRepository, factory))
-	remoteLibrary.AddEnumerator(NewGoogleComputeGlobalAddressEnumerator(assetRepository, factory))
-	remoteLibrary.AddEnumerator(NewGoogleCloudFunctionsFunctionEnumerator(assetRepository, factory))
-	remoteLibrary.AddEnumerator(NewGoogleComputeDiskEnumerator(assetRepository, factory))
-	remoteLibrary.AddEnumerator(NewGoogleComputeImageEnumerator(assetRepository, factory))
-	remoteLibrary.AddEnumerator(NewGoogleBigTableInstanceEnumerator(assetRepository, factory))
-	remoteLibrary.AddEnumerator(NewGoogleBigtableTableEnumerator(assetRepository, factory))
-	remoteLibrary.AddEnumerator(NewGoogleSQLDatabaseInstanceEnumerator(assetRepository, factory))
-	remoteLibrary.AddEnumerator(NewGoogleComputeHealthCheckEnumerator(assetRepository, factory))
-	remoteLibrary.AddEnumerator(NewGoogleCloudRunServiceEnumerator(assetRepository, factory))
-	remoteLibrary.AddEnumerator(NewGoogleComputeNodeGroupEnumerator(assetRepository, factory))
-	remoteLibrary.AddEnumerator(NewGoogleComputeForwardingRuleEnumerator(assetRepository, factory))
-	remoteLibrary.AddEnumerator(NewGoogleComputeInstanceGroupManagerEnumerator(assetRepository, factory))
-	remoteLibrary.AddEnumerator(NewGoogleComputeGlobalForwardingRuleEnumerator(assetRepository, factory))
+	// The whole scope is searched once, each project then gets the share of resources it owns
+	for _, project := range projects {
+		assetRepository := scopeRepository
+		projectConfig := provider.GetConfig()
+		if project.number != "" {
+			assetRepository = scopeRepository.ForProject(project.number)
+			projectConfig.Project = project.id
+		}
+		iamRepository := repository.NewCloudResourceManagerRepository(crmService, projectConfig, repositoryCache)
+		projectLibrary := common.NewScopedLibrary(remoteLibrary, project.id, "")
+
+		projectLibrary.AddEnumerator(NewGoogleStorageBucketEnumerator(assetRepository, factory))
+		projectLibrary.AddDetailsFetcher(google.GoogleStorageBucketResourceType, common.NewGenericDetailsFetcher(google.GoogleStorageBucketResourceType, provider, deserializer))
+
+		projectLibrary.AddEnumerator(NewGoogleComputeFirewallEnumerator(assetRepository, factory))
+		projectLibrary.AddDetailsFetcher(google.GoogleComputeFirewallResourceType, common.NewGenericDetailsFetcher(google.GoogleComputeFirewallResourceType, provider, deserializer))
+
+		projectLibrary.AddEnumerator(NewGoogleComputeRouterEnumerator(assetRepository, factory))
+
+		projectLibrary.AddEnumerator(NewGoogleComputeInstanceEnumerator(assetRepository, factory))
+
+		projectLibrary.AddEnumerator(NewGoogleProjectIamMemberEnumerator(iamRepository, factory))
+		projectLibrary.AddDetailsFetcher(google.GoogleProjectIamMemberResourceType, common.NewGenericDetailsFetcher(google.GoogleProjectIamMemberResourceType, provider, deserializer))
+
+		projectLibrary.AddEnumerator(NewGoogleStorageBucketIamMemberEnumerator(assetRepository, storageRepository, factory))
+		projectLibrary.AddDetailsFetcher(google.GoogleStorageBucketIamMemberResourceType, common.NewGenericDetailsFetcher(google.GoogleStorageBucketIamMemberResourceType, provider, deserializer))
+
+		projectLibrary.AddEnumerator(NewGoogleComputeNetworkEnumerator(assetRepository, factory))
+		projectLibrary.AddDetailsFetcher(google.GoogleComputeNetworkResourceType, common.NewGenericDetailsFetcher(google.GoogleComputeNetworkResourceType, provider, deserializer))
+
+		projectLibrary.AddEnumerator(NewGoogleComputeSubnetworkEnumerator(assetRepository, factory))
+		projectLibrary.AddDetailsFetcher(google.GoogleComputeSubnetworkResourceType, common.NewGenericDetailsFetcher(google.GoogleComputeSubnetworkResourceType, provider, deserializer))
+
+		projectLibrary.AddEnumerator(NewGoogleDNSManagedZoneEnumerator(assetRepository, factory))
+
+		projectLibrary.AddEnumerator(NewGoogleComputeInstanceGroupEnumerator(assetRepository, factory))
+		projectLibrary.AddDetailsFetcher(google.GoogleComputeInstanceGroupResourceType, common.NewGenericDetailsFetcher(google.GoogleComputeInstanceGroupResourceType, provider, deserializer))
+
+		projectLibrary.AddEnumerator(NewGoogleBigqueryDatasetEnumerator(assetRepository, factory))
+		projectLibrary.AddEnumerator(NewGoogleBigqueryTableEnumerator(assetRepository, factory))
+
+		projectLibrary.AddEnumerator(NewGoogleComputeAddressEnumerator(assetRepository, factory))
+		projectLibrary.AddEnumerator(NewGoogleComputeGlobalAddressEnumerator(assetRepository, factory))
+		projectLibrary.AddEnumerator(NewGoogleCloudFunctionsFunctionEnumerator(assetRepository, factory))
+		projectLibrary.AddEnumerator(NewGoogleComputeDiskEnumerator(assetRepository, factory))
+		projectLibrary.AddEnumerator(NewGoogleComputeImageEnumerator(assetRepository, factory))
+		projectLibrary.AddEnumerator(NewGoogleBigTableInstanceEnumerator(assetRepository, factory))
+		projectLibrary.AddEnumerator(NewGoogleBigtableTableEnumerator(assetRepository, factory))
+		projectLibrary.AddEnumerator(NewGoogleSQLDatabaseInstanceEnumerator(assetRepository, factory))
+		projectLibrary.AddEnumerator(NewGoogleComputeHealthCheckEnumerator(assetRepository, factory))
+		projectLibrary.AddEnumerator(NewGoogleCloudRunServiceEnumerator(assetRepository, factory))
+		projectLibrary.AddEnumerator(NewGoogleComputeNodeGroupEnumerator(assetRepository, factory))
+		projectLibrary.AddEnumerator(NewGoogleComputeForwardingRuleEnumerator(assetRepository, factory))
+		projectLibrary.AddEnumerator(NewGoogleComputeInstanceGroupManagerEnumerator(assetRepository, factory))
+		projectLibrary.AddEnumerator(NewGoogleComputeGlobalForwardingRuleEnumerator(assetRepository, factory))
+		projectLibrary.AddEnumerator(NewGoogleContainerClusterEnumerator(assetRepository, factory))
+		projectLibrary.AddEnumerator(NewGoogleContainerNodePoolEnumerator(assetRepository, factory))
+		projectLibrary.AddEnumerator(NewGooglePubsubTopicEnumerator(assetRepository, factory))
+		projectLibrary.AddEnumerator(NewGooglePubsubSubscriptionEnumerator(assetRepository, factory))
+		projectLibrary.AddEnumerator(NewGoogleKMSKeyRingEnumerator(assetRepository, factory))
+		projectLibrary.AddEnumerator(NewGoogleKMSCryptoKeyEnumerator(assetRepository, factory))
+	}
 
 	err = resourceSchemaRepository.Init(terraform.GOOGLE, provider.Version(), provider.Schema())
 	if err != nil {
@@ -0,0 +1,56 @@
+package google
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/snyk/driftctl/pkg/remote/common"
+	"github.com/snyk/driftctl/pkg/remote/google/repository"
+)
+
+var supportedScopePrefixes = []string{"projects/", "folders/", "organizations/"}
+
+// gcpProject is a project of the scanned scope, both are empty when only the configured project is scanned
+type gcpProject struct {
+	id     string
+	number string
+}
+
+func validateScope(scope string) error {
+	for _, prefix := range supportedScopePrefixes {
+		if strings.HasPrefix(scope, prefix) && len(scope) > len(prefix) {
+			return nil
+		}
+	}
+	return errors.Errorf("invalid GCP scope %s, expected projects/<id>, folders/<id> or organizations/<id>", scope)
+}
+
+// listProjects returns the projects to scan, only the configured project is scanned when no scope is given
+func listProjects(assetRepository repository.AssetRepository, opts common.RemoteOptions) ([]gcpProject, error) {
+	if opts.GCPScope == "" {
+		return []gcpProject{{}}, nil
+	}
+	if err := validateScope(opts.GCPScope); err != nil {
+		return nil, err
+	}
+
+	assets, err := assetRepository.ListAllProjects()
+	if err != nil {
+		return nil, errors.Errorf("unable to list projects of GCP scope %s: %s", opts.GCPScope, err)
+	}
+
+	projects := make([]gcpProject, 0, len(assets))
+	for _, asset := range assets {
+		fields := asset.GetResource().GetData().GetFields()
+		projects = append(projects, gcpProject{
+			id:     fields["projectId"].GetStringValue(),
+			number: fields["projectNumber"].GetStringValue(),
+		})
+	}
+	sort.Slice(projects, func(i, j int) bool {
+		return projects[i].id < projects[j].id
+	})
+
+	return projects, nil
+}
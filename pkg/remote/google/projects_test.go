@@ -0,0 +1,87 @@
+package google
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/snyk/driftctl/pkg/remote/common"
+	"github.com/snyk/driftctl/pkg/remote/google/repository"
+	"github.com/stretchr/testify/assert"
+	assetpb "google.golang.org/genproto/googleapis/cloud/asset/v1"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func projectAsset(id, number string) *assetpb.Asset {
+	return &assetpb.Asset{
+		AssetType: "cloudresourcemanager.googleapis.com/Project",
+		Name:      "//cloudresourcemanager.googleapis.com/projects/" + number,
+		Resource: &assetpb.Resource{
+			Data: &structpb.Struct{
+				Fields: map[string]*structpb.Value{
+					"projectId":      structpb.NewStringValue(id),
+					"projectNumber":  structpb.NewStringValue(number),
+					"lifecycleState": structpb.NewStringValue("ACTIVE"),
+				},
+			},
+		},
+	}
+}
+
+func TestListProjects(t *testing.T) {
+	tests := []struct {
+		name    string
+		opts    common.RemoteOptions
+		mocks   func(*repository.MockAssetRepository)
+		want    []gcpProject
+		wantErr string
+	}{
+		{
+			name: "configured project only",
+			opts: common.RemoteOptions{},
+			want: []gcpProject{{}},
+		},
+		{
+			name: "projects of an organization",
+			opts: common.RemoteOptions{GCPScope: "organizations/123"},
+			mocks: func(repository *repository.MockAssetRepository) {
+				repository.On("ListAllProjects").Return([]*assetpb.Asset{
+					projectAsset("project-b", "222222222222"),
+					projectAsset("project-a", "111111111111"),
+				}, nil).Once()
+			},
+			want: []gcpProject{
+				{id: "project-a", number: "111111111111"},
+				{id: "project-b", number: "222222222222"},
+			},
+		},
+		{
+			name:    "invalid scope",
+			opts:    common.RemoteOptions{GCPScope: "123"},
+			wantErr: "invalid GCP scope 123, expected projects/<id>, folders/<id> or organizations/<id>",
+		},
+		{
+			name: "scope without permission",
+			opts: common.RemoteOptions{GCPScope: "folders/456"},
+			mocks: func(repository *repository.MockAssetRepository) {
+				repository.On("ListAllProjects").Return(nil, errors.New("PermissionDenied")).Once()
+			},
+			wantErr: "unable to list projects of GCP scope folders/456: PermissionDenied",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assetRepository := &repository.MockAssetRepository{}
+			if tt.mocks != nil {
+				tt.mocks(assetRepository)
+			}
+			got, err := listProjects(assetRepository, tt.opts)
+			assetRepository.AssertExpectations(t)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -2,8 +2,10 @@ package google
 
 import (
 	"os"
+	"strings"
 
 	"github.com/snyk/driftctl/pkg/output"
+	"github.com/snyk/driftctl/pkg/remote/common"
 	"github.com/snyk/driftctl/pkg/remote/google/config"
 	"github.com/snyk/driftctl/pkg/remote/terraform"
 	tf "github.com/snyk/driftctl/pkg/terraform"
@@ -34,7 +36,7 @@ func NewGCPTerraformProvider(version string, progress output.Progress, configDir
 	tfProvider, err := terraform.NewTerraformProvider(installer, terraform.TerraformProviderConfig{
 		Name: p.name,
 		GetProviderConfig: func(alias string) interface{} {
-			return p.GetConfig()
+			return p.providerConfig(alias)
 		},
 	}, progress)
 
@@ -62,3 +64,13 @@ func (p *GCPTerraformProvider) GetConfig() config.GCPTerraformConfig {
 		Zone:    os.Getenv("CLOUDSDK_COMPUTE_ZONE"),
 	}
 }
+
+// providerConfig returns the configuration of the provider reading resources of the project given in the alias,
+// e.g. my-project/, resources without project in their alias are read in the project of the environment
+func (p *GCPTerraformProvider) providerConfig(alias string) config.GCPTerraformConfig {
+	cfg := p.GetConfig()
+	if i := strings.Index(alias, common.AliasAccountSeparator); i > 0 {
+		cfg.Project = alias[:i]
+	}
+	return cfg
+}
@@ -0,0 +1,38 @@
+package google
+
+import (
+	"testing"
+
+	"github.com/snyk/driftctl/pkg/remote/google/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGCPTerraformProvider_providerConfig(t *testing.T) {
+	t.Setenv("CLOUDSDK_CORE_PROJECT", "default-project")
+	t.Setenv("CLOUDSDK_COMPUTE_REGION", "us-central1")
+	t.Setenv("CLOUDSDK_COMPUTE_ZONE", "")
+
+	p := &GCPTerraformProvider{}
+
+	tests := []struct {
+		name  string
+		alias string
+		want  config.GCPTerraformConfig
+	}{
+		{
+			name:  "default project",
+			alias: "",
+			want:  config.GCPTerraformConfig{Project: "default-project", Region: "us-central1"},
+		},
+		{
+			name:  "project of the scope",
+			alias: "other-project/",
+			want:  config.GCPTerraformConfig{Project: "other-project", Region: "us-central1"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, p.providerConfig(tt.alias))
+		})
+	}
+}
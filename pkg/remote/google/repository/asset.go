@@ -37,6 +37,13 @@ const (
 	computeForwardingRuleAssetType       = "compute.googleapis.com/ForwardingRule"
 	instanceGroupManagerAssetType        = "compute.googleapis.com/InstanceGroupManager"
 	computeGlobalForwardingRuleAssetType = "compute.googleapis.com/GlobalForwardingRule"
+	projectAssetType                     = "cloudresourcemanager.googleapis.com/Project"
+	containerClusterAssetType            = "container.googleapis.com/Cluster"
+	containerNodePoolAssetType           = "container.googleapis.com/NodePool"
+	pubsubTopicAssetType                 = "pubsub.googleapis.com/Topic"
+	pubsubSubscriptionAssetType          = "pubsub.googleapis.com/Subscription"
+	kmsKeyRingAssetType                  = "cloudkms.googleapis.com/KeyRing"
+	kmsCryptoKeyAssetType                = "cloudkms.googleapis.com/CryptoKey"
 )
 
 type AssetRepository interface {
@@ -64,25 +71,58 @@ type AssetRepository interface {
 	SearchAllForwardingRules() ([]*assetpb.Asset, error)
 	SearchAllInstanceGroupManagers() ([]*assetpb.Asset, error)
 	SearchAllGlobalForwardingRules() ([]*assetpb.Asset, error)
+	SearchAllContainerClusters() ([]*assetpb.ResourceSearchResult, error)
+	SearchAllContainerNodePools() ([]*assetpb.ResourceSearchResult, error)
+	SearchAllPubsubTopics() ([]*assetpb.ResourceSearchResult, error)
+	SearchAllPubsubSubscriptions() ([]*assetpb.ResourceSearchResult, error)
+	SearchAllKMSKeyRings() ([]*assetpb.ResourceSearchResult, error)
+	SearchAllKMSCryptoKeys() ([]*assetpb.ResourceSearchResult, error)
+	ListAllProjects() ([]*assetpb.Asset, error)
 }
 
 type assetRepository struct {
 	client *asset.Client
-	config config.GCPTerraformConfig
-	cache  cache.Cache
+	// scope is the project, folder or organization searched, e.g. organizations/123
+	scope string
+	// project restricts results to a single project of the scope, e.g. projects/456
+	project string
+	cache   cache.Cache
 }
 
 func NewAssetRepository(client *asset.Client, config config.GCPTerraformConfig, c cache.Cache) *assetRepository {
+	return NewScopedAssetRepository(client, fmt.Sprintf("projects/%s", config.Project), c)
+}
+
+func NewScopedAssetRepository(client *asset.Client, scope string, c cache.Cache) *assetRepository {
 	return &assetRepository{
-		client,
-		config,
-		c,
+		client: client,
+		scope:  scope,
+		cache:  c,
+	}
+}
+
+// ForProject returns a repository restricted to the resources of a project of the scope,
+// the scope is still searched once and the results are shared through the cache
+func (s assetRepository) ForProject(projectNumber string) *assetRepository {
+	s.project = fmt.Sprintf("projects/%s", projectNumber)
+	return &s
+}
+
+func (s assetRepository) inProject(project string) bool {
+	return s.project == "" || s.project == project
+}
+
+// assetProject returns the project an asset belongs to, its closest ancestor
+func assetProject(asset *assetpb.Asset) string {
+	if len(asset.Ancestors) == 0 {
+		return ""
 	}
+	return asset.Ancestors[0]
 }
 
 func (s assetRepository) listAllResources(ty string) ([]*assetpb.Asset, error) {
 	req := &assetpb.ListAssetsRequest{
-		Parent:      fmt.Sprintf("projects/%s", s.config.Project),
+		Parent:      s.scope,
 		ContentType: assetpb.ContentType_RESOURCE,
 		AssetTypes: []string{
 			cloudFunctionsFunction,
@@ -122,7 +162,7 @@ func (s assetRepository) listAllResources(ty string) ([]*assetpb.Asset, error) {
 
 	filteredResults := []*assetpb.Asset{}
 	for _, result := range results {
-		if result.AssetType == ty {
+		if result.AssetType == ty && s.inProject(assetProject(result)) {
 			filteredResults = append(filteredResults, result)
 		}
 	}
@@ -132,7 +172,7 @@ func (s assetRepository) listAllResources(ty string) ([]*assetpb.Asset, error) {
 
 func (s assetRepository) searchAllResources(ty string) ([]*assetpb.ResourceSearchResult, error) {
 	req := &assetpb.SearchAllResourcesRequest{
-		Scope: fmt.Sprintf("projects/%s", s.config.Project),
+		Scope: s.scope,
 		AssetTypes: []string{
 			storageBucketAssetType,
 			computeFirewallAssetType,
@@ -149,6 +189,12 @@ func (s assetRepository) searchAllResources(ty string) ([]*assetpb.ResourceSearc
 			computeImageAssetType,
 			healthCheckAssetType,
 			cloudRunServiceAssetType,
+			containerClusterAssetType,
+			containerNodePoolAssetType,
+			pubsubTopicAssetType,
+			pubsubSubscriptionAssetType,
+			kmsKeyRingAssetType,
+			kmsCryptoKeyAssetType,
 		},
 	}
 	var results []*assetpb.ResourceSearchResult
@@ -177,7 +223,7 @@ func (s assetRepository) searchAllResources(ty string) ([]*assetpb.ResourceSearc
 
 	filteredResults := []*assetpb.ResourceSearchResult{}
 	for _, result := range results {
-		if result.AssetType == ty {
+		if result.AssetType == ty && s.inProject(result.Project) {
 			filteredResults = append(filteredResults, result)
 		}
 	}
@@ -185,6 +231,39 @@ func (s assetRepository) searchAllResources(ty string) ([]*assetpb.ResourceSearc
 	return filteredResults, nil
 }
 
+// ListAllProjects returns the active projects of the scope
+func (s assetRepository) ListAllProjects() ([]*assetpb.Asset, error) {
+	cacheKey := "listAllProjects"
+	if cachedResults := s.cache.Get(cacheKey); cachedResults != nil {
+		return cachedResults.([]*assetpb.Asset), nil
+	}
+
+	req := &assetpb.ListAssetsRequest{
+		Parent:      s.scope,
+		ContentType: assetpb.ContentType_RESOURCE,
+		AssetTypes:  []string{projectAssetType},
+	}
+	results := []*assetpb.Asset{}
+	it := s.client.ListAssets(context.Background(), req)
+	for {
+		resource, err := it.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if resource.GetResource().GetData().GetFields()["lifecycleState"].GetStringValue() != "ACTIVE" {
+			continue
+		}
+		results = append(results, resource)
+	}
+
+	s.cache.Put(cacheKey, results)
+
+	return results, nil
+}
+
 func (s assetRepository) SearchAllBuckets() ([]*assetpb.ResourceSearchResult, error) {
 	return s.searchAllResources(storageBucketAssetType)
 }
@@ -280,3 +359,27 @@ func (s assetRepository) SearchAllInstanceGroupManagers() ([]*assetpb.Asset, err
 func (s assetRepository) SearchAllGlobalForwardingRules() ([]*assetpb.Asset, error) {
 	return s.listAllResources(computeGlobalForwardingRuleAssetType)
 }
+
+func (s assetRepository) SearchAllContainerClusters() ([]*assetpb.ResourceSearchResult, error) {
+	return s.searchAllResources(containerClusterAssetType)
+}
+
+func (s assetRepository) SearchAllContainerNodePools() ([]*assetpb.ResourceSearchResult, error) {
+	return s.searchAllResources(containerNodePoolAssetType)
+}
+
+func (s assetRepository) SearchAllPubsubTopics() ([]*assetpb.ResourceSearchResult, error) {
+	return s.searchAllResources(pubsubTopicAssetType)
+}
+
+func (s assetRepository) SearchAllPubsubSubscriptions() ([]*assetpb.ResourceSearchResult, error) {
+	return s.searchAllResources(pubsubSubscriptionAssetType)
+}
+
+func (s assetRepository) SearchAllKMSKeyRings() ([]*assetpb.ResourceSearchResult, error) {
+	return s.searchAllResources(kmsKeyRingAssetType)
+}
+
+func (s assetRepository) SearchAllKMSCryptoKeys() ([]*assetpb.ResourceSearchResult, error) {
+	return s.searchAllResources(kmsCryptoKeyAssetType)
+}
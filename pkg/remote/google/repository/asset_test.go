@@ -62,3 +62,47 @@ func Test_assetRepository_searchAllResources_CacheMiss(t *testing.T) {
 	assert.Nil(t, err)
 	assert.Len(t, got, 1)
 }
+
+func Test_assetRepository_ForProject(t *testing.T) {
+
+	searchResults := []*assetpb.ResourceSearchResult{
+		{
+			AssetType:   "google_fake_type",
+			DisplayName: "driftctl-unittest-1",
+			Project:     "projects/111111111111",
+		},
+		{
+			AssetType:   "google_fake_type",
+			DisplayName: "driftctl-unittest-2",
+			Project:     "projects/222222222222",
+		},
+	}
+	listResults := []*assetpb.Asset{
+		{
+			AssetType: "google_fake_type",
+			Name:      "driftctl-unittest-1",
+			Ancestors: []string{"projects/111111111111", "organizations/123"},
+		},
+		{
+			AssetType: "google_fake_type",
+			Name:      "driftctl-unittest-2",
+			Ancestors: []string{"projects/222222222222", "organizations/123"},
+		},
+	}
+
+	c := &cache.MockCache{}
+	c.On("GetAndLock", "SearchAllResources").Return(searchResults).Times(1)
+	c.On("Unlock", "SearchAllResources").Times(1)
+	c.On("GetAndLock", "listAllResources").Return(listResults).Times(1)
+	c.On("Unlock", "listAllResources").Times(1)
+	repo := NewScopedAssetRepository(nil, "organizations/123", c).ForProject("222222222222")
+
+	got, err := repo.searchAllResources("google_fake_type")
+	assert.Nil(t, err)
+	assert.Equal(t, []*assetpb.ResourceSearchResult{searchResults[1]}, got)
+
+	gotAssets, err := repo.listAllResources("google_fake_type")
+	assert.Nil(t, err)
+	assert.Equal(t, []*assetpb.Asset{listResults[1]}, gotAssets)
+	c.AssertExpectations(t)
+}
@@ -1,6 +1,8 @@
 package repository
 
 import (
+	"fmt"
+
 	"github.com/snyk/driftctl/pkg/remote/cache"
 	"github.com/snyk/driftctl/pkg/remote/google/config"
 	"google.golang.org/api/cloudresourcemanager/v1"
@@ -25,7 +27,8 @@ func NewCloudResourceManagerRepository(service *cloudresourcemanager.Service, co
 }
 
 func (s *cloudResourceManagerRepository) ListProjectsBindings() (map[string]map[string][]string, error) {
-	if cachedResults := s.cache.Get("ListProjectsBindings"); cachedResults != nil {
+	cacheKey := fmt.Sprintf("ListProjectsBindings_%s", s.config.Project)
+	if cachedResults := s.cache.Get(cacheKey); cachedResults != nil {
 		return cachedResults.(map[string]map[string][]string), nil
 	}
 
@@ -44,7 +47,7 @@ func (s *cloudResourceManagerRepository) ListProjectsBindings() (map[string]map[
 	bindingsByProject := make(map[string]map[string][]string)
 	bindingsByProject[s.config.Project] = bindings
 
-	s.cache.Put("ListProjectsBindings", bindingsByProject)
+	s.cache.Put(cacheKey, bindingsByProject)
 
 	return bindingsByProject, nil
 }
@@ -12,6 +12,29 @@ type MockAssetRepository struct {
 	mock.Mock
 }
 
+// ListAllProjects provides a mock function with given fields:
+func (_m *MockAssetRepository) ListAllProjects() ([]*asset.Asset, error) {
+	ret := _m.Called()
+
+	var r0 []*asset.Asset
+	if rf, ok := ret.Get(0).(func() []*asset.Asset); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*asset.Asset)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // SearchAllAddresses provides a mock function with given fields:
 func (_m *MockAssetRepository) SearchAllAddresses() ([]*asset.ResourceSearchResult, error) {
 	ret := _m.Called()
@@ -127,6 +150,52 @@ func (_m *MockAssetRepository) SearchAllCloudRunServices() ([]*asset.ResourceSea
 	return r0, r1
 }
 
+// SearchAllContainerClusters provides a mock function with given fields:
+func (_m *MockAssetRepository) SearchAllContainerClusters() ([]*asset.ResourceSearchResult, error) {
+	ret := _m.Called()
+
+	var r0 []*asset.ResourceSearchResult
+	if rf, ok := ret.Get(0).(func() []*asset.ResourceSearchResult); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*asset.ResourceSearchResult)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SearchAllContainerNodePools provides a mock function with given fields:
+func (_m *MockAssetRepository) SearchAllContainerNodePools() ([]*asset.ResourceSearchResult, error) {
+	ret := _m.Called()
+
+	var r0 []*asset.ResourceSearchResult
+	if rf, ok := ret.Get(0).(func() []*asset.ResourceSearchResult); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*asset.ResourceSearchResult)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // SearchAllDNSManagedZones provides a mock function with given fields:
 func (_m *MockAssetRepository) SearchAllDNSManagedZones() ([]*asset.ResourceSearchResult, error) {
 	ret := _m.Called()
@@ -426,6 +495,52 @@ func (_m *MockAssetRepository) SearchAllInstances() ([]*asset.ResourceSearchResu
 	return r0, r1
 }
 
+// SearchAllKMSCryptoKeys provides a mock function with given fields:
+func (_m *MockAssetRepository) SearchAllKMSCryptoKeys() ([]*asset.ResourceSearchResult, error) {
+	ret := _m.Called()
+
+	var r0 []*asset.ResourceSearchResult
+	if rf, ok := ret.Get(0).(func() []*asset.ResourceSearchResult); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*asset.ResourceSearchResult)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SearchAllKMSKeyRings provides a mock function with given fields:
+func (_m *MockAssetRepository) SearchAllKMSKeyRings() ([]*asset.ResourceSearchResult, error) {
+	ret := _m.Called()
+
+	var r0 []*asset.ResourceSearchResult
+	if rf, ok := ret.Get(0).(func() []*asset.ResourceSearchResult); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*asset.ResourceSearchResult)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // SearchAllNetworks provides a mock function with given fields:
 func (_m *MockAssetRepository) SearchAllNetworks() ([]*asset.ResourceSearchResult, error) {
 	ret := _m.Called()
@@ -472,6 +587,52 @@ func (_m *MockAssetRepository) SearchAllNodeGroups() ([]*asset.Asset, error) {
 	return r0, r1
 }
 
+// SearchAllPubsubSubscriptions provides a mock function with given fields:
+func (_m *MockAssetRepository) SearchAllPubsubSubscriptions() ([]*asset.ResourceSearchResult, error) {
+	ret := _m.Called()
+
+	var r0 []*asset.ResourceSearchResult
+	if rf, ok := ret.Get(0).(func() []*asset.ResourceSearchResult); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*asset.ResourceSearchResult)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SearchAllPubsubTopics provides a mock function with given fields:
+func (_m *MockAssetRepository) SearchAllPubsubTopics() ([]*asset.ResourceSearchResult, error) {
+	ret := _m.Called()
+
+	var r0 []*asset.ResourceSearchResult
+	if rf, ok := ret.Get(0).(func() []*asset.ResourceSearchResult); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*asset.ResourceSearchResult)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // SearchAllRouters provides a mock function with given fields:
 func (_m *MockAssetRepository) SearchAllRouters() ([]*asset.ResourceSearchResult, error) {
 	ret := _m.Called()
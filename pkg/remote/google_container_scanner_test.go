@@ -0,0 +1,271 @@
+package remote
+
+import (
+	"testing"
+
+	"github.com/snyk/driftctl/mocks"
+	"github.com/snyk/driftctl/pkg/filter"
+	"github.com/snyk/driftctl/pkg/remote/alerts"
+	"github.com/snyk/driftctl/pkg/remote/cache"
+	"github.com/snyk/driftctl/pkg/remote/common"
+	remoteerr "github.com/snyk/driftctl/pkg/remote/error"
+	"github.com/snyk/driftctl/pkg/remote/google"
+	"github.com/snyk/driftctl/pkg/remote/google/repository"
+	"github.com/snyk/driftctl/pkg/resource"
+	googleresource "github.com/snyk/driftctl/pkg/resource/google"
+	"github.com/snyk/driftctl/pkg/terraform"
+	testgoogle "github.com/snyk/driftctl/test/google"
+	testresource "github.com/snyk/driftctl/test/resource"
+	terraform2 "github.com/snyk/driftctl/test/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	assetpb "google.golang.org/genproto/googleapis/cloud/asset/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestGoogleContainerCluster(t *testing.T) {
+
+	cases := []struct {
+		test             string
+		response         []*assetpb.ResourceSearchResult
+		responseErr      error
+		setupAlerterMock func(alerter *mocks.AlerterInterface)
+		wantErr          error
+		assertExpected   func(t *testing.T, got []*resource.Resource)
+	}{
+		{
+			test:     "no resource",
+			response: []*assetpb.ResourceSearchResult{},
+			wantErr:  nil,
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+		},
+		{
+			test: "multiples resources",
+			response: []*assetpb.ResourceSearchResult{
+				{
+					AssetType: "container.googleapis.com/Cluster",
+					Name:      "invalid ID", // Should be ignored
+				},
+				{
+					AssetType:   "container.googleapis.com/Cluster",
+					DisplayName: "driftctl-cluster-1",
+					Name:        "//container.googleapis.com/projects/cloudskiff-dev-elie/locations/us-central1/clusters/driftctl-cluster-1",
+					Location:    "us-central1",
+				},
+				{
+					AssetType:   "container.googleapis.com/Cluster",
+					DisplayName: "driftctl-cluster-2",
+					Name:        "//container.googleapis.com/projects/cloudskiff-dev-elie/zones/us-central1-a/clusters/driftctl-cluster-2",
+					Location:    "us-central1-a",
+				},
+			},
+			wantErr: nil,
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 2)
+
+				assert.Equal(t, got[0].ResourceId(), "projects/cloudskiff-dev-elie/locations/us-central1/clusters/driftctl-cluster-1")
+				assert.Equal(t, got[0].ResourceType(), googleresource.GoogleContainerClusterResourceType)
+
+				assert.Equal(t, got[1].ResourceId(), "projects/cloudskiff-dev-elie/locations/us-central1-a/clusters/driftctl-cluster-2")
+				assert.Equal(t, got[1].ResourceType(), googleresource.GoogleContainerClusterResourceType)
+			},
+		},
+		{
+			test:        "should return access denied error",
+			wantErr:     nil,
+			responseErr: status.Error(codes.PermissionDenied, "The caller does not have permission"),
+			setupAlerterMock: func(alerter *mocks.AlerterInterface) {
+				alerter.On(
+					"SendAlert",
+					googleresource.GoogleContainerClusterResourceType,
+					alerts.NewRemoteAccessDeniedAlert(
+						common.RemoteGoogleTerraform,
+						remoteerr.NewResourceListingError(
+							status.Error(codes.PermissionDenied, "The caller does not have permission"),
+							googleresource.GoogleContainerClusterResourceType,
+						),
+						alerts.EnumerationPhase,
+					),
+				).Once()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+		},
+	}
+
+	providerVersion := "3.78.0"
+	schemaRepository := testresource.InitFakeSchemaRepository("google", providerVersion)
+	googleresource.InitResourcesMetadata(schemaRepository)
+	factory := terraform.NewTerraformResourceFactory(schemaRepository)
+
+	for _, c := range cases {
+		t.Run(c.test, func(tt *testing.T) {
+			scanOptions := ScannerOptions{}
+			providerLibrary := terraform.NewProviderLibrary()
+			remoteLibrary := common.NewRemoteLibrary()
+
+			// Initialize mocks
+			alerter := &mocks.AlerterInterface{}
+			if c.setupAlerterMock != nil {
+				c.setupAlerterMock(alerter)
+			}
+
+			assetClient, err := testgoogle.NewFakeAssetServer(c.response, c.responseErr)
+			if err != nil {
+				tt.Fatal(err)
+			}
+
+			realProvider, err := terraform2.InitTestGoogleProvider(providerLibrary, providerVersion)
+			if err != nil {
+				tt.Fatal(err)
+			}
+
+			repo := repository.NewAssetRepository(assetClient, realProvider.GetConfig(), cache.New(0))
+
+			remoteLibrary.AddEnumerator(google.NewGoogleContainerClusterEnumerator(repo, factory))
+
+			testFilter := &filter.MockFilter{}
+			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
+
+			s := NewScanner(remoteLibrary, alerter, scanOptions, testFilter)
+			got, err := s.Resources()
+			assert.Equal(tt, c.wantErr, err)
+			if err != nil {
+				return
+			}
+
+			alerter.AssertExpectations(tt)
+			testFilter.AssertExpectations(tt)
+			if c.assertExpected != nil {
+				c.assertExpected(t, got)
+			}
+		})
+	}
+}
+
+func TestGoogleContainerNodePool(t *testing.T) {
+
+	cases := []struct {
+		test             string
+		response         []*assetpb.ResourceSearchResult
+		responseErr      error
+		setupAlerterMock func(alerter *mocks.AlerterInterface)
+		wantErr          error
+		assertExpected   func(t *testing.T, got []*resource.Resource)
+	}{
+		{
+			test:     "no resource",
+			response: []*assetpb.ResourceSearchResult{},
+			wantErr:  nil,
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+		},
+		{
+			test: "multiples resources",
+			response: []*assetpb.ResourceSearchResult{
+				{
+					AssetType: "container.googleapis.com/NodePool",
+					Name:      "invalid ID", // Should be ignored
+				},
+				{
+					AssetType:   "container.googleapis.com/NodePool",
+					DisplayName: "driftctl-pool-1",
+					Name:        "//container.googleapis.com/projects/cloudskiff-dev-elie/locations/us-central1/clusters/driftctl-cluster-1/nodePools/driftctl-pool-1",
+					Location:    "us-central1",
+				},
+				{
+					AssetType:   "container.googleapis.com/NodePool",
+					DisplayName: "driftctl-pool-2",
+					Name:        "//container.googleapis.com/projects/cloudskiff-dev-elie/zones/us-central1-a/clusters/driftctl-cluster-2/nodePools/driftctl-pool-2",
+					Location:    "us-central1-a",
+				},
+			},
+			wantErr: nil,
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 2)
+
+				assert.Equal(t, got[0].ResourceId(), "projects/cloudskiff-dev-elie/locations/us-central1/clusters/driftctl-cluster-1/nodePools/driftctl-pool-1")
+				assert.Equal(t, got[0].ResourceType(), googleresource.GoogleContainerNodePoolResourceType)
+
+				assert.Equal(t, got[1].ResourceId(), "projects/cloudskiff-dev-elie/locations/us-central1-a/clusters/driftctl-cluster-2/nodePools/driftctl-pool-2")
+				assert.Equal(t, got[1].ResourceType(), googleresource.GoogleContainerNodePoolResourceType)
+			},
+		},
+		{
+			test:        "should return access denied error",
+			wantErr:     nil,
+			responseErr: status.Error(codes.PermissionDenied, "The caller does not have permission"),
+			setupAlerterMock: func(alerter *mocks.AlerterInterface) {
+				alerter.On(
+					"SendAlert",
+					googleresource.GoogleContainerNodePoolResourceType,
+					alerts.NewRemoteAccessDeniedAlert(
+						common.RemoteGoogleTerraform,
+						remoteerr.NewResourceListingError(
+							status.Error(codes.PermissionDenied, "The caller does not have permission"),
+							googleresource.GoogleContainerNodePoolResourceType,
+						),
+						alerts.EnumerationPhase,
+					),
+				).Once()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+		},
+	}
+
+	providerVersion := "3.78.0"
+	schemaRepository := testresource.InitFakeSchemaRepository("google", providerVersion)
+	googleresource.InitResourcesMetadata(schemaRepository)
+	factory := terraform.NewTerraformResourceFactory(schemaRepository)
+
+	for _, c := range cases {
+		t.Run(c.test, func(tt *testing.T) {
+			scanOptions := ScannerOptions{}
+			providerLibrary := terraform.NewProviderLibrary()
+			remoteLibrary := common.NewRemoteLibrary()
+
+			// Initialize mocks
+			alerter := &mocks.AlerterInterface{}
+			if c.setupAlerterMock != nil {
+				c.setupAlerterMock(alerter)
+			}
+
+			assetClient, err := testgoogle.NewFakeAssetServer(c.response, c.responseErr)
+			if err != nil {
+				tt.Fatal(err)
+			}
+
+			realProvider, err := terraform2.InitTestGoogleProvider(providerLibrary, providerVersion)
+			if err != nil {
+				tt.Fatal(err)
+			}
+
+			repo := repository.NewAssetRepository(assetClient, realProvider.GetConfig(), cache.New(0))
+
+			remoteLibrary.AddEnumerator(google.NewGoogleContainerNodePoolEnumerator(repo, factory))
+
+			testFilter := &filter.MockFilter{}
+			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
+
+			s := NewScanner(remoteLibrary, alerter, scanOptions, testFilter)
+			got, err := s.Resources()
+			assert.Equal(tt, c.wantErr, err)
+			if err != nil {
+				return
+			}
+
+			alerter.AssertExpectations(tt)
+			testFilter.AssertExpectations(tt)
+			if c.assertExpected != nil {
+				c.assertExpected(t, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,263 @@
+package remote
+
+import (
+	"testing"
+
+	"github.com/snyk/driftctl/mocks"
+	"github.com/snyk/driftctl/pkg/filter"
+	"github.com/snyk/driftctl/pkg/remote/alerts"
+	"github.com/snyk/driftctl/pkg/remote/cache"
+	"github.com/snyk/driftctl/pkg/remote/common"
+	remoteerr "github.com/snyk/driftctl/pkg/remote/error"
+	"github.com/snyk/driftctl/pkg/remote/google"
+	"github.com/snyk/driftctl/pkg/remote/google/repository"
+	"github.com/snyk/driftctl/pkg/resource"
+	googleresource "github.com/snyk/driftctl/pkg/resource/google"
+	"github.com/snyk/driftctl/pkg/terraform"
+	testgoogle "github.com/snyk/driftctl/test/google"
+	testresource "github.com/snyk/driftctl/test/resource"
+	terraform2 "github.com/snyk/driftctl/test/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	assetpb "google.golang.org/genproto/googleapis/cloud/asset/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestGoogleKMSKeyRing(t *testing.T) {
+
+	cases := []struct {
+		test             string
+		response         []*assetpb.ResourceSearchResult
+		responseErr      error
+		setupAlerterMock func(alerter *mocks.AlerterInterface)
+		wantErr          error
+		assertExpected   func(t *testing.T, got []*resource.Resource)
+	}{
+		{
+			test:     "no resource",
+			response: []*assetpb.ResourceSearchResult{},
+			wantErr:  nil,
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+		},
+		{
+			test: "multiples resources",
+			response: []*assetpb.ResourceSearchResult{
+				{
+					AssetType:   "cloudkms.googleapis.com/KeyRing",
+					DisplayName: "driftctl-keyring-1",
+					Name:        "//cloudkms.googleapis.com/projects/cloudskiff-dev-elie/locations/us-central1/keyRings/driftctl-keyring-1",
+					Location:    "us-central1",
+				},
+				{
+					AssetType:   "cloudkms.googleapis.com/KeyRing",
+					DisplayName: "driftctl-keyring-2",
+					Name:        "//cloudkms.googleapis.com/projects/cloudskiff-dev-elie/locations/us-central1/keyRings/driftctl-keyring-2",
+					Location:    "us-central1",
+				},
+			},
+			wantErr: nil,
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 2)
+
+				assert.Equal(t, got[0].ResourceId(), "projects/cloudskiff-dev-elie/locations/us-central1/keyRings/driftctl-keyring-1")
+				assert.Equal(t, got[0].ResourceType(), googleresource.GoogleKMSKeyRingResourceType)
+
+				assert.Equal(t, got[1].ResourceId(), "projects/cloudskiff-dev-elie/locations/us-central1/keyRings/driftctl-keyring-2")
+				assert.Equal(t, got[1].ResourceType(), googleresource.GoogleKMSKeyRingResourceType)
+			},
+		},
+		{
+			test:        "should return access denied error",
+			wantErr:     nil,
+			responseErr: status.Error(codes.PermissionDenied, "The caller does not have permission"),
+			setupAlerterMock: func(alerter *mocks.AlerterInterface) {
+				alerter.On(
+					"SendAlert",
+					googleresource.GoogleKMSKeyRingResourceType,
+					alerts.NewRemoteAccessDeniedAlert(
+						common.RemoteGoogleTerraform,
+						remoteerr.NewResourceListingError(
+							status.Error(codes.PermissionDenied, "The caller does not have permission"),
+							googleresource.GoogleKMSKeyRingResourceType,
+						),
+						alerts.EnumerationPhase,
+					),
+				).Once()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+		},
+	}
+
+	providerVersion := "3.78.0"
+	schemaRepository := testresource.InitFakeSchemaRepository("google", providerVersion)
+	googleresource.InitResourcesMetadata(schemaRepository)
+	factory := terraform.NewTerraformResourceFactory(schemaRepository)
+
+	for _, c := range cases {
+		t.Run(c.test, func(tt *testing.T) {
+			scanOptions := ScannerOptions{}
+			providerLibrary := terraform.NewProviderLibrary()
+			remoteLibrary := common.NewRemoteLibrary()
+
+			// Initialize mocks
+			alerter := &mocks.AlerterInterface{}
+			if c.setupAlerterMock != nil {
+				c.setupAlerterMock(alerter)
+			}
+
+			assetClient, err := testgoogle.NewFakeAssetServer(c.response, c.responseErr)
+			if err != nil {
+				tt.Fatal(err)
+			}
+
+			realProvider, err := terraform2.InitTestGoogleProvider(providerLibrary, providerVersion)
+			if err != nil {
+				tt.Fatal(err)
+			}
+
+			repo := repository.NewAssetRepository(assetClient, realProvider.GetConfig(), cache.New(0))
+
+			remoteLibrary.AddEnumerator(google.NewGoogleKMSKeyRingEnumerator(repo, factory))
+
+			testFilter := &filter.MockFilter{}
+			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
+
+			s := NewScanner(remoteLibrary, alerter, scanOptions, testFilter)
+			got, err := s.Resources()
+			assert.Equal(tt, c.wantErr, err)
+			if err != nil {
+				return
+			}
+
+			alerter.AssertExpectations(tt)
+			testFilter.AssertExpectations(tt)
+			if c.assertExpected != nil {
+				c.assertExpected(t, got)
+			}
+		})
+	}
+}
+
+func TestGoogleKMSCryptoKey(t *testing.T) {
+
+	cases := []struct {
+		test             string
+		response         []*assetpb.ResourceSearchResult
+		responseErr      error
+		setupAlerterMock func(alerter *mocks.AlerterInterface)
+		wantErr          error
+		assertExpected   func(t *testing.T, got []*resource.Resource)
+	}{
+		{
+			test:     "no resource",
+			response: []*assetpb.ResourceSearchResult{},
+			wantErr:  nil,
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+		},
+		{
+			test: "multiples resources",
+			response: []*assetpb.ResourceSearchResult{
+				{
+					AssetType:   "cloudkms.googleapis.com/CryptoKey",
+					DisplayName: "driftctl-key-1",
+					Name:        "//cloudkms.googleapis.com/projects/cloudskiff-dev-elie/locations/us-central1/keyRings/driftctl-keyring/cryptoKeys/driftctl-key-1",
+					Location:    "us-central1",
+				},
+				{
+					AssetType:   "cloudkms.googleapis.com/CryptoKey",
+					DisplayName: "driftctl-key-2",
+					Name:        "//cloudkms.googleapis.com/projects/cloudskiff-dev-elie/locations/us-central1/keyRings/driftctl-keyring/cryptoKeys/driftctl-key-2",
+					Location:    "us-central1",
+				},
+			},
+			wantErr: nil,
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 2)
+
+				assert.Equal(t, got[0].ResourceId(), "projects/cloudskiff-dev-elie/locations/us-central1/keyRings/driftctl-keyring/cryptoKeys/driftctl-key-1")
+				assert.Equal(t, got[0].ResourceType(), googleresource.GoogleKMSCryptoKeyResourceType)
+
+				assert.Equal(t, got[1].ResourceId(), "projects/cloudskiff-dev-elie/locations/us-central1/keyRings/driftctl-keyring/cryptoKeys/driftctl-key-2")
+				assert.Equal(t, got[1].ResourceType(), googleresource.GoogleKMSCryptoKeyResourceType)
+			},
+		},
+		{
+			test:        "should return access denied error",
+			wantErr:     nil,
+			responseErr: status.Error(codes.PermissionDenied, "The caller does not have permission"),
+			setupAlerterMock: func(alerter *mocks.AlerterInterface) {
+				alerter.On(
+					"SendAlert",
+					googleresource.GoogleKMSCryptoKeyResourceType,
+					alerts.NewRemoteAccessDeniedAlert(
+						common.RemoteGoogleTerraform,
+						remoteerr.NewResourceListingError(
+							status.Error(codes.PermissionDenied, "The caller does not have permission"),
+							googleresource.GoogleKMSCryptoKeyResourceType,
+						),
+						alerts.EnumerationPhase,
+					),
+				).Once()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+		},
+	}
+
+	providerVersion := "3.78.0"
+	schemaRepository := testresource.InitFakeSchemaRepository("google", providerVersion)
+	googleresource.InitResourcesMetadata(schemaRepository)
+	factory := terraform.NewTerraformResourceFactory(schemaRepository)
+
+	for _, c := range cases {
+		t.Run(c.test, func(tt *testing.T) {
+			scanOptions := ScannerOptions{}
+			providerLibrary := terraform.NewProviderLibrary()
+			remoteLibrary := common.NewRemoteLibrary()
+
+			// Initialize mocks
+			alerter := &mocks.AlerterInterface{}
+			if c.setupAlerterMock != nil {
+				c.setupAlerterMock(alerter)
+			}
+
+			assetClient, err := testgoogle.NewFakeAssetServer(c.response, c.responseErr)
+			if err != nil {
+				tt.Fatal(err)
+			}
+
+			realProvider, err := terraform2.InitTestGoogleProvider(providerLibrary, providerVersion)
+			if err != nil {
+				tt.Fatal(err)
+			}
+
+			repo := repository.NewAssetRepository(assetClient, realProvider.GetConfig(), cache.New(0))
+
+			remoteLibrary.AddEnumerator(google.NewGoogleKMSCryptoKeyEnumerator(repo, factory))
+
+			testFilter := &filter.MockFilter{}
+			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
+
+			s := NewScanner(remoteLibrary, alerter, scanOptions, testFilter)
+			got, err := s.Resources()
+			assert.Equal(tt, c.wantErr, err)
+			if err != nil {
+				return
+			}
+
+			alerter.AssertExpectations(tt)
+			testFilter.AssertExpectations(tt)
+			if c.assertExpected != nil {
+				c.assertExpected(t, got)
+			}
+		})
+	}
+}
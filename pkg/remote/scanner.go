@@ -113,6 +113,10 @@ func (s *Scanner) scan() ([]*resource.Resource, error) {
 				}
 				return []*resource.Resource{}, nil
 			}
+			if resourceWithDetails != nil {
+				resourceWithDetails.Account = res.Account
+				resourceWithDetails.Region = res.Region
+			}
 			return []*resource.Resource{resourceWithDetails}, nil
 		})
 	}
@@ -0,0 +1,11 @@
+provider "aws" {
+  region = "us-east-1"
+}
+
+resource "aws_ecs_cluster" "foo" {
+  name = "foo"
+}
+
+resource "aws_ecs_cluster" "bar" {
+  name = "bar"
+}
@@ -0,0 +1,50 @@
+provider "aws" {
+  region = "us-east-1"
+}
+
+resource "aws_ecs_cluster" "foo" {
+  name = "foo"
+}
+
+resource "aws_ecs_cluster" "bar" {
+  name = "bar"
+}
+
+resource "aws_ecs_service" "foo_web" {
+  name            = "web"
+  cluster         = aws_ecs_cluster.foo.arn
+  task_definition = "service:2"
+  desired_count   = 2
+  launch_type     = "FARGATE"
+
+  network_configuration {
+    subnets         = ["subnet-05810d3f933925f6d"]
+    security_groups = ["sg-0a3b5c7d9e1f2a4b6"]
+  }
+}
+
+resource "aws_ecs_service" "foo_worker" {
+  name            = "worker"
+  cluster         = aws_ecs_cluster.foo.arn
+  task_definition = "worker:7"
+  desired_count   = 1
+  launch_type     = "FARGATE"
+
+  network_configuration {
+    subnets         = ["subnet-05810d3f933925f6d"]
+    security_groups = ["sg-0a3b5c7d9e1f2a4b6"]
+  }
+}
+
+resource "aws_ecs_service" "bar_web" {
+  name            = "web"
+  cluster         = aws_ecs_cluster.bar.arn
+  task_definition = "service:2"
+  desired_count   = 1
+  launch_type     = "FARGATE"
+
+  network_configuration {
+    subnets         = ["subnet-05810d3f933925f6d"]
+    security_groups = ["sg-0a3b5c7d9e1f2a4b6"]
+  }
+}
@@ -0,0 +1,35 @@
+provider "aws" {
+  region = "us-east-1"
+}
+
+resource "aws_ecs_task_definition" "service" {
+  family                   = "service"
+  requires_compatibilities = ["FARGATE"]
+  network_mode             = "awsvpc"
+  cpu                      = 256
+  memory                   = 512
+  execution_role_arn       = "arn:aws:iam::929327065333:role/ecsTaskExecutionRole"
+  container_definitions = jsonencode([
+    {
+      name      = "service"
+      image     = "nginx:1.21"
+      essential = true
+    }
+  ])
+}
+
+resource "aws_ecs_task_definition" "worker" {
+  family                   = "worker"
+  requires_compatibilities = ["FARGATE"]
+  network_mode             = "awsvpc"
+  cpu                      = 256
+  memory                   = 512
+  execution_role_arn       = "arn:aws:iam::929327065333:role/ecsTaskExecutionRole"
+  container_definitions = jsonencode([
+    {
+      name      = "worker"
+      image     = "busybox:1.34"
+      essential = true
+    }
+  ])
+}
@@ -0,0 +1,28 @@
+provider "aws" {
+  region = "us-east-1"
+}
+
+resource "aws_efs_file_system" "shared_data" {
+  creation_token = "shared-data"
+  encrypted      = true
+
+  lifecycle_policy {
+    transition_to_ia = "AFTER_30_DAYS"
+  }
+
+  tags = {
+    Name = "shared-data"
+  }
+}
+
+resource "aws_efs_file_system" "backups" {
+  creation_token = "backups"
+
+  lifecycle_policy {
+    transition_to_ia = "AFTER_30_DAYS"
+  }
+
+  tags = {
+    Name = "backups"
+  }
+}
@@ -0,0 +1,21 @@
+provider "aws" {
+  region = "us-east-1"
+}
+
+resource "aws_efs_mount_target" "shared_data_a" {
+  file_system_id  = "fs-0a1b2c3d"
+  subnet_id       = "subnet-0a1b2c3d"
+  security_groups = ["sg-0f1e2d3c4b5a69788"]
+}
+
+resource "aws_efs_mount_target" "shared_data_b" {
+  file_system_id  = "fs-0a1b2c3d"
+  subnet_id       = "subnet-1b2c3d4e"
+  security_groups = ["sg-0f1e2d3c4b5a69788"]
+}
+
+resource "aws_efs_mount_target" "backups_a" {
+  file_system_id  = "fs-1b2c3d4e"
+  subnet_id       = "subnet-0a1b2c3d"
+  security_groups = ["sg-0f1e2d3c4b5a69788"]
+}
@@ -0,0 +1,23 @@
+provider "aws" {
+  region = "us-east-1"
+}
+
+resource "aws_eks_cluster" "foo" {
+  name     = "foo"
+  role_arn = "arn:aws:iam::929327065333:role/eks-cluster"
+  version  = "1.21"
+
+  vpc_config {
+    subnet_ids = ["subnet-05810d3f933925f6d", "subnet-0b13f1e0eacf67424"]
+  }
+}
+
+resource "aws_eks_cluster" "bar" {
+  name     = "bar"
+  role_arn = "arn:aws:iam::929327065333:role/eks-cluster"
+  version  = "1.21"
+
+  vpc_config {
+    subnet_ids = ["subnet-05810d3f933925f6d", "subnet-0b13f1e0eacf67424"]
+  }
+}
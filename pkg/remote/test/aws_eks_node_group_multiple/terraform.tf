@@ -0,0 +1,45 @@
+provider "aws" {
+  region = "us-east-1"
+}
+
+resource "aws_eks_node_group" "foo_default" {
+  cluster_name    = "foo"
+  node_group_name = "default"
+  node_role_arn   = "arn:aws:iam::929327065333:role/eks-node-group"
+  subnet_ids      = ["subnet-05810d3f933925f6d", "subnet-0b13f1e0eacf67424"]
+  instance_types  = ["t3.medium"]
+
+  scaling_config {
+    desired_size = 2
+    max_size     = 3
+    min_size     = 1
+  }
+}
+
+resource "aws_eks_node_group" "foo_spot" {
+  cluster_name    = "foo"
+  node_group_name = "spot"
+  node_role_arn   = "arn:aws:iam::929327065333:role/eks-node-group"
+  subnet_ids      = ["subnet-05810d3f933925f6d", "subnet-0b13f1e0eacf67424"]
+  instance_types  = ["t3.large"]
+
+  scaling_config {
+    desired_size = 1
+    max_size     = 3
+    min_size     = 1
+  }
+}
+
+resource "aws_eks_node_group" "bar_default" {
+  cluster_name    = "bar"
+  node_group_name = "default"
+  node_role_arn   = "arn:aws:iam::929327065333:role/eks-node-group"
+  subnet_ids      = ["subnet-05810d3f933925f6d", "subnet-0b13f1e0eacf67424"]
+  instance_types  = ["t3.medium"]
+
+  scaling_config {
+    desired_size = 1
+    max_size     = 3
+    min_size     = 1
+  }
+}
@@ -0,0 +1,27 @@
+provider "aws" {
+  region = "us-east-1"
+}
+
+resource "aws_elasticache_cluster" "sessions" {
+  cluster_id           = "sessions"
+  engine               = "redis"
+  node_type            = "cache.t3.micro"
+  num_cache_nodes      = 1
+  parameter_group_name = "default.redis6.x"
+  engine_version       = "6.x"
+  port                 = 6379
+  subnet_group_name    = "cache-subnets"
+  security_group_ids   = ["sg-0d4f6a8b1c3e5a7b9"]
+}
+
+resource "aws_elasticache_cluster" "rate_limiter" {
+  cluster_id           = "rate-limiter"
+  engine               = "redis"
+  node_type            = "cache.t3.micro"
+  num_cache_nodes      = 1
+  parameter_group_name = "default.redis6.x"
+  engine_version       = "6.x"
+  port                 = 6379
+  subnet_group_name    = "cache-subnets"
+  security_group_ids   = ["sg-0d4f6a8b1c3e5a7b9"]
+}
@@ -0,0 +1,30 @@
+provider "aws" {
+  region = "us-east-1"
+}
+
+resource "aws_elasticache_replication_group" "sessions" {
+  replication_group_id          = "sessions-cache"
+  replication_group_description = "sessions-cache replication group"
+  node_type                     = "cache.t3.small"
+  number_cache_clusters         = 2
+  automatic_failover_enabled    = true
+  at_rest_encryption_enabled    = true
+  engine_version                = "6.x"
+  parameter_group_name          = "default.redis6.x"
+  subnet_group_name             = "cache-subnets"
+  security_group_ids            = ["sg-0d4f6a8b1c3e5a7b9"]
+  snapshot_retention_limit      = 1
+}
+
+resource "aws_elasticache_replication_group" "leaderboard" {
+  replication_group_id          = "leaderboard"
+  replication_group_description = "leaderboard replication group"
+  node_type                     = "cache.t3.small"
+  number_cache_clusters         = 1
+  at_rest_encryption_enabled    = true
+  engine_version                = "6.x"
+  parameter_group_name          = "default.redis6.x"
+  subnet_group_name             = "cache-subnets"
+  security_group_ids            = ["sg-0d4f6a8b1c3e5a7b9"]
+  snapshot_retention_limit      = 1
+}
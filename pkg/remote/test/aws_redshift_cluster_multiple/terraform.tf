@@ -0,0 +1,30 @@
+provider "aws" {
+  region = "us-east-1"
+}
+
+resource "aws_redshift_cluster" "analytics" {
+  cluster_identifier        = "analytics"
+  database_name             = "dev"
+  master_username           = "admin"
+  master_password           = "Mustbe8characters"
+  node_type                 = "dc2.large"
+  cluster_type              = "multi-node"
+  number_of_nodes           = 2
+  encrypted                 = true
+  cluster_subnet_group_name = "redshift-subnets"
+  vpc_security_group_ids    = ["sg-0b2c4d6e8f0a1b3c5"]
+  skip_final_snapshot       = true
+}
+
+resource "aws_redshift_cluster" "reporting" {
+  cluster_identifier        = "reporting"
+  database_name             = "dev"
+  master_username           = "admin"
+  master_password           = "Mustbe8characters"
+  node_type                 = "dc2.large"
+  cluster_type              = "single-node"
+  encrypted                 = true
+  cluster_subnet_group_name = "redshift-subnets"
+  vpc_security_group_ids    = ["sg-0b2c4d6e8f0a1b3c5"]
+  skip_final_snapshot       = true
+}
@@ -0,0 +1,24 @@
+package aws
+
+import "github.com/snyk/driftctl/pkg/resource"
+
+const AwsAcmCertificateResourceType = "aws_acm_certificate"
+
+func initAwsAcmCertificateMetaData(resourceSchemaRepository resource.SchemaRepositoryInterface) {
+	resourceSchemaRepository.SetNormalizeFunc(AwsAcmCertificateResourceType, func(res *resource.Resource) {
+		val := res.Attrs
+		// The material of imported certificates is never returned by AWS
+		val.SafeDelete([]string{"private_key"})
+		val.SafeDelete([]string{"certificate_body"})
+		val.SafeDelete([]string{"certificate_chain"})
+	})
+	resourceSchemaRepository.SetHumanReadableAttributesFunc(AwsAcmCertificateResourceType, func(res *resource.Resource) map[string]string {
+		val := res.Attrs
+		attrs := make(map[string]string)
+		if domain := val.GetString("domain_name"); domain != nil && *domain != "" {
+			attrs["Domain"] = *domain
+		}
+		return attrs
+	})
+	resourceSchemaRepository.SetFlags(AwsAcmCertificateResourceType, resource.FlagDeepMode)
+}
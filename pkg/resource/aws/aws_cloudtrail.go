@@ -0,0 +1,9 @@
+package aws
+
+import "github.com/snyk/driftctl/pkg/resource"
+
+const AwsCloudtrailResourceType = "aws_cloudtrail"
+
+func initAwsCloudtrailMetaData(resourceSchemaRepository resource.SchemaRepositoryInterface) {
+	resourceSchemaRepository.SetFlags(AwsCloudtrailResourceType, resource.FlagDeepMode)
+}
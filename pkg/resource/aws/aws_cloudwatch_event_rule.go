@@ -0,0 +1,26 @@
+package aws
+
+import (
+	"github.com/snyk/driftctl/pkg/helpers"
+	"github.com/snyk/driftctl/pkg/resource"
+)
+
+const AwsCloudwatchEventRuleResourceType = "aws_cloudwatch_event_rule"
+
+func initAwsCloudwatchEventRuleMetaData(resourceSchemaRepository resource.SchemaRepositoryInterface) {
+	resourceSchemaRepository.UpdateSchema(AwsCloudwatchEventRuleResourceType, map[string]func(attributeSchema *resource.AttributeSchema){
+		"event_pattern": func(attributeSchema *resource.AttributeSchema) {
+			attributeSchema.JsonString = true
+		},
+	})
+	resourceSchemaRepository.SetNormalizeFunc(AwsCloudwatchEventRuleResourceType, func(res *resource.Resource) {
+		val := res.Attrs
+		val.SafeDelete([]string{"name_prefix"})
+		jsonString, err := helpers.NormalizeJsonString((*val)["event_pattern"])
+		if err != nil {
+			return
+		}
+		_ = val.SafeSet([]string{"event_pattern"}, jsonString)
+	})
+	resourceSchemaRepository.SetFlags(AwsCloudwatchEventRuleResourceType, resource.FlagDeepMode)
+}
@@ -0,0 +1,42 @@
+package aws
+
+import (
+	"github.com/snyk/driftctl/pkg/helpers"
+	"github.com/snyk/driftctl/pkg/resource"
+)
+
+const AwsCloudwatchEventTargetResourceType = "aws_cloudwatch_event_target"
+
+func initAwsCloudwatchEventTargetMetaData(resourceSchemaRepository resource.SchemaRepositoryInterface) {
+	resourceSchemaRepository.SetResolveReadAttributesFunc(AwsCloudwatchEventTargetResourceType, func(res *resource.Resource) map[string]string {
+		return map[string]string{
+			"rule":      *res.Attributes().GetString("rule"),
+			"target_id": *res.Attributes().GetString("target_id"),
+		}
+	})
+	resourceSchemaRepository.UpdateSchema(AwsCloudwatchEventTargetResourceType, map[string]func(attributeSchema *resource.AttributeSchema){
+		"input": func(attributeSchema *resource.AttributeSchema) {
+			attributeSchema.JsonString = true
+		},
+	})
+	resourceSchemaRepository.SetNormalizeFunc(AwsCloudwatchEventTargetResourceType, func(res *resource.Resource) {
+		val := res.Attrs
+		jsonString, err := helpers.NormalizeJsonString((*val)["input"])
+		if err != nil {
+			return
+		}
+		_ = val.SafeSet([]string{"input"}, jsonString)
+	})
+	resourceSchemaRepository.SetHumanReadableAttributesFunc(AwsCloudwatchEventTargetResourceType, func(res *resource.Resource) map[string]string {
+		val := res.Attrs
+		attrs := make(map[string]string)
+		if rule := val.GetString("rule"); rule != nil && *rule != "" {
+			attrs["Rule"] = *rule
+		}
+		if arn := val.GetString("arn"); arn != nil && *arn != "" {
+			attrs["Target"] = *arn
+		}
+		return attrs
+	})
+	resourceSchemaRepository.SetFlags(AwsCloudwatchEventTargetResourceType, resource.FlagDeepMode)
+}
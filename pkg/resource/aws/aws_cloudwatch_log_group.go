@@ -0,0 +1,13 @@
+package aws
+
+import "github.com/snyk/driftctl/pkg/resource"
+
+const AwsCloudwatchLogGroupResourceType = "aws_cloudwatch_log_group"
+
+func initAwsCloudwatchLogGroupMetaData(resourceSchemaRepository resource.SchemaRepositoryInterface) {
+	resourceSchemaRepository.SetNormalizeFunc(AwsCloudwatchLogGroupResourceType, func(res *resource.Resource) {
+		val := res.Attrs
+		val.SafeDelete([]string{"name_prefix"})
+	})
+	resourceSchemaRepository.SetFlags(AwsCloudwatchLogGroupResourceType, resource.FlagDeepMode)
+}
@@ -0,0 +1,23 @@
+package aws
+
+import "github.com/snyk/driftctl/pkg/resource"
+
+const AwsCloudwatchLogMetricFilterResourceType = "aws_cloudwatch_log_metric_filter"
+
+func initAwsCloudwatchLogMetricFilterMetaData(resourceSchemaRepository resource.SchemaRepositoryInterface) {
+	resourceSchemaRepository.SetResolveReadAttributesFunc(AwsCloudwatchLogMetricFilterResourceType, func(res *resource.Resource) map[string]string {
+		return map[string]string{
+			"name":           *res.Attributes().GetString("name"),
+			"log_group_name": *res.Attributes().GetString("log_group_name"),
+		}
+	})
+	resourceSchemaRepository.SetHumanReadableAttributesFunc(AwsCloudwatchLogMetricFilterResourceType, func(res *resource.Resource) map[string]string {
+		val := res.Attrs
+		attrs := make(map[string]string)
+		if logGroup := val.GetString("log_group_name"); logGroup != nil && *logGroup != "" {
+			attrs["LogGroup"] = *logGroup
+		}
+		return attrs
+	})
+	resourceSchemaRepository.SetFlags(AwsCloudwatchLogMetricFilterResourceType, resource.FlagDeepMode)
+}
@@ -0,0 +1,9 @@
+package aws
+
+import "github.com/snyk/driftctl/pkg/resource"
+
+const AwsCloudwatchMetricAlarmResourceType = "aws_cloudwatch_metric_alarm"
+
+func initAwsCloudwatchMetricAlarmMetaData(resourceSchemaRepository resource.SchemaRepositoryInterface) {
+	resourceSchemaRepository.SetFlags(AwsCloudwatchMetricAlarmResourceType, resource.FlagDeepMode)
+}
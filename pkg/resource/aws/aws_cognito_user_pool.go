@@ -0,0 +1,21 @@
+package aws
+
+import "github.com/snyk/driftctl/pkg/resource"
+
+const AwsCognitoUserPoolResourceType = "aws_cognito_user_pool"
+
+func initAwsCognitoUserPoolMetaData(resourceSchemaRepository resource.SchemaRepositoryInterface) {
+	resourceSchemaRepository.SetNormalizeFunc(AwsCognitoUserPoolResourceType, func(res *resource.Resource) {
+		val := res.Attrs
+		val.SafeDelete([]string{"last_modified_date"})
+	})
+	resourceSchemaRepository.SetHumanReadableAttributesFunc(AwsCognitoUserPoolResourceType, func(res *resource.Resource) map[string]string {
+		val := res.Attrs
+		attrs := make(map[string]string)
+		if name := val.GetString("name"); name != nil && *name != "" {
+			attrs["Name"] = *name
+		}
+		return attrs
+	})
+	resourceSchemaRepository.SetFlags(AwsCognitoUserPoolResourceType, resource.FlagDeepMode)
+}
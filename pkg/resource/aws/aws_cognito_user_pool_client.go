@@ -0,0 +1,31 @@
+package aws
+
+import "github.com/snyk/driftctl/pkg/resource"
+
+const AwsCognitoUserPoolClientResourceType = "aws_cognito_user_pool_client"
+
+func initAwsCognitoUserPoolClientMetaData(resourceSchemaRepository resource.SchemaRepositoryInterface) {
+	// A client is read from the user pool it belongs to
+	resourceSchemaRepository.SetResolveReadAttributesFunc(AwsCognitoUserPoolClientResourceType, func(res *resource.Resource) map[string]string {
+		return map[string]string{
+			"user_pool_id": *res.Attributes().GetString("user_pool_id"),
+		}
+	})
+	resourceSchemaRepository.SetNormalizeFunc(AwsCognitoUserPoolClientResourceType, func(res *resource.Resource) {
+		val := res.Attrs
+		// The secret of a client must not end up in diffs nor outputs
+		val.SafeDelete([]string{"client_secret"})
+	})
+	resourceSchemaRepository.SetHumanReadableAttributesFunc(AwsCognitoUserPoolClientResourceType, func(res *resource.Resource) map[string]string {
+		val := res.Attrs
+		attrs := make(map[string]string)
+		if name := val.GetString("name"); name != nil && *name != "" {
+			attrs["Name"] = *name
+		}
+		if userPoolID := val.GetString("user_pool_id"); userPoolID != nil && *userPoolID != "" {
+			attrs["UserPool"] = *userPoolID
+		}
+		return attrs
+	})
+	resourceSchemaRepository.SetFlags(AwsCognitoUserPoolClientResourceType, resource.FlagDeepMode)
+}
@@ -0,0 +1,13 @@
+package aws
+
+import "github.com/snyk/driftctl/pkg/resource"
+
+const AwsEc2TransitGatewayResourceType = "aws_ec2_transit_gateway"
+
+func initAwsEc2TransitGatewayMetaData(resourceSchemaRepository resource.SchemaRepositoryInterface) {
+	resourceSchemaRepository.SetNormalizeFunc(AwsEc2TransitGatewayResourceType, func(res *resource.Resource) {
+		val := res.Attrs
+		val.SafeDelete([]string{"timeouts"})
+	})
+	resourceSchemaRepository.SetFlags(AwsEc2TransitGatewayResourceType, resource.FlagDeepMode)
+}
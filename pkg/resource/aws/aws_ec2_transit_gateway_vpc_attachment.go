@@ -0,0 +1,20 @@
+package aws
+
+import "github.com/snyk/driftctl/pkg/resource"
+
+const AwsEc2TransitGatewayVpcAttachmentResourceType = "aws_ec2_transit_gateway_vpc_attachment"
+
+func initAwsEc2TransitGatewayVpcAttachmentMetaData(resourceSchemaRepository resource.SchemaRepositoryInterface) {
+	resourceSchemaRepository.SetHumanReadableAttributesFunc(AwsEc2TransitGatewayVpcAttachmentResourceType, func(res *resource.Resource) map[string]string {
+		val := res.Attrs
+		attrs := make(map[string]string)
+		if gatewayID := val.GetString("transit_gateway_id"); gatewayID != nil && *gatewayID != "" {
+			attrs["Gateway"] = *gatewayID
+		}
+		if vpcID := val.GetString("vpc_id"); vpcID != nil && *vpcID != "" {
+			attrs["VPC"] = *vpcID
+		}
+		return attrs
+	})
+	resourceSchemaRepository.SetFlags(AwsEc2TransitGatewayVpcAttachmentResourceType, resource.FlagDeepMode)
+}
@@ -0,0 +1,16 @@
+package aws
+
+import "github.com/snyk/driftctl/pkg/resource"
+
+const AwsEcsClusterResourceType = "aws_ecs_cluster"
+
+func initAwsEcsClusterMetaData(resourceSchemaRepository resource.SchemaRepositoryInterface) {
+	resourceSchemaRepository.SetHumanReadableAttributesFunc(AwsEcsClusterResourceType, func(res *resource.Resource) map[string]string {
+		attrs := make(map[string]string)
+		if name := res.Attributes().GetString("name"); name != nil && *name != "" {
+			attrs["Name"] = *name
+		}
+		return attrs
+	})
+	resourceSchemaRepository.SetFlags(AwsEcsClusterResourceType, resource.FlagDeepMode)
+}
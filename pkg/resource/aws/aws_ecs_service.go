@@ -0,0 +1,28 @@
+package aws
+
+import "github.com/snyk/driftctl/pkg/resource"
+
+const AwsEcsServiceResourceType = "aws_ecs_service"
+
+func initAwsEcsServiceMetaData(resourceSchemaRepository resource.SchemaRepositoryInterface) {
+	resourceSchemaRepository.SetResolveReadAttributesFunc(AwsEcsServiceResourceType, func(res *resource.Resource) map[string]string {
+		return map[string]string{
+			"cluster": *res.Attributes().GetString("cluster"),
+		}
+	})
+	resourceSchemaRepository.SetNormalizeFunc(AwsEcsServiceResourceType, func(res *resource.Resource) {
+		val := res.Attrs
+		val.SafeDelete([]string{"timeouts"})
+		// Only used by Terraform when applying, never returned by the API
+		val.SafeDelete([]string{"force_new_deployment"})
+		val.SafeDelete([]string{"wait_for_steady_state"})
+	})
+	resourceSchemaRepository.SetHumanReadableAttributesFunc(AwsEcsServiceResourceType, func(res *resource.Resource) map[string]string {
+		attrs := make(map[string]string)
+		if name := res.Attributes().GetString("name"); name != nil && *name != "" {
+			attrs["Name"] = *name
+		}
+		return attrs
+	})
+	resourceSchemaRepository.SetFlags(AwsEcsServiceResourceType, resource.FlagDeepMode)
+}
@@ -0,0 +1,31 @@
+package aws
+
+import (
+	"github.com/snyk/driftctl/pkg/helpers"
+	"github.com/snyk/driftctl/pkg/resource"
+)
+
+const AwsEcsTaskDefinitionResourceType = "aws_ecs_task_definition"
+
+func initAwsEcsTaskDefinitionMetaData(resourceSchemaRepository resource.SchemaRepositoryInterface) {
+	// Task definitions are identified by their family but read through the ARN of a revision
+	resourceSchemaRepository.SetResolveReadAttributesFunc(AwsEcsTaskDefinitionResourceType, func(res *resource.Resource) map[string]string {
+		return map[string]string{
+			"arn": *res.Attributes().GetString("arn"),
+		}
+	})
+	resourceSchemaRepository.UpdateSchema(AwsEcsTaskDefinitionResourceType, map[string]func(attributeSchema *resource.AttributeSchema){
+		"container_definitions": func(attributeSchema *resource.AttributeSchema) {
+			attributeSchema.JsonString = true
+		},
+	})
+	resourceSchemaRepository.SetNormalizeFunc(AwsEcsTaskDefinitionResourceType, func(res *resource.Resource) {
+		val := res.Attrs
+		jsonString, err := helpers.NormalizeJsonString((*val)["container_definitions"])
+		if err != nil {
+			return
+		}
+		_ = val.SafeSet([]string{"container_definitions"}, jsonString)
+	})
+	resourceSchemaRepository.SetFlags(AwsEcsTaskDefinitionResourceType, resource.FlagDeepMode)
+}
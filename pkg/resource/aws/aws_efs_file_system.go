@@ -0,0 +1,19 @@
+package aws
+
+import "github.com/snyk/driftctl/pkg/resource"
+
+const AwsEfsFileSystemResourceType = "aws_efs_file_system"
+
+func initAwsEfsFileSystemMetaData(resourceSchemaRepository resource.SchemaRepositoryInterface) {
+	resourceSchemaRepository.SetHumanReadableAttributesFunc(AwsEfsFileSystemResourceType, func(res *resource.Resource) map[string]string {
+		val := res.Attrs
+		attrs := make(map[string]string)
+		if tags := val.GetMap("tags"); tags != nil {
+			if name, ok := tags["Name"]; ok {
+				attrs["Name"] = name.(string)
+			}
+		}
+		return attrs
+	})
+	resourceSchemaRepository.SetFlags(AwsEfsFileSystemResourceType, resource.FlagDeepMode)
+}
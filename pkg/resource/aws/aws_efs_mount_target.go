@@ -0,0 +1,20 @@
+package aws
+
+import "github.com/snyk/driftctl/pkg/resource"
+
+const AwsEfsMountTargetResourceType = "aws_efs_mount_target"
+
+func initAwsEfsMountTargetMetaData(resourceSchemaRepository resource.SchemaRepositoryInterface) {
+	resourceSchemaRepository.SetHumanReadableAttributesFunc(AwsEfsMountTargetResourceType, func(res *resource.Resource) map[string]string {
+		val := res.Attrs
+		attrs := make(map[string]string)
+		if fileSystemID := val.GetString("file_system_id"); fileSystemID != nil && *fileSystemID != "" {
+			attrs["FileSystem"] = *fileSystemID
+		}
+		if subnetID := val.GetString("subnet_id"); subnetID != nil && *subnetID != "" {
+			attrs["Subnet"] = *subnetID
+		}
+		return attrs
+	})
+	resourceSchemaRepository.SetFlags(AwsEfsMountTargetResourceType, resource.FlagDeepMode)
+}
@@ -0,0 +1,26 @@
+package aws
+
+import "github.com/snyk/driftctl/pkg/resource"
+
+const AwsEksAddonResourceType = "aws_eks_addon"
+
+func initAwsEksAddonMetaData(resourceSchemaRepository resource.SchemaRepositoryInterface) {
+	resourceSchemaRepository.SetNormalizeFunc(AwsEksAddonResourceType, func(res *resource.Resource) {
+		val := res.Attrs
+		val.SafeDelete([]string{"timeouts"})
+		// Only used by Terraform when applying, never returned by the API
+		val.SafeDelete([]string{"resolve_conflicts"})
+	})
+	resourceSchemaRepository.SetHumanReadableAttributesFunc(AwsEksAddonResourceType, func(res *resource.Resource) map[string]string {
+		val := res.Attrs
+		attrs := make(map[string]string)
+		if cluster := val.GetString("cluster_name"); cluster != nil && *cluster != "" {
+			attrs["Cluster"] = *cluster
+		}
+		if name := val.GetString("addon_name"); name != nil && *name != "" {
+			attrs["Name"] = *name
+		}
+		return attrs
+	})
+	resourceSchemaRepository.SetFlags(AwsEksAddonResourceType, resource.FlagDeepMode)
+}
@@ -0,0 +1,13 @@
+package aws
+
+import "github.com/snyk/driftctl/pkg/resource"
+
+const AwsEksClusterResourceType = "aws_eks_cluster"
+
+func initAwsEksClusterMetaData(resourceSchemaRepository resource.SchemaRepositoryInterface) {
+	resourceSchemaRepository.SetNormalizeFunc(AwsEksClusterResourceType, func(res *resource.Resource) {
+		val := res.Attrs
+		val.SafeDelete([]string{"timeouts"})
+	})
+	resourceSchemaRepository.SetFlags(AwsEksClusterResourceType, resource.FlagDeepMode)
+}
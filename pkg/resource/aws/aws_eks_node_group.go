@@ -0,0 +1,24 @@
+package aws
+
+import "github.com/snyk/driftctl/pkg/resource"
+
+const AwsEksNodeGroupResourceType = "aws_eks_node_group"
+
+func initAwsEksNodeGroupMetaData(resourceSchemaRepository resource.SchemaRepositoryInterface) {
+	resourceSchemaRepository.SetNormalizeFunc(AwsEksNodeGroupResourceType, func(res *resource.Resource) {
+		val := res.Attrs
+		val.SafeDelete([]string{"timeouts"})
+	})
+	resourceSchemaRepository.SetHumanReadableAttributesFunc(AwsEksNodeGroupResourceType, func(res *resource.Resource) map[string]string {
+		val := res.Attrs
+		attrs := make(map[string]string)
+		if cluster := val.GetString("cluster_name"); cluster != nil && *cluster != "" {
+			attrs["Cluster"] = *cluster
+		}
+		if name := val.GetString("node_group_name"); name != nil && *name != "" {
+			attrs["Name"] = *name
+		}
+		return attrs
+	})
+	resourceSchemaRepository.SetFlags(AwsEksNodeGroupResourceType, resource.FlagDeepMode)
+}
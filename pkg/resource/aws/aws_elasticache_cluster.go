@@ -0,0 +1,13 @@
+package aws
+
+import "github.com/snyk/driftctl/pkg/resource"
+
+const AwsElasticacheClusterResourceType = "aws_elasticache_cluster"
+
+func initAwsElasticacheClusterMetaData(resourceSchemaRepository resource.SchemaRepositoryInterface) {
+	resourceSchemaRepository.SetNormalizeFunc(AwsElasticacheClusterResourceType, func(res *resource.Resource) {
+		val := res.Attrs
+		val.SafeDelete([]string{"apply_immediately"})
+	})
+	resourceSchemaRepository.SetFlags(AwsElasticacheClusterResourceType, resource.FlagDeepMode)
+}
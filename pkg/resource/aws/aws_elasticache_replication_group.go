@@ -0,0 +1,16 @@
+package aws
+
+import "github.com/snyk/driftctl/pkg/resource"
+
+const AwsElasticacheReplicationGroupResourceType = "aws_elasticache_replication_group"
+
+func initAwsElasticacheReplicationGroupMetaData(resourceSchemaRepository resource.SchemaRepositoryInterface) {
+	resourceSchemaRepository.SetNormalizeFunc(AwsElasticacheReplicationGroupResourceType, func(res *resource.Resource) {
+		val := res.Attrs
+		val.SafeDelete([]string{"apply_immediately"})
+		// The auth token is never returned by AWS
+		val.SafeDelete([]string{"auth_token"})
+		val.SafeDelete([]string{"timeouts"})
+	})
+	resourceSchemaRepository.SetFlags(AwsElasticacheReplicationGroupResourceType, resource.FlagDeepMode)
+}
@@ -0,0 +1,48 @@
+package aws
+
+import (
+	"github.com/snyk/driftctl/pkg/helpers"
+	"github.com/snyk/driftctl/pkg/resource"
+)
+
+const AwsElasticsearchDomainResourceType = "aws_elasticsearch_domain"
+
+func initAwsElasticsearchDomainMetaData(resourceSchemaRepository resource.SchemaRepositoryInterface) {
+	// The ID of a domain is its ARN but the provider reads it from its name
+	resourceSchemaRepository.SetResolveReadAttributesFunc(AwsElasticsearchDomainResourceType, func(res *resource.Resource) map[string]string {
+		return map[string]string{
+			"domain_name": *res.Attributes().GetString("domain_name"),
+		}
+	})
+	resourceSchemaRepository.UpdateSchema(AwsElasticsearchDomainResourceType, map[string]func(attributeSchema *resource.AttributeSchema){
+		"access_policies": func(attributeSchema *resource.AttributeSchema) {
+			attributeSchema.JsonString = true
+		},
+	})
+	resourceSchemaRepository.SetNormalizeFunc(AwsElasticsearchDomainResourceType, func(res *resource.Resource) {
+		val := res.Attrs
+		val.SafeDelete([]string{"timeouts"})
+		// The master user password is never returned by AWS and must not end up in diffs
+		if securityOptions, ok := (*val)["advanced_security_options"].([]interface{}); ok {
+			for _, options := range securityOptions {
+				if options, ok := options.(map[string]interface{}); ok {
+					delete(options, "master_user_options")
+				}
+			}
+		}
+		jsonString, err := helpers.NormalizeJsonString((*val)["access_policies"])
+		if err != nil {
+			return
+		}
+		_ = val.SafeSet([]string{"access_policies"}, jsonString)
+	})
+	resourceSchemaRepository.SetHumanReadableAttributesFunc(AwsElasticsearchDomainResourceType, func(res *resource.Resource) map[string]string {
+		val := res.Attrs
+		attrs := make(map[string]string)
+		if name := val.GetString("domain_name"); name != nil && *name != "" {
+			attrs["Name"] = *name
+		}
+		return attrs
+	})
+	resourceSchemaRepository.SetFlags(AwsElasticsearchDomainResourceType, resource.FlagDeepMode)
+}
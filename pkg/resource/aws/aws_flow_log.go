@@ -0,0 +1,9 @@
+package aws
+
+import "github.com/snyk/driftctl/pkg/resource"
+
+const AwsFlowLogResourceType = "aws_flow_log"
+
+func initAwsFlowLogMetaData(resourceSchemaRepository resource.SchemaRepositoryInterface) {
+	resourceSchemaRepository.SetFlags(AwsFlowLogResourceType, resource.FlagDeepMode)
+}
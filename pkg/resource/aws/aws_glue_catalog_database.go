@@ -0,0 +1,17 @@
+package aws
+
+import "github.com/snyk/driftctl/pkg/resource"
+
+const AwsGlueCatalogDatabaseResourceType = "aws_glue_catalog_database"
+
+func initAwsGlueCatalogDatabaseMetaData(resourceSchemaRepository resource.SchemaRepositoryInterface) {
+	resourceSchemaRepository.SetHumanReadableAttributesFunc(AwsGlueCatalogDatabaseResourceType, func(res *resource.Resource) map[string]string {
+		val := res.Attrs
+		attrs := make(map[string]string)
+		if name := val.GetString("name"); name != nil && *name != "" {
+			attrs["Name"] = *name
+		}
+		return attrs
+	})
+	resourceSchemaRepository.SetFlags(AwsGlueCatalogDatabaseResourceType, resource.FlagDeepMode)
+}
@@ -0,0 +1,25 @@
+package aws
+
+import (
+	"github.com/snyk/driftctl/pkg/helpers"
+	"github.com/snyk/driftctl/pkg/resource"
+)
+
+const AwsGlueCrawlerResourceType = "aws_glue_crawler"
+
+func initAwsGlueCrawlerMetaData(resourceSchemaRepository resource.SchemaRepositoryInterface) {
+	resourceSchemaRepository.UpdateSchema(AwsGlueCrawlerResourceType, map[string]func(attributeSchema *resource.AttributeSchema){
+		"configuration": func(attributeSchema *resource.AttributeSchema) {
+			attributeSchema.JsonString = true
+		},
+	})
+	resourceSchemaRepository.SetNormalizeFunc(AwsGlueCrawlerResourceType, func(res *resource.Resource) {
+		val := res.Attrs
+		jsonString, err := helpers.NormalizeJsonString((*val)["configuration"])
+		if err != nil {
+			return
+		}
+		_ = val.SafeSet([]string{"configuration"}, jsonString)
+	})
+	resourceSchemaRepository.SetFlags(AwsGlueCrawlerResourceType, resource.FlagDeepMode)
+}
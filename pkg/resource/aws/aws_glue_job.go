@@ -0,0 +1,9 @@
+package aws
+
+import "github.com/snyk/driftctl/pkg/resource"
+
+const AwsGlueJobResourceType = "aws_glue_job"
+
+func initAwsGlueJobMetaData(resourceSchemaRepository resource.SchemaRepositoryInterface) {
+	resourceSchemaRepository.SetFlags(AwsGlueJobResourceType, resource.FlagDeepMode)
+}
@@ -0,0 +1,9 @@
+package aws
+
+import "github.com/snyk/driftctl/pkg/resource"
+
+const AwsGuarddutyDetectorResourceType = "aws_guardduty_detector"
+
+func initAwsGuarddutyDetectorMetaData(resourceSchemaRepository resource.SchemaRepositoryInterface) {
+	resourceSchemaRepository.SetFlags(AwsGuarddutyDetectorResourceType, resource.FlagDeepMode)
+}
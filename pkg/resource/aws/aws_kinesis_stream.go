@@ -0,0 +1,28 @@
+package aws
+
+import "github.com/snyk/driftctl/pkg/resource"
+
+const AwsKinesisStreamResourceType = "aws_kinesis_stream"
+
+func initAwsKinesisStreamMetaData(resourceSchemaRepository resource.SchemaRepositoryInterface) {
+	// The ID of a stream is its ARN but the provider reads it from its name
+	resourceSchemaRepository.SetResolveReadAttributesFunc(AwsKinesisStreamResourceType, func(res *resource.Resource) map[string]string {
+		return map[string]string{
+			"name": *res.Attributes().GetString("name"),
+		}
+	})
+	resourceSchemaRepository.SetNormalizeFunc(AwsKinesisStreamResourceType, func(res *resource.Resource) {
+		val := res.Attrs
+		val.SafeDelete([]string{"enforce_consumer_deletion"})
+		val.SafeDelete([]string{"timeouts"})
+	})
+	resourceSchemaRepository.SetHumanReadableAttributesFunc(AwsKinesisStreamResourceType, func(res *resource.Resource) map[string]string {
+		val := res.Attrs
+		attrs := make(map[string]string)
+		if name := val.GetString("name"); name != nil && *name != "" {
+			attrs["Name"] = *name
+		}
+		return attrs
+	})
+	resourceSchemaRepository.SetFlags(AwsKinesisStreamResourceType, resource.FlagDeepMode)
+}
@@ -0,0 +1,42 @@
+package aws
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/snyk/driftctl/pkg/resource"
+)
+
+const AwsKmsGrantResourceType = "aws_kms_grant"
+
+func initAwsKmsGrantMetaData(resourceSchemaRepository resource.SchemaRepositoryInterface) {
+	resourceSchemaRepository.SetNormalizeFunc(AwsKmsGrantResourceType, func(res *resource.Resource) {
+		val := res.Attrs
+		// The grant token is only returned when the grant is created
+		val.SafeDelete([]string{"grant_token"})
+		val.SafeDelete([]string{"grant_creation_tokens"})
+		val.SafeDelete([]string{"retire_on_delete"})
+
+		// The ID of a grant is made of the key ID as written in the configuration, which could be the key ARN.
+		// Grants are listed by key ID, we rewrite the ID to be able to match them.
+		keyId := val.GetString("key_id")
+		grantId := val.GetString("grant_id")
+		if keyId == nil || grantId == nil || !strings.HasPrefix(*keyId, "arn:") {
+			return
+		}
+		if i := strings.LastIndex(*keyId, "key/"); i != -1 {
+			_ = val.SafeSet([]string{"key_id"}, (*keyId)[i+len("key/"):])
+		}
+		res.Id = fmt.Sprintf("%s:%s", *val.GetString("key_id"), *grantId)
+		_ = val.SafeSet([]string{"id"}, res.Id)
+	})
+	resourceSchemaRepository.SetHumanReadableAttributesFunc(AwsKmsGrantResourceType, func(res *resource.Resource) map[string]string {
+		val := res.Attrs
+		attrs := make(map[string]string)
+		if name := val.GetString("name"); name != nil && *name != "" {
+			attrs["Name"] = *name
+		}
+		return attrs
+	})
+	resourceSchemaRepository.SetFlags(AwsKmsGrantResourceType, resource.FlagDeepMode)
+}
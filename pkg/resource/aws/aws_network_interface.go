@@ -0,0 +1,14 @@
+package aws
+
+import "github.com/snyk/driftctl/pkg/resource"
+
+const AwsNetworkInterfaceResourceType = "aws_network_interface"
+
+func initAwsNetworkInterfaceMetaData(resourceSchemaRepository resource.SchemaRepositoryInterface) {
+	resourceSchemaRepository.SetNormalizeFunc(AwsNetworkInterfaceResourceType, func(res *resource.Resource) {
+		val := res.Attrs
+		// The attachment of an interface is managed by aws_network_interface_attachment or by the instance
+		val.SafeDelete([]string{"attachment"})
+	})
+	resourceSchemaRepository.SetFlags(AwsNetworkInterfaceResourceType, resource.FlagDeepMode)
+}
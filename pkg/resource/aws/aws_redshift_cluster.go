@@ -0,0 +1,20 @@
+package aws
+
+import "github.com/snyk/driftctl/pkg/resource"
+
+const AwsRedshiftClusterResourceType = "aws_redshift_cluster"
+
+func initAwsRedshiftClusterMetaData(resourceSchemaRepository resource.SchemaRepositoryInterface) {
+	resourceSchemaRepository.SetNormalizeFunc(AwsRedshiftClusterResourceType, func(res *resource.Resource) {
+		val := res.Attrs
+		// The master password is never returned by AWS
+		val.SafeDelete([]string{"master_password"})
+		val.SafeDelete([]string{"skip_final_snapshot"})
+		val.SafeDelete([]string{"final_snapshot_identifier"})
+		val.SafeDelete([]string{"snapshot_identifier"})
+		val.SafeDelete([]string{"snapshot_cluster_identifier"})
+		val.SafeDelete([]string{"owner_account"})
+		val.SafeDelete([]string{"timeouts"})
+	})
+	resourceSchemaRepository.SetFlags(AwsRedshiftClusterResourceType, resource.FlagDeepMode)
+}
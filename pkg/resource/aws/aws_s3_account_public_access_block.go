@@ -0,0 +1,9 @@
+package aws
+
+import "github.com/snyk/driftctl/pkg/resource"
+
+const AwsS3AccountPublicAccessBlockResourceType = "aws_s3_account_public_access_block"
+
+func initAwsS3AccountPublicAccessBlockMetaData(resourceSchemaRepository resource.SchemaRepositoryInterface) {
+	resourceSchemaRepository.SetFlags(AwsS3AccountPublicAccessBlockResourceType, resource.FlagDeepMode)
+}
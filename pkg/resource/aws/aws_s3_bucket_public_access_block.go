@@ -0,0 +1,14 @@
+package aws
+
+import "github.com/snyk/driftctl/pkg/resource"
+
+const AwsS3BucketPublicAccessBlockResourceType = "aws_s3_bucket_public_access_block"
+
+func initAwsS3BucketPublicAccessBlockMetaData(resourceSchemaRepository resource.SchemaRepositoryInterface) {
+	resourceSchemaRepository.SetResolveReadAttributesFunc(AwsS3BucketPublicAccessBlockResourceType, func(res *resource.Resource) map[string]string {
+		return map[string]string{
+			"alias": *res.Attributes().GetString("region"),
+		}
+	})
+	resourceSchemaRepository.SetFlags(AwsS3BucketPublicAccessBlockResourceType, resource.FlagDeepMode)
+}
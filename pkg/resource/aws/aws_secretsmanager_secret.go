@@ -0,0 +1,37 @@
+package aws
+
+import (
+	"github.com/snyk/driftctl/pkg/helpers"
+	"github.com/snyk/driftctl/pkg/resource"
+)
+
+const AwsSecretsManagerSecretResourceType = "aws_secretsmanager_secret"
+
+// Only the metadata of a secret is read, its value is held by aws_secretsmanager_secret_version which is not supported
+func initAwsSecretsManagerSecretMetaData(resourceSchemaRepository resource.SchemaRepositoryInterface) {
+	resourceSchemaRepository.UpdateSchema(AwsSecretsManagerSecretResourceType, map[string]func(attributeSchema *resource.AttributeSchema){
+		"policy": func(attributeSchema *resource.AttributeSchema) {
+			attributeSchema.JsonString = true
+		},
+	})
+	resourceSchemaRepository.SetNormalizeFunc(AwsSecretsManagerSecretResourceType, func(res *resource.Resource) {
+		val := res.Attrs
+		val.SafeDelete([]string{"name_prefix"})
+		val.SafeDelete([]string{"recovery_window_in_days"})
+		val.SafeDelete([]string{"force_overwrite_replica_secret"})
+		jsonString, err := helpers.NormalizeJsonString((*val)["policy"])
+		if err != nil {
+			return
+		}
+		_ = val.SafeSet([]string{"policy"}, jsonString)
+	})
+	resourceSchemaRepository.SetHumanReadableAttributesFunc(AwsSecretsManagerSecretResourceType, func(res *resource.Resource) map[string]string {
+		val := res.Attrs
+		attrs := make(map[string]string)
+		if name := val.GetString("name"); name != nil && *name != "" {
+			attrs["Name"] = *name
+		}
+		return attrs
+	})
+	resourceSchemaRepository.SetFlags(AwsSecretsManagerSecretResourceType, resource.FlagDeepMode)
+}
@@ -0,0 +1,31 @@
+package aws
+
+import (
+	"github.com/snyk/driftctl/pkg/helpers"
+	"github.com/snyk/driftctl/pkg/resource"
+)
+
+const AwsSecretsManagerSecretPolicyResourceType = "aws_secretsmanager_secret_policy"
+
+func initAwsSecretsManagerSecretPolicyMetaData(resourceSchemaRepository resource.SchemaRepositoryInterface) {
+	resourceSchemaRepository.SetResolveReadAttributesFunc(AwsSecretsManagerSecretPolicyResourceType, func(res *resource.Resource) map[string]string {
+		return map[string]string{
+			"secret_arn": res.ResourceId(),
+		}
+	})
+	resourceSchemaRepository.UpdateSchema(AwsSecretsManagerSecretPolicyResourceType, map[string]func(attributeSchema *resource.AttributeSchema){
+		"policy": func(attributeSchema *resource.AttributeSchema) {
+			attributeSchema.JsonString = true
+		},
+	})
+	resourceSchemaRepository.SetNormalizeFunc(AwsSecretsManagerSecretPolicyResourceType, func(res *resource.Resource) {
+		val := res.Attrs
+		val.SafeDelete([]string{"block_public_policy"})
+		jsonString, err := helpers.NormalizeJsonString((*val)["policy"])
+		if err != nil {
+			return
+		}
+		_ = val.SafeSet([]string{"policy"}, jsonString)
+	})
+	resourceSchemaRepository.SetFlags(AwsSecretsManagerSecretPolicyResourceType, resource.FlagDeepMode)
+}
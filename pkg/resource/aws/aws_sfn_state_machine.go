@@ -0,0 +1,33 @@
+package aws
+
+import (
+	"github.com/snyk/driftctl/pkg/helpers"
+	"github.com/snyk/driftctl/pkg/resource"
+)
+
+const AwsSfnStateMachineResourceType = "aws_sfn_state_machine"
+
+func initAwsSfnStateMachineMetaData(resourceSchemaRepository resource.SchemaRepositoryInterface) {
+	resourceSchemaRepository.UpdateSchema(AwsSfnStateMachineResourceType, map[string]func(attributeSchema *resource.AttributeSchema){
+		"definition": func(attributeSchema *resource.AttributeSchema) {
+			attributeSchema.JsonString = true
+		},
+	})
+	resourceSchemaRepository.SetNormalizeFunc(AwsSfnStateMachineResourceType, func(res *resource.Resource) {
+		val := res.Attrs
+		jsonString, err := helpers.NormalizeJsonString((*val)["definition"])
+		if err != nil {
+			return
+		}
+		_ = val.SafeSet([]string{"definition"}, jsonString)
+	})
+	resourceSchemaRepository.SetHumanReadableAttributesFunc(AwsSfnStateMachineResourceType, func(res *resource.Resource) map[string]string {
+		val := res.Attrs
+		attrs := make(map[string]string)
+		if name := val.GetString("name"); name != nil && *name != "" {
+			attrs["Name"] = *name
+		}
+		return attrs
+	})
+	resourceSchemaRepository.SetFlags(AwsSfnStateMachineResourceType, resource.FlagDeepMode)
+}
@@ -0,0 +1,29 @@
+package aws
+
+import "github.com/snyk/driftctl/pkg/resource"
+
+const AwsSsmParameterResourceType = "aws_ssm_parameter"
+
+// Reading a parameter with the provider would decrypt its value, so parameters are never read in deep mode.
+// Their metadata is described while listing them and compared to the state without the value.
+func initAwsSsmParameterMetaData(resourceSchemaRepository resource.SchemaRepositoryInterface) {
+	resourceSchemaRepository.SetNormalizeFunc(AwsSsmParameterResourceType, func(res *resource.Resource) {
+		val := res.Attrs
+		val.SafeDelete([]string{"value"})
+		val.SafeDelete([]string{"insecure_value"})
+		val.SafeDelete([]string{"overwrite"})
+		// Neither the ARN nor the tags are part of the described metadata
+		val.SafeDelete([]string{"arn"})
+		val.SafeDelete([]string{"tags"})
+		val.SafeDelete([]string{"tags_all"})
+	})
+	resourceSchemaRepository.SetHumanReadableAttributesFunc(AwsSsmParameterResourceType, func(res *resource.Resource) map[string]string {
+		val := res.Attrs
+		attrs := make(map[string]string)
+		if ty := val.GetString("type"); ty != nil && *ty != "" {
+			attrs["Type"] = *ty
+		}
+		return attrs
+	})
+	resourceSchemaRepository.SetFlags(AwsSsmParameterResourceType, resource.FlagDeepMode)
+}
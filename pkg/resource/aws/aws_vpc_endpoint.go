@@ -0,0 +1,35 @@
+package aws
+
+import (
+	"github.com/snyk/driftctl/pkg/helpers"
+	"github.com/snyk/driftctl/pkg/resource"
+)
+
+const AwsVpcEndpointResourceType = "aws_vpc_endpoint"
+
+func initAwsVpcEndpointMetaData(resourceSchemaRepository resource.SchemaRepositoryInterface) {
+	resourceSchemaRepository.UpdateSchema(AwsVpcEndpointResourceType, map[string]func(attributeSchema *resource.AttributeSchema){
+		"policy": func(attributeSchema *resource.AttributeSchema) {
+			attributeSchema.JsonString = true
+		},
+	})
+	resourceSchemaRepository.SetNormalizeFunc(AwsVpcEndpointResourceType, func(res *resource.Resource) {
+		val := res.Attrs
+		val.SafeDelete([]string{"timeouts"})
+		val.SafeDelete([]string{"auto_accept"})
+		jsonString, err := helpers.NormalizeJsonString((*val)["policy"])
+		if err != nil {
+			return
+		}
+		_ = val.SafeSet([]string{"policy"}, jsonString)
+	})
+	resourceSchemaRepository.SetHumanReadableAttributesFunc(AwsVpcEndpointResourceType, func(res *resource.Resource) map[string]string {
+		val := res.Attrs
+		attrs := make(map[string]string)
+		if serviceName := val.GetString("service_name"); serviceName != nil && *serviceName != "" {
+			attrs["Service"] = *serviceName
+		}
+		return attrs
+	})
+	resourceSchemaRepository.SetFlags(AwsVpcEndpointResourceType, resource.FlagDeepMode)
+}
@@ -0,0 +1,14 @@
+package aws
+
+import "github.com/snyk/driftctl/pkg/resource"
+
+const AwsVpcPeeringConnectionResourceType = "aws_vpc_peering_connection"
+
+func initAwsVpcPeeringConnectionMetaData(resourceSchemaRepository resource.SchemaRepositoryInterface) {
+	resourceSchemaRepository.SetNormalizeFunc(AwsVpcPeeringConnectionResourceType, func(res *resource.Resource) {
+		val := res.Attrs
+		val.SafeDelete([]string{"timeouts"})
+		val.SafeDelete([]string{"auto_accept"})
+	})
+	resourceSchemaRepository.SetFlags(AwsVpcPeeringConnectionResourceType, resource.FlagDeepMode)
+}
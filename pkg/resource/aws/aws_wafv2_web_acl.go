@@ -0,0 +1,31 @@
+package aws
+
+import "github.com/snyk/driftctl/pkg/resource"
+
+const AwsWafv2WebAclResourceType = "aws_wafv2_web_acl"
+
+func initAwsWafv2WebAclMetaData(resourceSchemaRepository resource.SchemaRepositoryInterface) {
+	resourceSchemaRepository.SetResolveReadAttributesFunc(AwsWafv2WebAclResourceType, func(res *resource.Resource) map[string]string {
+		return map[string]string{
+			"name":  *res.Attributes().GetString("name"),
+			"scope": *res.Attributes().GetString("scope"),
+		}
+	})
+	resourceSchemaRepository.SetNormalizeFunc(AwsWafv2WebAclResourceType, func(res *resource.Resource) {
+		val := res.Attrs
+		// The lock token changes on every update of the web ACL
+		val.SafeDelete([]string{"lock_token"})
+	})
+	resourceSchemaRepository.SetHumanReadableAttributesFunc(AwsWafv2WebAclResourceType, func(res *resource.Resource) map[string]string {
+		val := res.Attrs
+		attrs := make(map[string]string)
+		if name := val.GetString("name"); name != nil && *name != "" {
+			attrs["Name"] = *name
+		}
+		if scope := val.GetString("scope"); scope != nil && *scope != "" {
+			attrs["Scope"] = *scope
+		}
+		return attrs
+	})
+	resourceSchemaRepository.SetFlags(AwsWafv2WebAclResourceType, resource.FlagDeepMode)
+}
@@ -3,6 +3,8 @@ package aws
 import (
 	"testing"
 
+	"github.com/hashicorp/terraform/configs/configschema"
+	"github.com/hashicorp/terraform/providers"
 	"github.com/snyk/driftctl/pkg/resource"
 	tf "github.com/snyk/driftctl/pkg/terraform"
 	testresource "github.com/snyk/driftctl/test/resource"
@@ -12,6 +14,7 @@ import (
 func TestAWS_Metadata_Flags(t *testing.T) {
 	testcases := map[string][]resource.Flags{
 		AwsAmiResourceType:                             {resource.FlagDeepMode},
+		AwsAcmCertificateResourceType:                  {resource.FlagDeepMode},
 		AwsApiGatewayAccountResourceType:               {},
 		AwsApiGatewayApiKeyResourceType:                {},
 		AwsApiGatewayAuthorizerResourceType:            {},
@@ -47,7 +50,15 @@ func TestAWS_Metadata_Flags(t *testing.T) {
 		AwsAppAutoscalingScheduledActionResourceType:   {},
 		AwsAppAutoscalingTargetResourceType:            {resource.FlagDeepMode},
 		AwsCloudformationStackResourceType:             {resource.FlagDeepMode},
+		AwsCloudtrailResourceType:                      {resource.FlagDeepMode},
 		AwsCloudfrontDistributionResourceType:          {resource.FlagDeepMode},
+		AwsCloudwatchEventRuleResourceType:             {resource.FlagDeepMode},
+		AwsCloudwatchEventTargetResourceType:           {resource.FlagDeepMode},
+		AwsCloudwatchLogGroupResourceType:              {resource.FlagDeepMode},
+		AwsCloudwatchLogMetricFilterResourceType:       {resource.FlagDeepMode},
+		AwsCloudwatchMetricAlarmResourceType:           {resource.FlagDeepMode},
+		AwsCognitoUserPoolResourceType:                 {resource.FlagDeepMode},
+		AwsCognitoUserPoolClientResourceType:           {resource.FlagDeepMode},
 		AwsDbInstanceResourceType:                      {resource.FlagDeepMode},
 		AwsDbSubnetGroupResourceType:                   {resource.FlagDeepMode},
 		AwsDefaultNetworkACLResourceType:               {resource.FlagDeepMode},
@@ -59,9 +70,27 @@ func TestAWS_Metadata_Flags(t *testing.T) {
 		AwsEbsEncryptionByDefaultResourceType:          {resource.FlagDeepMode},
 		AwsEbsSnapshotResourceType:                     {resource.FlagDeepMode},
 		AwsEbsVolumeResourceType:                       {resource.FlagDeepMode},
+		AwsEc2TransitGatewayResourceType:               {resource.FlagDeepMode},
+		AwsEc2TransitGatewayVpcAttachmentResourceType:  {resource.FlagDeepMode},
 		AwsEcrRepositoryResourceType:                   {resource.FlagDeepMode},
+		AwsEcsClusterResourceType:                      {resource.FlagDeepMode},
+		AwsEcsServiceResourceType:                      {resource.FlagDeepMode},
+		AwsEcsTaskDefinitionResourceType:               {resource.FlagDeepMode},
+		AwsEfsFileSystemResourceType:                   {resource.FlagDeepMode},
+		AwsEfsMountTargetResourceType:                  {resource.FlagDeepMode},
+		AwsEksAddonResourceType:                        {resource.FlagDeepMode},
+		AwsEksClusterResourceType:                      {resource.FlagDeepMode},
+		AwsEksNodeGroupResourceType:                    {resource.FlagDeepMode},
+		AwsGuarddutyDetectorResourceType:               {resource.FlagDeepMode},
 		AwsEipResourceType:                             {resource.FlagDeepMode},
 		AwsEipAssociationResourceType:                  {resource.FlagDeepMode},
+		AwsElasticacheClusterResourceType:              {resource.FlagDeepMode},
+		AwsElasticacheReplicationGroupResourceType:     {resource.FlagDeepMode},
+		AwsElasticsearchDomainResourceType:             {resource.FlagDeepMode},
+		AwsFlowLogResourceType:                         {resource.FlagDeepMode},
+		AwsGlueCatalogDatabaseResourceType:             {resource.FlagDeepMode},
+		AwsGlueCrawlerResourceType:                     {resource.FlagDeepMode},
+		AwsGlueJobResourceType:                         {resource.FlagDeepMode},
 		AwsIamAccessKeyResourceType:                    {resource.FlagDeepMode},
 		AwsIamPolicyResourceType:                       {resource.FlagDeepMode},
 		AwsIamPolicyAttachmentResourceType:             {resource.FlagDeepMode},
@@ -74,15 +103,19 @@ func TestAWS_Metadata_Flags(t *testing.T) {
 		AwsIamGroupPolicyResourceType:                  {},
 		AwsInstanceResourceType:                        {resource.FlagDeepMode},
 		AwsInternetGatewayResourceType:                 {resource.FlagDeepMode},
+		AwsKinesisStreamResourceType:                   {resource.FlagDeepMode},
 		AwsKeyPairResourceType:                         {resource.FlagDeepMode},
 		AwsKmsAliasResourceType:                        {resource.FlagDeepMode},
+		AwsKmsGrantResourceType:                        {resource.FlagDeepMode},
 		AwsKmsKeyResourceType:                          {resource.FlagDeepMode},
 		AwsLambdaEventSourceMappingResourceType:        {resource.FlagDeepMode},
 		AwsLambdaFunctionResourceType:                  {resource.FlagDeepMode},
 		AwsNatGatewayResourceType:                      {resource.FlagDeepMode},
 		AwsNetworkACLResourceType:                      {resource.FlagDeepMode},
+		AwsNetworkInterfaceResourceType:                {resource.FlagDeepMode},
 		AwsRDSClusterResourceType:                      {resource.FlagDeepMode},
 		AwsRDSClusterInstanceResourceType:              {},
+		AwsRedshiftClusterResourceType:                 {resource.FlagDeepMode},
 		AwsRouteResourceType:                           {resource.FlagDeepMode},
 		AwsRoute53HealthCheckResourceType:              {resource.FlagDeepMode},
 		AwsRoute53RecordResourceType:                   {resource.FlagDeepMode},
@@ -95,14 +128,23 @@ func TestAWS_Metadata_Flags(t *testing.T) {
 		AwsS3BucketMetricResourceType:                  {resource.FlagDeepMode},
 		AwsS3BucketNotificationResourceType:            {resource.FlagDeepMode},
 		AwsS3BucketPolicyResourceType:                  {resource.FlagDeepMode},
+		AwsS3BucketPublicAccessBlockResourceType:       {resource.FlagDeepMode},
+		AwsS3AccountPublicAccessBlockResourceType:      {resource.FlagDeepMode},
+		AwsSecretsManagerSecretResourceType:            {resource.FlagDeepMode},
+		AwsSecretsManagerSecretPolicyResourceType:      {resource.FlagDeepMode},
 		AwsSecurityGroupResourceType:                   {resource.FlagDeepMode},
+		AwsSfnStateMachineResourceType:                 {resource.FlagDeepMode},
 		AwsSnsTopicResourceType:                        {resource.FlagDeepMode},
 		AwsSnsTopicPolicyResourceType:                  {resource.FlagDeepMode},
 		AwsSnsTopicSubscriptionResourceType:            {resource.FlagDeepMode},
 		AwsSqsQueueResourceType:                        {resource.FlagDeepMode},
 		AwsSqsQueuePolicyResourceType:                  {resource.FlagDeepMode},
+		AwsSsmParameterResourceType:                    {resource.FlagDeepMode},
 		AwsSubnetResourceType:                          {resource.FlagDeepMode},
 		AwsVpcResourceType:                             {resource.FlagDeepMode},
+		AwsVpcEndpointResourceType:                     {resource.FlagDeepMode},
+		AwsVpcPeeringConnectionResourceType:            {resource.FlagDeepMode},
+		AwsWafv2WebAclResourceType:                     {resource.FlagDeepMode},
 		AwsSecurityGroupRuleResourceType:               {resource.FlagDeepMode},
 		AwsNetworkACLRuleResourceType:                  {resource.FlagDeepMode},
 		AwsLaunchTemplateResourceType:                  {resource.FlagDeepMode},
@@ -130,3 +172,97 @@ func TestAWS_Metadata_Flags(t *testing.T) {
 		})
 	}
 }
+
+func TestAWS_Metadata_Normalize(t *testing.T) {
+	testcases := []struct {
+		name     string
+		ty       string
+		init     func(resource.SchemaRepositoryInterface)
+		attrs    resource.Attributes
+		expected resource.Attributes
+	}{
+		{
+			name: "cognito user pool client secret is removed",
+			ty:   AwsCognitoUserPoolClientResourceType,
+			init: initAwsCognitoUserPoolClientMetaData,
+			attrs: resource.Attributes{
+				"id":            "4f1gk1a2b3c4d5e6f7g8h9i0j1",
+				"name":          "web",
+				"client_secret": "s3cr3t",
+				"user_pool_id":  "us-east-1_AbCdEfGhI",
+			},
+			expected: resource.Attributes{
+				"id":           "4f1gk1a2b3c4d5e6f7g8h9i0j1",
+				"name":         "web",
+				"user_pool_id": "us-east-1_AbCdEfGhI",
+			},
+		},
+		{
+			name: "elasticsearch domain master user options are removed",
+			ty:   AwsElasticsearchDomainResourceType,
+			init: initAwsElasticsearchDomainMetaData,
+			attrs: resource.Attributes{
+				"id":              "arn:aws:es:us-east-1:123456789012:domain/logs",
+				"domain_name":     "logs",
+				"access_policies": "{}",
+				"advanced_security_options": []interface{}{
+					map[string]interface{}{
+						"enabled":                        true,
+						"internal_user_database_enabled": true,
+						"master_user_options": []interface{}{
+							map[string]interface{}{
+								"master_user_arn":      "",
+								"master_user_name":     "admin",
+								"master_user_password": "p4ssw0rd",
+							},
+						},
+					},
+				},
+			},
+			expected: resource.Attributes{
+				"id":              "arn:aws:es:us-east-1:123456789012:domain/logs",
+				"domain_name":     "logs",
+				"access_policies": "{}",
+				"advanced_security_options": []interface{}{
+					map[string]interface{}{
+						"enabled":                        true,
+						"internal_user_database_enabled": true,
+					},
+				},
+			},
+		},
+		{
+			name: "elasticsearch domain without advanced security options",
+			ty:   AwsElasticsearchDomainResourceType,
+			init: initAwsElasticsearchDomainMetaData,
+			attrs: resource.Attributes{
+				"id":                        "arn:aws:es:us-east-1:123456789012:domain/logs",
+				"domain_name":               "logs",
+				"access_policies":           "{}",
+				"advanced_security_options": nil,
+			},
+			expected: resource.Attributes{
+				"id":                        "arn:aws:es:us-east-1:123456789012:domain/logs",
+				"domain_name":               "logs",
+				"access_policies":           "{}",
+				"advanced_security_options": nil,
+			},
+		},
+	}
+
+	for _, c := range testcases {
+		t.Run(c.name, func(tt *testing.T) {
+			schemaRepository := resource.NewSchemaRepository()
+			err := schemaRepository.Init(tf.AWS, "3.19.0", map[string]providers.Schema{
+				c.ty: {Block: &configschema.Block{}},
+			})
+			assert.NoError(tt, err)
+			c.init(schemaRepository)
+
+			sch, _ := schemaRepository.GetSchema(c.ty)
+			res := &resource.Resource{Type: c.ty, Attrs: &c.attrs}
+			sch.NormalizeFunc(res)
+			assert.Equal(tt, c.expected, *res.Attrs)
+		})
+	}
+}
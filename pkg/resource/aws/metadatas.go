@@ -67,4 +67,44 @@ func InitResourcesMetadata(resourceSchemaRepository resource.SchemaRepositoryInt
 	initAwsApiGatewayV2MappingMetaData(resourceSchemaRepository)
 	initAwsEbsEncryptionByDefaultMetaData(resourceSchemaRepository)
 	initAwsLoadBalancerMetaData(resourceSchemaRepository)
+	initAwsEcsClusterMetaData(resourceSchemaRepository)
+	initAwsEcsServiceMetaData(resourceSchemaRepository)
+	initAwsEcsTaskDefinitionMetaData(resourceSchemaRepository)
+	initAwsEksClusterMetaData(resourceSchemaRepository)
+	initAwsEksNodeGroupMetaData(resourceSchemaRepository)
+	initAwsEksAddonMetaData(resourceSchemaRepository)
+	initAwsSecretsManagerSecretMetaData(resourceSchemaRepository)
+	initAwsSecretsManagerSecretPolicyMetaData(resourceSchemaRepository)
+	initAwsSsmParameterMetaData(resourceSchemaRepository)
+	initAwsKmsGrantMetaData(resourceSchemaRepository)
+	initAwsCloudwatchMetricAlarmMetaData(resourceSchemaRepository)
+	initAwsCloudwatchLogGroupMetaData(resourceSchemaRepository)
+	initAwsCloudwatchLogMetricFilterMetaData(resourceSchemaRepository)
+	initAwsCloudwatchEventRuleMetaData(resourceSchemaRepository)
+	initAwsCloudwatchEventTargetMetaData(resourceSchemaRepository)
+	initAwsVpcEndpointMetaData(resourceSchemaRepository)
+	initAwsVpcPeeringConnectionMetaData(resourceSchemaRepository)
+	initAwsEc2TransitGatewayMetaData(resourceSchemaRepository)
+	initAwsEc2TransitGatewayVpcAttachmentMetaData(resourceSchemaRepository)
+	initAwsNetworkInterfaceMetaData(resourceSchemaRepository)
+	initAwsFlowLogMetaData(resourceSchemaRepository)
+	initAwsElasticacheClusterMetaData(resourceSchemaRepository)
+	initAwsElasticacheReplicationGroupMetaData(resourceSchemaRepository)
+	initAwsEfsFileSystemMetaData(resourceSchemaRepository)
+	initAwsEfsMountTargetMetaData(resourceSchemaRepository)
+	initAwsRedshiftClusterMetaData(resourceSchemaRepository)
+	initAwsElasticsearchDomainMetaData(resourceSchemaRepository)
+	initAwsKinesisStreamMetaData(resourceSchemaRepository)
+	initAwsWafv2WebAclMetaData(resourceSchemaRepository)
+	initAwsAcmCertificateMetaData(resourceSchemaRepository)
+	initAwsS3BucketPublicAccessBlockMetaData(resourceSchemaRepository)
+	initAwsS3AccountPublicAccessBlockMetaData(resourceSchemaRepository)
+	initAwsCloudtrailMetaData(resourceSchemaRepository)
+	initAwsGuarddutyDetectorMetaData(resourceSchemaRepository)
+	initAwsSfnStateMachineMetaData(resourceSchemaRepository)
+	initAwsCognitoUserPoolMetaData(resourceSchemaRepository)
+	initAwsCognitoUserPoolClientMetaData(resourceSchemaRepository)
+	initAwsGlueJobMetaData(resourceSchemaRepository)
+	initAwsGlueCatalogDatabaseMetaData(resourceSchemaRepository)
+	initAwsGlueCrawlerMetaData(resourceSchemaRepository)
 }
@@ -0,0 +1,18 @@
+package google
+
+import "github.com/snyk/driftctl/pkg/resource"
+
+const GoogleContainerClusterResourceType = "google_container_cluster"
+
+func initGoogleContainerClusterMetadata(resourceSchemaRepository resource.SchemaRepositoryInterface) {
+	resourceSchemaRepository.SetHumanReadableAttributesFunc(GoogleContainerClusterResourceType, func(res *resource.Resource) map[string]string {
+		attrs := make(map[string]string)
+		if v := res.Attributes().GetString("name"); v != nil && *v != "" {
+			attrs["Name"] = *v
+		}
+		if v := res.Attributes().GetString("location"); v != nil && *v != "" {
+			attrs["Location"] = *v
+		}
+		return attrs
+	})
+}
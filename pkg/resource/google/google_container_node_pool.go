@@ -0,0 +1,18 @@
+package google
+
+import "github.com/snyk/driftctl/pkg/resource"
+
+const GoogleContainerNodePoolResourceType = "google_container_node_pool"
+
+func initGoogleContainerNodePoolMetadata(resourceSchemaRepository resource.SchemaRepositoryInterface) {
+	resourceSchemaRepository.SetHumanReadableAttributesFunc(GoogleContainerNodePoolResourceType, func(res *resource.Resource) map[string]string {
+		attrs := make(map[string]string)
+		if v := res.Attributes().GetString("name"); v != nil && *v != "" {
+			attrs["Name"] = *v
+		}
+		if v := res.Attributes().GetString("cluster"); v != nil && *v != "" {
+			attrs["Cluster"] = *v
+		}
+		return attrs
+	})
+}
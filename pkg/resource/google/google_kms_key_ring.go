@@ -0,0 +1,3 @@
+package google
+
+const GoogleKMSKeyRingResourceType = "google_kms_key_ring"
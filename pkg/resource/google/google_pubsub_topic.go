@@ -0,0 +1,3 @@
+package google
+
+const GooglePubsubTopicResourceType = "google_pubsub_topic"
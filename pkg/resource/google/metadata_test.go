@@ -34,6 +34,12 @@ func TestGoogle_Metadata_Flags(t *testing.T) {
 		GoogleComputeForwardingRuleResourceType:       {},
 		GoogleComputeInstanceGroupManagerResourceType: {},
 		GoogleComputeGlobalForwardingRuleResourceType: {},
+		GoogleContainerClusterResourceType:            {},
+		GoogleContainerNodePoolResourceType:           {},
+		GooglePubsubTopicResourceType:                 {},
+		GooglePubsubSubscriptionResourceType:          {},
+		GoogleKMSKeyRingResourceType:                  {},
+		GoogleKMSCryptoKeyResourceType:                {},
 	}
 
 	schemaRepository := testresource.InitFakeSchemaRepository(tf.GOOGLE, "3.78.0")
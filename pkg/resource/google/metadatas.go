@@ -19,4 +19,6 @@ func InitResourcesMetadata(resourceSchemaRepository resource.SchemaRepositoryInt
 	initGoogleComputeImageMetadata(resourceSchemaRepository)
 	initGoogleComputeHealthCheckMetadata(resourceSchemaRepository)
 	initComputeInstanceGroupManagerMetadata(resourceSchemaRepository)
+	initGoogleContainerClusterMetadata(resourceSchemaRepository)
+	initGoogleContainerNodePoolMetadata(resourceSchemaRepository)
 }
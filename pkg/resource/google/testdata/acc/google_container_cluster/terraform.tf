@@ -0,0 +1,16 @@
+provider "google" {}
+
+terraform {
+  required_version = "~> 0.15.0"
+  required_providers {
+    google = {
+      version = "3.78.0"
+    }
+  }
+}
+
+resource "google_container_cluster" "default" {
+  name               = "driftctl-unittest-cluster"
+  location           = "us-central1-a"
+  initial_node_count = 1
+}
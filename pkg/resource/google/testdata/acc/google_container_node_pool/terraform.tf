@@ -0,0 +1,28 @@
+provider "google" {}
+
+terraform {
+  required_version = "~> 0.15.0"
+  required_providers {
+    google = {
+      version = "3.78.0"
+    }
+  }
+}
+
+resource "google_container_cluster" "default" {
+  name                     = "driftctl-unittest-pool-cluster"
+  location                 = "us-central1-a"
+  remove_default_node_pool = true
+  initial_node_count       = 1
+}
+
+resource "google_container_node_pool" "default" {
+  name       = "driftctl-unittest-pool"
+  location   = "us-central1-a"
+  cluster    = google_container_cluster.default.name
+  node_count = 1
+
+  node_config {
+    machine_type = "e2-small"
+  }
+}
@@ -0,0 +1,21 @@
+provider "google" {}
+
+terraform {
+  required_version = "~> 0.15.0"
+  required_providers {
+    google = {
+      version = "3.78.0"
+    }
+  }
+}
+
+resource "random_string" "postfix" {
+  length  = 6
+  upper   = false
+  special = false
+}
+
+resource "google_kms_key_ring" "default" {
+  name     = "driftctl-unittest-keyring-${random_string.postfix.result}"
+  location = "us-central1"
+}
@@ -0,0 +1,20 @@
+provider "google" {}
+
+terraform {
+  required_version = "~> 0.15.0"
+  required_providers {
+    google = {
+      version = "3.78.0"
+    }
+  }
+}
+
+resource "google_pubsub_topic" "default" {
+  name = "driftctl-unittest-subscription-topic"
+}
+
+resource "google_pubsub_subscription" "default" {
+  name  = "driftctl-unittest-subscription-${count.index}"
+  topic = google_pubsub_topic.default.name
+  count = 2
+}
@@ -0,0 +1,15 @@
+provider "google" {}
+
+terraform {
+  required_version = "~> 0.15.0"
+  required_providers {
+    google = {
+      version = "3.78.0"
+    }
+  }
+}
+
+resource "google_pubsub_topic" "default" {
+  name  = "driftctl-unittest-topic-${count.index}"
+  count = 2
+}
@@ -7,6 +7,7 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/pkg/errors"
 )
 
@@ -20,19 +21,36 @@ type SerializableSource struct {
 	S    string `json:"source"`
 	Ns   string `json:"namespace"`
 	Name string `json:"internal_name"`
+	Key  string `json:"instance_key,omitempty"`
+	Mode string `json:"mode,omitempty"`
 }
 
 type TerraformStateSource struct {
 	State  string
 	Module string
 	Name   string
+	// Version of the state object that was read, when an older version was requested
+	Version string
+	// Lineage and Serial identify the state file, copies of a state share the same lineage
+	Lineage string
+	Serial  uint64
+	// Key is the count or for_each key of the instance formatted as in terraform addresses, e.g. [3] or ["eu-west-1"]
+	Key string
+	// Mode is TerraformDataMode for data sources and empty for managed resources
+	Mode string
 }
 
+// TerraformDataMode is the mode of data sources in terraform states and plans
+const TerraformDataMode = "data"
+
 func NewTerraformStateSource(state, module, name string) *TerraformStateSource {
-	return &TerraformStateSource{state, module, name}
+	return &TerraformStateSource{State: state, Module: module, Name: name}
 }
 
 func (s *TerraformStateSource) Source() string {
+	if s.Version != "" {
+		return fmt.Sprintf("%s@%s", s.State, s.Version)
+	}
 	return s.State
 }
 
@@ -44,12 +62,58 @@ func (s *TerraformStateSource) InternalName() string {
 	return s.Name
 }
 
+type CloudformationStackSource struct {
+	Stack     string
+	LogicalId string
+}
+
+func NewCloudformationStackSource(stack, logicalId string) *CloudformationStackSource {
+	return &CloudformationStackSource{stack, logicalId}
+}
+
+func (s *CloudformationStackSource) Source() string {
+	return s.Stack
+}
+
+func (s *CloudformationStackSource) Namespace() string {
+	return ""
+}
+
+func (s *CloudformationStackSource) InternalName() string {
+	return s.LogicalId
+}
+
+type PulumiStackSource struct {
+	Stack string
+	Urn   string
+}
+
+func NewPulumiStackSource(stack, urn string) *PulumiStackSource {
+	return &PulumiStackSource{stack, urn}
+}
+
+func (s *PulumiStackSource) Source() string {
+	return s.Stack
+}
+
+func (s *PulumiStackSource) Namespace() string {
+	return ""
+}
+
+func (s *PulumiStackSource) InternalName() string {
+	return s.Urn
+}
+
 type Resource struct {
 	Id     string
 	Type   string
 	Attrs  *Attributes
 	Sch    *Schema `json:"-" diff:"-"`
 	Source Source  `json:"-"`
+	// Account the resource was found in (a GCP project or an Azure subscription outside of AWS), only set when scanning several accounts
+	Account string `json:",omitempty"`
+	// Region the resource was found in, empty for global resources
+	Region string `json:",omitempty"`
 }
 
 func (r *Resource) Schema() *Schema {
@@ -76,10 +140,56 @@ func (r *Resource) SourceString() string {
 	if r.Source == nil {
 		return ""
 	}
-	if r.Source.Namespace() == "" {
-		return fmt.Sprintf("%s.%s", r.ResourceType(), r.Source.InternalName())
+	// URNs already contain the resource type
+	if _, isPulumi := r.Source.(*PulumiStackSource); isPulumi {
+		return r.Source.InternalName()
+	}
+	address := fmt.Sprintf("%s.%s", r.ResourceType(), r.Source.InternalName())
+	s, isTerraform := r.Source.(*TerraformStateSource)
+	if isTerraform && s.Mode == TerraformDataMode {
+		address = fmt.Sprintf("data.%s", address)
+	}
+	if r.Source.Namespace() != "" {
+		address = fmt.Sprintf("%s.%s", r.Source.Namespace(), address)
+	}
+	if isTerraform {
+		address += s.Key
+	}
+	return address
+}
+
+// Scope returns the account and region of a resource, IaC resources usually only know them through their ARN,
+// GCP resources are scoped by the project they belong to
+func (r *Resource) Scope() (string, string) {
+	account, region := r.Account, r.Region
+	if r.Attributes() == nil {
+		return account, region
+	}
+	if strings.HasPrefix(r.Type, "google_") {
+		if project, isString := (*r.Attributes())["project"].(string); isString && account == "" {
+			account = project
+		}
+		return account, region
+	}
+	value, exists := r.Attributes().Get("arn")
+	if !exists {
+		return account, region
 	}
-	return fmt.Sprintf("%s.%s.%s", r.Source.Namespace(), r.ResourceType(), r.Source.InternalName())
+	str, isString := value.(string)
+	if !isString {
+		return account, region
+	}
+	resourceARN, err := arn.Parse(str)
+	if err != nil {
+		return account, region
+	}
+	if account == "" {
+		account = resourceARN.AccountID
+	}
+	if region == "" {
+		region = resourceARN.Region
+	}
+	return account, region
 }
 
 func (r *Resource) Equal(res *Resource) bool {
@@ -87,6 +197,14 @@ func (r *Resource) Equal(res *Resource) bool {
 		return false
 	}
 
+	// The same identifier may be used in several accounts or regions, it is only known for scanned resources
+	if r.Account != "" && res.Account != "" && r.Account != res.Account {
+		return false
+	}
+	if r.Region != "" && res.Region != "" && r.Region != res.Region {
+		return false
+	}
+
 	if r.Schema() != nil && r.Schema().DiscriminantFunc != nil {
 		return r.Schema().DiscriminantFunc(r, res)
 	}
@@ -103,6 +221,8 @@ type SerializableResource struct {
 	Type               string              `json:"type"`
 	ReadableAttributes map[string]string   `json:"human_readable_attributes,omitempty"`
 	Source             *SerializableSource `json:"source,omitempty"`
+	Account            string              `json:"account,omitempty"`
+	Region             string              `json:"region,omitempty"`
 }
 
 func NewSerializableResource(res *Resource) *SerializableResource {
@@ -113,12 +233,18 @@ func NewSerializableResource(res *Resource) *SerializableResource {
 			Ns:   res.Src().Namespace(),
 			Name: res.Src().InternalName(),
 		}
+		if s, ok := res.Src().(*TerraformStateSource); ok {
+			src.Key = s.Key
+			src.Mode = s.Mode
+		}
 	}
 	return &SerializableResource{
 		Id:                 res.ResourceId(),
 		Type:               res.ResourceType(),
 		ReadableAttributes: formatReadableAttributes(res),
 		Source:             src,
+		Account:            res.Account,
+		Region:             res.Region,
 	}
 }
 
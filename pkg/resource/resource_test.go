@@ -73,3 +73,151 @@ func TestSanitizeDefaults(t *testing.T) {
 		})
 	}
 }
+
+func TestResource_SourceString(t *testing.T) {
+	tests := []struct {
+		name string
+		res  *Resource
+		want string
+	}{
+		{
+			name: "without source",
+			res:  &Resource{Type: "aws_s3_bucket"},
+			want: "",
+		},
+		{
+			name: "from terraform state root module",
+			res:  &Resource{Type: "aws_s3_bucket", Source: NewTerraformStateSource("tfstate://terraform.tfstate", "", "bucket")},
+			want: "aws_s3_bucket.bucket",
+		},
+		{
+			name: "from terraform state child module",
+			res:  &Resource{Type: "aws_s3_bucket", Source: NewTerraformStateSource("tfstate://terraform.tfstate", "module.s3", "bucket")},
+			want: "module.s3.aws_s3_bucket.bucket",
+		},
+		{
+			name: "from terraform state with count",
+			res:  &Resource{Type: "aws_s3_bucket", Source: &TerraformStateSource{State: "tfstate://terraform.tfstate", Module: "module.s3", Name: "bucket", Key: "[3]"}},
+			want: "module.s3.aws_s3_bucket.bucket[3]",
+		},
+		{
+			name: "from terraform state with for_each",
+			res:  &Resource{Type: "aws_s3_bucket", Source: &TerraformStateSource{State: "tfstate://terraform.tfstate", Name: "bucket", Key: `["eu-west-1"]`}},
+			want: `aws_s3_bucket.bucket["eu-west-1"]`,
+		},
+		{
+			name: "from terraform state data source",
+			res:  &Resource{Type: "aws_ami", Source: &TerraformStateSource{State: "tfstate://terraform.tfstate", Module: "module.ec2", Name: "ubuntu", Key: "[0]", Mode: TerraformDataMode}},
+			want: "module.ec2.data.aws_ami.ubuntu[0]",
+		},
+		{
+			name: "from pulumi stack",
+			res:  &Resource{Type: "aws_s3_bucket", Source: NewPulumiStackSource("pulumi://website/production", "urn:pulumi:production::website::aws:s3/bucket:Bucket::assets")},
+			want: "urn:pulumi:production::website::aws:s3/bucket:Bucket::assets",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.res.SourceString())
+		})
+	}
+}
+
+func TestResource_Scope(t *testing.T) {
+	tests := []struct {
+		name        string
+		res         *Resource
+		wantAccount string
+		wantRegion  string
+	}{
+		{
+			name: "without scope",
+			res:  &Resource{Attrs: &Attributes{"name": "users"}},
+		},
+		{
+			name:        "scanned resource",
+			res:         &Resource{Account: "111111111111", Region: "eu-west-1"},
+			wantAccount: "111111111111",
+			wantRegion:  "eu-west-1",
+		},
+		{
+			name:        "from ARN",
+			res:         &Resource{Attrs: &Attributes{"arn": "arn:aws:dynamodb:us-east-1:222222222222:table/users"}},
+			wantAccount: "222222222222",
+			wantRegion:  "us-east-1",
+		},
+		{
+			name:        "from global ARN",
+			res:         &Resource{Attrs: &Attributes{"arn": "arn:aws:iam::222222222222:role/admin"}},
+			wantAccount: "222222222222",
+		},
+		{
+			name: "from invalid ARN",
+			res:  &Resource{Attrs: &Attributes{"arn": "users"}},
+		},
+		{
+			name:        "from GCP project",
+			res:         &Resource{Type: "google_storage_bucket", Attrs: &Attributes{"project": "driftctl-qa-1"}},
+			wantAccount: "driftctl-qa-1",
+		},
+		{
+			name:        "scanned GCP resource",
+			res:         &Resource{Type: "google_storage_bucket", Account: "driftctl-qa-2", Attrs: &Attributes{"project": "driftctl-qa-1"}},
+			wantAccount: "driftctl-qa-2",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			account, region := tt.res.Scope()
+			assert.Equal(t, tt.wantAccount, account)
+			assert.Equal(t, tt.wantRegion, region)
+		})
+	}
+}
+
+func TestResource_Equal(t *testing.T) {
+	tests := []struct {
+		name  string
+		left  *Resource
+		right *Resource
+		want  bool
+	}{
+		{
+			name:  "same identifier",
+			left:  &Resource{Id: "users", Type: "aws_dynamodb_table"},
+			right: &Resource{Id: "users", Type: "aws_dynamodb_table", Account: "111111111111", Region: "eu-west-1"},
+			want:  true,
+		},
+		{
+			name:  "different type",
+			left:  &Resource{Id: "users", Type: "aws_dynamodb_table"},
+			right: &Resource{Id: "users", Type: "aws_iam_user"},
+			want:  false,
+		},
+		{
+			name:  "different account",
+			left:  &Resource{Id: "users", Type: "aws_dynamodb_table", Account: "222222222222", Region: "eu-west-1"},
+			right: &Resource{Id: "users", Type: "aws_dynamodb_table", Account: "111111111111", Region: "eu-west-1"},
+			want:  false,
+		},
+		{
+			name:  "different region",
+			left:  &Resource{Id: "users", Type: "aws_dynamodb_table", Region: "us-east-1"},
+			right: &Resource{Id: "users", Type: "aws_dynamodb_table", Account: "111111111111", Region: "eu-west-1"},
+			want:  false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.left.Equal(tt.right))
+		})
+	}
+}
+
+func TestTerraformStateSource_Source(t *testing.T) {
+	source := NewTerraformStateSource("tfstate+s3://bucket/terraform.tfstate", "", "bucket")
+	assert.Equal(t, "tfstate+s3://bucket/terraform.tfstate", source.Source())
+
+	source.Version = "3HL4kqtJlcpXroDTDmJ+rmSpXd3dIbrHY"
+	assert.Equal(t, "tfstate+s3://bucket/terraform.tfstate@3HL4kqtJlcpXroDTDmJ+rmSpXd3dIbrHY", source.Source())
+}
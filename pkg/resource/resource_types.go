@@ -3,10 +3,23 @@ package resource
 type ResourceType string
 
 var supportedTypes = map[string]ResourceTypeMeta{
+	"aws_acm_certificate":         {},
 	"aws_ami":                     {},
 	"aws_cloudfront_distribution": {},
-	"aws_db_instance":             {},
-	"aws_db_subnet_group":         {},
+	"aws_cloudtrail":              {},
+	"aws_cloudwatch_event_rule": {children: []ResourceType{
+		"aws_cloudwatch_event_target",
+	}},
+	"aws_cloudwatch_event_target":      {},
+	"aws_cloudwatch_log_group":         {},
+	"aws_cloudwatch_log_metric_filter": {},
+	"aws_cloudwatch_metric_alarm":      {},
+	"aws_cognito_user_pool": {children: []ResourceType{
+		"aws_cognito_user_pool_client",
+	}},
+	"aws_cognito_user_pool_client": {},
+	"aws_db_instance":              {},
+	"aws_db_subnet_group":          {},
 	"aws_default_network_acl": {children: []ResourceType{
 		"aws_network_acl_rule",
 	}},
@@ -27,16 +40,40 @@ var supportedTypes = map[string]ResourceTypeMeta{
 	"aws_alb": {children: []ResourceType{
 		"aws_lb",
 	}},
-	"aws_lb":                        {},
-	"aws_ebs_encryption_by_default": {},
-	"aws_ecr_repository":            {},
+	"aws_lb":                                 {},
+	"aws_ebs_encryption_by_default":          {},
+	"aws_ec2_transit_gateway":                {},
+	"aws_ec2_transit_gateway_vpc_attachment": {},
+	"aws_ecr_repository":                     {},
+	"aws_ecs_cluster":                        {},
+	"aws_ecs_service":                        {},
+	"aws_ecs_task_definition":                {},
+	"aws_eks_addon":                          {},
+	"aws_eks_cluster": {children: []ResourceType{
+		// The security group created by EKS for a cluster is ignored in middleware when the cluster is managed
+		"aws_security_group",
+		"aws_security_group_rule",
+	}},
+	"aws_eks_node_group": {},
+	"aws_efs_file_system": {children: []ResourceType{
+		"aws_efs_mount_target",
+	}},
+	"aws_efs_mount_target": {},
 	"aws_eip": {children: []ResourceType{
 		"aws_eip_association",
 	}},
-	"aws_eip_association":       {},
-	"aws_iam_access_key":        {},
-	"aws_iam_policy":            {},
-	"aws_iam_policy_attachment": {},
+	"aws_eip_association":               {},
+	"aws_elasticache_cluster":           {},
+	"aws_elasticache_replication_group": {},
+	"aws_elasticsearch_domain":          {},
+	"aws_flow_log":                      {},
+	"aws_glue_catalog_database":         {},
+	"aws_glue_crawler":                  {},
+	"aws_glue_job":                      {},
+	"aws_guardduty_detector":            {},
+	"aws_iam_access_key":                {},
+	"aws_iam_policy":                    {},
+	"aws_iam_policy_attachment":         {},
 	"aws_iam_role": {children: []ResourceType{
 		"aws_iam_role_policy",
 		"aws_iam_policy_attachment",
@@ -64,8 +101,10 @@ var supportedTypes = map[string]ResourceTypeMeta{
 		// This is used to determine internet gateway default rule
 		"aws_route",
 	}},
+	"aws_kinesis_stream":              {},
 	"aws_key_pair":                    {},
 	"aws_kms_alias":                   {},
+	"aws_kms_grant":                   {},
 	"aws_kms_key":                     {},
 	"aws_lambda_event_source_mapping": {},
 	"aws_lambda_function":             {},
@@ -74,6 +113,8 @@ var supportedTypes = map[string]ResourceTypeMeta{
 		"aws_network_acl_rule",
 	}},
 	"aws_network_acl_rule":     {},
+	"aws_network_interface":    {},
+	"aws_redshift_cluster":     {},
 	"aws_route":                {},
 	"aws_route53_health_check": {},
 	"aws_route53_record":       {},
@@ -81,7 +122,8 @@ var supportedTypes = map[string]ResourceTypeMeta{
 	"aws_route_table": {children: []ResourceType{
 		"aws_route",
 	}},
-	"aws_route_table_association": {},
+	"aws_route_table_association":        {},
+	"aws_s3_account_public_access_block": {},
 	"aws_s3_bucket": {children: []ResourceType{
 		"aws_s3_bucket_policy",
 	}},
@@ -90,10 +132,16 @@ var supportedTypes = map[string]ResourceTypeMeta{
 	"aws_s3_bucket_metric":                  {},
 	"aws_s3_bucket_notification":            {},
 	"aws_s3_bucket_policy":                  {},
+	"aws_s3_bucket_public_access_block":     {},
+	"aws_secretsmanager_secret": {children: []ResourceType{
+		"aws_secretsmanager_secret_policy",
+	}},
+	"aws_secretsmanager_secret_policy": {},
 	"aws_security_group": {children: []ResourceType{
 		"aws_security_group_rule",
 	}},
 	"aws_security_group_rule": {},
+	"aws_sfn_state_machine":   {},
 	"aws_sns_topic": {children: []ResourceType{
 		"aws_sns_topic_policy",
 	}},
@@ -102,11 +150,15 @@ var supportedTypes = map[string]ResourceTypeMeta{
 	"aws_sqs_queue": {children: []ResourceType{
 		"aws_sqs_queue_policy",
 	}},
-	"aws_sqs_queue_policy":     {},
-	"aws_subnet":               {},
-	"aws_vpc":                  {},
-	"aws_rds_cluster":          {},
-	"aws_cloudformation_stack": {},
+	"aws_sqs_queue_policy":       {},
+	"aws_ssm_parameter":          {},
+	"aws_subnet":                 {},
+	"aws_vpc":                    {},
+	"aws_vpc_endpoint":           {},
+	"aws_vpc_peering_connection": {},
+	"aws_wafv2_web_acl":          {},
+	"aws_rds_cluster":            {},
+	"aws_cloudformation_stack":   {},
 	"aws_api_gateway_rest_api": {children: []ResourceType{
 		"aws_api_gateway_resource",
 		"aws_api_gateway_rest_api_policy",
@@ -207,6 +259,12 @@ var supportedTypes = map[string]ResourceTypeMeta{
 	"google_compute_forwarding_rule":        {},
 	"google_compute_instance_group_manager": {},
 	"google_compute_global_forwarding_rule": {},
+	"google_container_cluster":              {},
+	"google_container_node_pool":            {},
+	"google_pubsub_topic":                   {},
+	"google_pubsub_subscription":            {},
+	"google_kms_key_ring":                   {},
+	"google_kms_crypto_key":                 {},
 
 	"azurerm_storage_account":   {},
 	"azurerm_storage_container": {},
@@ -11,6 +11,12 @@ type IaCSupplier interface {
 	SourceCount() uint
 }
 
+// DataSourceSupplier supply the data sources read along with IaC resources,
+// they are reported as is and never compared with remote resources
+type DataSourceSupplier interface {
+	DataSources() []*Resource
+}
+
 type StoppableSupplier interface {
 	Supplier
 	Stop()
@@ -0,0 +1,7 @@
+package aws
+
+import "github.com/aws/aws-sdk-go/service/cloudtrail/cloudtrailiface"
+
+type FakeCloudTrail interface {
+	cloudtrailiface.CloudTrailAPI
+}
@@ -0,0 +1,7 @@
+package aws
+
+import "github.com/aws/aws-sdk-go/service/cognitoidentityprovider/cognitoidentityprovideriface"
+
+type FakeCognitoIdentityProvider interface {
+	cognitoidentityprovideriface.CognitoIdentityProviderAPI
+}
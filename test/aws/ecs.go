@@ -0,0 +1,7 @@
+package aws
+
+import "github.com/aws/aws-sdk-go/service/ecs/ecsiface"
+
+type FakeECS interface {
+	ecsiface.ECSAPI
+}
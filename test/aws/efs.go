@@ -0,0 +1,7 @@
+package aws
+
+import "github.com/aws/aws-sdk-go/service/efs/efsiface"
+
+type FakeEFS interface {
+	efsiface.EFSAPI
+}
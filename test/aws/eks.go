@@ -0,0 +1,7 @@
+package aws
+
+import "github.com/aws/aws-sdk-go/service/eks/eksiface"
+
+type FakeEKS interface {
+	eksiface.EKSAPI
+}
@@ -0,0 +1,7 @@
+package aws
+
+import "github.com/aws/aws-sdk-go/service/elasticsearchservice/elasticsearchserviceiface"
+
+type FakeElasticsearchService interface {
+	elasticsearchserviceiface.ElasticsearchServiceAPI
+}
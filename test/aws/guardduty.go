@@ -0,0 +1,7 @@
+package aws
+
+import "github.com/aws/aws-sdk-go/service/guardduty/guarddutyiface"
+
+type FakeGuardDuty interface {
+	guarddutyiface.GuardDutyAPI
+}
@@ -0,0 +1,7 @@
+package aws
+
+import "github.com/aws/aws-sdk-go/service/kinesis/kinesisiface"
+
+type FakeKinesis interface {
+	kinesisiface.KinesisAPI
+}
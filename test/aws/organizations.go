@@ -0,0 +1,7 @@
+package aws
+
+import "github.com/aws/aws-sdk-go/service/organizations/organizationsiface"
+
+type FakeOrganizations interface {
+	organizationsiface.OrganizationsAPI
+}
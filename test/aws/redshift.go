@@ -0,0 +1,7 @@
+package aws
+
+import "github.com/aws/aws-sdk-go/service/redshift/redshiftiface"
+
+type FakeRedshift interface {
+	redshiftiface.RedshiftAPI
+}
@@ -0,0 +1,7 @@
+package aws
+
+import "github.com/aws/aws-sdk-go/service/s3control/s3controliface"
+
+type FakeS3Control interface {
+	s3controliface.S3ControlAPI
+}
@@ -0,0 +1,7 @@
+package aws
+
+import "github.com/aws/aws-sdk-go/service/ssm/ssmiface"
+
+type FakeSSM interface {
+	ssmiface.SSMAPI
+}
@@ -0,0 +1,7 @@
+package aws
+
+import "github.com/aws/aws-sdk-go/service/sts/stsiface"
+
+type FakeSTS interface {
+	stsiface.STSAPI
+}
@@ -0,0 +1,7 @@
+package aws
+
+import "github.com/aws/aws-sdk-go/service/wafv2/wafv2iface"
+
+type FakeWAFV2 interface {
+	wafv2iface.WAFV2API
+}
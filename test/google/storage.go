@@ -8,6 +8,7 @@ import (
 	"os"
 
 	"cloud.google.com/go/storage"
+	"google.golang.org/api/option"
 )
 
 type FakeStorageServer struct {
@@ -16,7 +17,7 @@ type FakeStorageServer struct {
 
 func (s *FakeStorageServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	for path, handler := range s.routes {
-		if path == r.RequestURI {
+		if path == r.RequestURI || path == r.URL.Path {
 			handler(w, r)
 			return
 		}
@@ -36,7 +37,8 @@ func newStorageClient(fakeServer *FakeStorageServer) (*storage.Client, *httptest
 
 	// Create a client.
 	ctx := context.Background()
-	client, err := storage.NewClient(ctx)
+	// Objects listing relies on the JSON API which does not honor the emulator host
+	client, err := storage.NewClient(ctx, option.WithEndpoint(ts.URL+"/storage/v1/"))
 	if err != nil {
 		return nil, nil, err
 	}